@@ -0,0 +1,278 @@
+// File: accesslog/accesslog.go
+/*
+Package accesslog turns web server and load balancer access logs into raw
+URLs, for replaying production traffic.
+
+Parsers exist for the nginx/Apache combined format, AWS Application Load
+Balancer logs and JSON logs with a configurable field mapping. Each one
+extracts the request line, host and timestamp of a line; the request-target
+is kept byte for byte once the log format's own escaping is undone. Stream
+runs a parser over a whole log and delivers entries and unparseable lines
+on separate channels.
+*/
+package accesslog
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/slicingmelon/go-rawurlparser"
+)
+
+var ErrInvalidLine = errors.New("invalid log line")
+
+// maxLineSize is the longest log line Stream accepts
+const maxLineSize = 1 << 20
+
+// Entry is a single request taken from a log line
+type Entry struct {
+	URL        *rawurlparser.RawURL // Request URL, see rawurlparser.FromRequestTarget
+	Method     string
+	Target     string    // Request-target as logged, after unescaping
+	Proto      string    // e.g. "HTTP/1.1", empty when the log does not record it
+	Host       string    // Host the request was sent to, empty when unknown
+	Time       time.Time // Zero when the log does not record it
+	Status     int       // Response status, 0 when unknown
+	RemoteAddr string
+	UserAgent  string
+	Line       int    // Line number in the log, set by Stream
+	Raw        string // The log line
+}
+
+// Parser parses a single log line
+type Parser interface {
+	ParseLine(line string) (*Entry, error)
+}
+
+// LineError is a log line that could not be parsed
+type LineError struct {
+	Line int    // Line number, starting at 1
+	Text string // The log line
+	Err  error
+}
+
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// Stream parses r line by line with p. Entries are sent on the first channel
+// and a *LineError for every unparseable line on the second; a read error
+// ends the stream and is sent as it is. Empty lines are skipped. Both
+// channels are closed at the end of r or when ctx is cancelled. Errors are
+// queued until they are read, so entries can be drained before errs, but the
+// queue only empties once errs is read or ctx is cancelled.
+func Stream(ctx context.Context, r io.Reader, p Parser) (<-chan *Entry, <-chan error) {
+	entries := make(chan *Entry)
+	errs := make(chan error)
+	pending := make(chan error)
+	go queueErrors(ctx, pending, errs)
+
+	go func() {
+		defer close(entries)
+		defer close(pending)
+
+		sc := bufio.NewScanner(r)
+		sc.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+		for n := 1; sc.Scan(); n++ {
+			line := strings.TrimSuffix(sc.Text(), "\r")
+			if strings.TrimSpace(line) == "" {
+				continue
+			}
+
+			entry, err := p.ParseLine(line)
+			if err != nil {
+				select {
+				case pending <- &LineError{Line: n, Text: line, Err: err}:
+				case <-ctx.Done():
+					return
+				}
+				continue
+			}
+			entry.Line, entry.Raw = n, line
+			select {
+			case entries <- entry:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if err := sc.Err(); err != nil {
+			select {
+			case pending <- err:
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	return entries, errs
+}
+
+// queueErrors relays in to out without ever blocking the sender, and closes
+// out once in is closed and the queue is empty or ctx is cancelled
+func queueErrors(ctx context.Context, in <-chan error, out chan<- error) {
+	defer close(out)
+	var queue []error
+	for in != nil || len(queue) > 0 {
+		var send chan<- error
+		var next error
+		if len(queue) > 0 {
+			send, next = out, queue[0]
+		}
+		select {
+		case err, ok := <-in:
+			if !ok {
+				in = nil
+				continue
+			}
+			queue = append(queue, err)
+		case send <- next:
+			queue[0] = nil
+			queue = queue[1:]
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// newEntry splits a logged request line and builds the entry's URL
+func newEntry(scheme, host, request string) (*Entry, error) {
+	method, target, proto, err := rawurlparser.ParseRequestLine(request)
+	if err != nil {
+		// HTTP/0.9 style "GET /path" without a version
+		m, t, ok := strings.Cut(request, " ")
+		if !ok || m == "" || t == "" || strings.HasSuffix(t, " ") {
+			return nil, fmt.Errorf("%w: request %q", ErrInvalidLine, request)
+		}
+		method, target, proto = m, t, ""
+	}
+	return newEntryTarget(scheme, host, method, target, proto)
+}
+
+// newEntryTarget builds an entry from an already split request line. Without
+// a host, origin-form targets give a URL with an empty Host.
+func newEntryTarget(scheme, host, method, target, proto string) (*Entry, error) {
+	if method == "" || target == "" {
+		return nil, fmt.Errorf("%w: no request", ErrInvalidLine)
+	}
+	if scheme == "" {
+		scheme = "http"
+	}
+	u, err := rawurlparser.FromRequestTarget(scheme, host, target)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidLine, err)
+	}
+	if host == "" {
+		host = u.Host
+	}
+	return &Entry{URL: u, Method: method, Target: target, Proto: proto, Host: host}, nil
+}
+
+// splitFields splits a line at spaces. Fields in double quotes or square
+// brackets may contain spaces; quoted fields are returned without the
+// quotes, backslash escapes are left for the caller.
+func splitFields(line string) ([]string, error) {
+	var fields []string
+	for i := 0; i < len(line); {
+		switch line[i] {
+		case ' ', '\t':
+			i++
+		case '"':
+			j := i + 1
+			for ; j < len(line) && line[j] != '"'; j++ {
+				if line[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(line) {
+				return nil, fmt.Errorf("%w: unterminated quote", ErrInvalidLine)
+			}
+			fields = append(fields, line[i+1:j])
+			i = j + 1
+		case '[':
+			j := strings.IndexByte(line[i:], ']')
+			if j == -1 {
+				return nil, fmt.Errorf("%w: unterminated bracket", ErrInvalidLine)
+			}
+			fields = append(fields, line[i+1:i+j])
+			i += j + 1
+		default:
+			j := strings.IndexAny(line[i:], " \t")
+			if j == -1 {
+				j = len(line) - i
+			}
+			fields = append(fields, line[i:i+j])
+			i += j
+		}
+	}
+	return fields, nil
+}
+
+// unescapeLog undoes the escaping nginx and Apache apply to logged strings:
+// \xHH, \" and \\, plus \n, \r and \t
+func unescapeLog(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var buf strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' || i+1 >= len(s) {
+			buf.WriteByte(c)
+			continue
+		}
+		switch s[i+1] {
+		case 'x':
+			if i+3 < len(s) && isHex(s[i+2]) && isHex(s[i+3]) {
+				buf.WriteByte(unhex(s[i+2])<<4 | unhex(s[i+3]))
+				i += 3
+				continue
+			}
+			buf.WriteByte(c)
+		case '"', '\\':
+			buf.WriteByte(s[i+1])
+			i++
+		case 'n':
+			buf.WriteByte('\n')
+			i++
+		case 'r':
+			buf.WriteByte('\r')
+			i++
+		case 't':
+			buf.WriteByte('\t')
+			i++
+		default:
+			buf.WriteByte(c)
+		}
+	}
+	return buf.String()
+}
+
+func isHex(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+func unhex(c byte) byte {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0'
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10
+	}
+	return c - 'A' + 10
+}
+
+// orEmpty maps the "-" placeholder for missing values to ""
+func orEmpty(s string) string {
+	if s == "-" {
+		return ""
+	}
+	return s
+}
@@ -0,0 +1,142 @@
+package accesslog
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStream(t *testing.T) {
+	log := strings.Join([]string{
+		`10.0.0.1 - - [01/May/2024:10:00:00 +0000] "GET /a/..;/b HTTP/1.1" 200 12 "-" "curl/8.0"`,
+		``,
+		`garbage`,
+		`10.0.0.2 - - [01/May/2024:10:00:01 +0000] "GET /%2e%2e/c?x=1 HTTP/1.1" 404 0 "-" "-"` + "\r",
+		`10.0.0.3 - - [01/May/2024:10:00:02 +0000] "\x16\x03\x01\x02\x00\x01" 400 150 "-" "-"`,
+	}, "\n")
+
+	entries, errs := Stream(context.Background(), strings.NewReader(log), &Combined{Host: "example.com"})
+
+	var targets []string
+	var lines []int
+	var errLines []int
+	for entries != nil || errs != nil {
+		select {
+		case e, ok := <-entries:
+			if !ok {
+				entries = nil
+				continue
+			}
+			targets = append(targets, e.URL.GetRawRequestURI())
+			lines = append(lines, e.Line)
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+			var le *LineError
+			if !errors.As(err, &le) || !errors.Is(err, ErrInvalidLine) {
+				t.Errorf("error = %v, want *LineError wrapping ErrInvalidLine", err)
+				continue
+			}
+			errLines = append(errLines, le.Line)
+		}
+	}
+
+	if got := strings.Join(targets, " "); got != "/a/..;/b /%2e%2e/c?x=1" {
+		t.Errorf("targets = %q", got)
+	}
+	if len(lines) != 2 || lines[0] != 1 || lines[1] != 4 {
+		t.Errorf("entry lines = %v, want [1 4]", lines)
+	}
+	if len(errLines) != 2 || errLines[0] != 3 || errLines[1] != 5 {
+		t.Errorf("error lines = %v, want [3 5]", errLines)
+	}
+}
+
+func TestStreamCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	line := `10.0.0.1 - - [01/May/2024:10:00:00 +0000] "GET / HTTP/1.1" 200 12 "-" "-"` + "\n"
+	entries, errs := Stream(ctx, strings.NewReader(strings.Repeat(line, 100)), &Combined{Host: "h"})
+
+	<-entries
+	cancel()
+	timeout := time.After(5 * time.Second)
+	for entries != nil || errs != nil {
+		select {
+		case _, ok := <-entries:
+			if !ok {
+				entries = nil
+			}
+		case _, ok := <-errs:
+			if !ok {
+				errs = nil
+			}
+		case <-timeout:
+			t.Fatal("channels not closed after cancel")
+		}
+	}
+}
+
+func TestStreamEntriesBeforeErrors(t *testing.T) {
+	good := `10.0.0.1 - - [01/May/2024:10:00:00 +0000] "GET / HTTP/1.1" 200 12 "-" "-"` + "\n"
+	log := strings.Repeat("garbage\n"+good, 50)
+	entries, errs := Stream(context.Background(), strings.NewReader(log), &Combined{Host: "h"})
+
+	done := make(chan int)
+	go func() {
+		n := 0
+		for range entries {
+			n++
+		}
+		for range errs {
+			n--
+		}
+		done <- n
+	}()
+	select {
+	case n := <-done:
+		if n != 0 {
+			t.Errorf("entries - errors = %d, want 0", n)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Stream blocked on unread errors")
+	}
+}
+
+func TestUnescapeLog(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{`/plain`, `/plain`},
+		{`/a\x22b\x00\xFF`, "/a\"b\x00\xff"},
+		{`/q\"x\\y`, `/q"x\y`},
+		{`/\r\n\t`, "/\r\n\t"},
+		{`/\xZZ\q\`, `/\xZZ\q\`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := unescapeLog(tt.in); got != tt.want {
+				t.Errorf("unescapeLog() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitFields(t *testing.T) {
+	got, err := splitFields(`a [b c] "d \"e\" f" "" g`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"a", "b c", `d \"e\" f`, "", "g"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("splitFields() = %q, want %q", got, want)
+	}
+	for _, bad := range []string{`a "b`, `a [b`} {
+		if _, err := splitFields(bad); !errors.Is(err, ErrInvalidLine) {
+			t.Errorf("splitFields(%q) error = %v", bad, err)
+		}
+	}
+}
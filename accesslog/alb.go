@@ -0,0 +1,60 @@
+// File: accesslog/alb.go
+package accesslog
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ALB field positions, see the AWS "Access log entries" documentation
+const (
+	albType        = 0
+	albTime        = 1
+	albClient      = 3
+	albStatus      = 8
+	albRequest     = 12
+	albUserAgent   = 13
+	albMinFields   = 14
+	albRequestNone = "- - - "
+)
+
+// ALB parses AWS Application Load Balancer access logs. The request field
+// holds the URL in absolute-form with an explicit port, which is kept:
+//
+//	https 2018-07-02T22:23:00.186641Z app/my-lb/50dc6c495c0c9188 192.168.131.39:2817 10.0.0.1:80 0.086 0.048 0.037 200 200 0 57 "GET https://www.example.com:443/ HTTP/1.1" "curl/7.46.0" ...
+//
+// Lines of requests the load balancer could not parse ("- - - ") are errors.
+type ALB struct{}
+
+// ParseLine implements Parser
+func (ALB) ParseLine(line string) (*Entry, error) {
+	fields, err := splitFields(line)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) < albMinFields {
+		return nil, fmt.Errorf("%w: %d fields, want at least %d", ErrInvalidLine, len(fields), albMinFields)
+	}
+	request := unescapeLog(fields[albRequest])
+	if request == albRequestNone {
+		return nil, fmt.Errorf("%w: request not recorded", ErrInvalidLine)
+	}
+
+	scheme := "http"
+	switch fields[albType] {
+	case "https", "h2", "grpcs", "wss":
+		scheme = "https"
+	}
+	entry, err := newEntry(scheme, "", request)
+	if err != nil {
+		return nil, err
+	}
+	if entry.Time, err = time.Parse(time.RFC3339Nano, fields[albTime]); err != nil {
+		return nil, fmt.Errorf("%w: time %q", ErrInvalidLine, fields[albTime])
+	}
+	entry.Status, _ = strconv.Atoi(fields[albStatus])
+	entry.RemoteAddr = fields[albClient]
+	entry.UserAgent = orEmpty(unescapeLog(fields[albUserAgent]))
+	return entry, nil
+}
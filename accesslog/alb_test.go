@@ -0,0 +1,71 @@
+package accesslog
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestALBParseLine(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		wantURL string
+		wantURI string
+		wantUA  string
+	}{
+		{
+			name:    "https",
+			line:    `https 2018-07-02T22:23:00.186641Z app/my-loadbalancer/50dc6c495c0c9188 192.168.131.39:2817 10.0.0.1:80 0.086 0.048 0.037 200 200 0 57 "GET https://www.example.com:443/admin/..;/x?y=%zz HTTP/1.1" "curl/7.46.0" ECDHE-RSA-AES128-GCM-SHA256 TLSv1.2 arn:aws:elasticloadbalancing:us-east-2:123456789012:targetgroup/my-targets/73e2d6bc24d8a067 "Root=1-58337281-1d84f3d73c47ec4e58577259" "www.example.com" "arn:aws:acm:us-east-2:123456789012:certificate/12345678-1234-1234-1234-123456789012" 1 2018-07-02T22:22:48.364000Z "authenticate,forward" "-" "-" "10.0.0.1:80" "200" "-" "-"`,
+			wantURL: "https://www.example.com:443/admin/..;/x?y=%zz",
+			wantURI: "/admin/..;/x?y=%zz",
+			wantUA:  "curl/7.46.0",
+		},
+		{
+			name:    "h2 with space in user agent",
+			line:    `h2 2018-07-02T22:23:00.186641Z app/my-loadbalancer/50dc6c495c0c9188 10.0.1.252:48160 10.0.0.66:9000 0.000 0.002 0.000 200 200 5 257 "GET https://10.0.2.105:773/%2e%2e/ HTTP/2.0" "Mozilla/5.0 (X11; Linux)" ECDHE-RSA-AES128-GCM-SHA256 TLSv1.2`,
+			wantURL: "https://10.0.2.105:773/%2e%2e/",
+			wantURI: "/%2e%2e/",
+			wantUA:  "Mozilla/5.0 (X11; Linux)",
+		},
+		{
+			name:    "http",
+			line:    `http 2018-07-02T22:23:00.186641Z app/lb/1 192.168.131.39:2817 - 0.000 0.001 0.000 200 200 34 366 "GET http://www.example.com:80/ HTTP/1.1" "-" - -`,
+			wantURL: "http://www.example.com:80/",
+			wantURI: "/",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := ALB{}.ParseLine(tt.line)
+			if err != nil {
+				t.Fatalf("ParseLine() error = %v", err)
+			}
+			if got := e.URL.String(); got != tt.wantURL {
+				t.Errorf("URL = %q, want %q", got, tt.wantURL)
+			}
+			if got := e.URL.GetRawRequestURI(); got != tt.wantURI {
+				t.Errorf("GetRawRequestURI() = %q, want %q", got, tt.wantURI)
+			}
+			if e.UserAgent != tt.wantUA || e.Status != 200 || e.RemoteAddr == "" {
+				t.Errorf("UserAgent, Status, RemoteAddr = %q, %d, %q", e.UserAgent, e.Status, e.RemoteAddr)
+			}
+			if !e.Time.Equal(time.Date(2018, 7, 2, 22, 23, 0, 186641000, time.UTC)) {
+				t.Errorf("Time = %v", e.Time)
+			}
+		})
+	}
+}
+
+func TestALBParseLineErrors(t *testing.T) {
+	for _, line := range []string{
+		`http 2018-07-02T22:23:00.186641Z app/lb/1 1.2.3.4:1 - -1 -1 -1 400 - 0 0 "- - - " "-" - -`,
+		`http 2018-07-02T22:23:00.186641Z app/lb/1 1.2.3.4:1 - 0 0 0 200`,
+		`http not-a-time app/lb/1 1.2.3.4:1 - 0 0 0 200 200 0 0 "GET http://a:80/ HTTP/1.1" "-"`,
+	} {
+		if _, err := (ALB{}).ParseLine(line); !errors.Is(err, ErrInvalidLine) {
+			t.Errorf("ParseLine(%q) error = %v, want ErrInvalidLine", line, err)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// File: accesslog/combined.go
+package accesslog
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// combinedTimeLayout is the $time_local / %t format
+const combinedTimeLayout = "02/Jan/2006:15:04:05 -0700"
+
+// Combined parses the nginx and Apache combined log format:
+//
+//	127.0.0.1 - frank [10/Oct/2000:13:55:36 -0700] "GET /a.gif HTTP/1.0" 200 2326 "http://ref/" "Mozilla/4.08"
+//
+// The common format (without referer and user agent) is accepted as well.
+// Combined logs do not record the host, so origin-form targets need Host,
+// or VHost for Apache's vhost_combined format, which starts with "%v:%p";
+// without either their URLs have an empty Host.
+type Combined struct {
+	Scheme string // Scheme of the URLs, "http" when empty
+	Host   string // Host for origin-form targets
+	VHost  bool   // Lines start with the virtual host and port
+}
+
+// ParseLine implements Parser
+func (c *Combined) ParseLine(line string) (*Entry, error) {
+	fields, err := splitFields(line)
+	if err != nil {
+		return nil, err
+	}
+	host := c.Host
+	if c.VHost {
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("%w: no virtual host", ErrInvalidLine)
+		}
+		host, fields = fields[0], fields[1:]
+	}
+	if len(fields) < 7 {
+		return nil, fmt.Errorf("%w: %d fields, want at least 7", ErrInvalidLine, len(fields))
+	}
+
+	entry, err := newEntry(c.Scheme, host, unescapeLog(fields[4]))
+	if err != nil {
+		return nil, err
+	}
+	if entry.Time, err = time.Parse(combinedTimeLayout, fields[3]); err != nil {
+		return nil, fmt.Errorf("%w: time %q", ErrInvalidLine, fields[3])
+	}
+	entry.Status, _ = strconv.Atoi(fields[5])
+	entry.RemoteAddr = fields[0]
+	if len(fields) > 8 {
+		entry.UserAgent = orEmpty(unescapeLog(fields[8]))
+	}
+	return entry, nil
+}
@@ -0,0 +1,113 @@
+package accesslog
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCombinedParseLine(t *testing.T) {
+	tests := []struct {
+		name      string
+		parser    *Combined
+		line      string
+		wantURL   string
+		wantHost  string
+		wantProto string
+		wantAgent string
+	}{
+		{
+			name:      "nginx combined",
+			parser:    &Combined{Host: "example.com", Scheme: "https"},
+			line:      `203.0.113.7 - alice [10/Oct/2000:13:55:36 -0700] "GET /admin/..;/users?id=1 HTTP/1.1" 200 2326 "http://ref/" "Mozilla/5.0 (X11)"`,
+			wantURL:   "https://example.com/admin/..;/users?id=1",
+			wantHost:  "example.com",
+			wantProto: "HTTP/1.1",
+			wantAgent: "Mozilla/5.0 (X11)",
+		},
+		{
+			name:      "escaped bytes",
+			parser:    &Combined{Host: "example.com"},
+			line:      `203.0.113.7 - - [10/Oct/2000:13:55:36 -0700] "GET /a\x22b/\xC3\xA9 c HTTP/1.0" 400 0 "-" "-"`,
+			wantURL:   "http://example.com/a\"b/é c",
+			wantHost:  "example.com",
+			wantProto: "HTTP/1.0",
+		},
+		{
+			name:      "common format, absolute-form",
+			parser:    &Combined{},
+			line:      `203.0.113.7 - - [10/Oct/2000:13:55:36 -0700] "GET http://target.com:8080/%2e%2e/ HTTP/1.1" 200 0`,
+			wantURL:   "http://target.com:8080/%2e%2e/",
+			wantHost:  "target.com:8080",
+			wantProto: "HTTP/1.1",
+		},
+		{
+			name:      "apache vhost_combined",
+			parser:    &Combined{VHost: true},
+			line:      `www.example.com:80 203.0.113.7 - - [10/Oct/2000:13:55:36 -0700] "POST /login HTTP/1.1" 302 0 "-" "agent"`,
+			wantURL:   "http://www.example.com:80/login",
+			wantHost:  "www.example.com:80",
+			wantProto: "HTTP/1.1",
+			wantAgent: "agent",
+		},
+		{
+			name:     "no protocol",
+			parser:   &Combined{Host: "h"},
+			line:     `1.2.3.4 - - [10/Oct/2000:13:55:36 -0700] "GET /old" 200 0 "-" "-"`,
+			wantURL:  "http://h/old",
+			wantHost: "h",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := tt.parser.ParseLine(tt.line)
+			if err != nil {
+				t.Fatalf("ParseLine() error = %v", err)
+			}
+			if got := e.URL.String(); got != tt.wantURL {
+				t.Errorf("URL = %q, want %q", got, tt.wantURL)
+			}
+			if e.Host != tt.wantHost || e.Proto != tt.wantProto || e.UserAgent != tt.wantAgent {
+				t.Errorf("Host, Proto, UserAgent = %q, %q, %q", e.Host, e.Proto, e.UserAgent)
+			}
+			if !e.Time.Equal(time.Date(2000, 10, 10, 20, 55, 36, 0, time.UTC)) {
+				t.Errorf("Time = %v", e.Time)
+			}
+			if e.RemoteAddr == "" || e.Status == 0 {
+				t.Errorf("RemoteAddr, Status = %q, %d", e.RemoteAddr, e.Status)
+			}
+		})
+	}
+}
+
+func TestCombinedParseLineErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+	}{
+		{"too few fields", `1.2.3.4 - - [10/Oct/2000:13:55:36 -0700] "GET / HTTP/1.1"`},
+		{"no request", `1.2.3.4 - - [10/Oct/2000:13:55:36 -0700] "-" 400 0 "-" "-"`},
+		{"bad time", `1.2.3.4 - - [yesterday] "GET / HTTP/1.1" 200 0 "-" "-"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Combined{Host: "h"}
+			if _, err := p.ParseLine(tt.line); !errors.Is(err, ErrInvalidLine) {
+				t.Errorf("ParseLine() error = %v, want ErrInvalidLine", err)
+			}
+		})
+	}
+}
+
+func TestCombinedWithoutHost(t *testing.T) {
+	p := &Combined{}
+	e, err := p.ParseLine(`1.2.3.4 - - [10/Oct/2000:13:55:36 -0700] "GET /a?b HTTP/1.1" 200 0 "-" "-"`)
+	if err != nil {
+		t.Fatalf("ParseLine() error = %v", err)
+	}
+	if e.Host != "" || e.URL.Host != "" || e.URL.Path != "/a" || e.URL.GetRawRequestURI() != "/a?b" {
+		t.Errorf("entry = %+v, URL = %+v", e, e.URL)
+	}
+}
@@ -0,0 +1,163 @@
+// File: accesslog/json.go
+package accesslog
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// JSONFields maps entry fields to keys of a JSON log object. Keys may be
+// dotted paths into nested objects, e.g. "request.uri". Empty keys are not
+// read. Either Request or Method and Target must be set.
+type JSONFields struct {
+	Request    string // Full request line, "GET /path HTTP/1.1"
+	Method     string
+	Target     string // Request-target, e.g. nginx $request_uri
+	Proto      string
+	Host       string
+	Scheme     string
+	Time       string
+	TimeLayout string // time.Parse layout, "unix" for (fractional) epoch seconds; RFC 3339 when empty
+	Status     string
+	RemoteAddr string
+	UserAgent  string
+}
+
+// DefaultJSONFields matches an nginx log_format with escape=json that is
+// named after the variables it logs:
+//
+//	{"time":"$time_iso8601","remote_addr":"$remote_addr","scheme":"$scheme","host":"$host",
+//	 "request":"$request","status":$status,"http_user_agent":"$http_user_agent"}
+func DefaultJSONFields() JSONFields {
+	return JSONFields{
+		Request:    "request",
+		Host:       "host",
+		Scheme:     "scheme",
+		Time:       "time",
+		Status:     "status",
+		RemoteAddr: "remote_addr",
+		UserAgent:  "http_user_agent",
+	}
+}
+
+// JSON parses logs with one JSON object per line. Values are decoded as JSON
+// strings, so invalid UTF-8 in a logged target becomes U+FFFD. Caddy's logs,
+// for example, are read with
+//
+//	JSONFields{Method: "request.method", Target: "request.uri", Proto: "request.proto",
+//	           Host: "request.host", Time: "ts", TimeLayout: "unix", Status: "status",
+//	           RemoteAddr: "request.remote_ip"}
+type JSON struct {
+	Fields JSONFields
+	Scheme string // Scheme when the log has none, "http" when empty
+	Host   string // Host when the log has none
+}
+
+// NewJSON returns a JSON parser using DefaultJSONFields
+func NewJSON() *JSON {
+	return &JSON{Fields: DefaultJSONFields()}
+}
+
+// ParseLine implements Parser
+func (p *JSON) ParseLine(line string) (*Entry, error) {
+	dec := json.NewDecoder(strings.NewReader(line))
+	dec.UseNumber()
+	var obj map[string]any
+	if err := dec.Decode(&obj); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidLine, err)
+	}
+	f := p.Fields
+
+	scheme, host := p.Scheme, p.Host
+	if v := jsonString(obj, f.Scheme); v != "" {
+		scheme = v
+	}
+	if v := jsonString(obj, f.Host); v != "" {
+		host = v
+	}
+
+	var entry *Entry
+	var err error
+	if f.Request != "" {
+		entry, err = newEntry(scheme, host, jsonString(obj, f.Request))
+	} else {
+		entry, err = newEntryTarget(scheme, host, jsonString(obj, f.Method), jsonString(obj, f.Target), jsonString(obj, f.Proto))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if f.Time != "" {
+		if v, ok := jsonValue(obj, f.Time); ok {
+			if entry.Time, err = jsonTime(v, f.TimeLayout); err != nil {
+				return nil, fmt.Errorf("%w: %s: %v", ErrInvalidLine, f.Time, err)
+			}
+		}
+	}
+	entry.Status, _ = strconv.Atoi(jsonString(obj, f.Status))
+	entry.RemoteAddr = jsonString(obj, f.RemoteAddr)
+	entry.UserAgent = jsonString(obj, f.UserAgent)
+	return entry, nil
+}
+
+// jsonValue looks up a dotted key, trying the whole key first so keys that
+// contain dots themselves are found too
+func jsonValue(obj map[string]any, key string) (any, bool) {
+	if key == "" {
+		return nil, false
+	}
+	if v, ok := obj[key]; ok {
+		return v, true
+	}
+	head, rest, ok := strings.Cut(key, ".")
+	if !ok {
+		return nil, false
+	}
+	inner, ok := obj[head].(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	return jsonValue(inner, rest)
+}
+
+// jsonString returns a string or number value as a string, "" otherwise
+func jsonString(obj map[string]any, key string) string {
+	v, _ := jsonValue(obj, key)
+	switch v := v.(type) {
+	case string:
+		return orEmpty(v)
+	case json.Number:
+		return v.String()
+	}
+	return ""
+}
+
+// jsonTime parses a timestamp value with the given layout
+func jsonTime(v any, layout string) (time.Time, error) {
+	var s string
+	switch v := v.(type) {
+	case string:
+		s = v
+	case json.Number:
+		s = v.String()
+	default:
+		return time.Time{}, fmt.Errorf("not a timestamp: %v", v)
+	}
+
+	switch layout {
+	case "unix":
+		secs, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		whole, frac := math.Modf(secs)
+		return time.Unix(int64(whole), int64(math.Round(frac*1e6))*1e3).UTC(), nil
+	case "":
+		layout = time.RFC3339Nano
+	}
+	return time.Parse(layout, s)
+}
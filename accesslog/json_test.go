@@ -0,0 +1,85 @@
+package accesslog
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestJSONParseLine(t *testing.T) {
+	caddy := &JSON{Fields: JSONFields{
+		Method: "request.method", Target: "request.uri", Proto: "request.proto",
+		Host: "request.host", Time: "ts", TimeLayout: "unix", Status: "status",
+		RemoteAddr: "request.remote_ip", UserAgent: "request.headers.User-Agent",
+	}, Scheme: "https"}
+
+	tests := []struct {
+		name     string
+		parser   *JSON
+		line     string
+		wantURL  string
+		wantTime time.Time
+		wantUA   string
+	}{
+		{
+			name:     "nginx defaults",
+			parser:   NewJSON(),
+			line:     `{"time":"2024-05-01T10:00:00+00:00","remote_addr":"1.2.3.4","scheme":"https","host":"example.com","request":"GET /a/..;/b?c=\"d\" HTTP/1.1","status":200,"http_user_agent":"curl/8"}`,
+			wantURL:  `https://example.com/a/..;/b?c="d"`,
+			wantTime: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+			wantUA:   "curl/8",
+		},
+		{
+			name:     "caddy nested fields",
+			parser:   caddy,
+			line:     `{"level":"info","ts":1714557600.5,"request":{"remote_ip":"1.2.3.4","proto":"HTTP/2.0","method":"GET","host":"example.com","uri":"/%2e%2e/x","headers":{"User-Agent":"ua"}},"status":404}`,
+			wantURL:  "https://example.com/%2e%2e/x",
+			wantTime: time.Date(2024, 5, 1, 10, 0, 0, 500000000, time.UTC),
+			wantUA:   "ua",
+		},
+		{
+			name:    "fallback host and dotted key",
+			parser:  &JSON{Fields: JSONFields{Request: "http.request", Status: "status"}, Host: "fallback"},
+			line:    `{"http.request":"POST /submit HTTP/1.1","status":"201"}`,
+			wantURL: "http://fallback/submit",
+		},
+		{
+			name:     "custom time layout",
+			parser:   &JSON{Fields: JSONFields{Request: "req", Host: "h", Time: "t", TimeLayout: "02/Jan/2006:15:04:05 -0700"}},
+			line:     `{"req":"GET / HTTP/1.1","h":"a","t":"01/May/2024:10:00:00 +0000"}`,
+			wantURL:  "http://a/",
+			wantTime: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := tt.parser.ParseLine(tt.line)
+			if err != nil {
+				t.Fatalf("ParseLine() error = %v", err)
+			}
+			if got := e.URL.String(); got != tt.wantURL {
+				t.Errorf("URL = %q, want %q", got, tt.wantURL)
+			}
+			if !e.Time.Equal(tt.wantTime) {
+				t.Errorf("Time = %v, want %v", e.Time, tt.wantTime)
+			}
+			if e.UserAgent != tt.wantUA {
+				t.Errorf("UserAgent = %q, want %q", e.UserAgent, tt.wantUA)
+			}
+		})
+	}
+}
+
+func TestJSONParseLineErrors(t *testing.T) {
+	for _, line := range []string{
+		`not json`,
+		`{"host":"a"}`,
+		`{"host":"a","request":"-"}`,
+		`{"host":"a","request":"GET / HTTP/1.1","time":"yesterday"}`,
+	} {
+		if _, err := NewJSON().ParseLine(line); !errors.Is(err, ErrInvalidLine) {
+			t.Errorf("ParseLine(%q) error = %v, want ErrInvalidLine", line, err)
+		}
+	}
+}
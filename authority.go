@@ -0,0 +1,204 @@
+package rawurlparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Authority parsing rules compared by AnalyzeAuthority
+const (
+	AuthorityFirstAt = "first-at" // RawURLParse: authority ends at '/', userinfo at the first '@'
+	AuthorityLastAt  = "last-at"  // RFC 3986 and net/url: authority ends at '/', '?' or '#', userinfo at the last '@'
+	AuthorityBrowser = "browser"  // WHATWG: also ends at '\', tabs and newlines removed, last '@'
+	AuthorityCurl    = "curl"     // curl: authority ends at '/', '?' or '#', userinfo at the first '@'
+)
+
+// curlForbiddenHostChars are rejected by curl's host name check
+const curlForbiddenHostChars = " \r\n\t/:#?!@{}[]\\$'\"^`*<>=;,+&()%"
+
+// AuthoritySplit is one reading of a URL's authority
+type AuthoritySplit struct {
+	Rule      string // One of the Authority* constants
+	Authority string // The authority as delimited by this rule
+	Userinfo  string // Everything before the chosen '@', without it
+	HasUser   bool   // Whether an '@' was found at all
+	Username  string // Userinfo up to the first ':'
+	Password  string // Userinfo after the first ':'
+	Host      string // Host as read by this rule; browsers serialize it
+	Port      string // Port as read by this rule
+	Err       error  // Set when this rule rejects the authority
+}
+
+// AuthorityAnalysis lists every plausible reading of an authority
+type AuthorityAnalysis struct {
+	Input          string
+	Splits         []AuthoritySplit
+	Ambiguous      bool // The rules that accept the input disagree on the host
+	ColonAmbiguous bool // A password or host:port contains more than one ':'
+}
+
+// Hosts returns the distinct hosts of the accepted splits, in rule order
+func (a *AuthorityAnalysis) Hosts() []string {
+	var hosts []string
+	seen := map[string]struct{}{}
+	for _, s := range a.Splits {
+		if s.Err != nil {
+			continue
+		}
+		h := comparableHost(s.Host)
+		if _, ok := seen[h]; ok {
+			continue
+		}
+		seen[h] = struct{}{}
+		hosts = append(hosts, s.Host)
+	}
+	return hosts
+}
+
+// AnalyzeAuthority splits the authority of rawURL with the rules of
+// RawURLParse, RFC 3986, browsers and curl and reports whether they end up
+// at different hosts, as with "https://a.com@b.com@c.com/" or
+// "https://evil.com\@good.com/". rawURL must contain "://".
+func AnalyzeAuthority(rawURL string) (*AuthorityAnalysis, error) {
+	i := strings.Index(rawURL, "://")
+	if i == -1 {
+		return nil, fmt.Errorf("%w: no authority in %q", ErrInvalidURL, rawURL)
+	}
+	rest := rawURL[i+3:]
+	a := &AuthorityAnalysis{Input: rawURL}
+
+	// RawURLParse itself, so the analysis never drifts from the parser
+	first := AuthoritySplit{Rule: AuthorityFirstAt, Authority: cutAny(rest, "/")}
+	if u, err := RawURLParseStrict(rawURL); err != nil {
+		first.Err = err
+	} else {
+		if u.User != nil {
+			first.HasUser = true
+			first.Username, first.Password = u.User.username, u.User.password
+			first.Userinfo = strings.TrimSuffix(GetUserInfo(u), "@")
+		}
+		first.Host, first.Port = u.Hostname, u.Port
+	}
+
+	last := splitAuthority(AuthorityLastAt, cutAny(rest, "/?#"), true)
+	last.Host, last.Port = splitHostPortLast(last.Host)
+	if !strings.HasPrefix(last.Host, "[") && strings.Contains(last.Host, ":") || !validSplitPort(last.Port) {
+		last.Err = fmt.Errorf("%w: invalid host:port %q", ErrInvalidURL, last.Host+":"+last.Port)
+	}
+
+	curl := splitAuthority(AuthorityCurl, cutAny(rest, "/?#"), false)
+	curl.Host, curl.Port = splitHostPortFirst(curl.Host)
+	if !strings.HasPrefix(curl.Host, "[") && strings.ContainsAny(curl.Host, curlForbiddenHostChars) || !validSplitPort(curl.Port) {
+		curl.Err = fmt.Errorf("%w: curl rejects host %q", ErrInvalidURL, curl.Host)
+	}
+
+	a.Splits = []AuthoritySplit{first, last, browserAuthority(rawURL), curl}
+
+	for _, s := range a.Splits {
+		if strings.Contains(s.Password, ":") || strings.Count(unbracketed(s.Authority[len(s.Userinfo):]), ":") > 1 {
+			a.ColonAmbiguous = true
+		}
+	}
+	a.Ambiguous = len(a.Hosts()) > 1
+	return a, nil
+}
+
+// browserAuthority reads the authority the way the WHATWG URL parser does
+func browserAuthority(rawURL string) AuthoritySplit {
+	s := strings.NewReplacer("\t", "", "\n", "", "\r", "").Replace(strings.TrimFunc(rawURL, func(r rune) bool { return r <= ' ' }))
+	rest := s
+	if scheme, after, ok := cutWHATWGScheme(s); ok {
+		rest = after
+		if info, known := LookupScheme(scheme); known && info.Special {
+			rest = strings.TrimLeft(rest, `/\`)
+		} else {
+			rest = strings.TrimPrefix(rest, "//")
+		}
+	}
+
+	split := splitAuthority(AuthorityBrowser, cutAny(rest, `/\?#`), true)
+	host, port := splitHostPortFirst(split.Host)
+	split.Host, split.Port = host, port
+	if !validSplitPort(port) {
+		split.Err = fmt.Errorf("%w: invalid port %q", ErrInvalidURL, port)
+	} else if serialized, err := parseWHATWGHost(host); err != nil {
+		split.Err = err
+	} else {
+		split.Host = serialized
+	}
+	return split
+}
+
+// splitAuthority separates userinfo at the first or last '@'. Host holds the
+// remaining host:port.
+func splitAuthority(rule, authority string, lastAt bool) AuthoritySplit {
+	s := AuthoritySplit{Rule: rule, Authority: authority, Host: authority}
+	at := strings.IndexByte(authority, '@')
+	if lastAt {
+		at = strings.LastIndexByte(authority, '@')
+	}
+	if at != -1 {
+		s.HasUser = true
+		s.Userinfo, s.Host = authority[:at], authority[at+1:]
+		s.Username, s.Password, _ = strings.Cut(s.Userinfo, ":")
+	}
+	return s
+}
+
+// splitHostPortLast splits at the last ':' outside brackets
+func splitHostPortLast(hostport string) (host, port string) {
+	if i := strings.LastIndexByte(hostport, ':'); i != -1 && i > strings.LastIndexByte(hostport, ']') {
+		return hostport[:i], hostport[i+1:]
+	}
+	return hostport, ""
+}
+
+// splitHostPortFirst splits at the first ':' outside brackets
+func splitHostPortFirst(hostport string) (host, port string) {
+	start := 0
+	if strings.HasPrefix(hostport, "[") {
+		if i := strings.IndexByte(hostport, ']'); i != -1 {
+			start = i
+		}
+	}
+	if i := strings.IndexByte(hostport[start:], ':'); i != -1 {
+		return hostport[:start+i], hostport[start+i+1:]
+	}
+	return hostport, ""
+}
+
+// validSplitPort reports whether port is empty or all digits
+func validSplitPort(port string) bool {
+	return port == "" || isDigits(port)
+}
+
+// cutAny returns s up to the first of the given delimiters
+func cutAny(s, delims string) string {
+	if i := strings.IndexAny(s, delims); i != -1 {
+		return s[:i]
+	}
+	return s
+}
+
+// unbracketed removes bracketed IP literals from s
+func unbracketed(s string) string {
+	for {
+		open := strings.IndexByte(s, '[')
+		if open == -1 {
+			return s
+		}
+		end := strings.IndexByte(s[open:], ']')
+		if end == -1 {
+			return s
+		}
+		s = s[:open] + s[open+end+1:]
+	}
+}
+
+// comparableHost normalizes a host so equivalent spellings compare equal
+func comparableHost(host string) string {
+	if h, err := parseWHATWGHost(host); err == nil {
+		return h
+	}
+	return strings.ToLower(host)
+}
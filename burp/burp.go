@@ -0,0 +1,393 @@
+// File: burp/burp.go
+/*
+Package burp reads and writes Burp Suite's "Save items" XML export and plain
+raw request files.
+
+Requests are kept as the exact bytes Burp recorded: the XML stores them
+base64 encoded and raw files hold them as they are, so reading and writing
+never touches the request-target. Each item also carries the request parsed
+with rawurlparser.ParseRequest and its RawURL.
+*/
+package burp
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/slicingmelon/go-rawurlparser"
+)
+
+var ErrInvalidItem = errors.New("invalid Burp item")
+
+// timeLayout is Java's Date.toString() format used by Burp
+const timeLayout = "Mon Jan 02 15:04:05 MST 2006"
+
+// Item is a single request, with its response when Burp recorded one
+type Item struct {
+	Time           string // As written by Burp, e.g. "Mon Jan 02 15:04:05 UTC 2006"
+	Host           string // Host Burp connected to
+	IP             string // Resolved address, empty when unknown
+	Port           string
+	Protocol       string // "http" or "https"
+	Method         string
+	Path           string // Request-target as listed by Burp, informational only
+	Extension      string // File extension of the path, "null" when there is none
+	Request        []byte // Request bytes exactly as sent
+	Status         string
+	ResponseLength string
+	MIMEType       string
+	Response       []byte // Response bytes, nil when there is none
+	Comment        string
+
+	Parsed *rawurlparser.RawRequest // Request parsed from Request, nil when it could not be parsed
+	URL    *rawurlparser.RawURL     // URL of the request, built from the request-target and Host header
+	Err    error                    // Why Request or its URL could not be parsed, e.g. a CRLF injected target
+}
+
+// xmlItems is the document written by "Save items"
+type xmlItems struct {
+	XMLName     xml.Name  `xml:"items"`
+	BurpVersion string    `xml:"burpVersion,attr"`
+	ExportTime  string    `xml:"exportTime,attr"`
+	Items       []xmlItem `xml:"item"`
+}
+
+type xmlItem struct {
+	Time           string  `xml:"time"`
+	URL            xmlText `xml:"url"`
+	Host           xmlHost `xml:"host"`
+	Port           string  `xml:"port"`
+	Protocol       string  `xml:"protocol"`
+	Method         xmlText `xml:"method"`
+	Path           xmlText `xml:"path"`
+	Extension      string  `xml:"extension"`
+	Request        xmlData `xml:"request"`
+	Status         string  `xml:"status"`
+	ResponseLength string  `xml:"responselength"`
+	MIMEType       string  `xml:"mimetype"`
+	Response       xmlData `xml:"response"`
+	Comment        string  `xml:"comment"`
+}
+
+type xmlText struct {
+	Value string `xml:",cdata"`
+}
+
+type xmlHost struct {
+	IP    string `xml:"ip,attr"`
+	Value string `xml:",chardata"`
+}
+
+type xmlData struct {
+	Base64 string `xml:"base64,attr"`
+	Value  string `xml:",cdata"`
+}
+
+// xmlHeader is the prolog and DTD Burp writes before the items
+const xmlHeader = `<?xml version="1.0"?>
+<!DOCTYPE items [
+<!ELEMENT items (item*)>
+<!ATTLIST items burpVersion CDATA "">
+<!ATTLIST items exportTime CDATA "">
+<!ELEMENT item (time, url, host, port, protocol, method, path, extension, request, status, responselength, mimetype, response, comment)>
+<!ELEMENT time (#PCDATA)>
+<!ELEMENT url (#PCDATA)>
+<!ELEMENT host (#PCDATA)>
+<!ATTLIST host ip CDATA "">
+<!ELEMENT port (#PCDATA)>
+<!ELEMENT protocol (#PCDATA)>
+<!ELEMENT method (#PCDATA)>
+<!ELEMENT path (#PCDATA)>
+<!ELEMENT extension (#PCDATA)>
+<!ELEMENT request (#PCDATA)>
+<!ATTLIST request base64 (true|false) "false">
+<!ELEMENT status (#PCDATA)>
+<!ELEMENT responselength (#PCDATA)>
+<!ELEMENT mimetype (#PCDATA)>
+<!ELEMENT response (#PCDATA)>
+<!ATTLIST response base64 (true|false) "false">
+<!ELEMENT comment (#PCDATA)>
+]>
+`
+
+// NewItem builds the request for u with rawurlparser.BuildRequest and wraps
+// it in an item, ready for WriteItems or WriteRawRequest. URL is u itself,
+// so it is set even when the request cannot be parsed back.
+func NewItem(u *rawurlparser.RawURL, opts *rawurlparser.RequestOptions) (*Item, error) {
+	raw, err := rawurlparser.BuildRequest(u, opts)
+	if err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = rawurlparser.DefaultRequestOptions()
+	}
+	item := &Item{
+		Time:     time.Now().UTC().Format(timeLayout),
+		Host:     u.Hostname,
+		Port:     u.EffectivePort(),
+		Protocol: strings.ToLower(u.Scheme),
+		Method:   opts.Method,
+		Path:     u.RequestTarget(opts.Form),
+		Request:  raw,
+		URL:      u,
+	}
+	if item.Method == "" {
+		item.Method = "GET"
+	}
+	item.parse()
+	if item.Parsed != nil {
+		// The URL is known, only a failure to parse the request counts
+		item.Err = nil
+	}
+	item.URL = u
+	item.setExtension()
+	return item, nil
+}
+
+// ReadItemsFile reads a "Save items" XML file
+func ReadItemsFile(path string) ([]*Item, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadItems(f)
+}
+
+// ReadItems reads a "Save items" XML document. Requests and responses may
+// be base64 encoded or plain text; XML turns CRLF in plain text into LF.
+// Items whose request cannot be parsed are returned with Err set.
+func ReadItems(r io.Reader) ([]*Item, error) {
+	var doc xmlItems
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidItem, err)
+	}
+
+	items := make([]*Item, 0, len(doc.Items))
+	for i, x := range doc.Items {
+		request, err := x.Request.decode()
+		if err != nil {
+			return nil, fmt.Errorf("%w: item %d: request: %v", ErrInvalidItem, i, err)
+		}
+		response, err := x.Response.decode()
+		if err != nil {
+			return nil, fmt.Errorf("%w: item %d: response: %v", ErrInvalidItem, i, err)
+		}
+		item := &Item{
+			Time:           x.Time,
+			Host:           x.Host.Value,
+			IP:             x.Host.IP,
+			Port:           x.Port,
+			Protocol:       x.Protocol,
+			Method:         x.Method.Value,
+			Path:           x.Path.Value,
+			Extension:      x.Extension,
+			Request:        request,
+			Status:         x.Status,
+			ResponseLength: x.ResponseLength,
+			MIMEType:       x.MIMEType,
+			Response:       response,
+			Comment:        x.Comment,
+		}
+		item.parse()
+		item.setExtension()
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// WriteItems writes items as a "Save items" XML document. Requests and
+// responses are always base64 encoded, so their bytes survive unchanged.
+func WriteItems(w io.Writer, items []*Item) error {
+	doc := xmlItems{
+		BurpVersion: "rawurlparser",
+		ExportTime:  time.Now().UTC().Format(timeLayout),
+	}
+	for _, item := range items {
+		x := xmlItem{
+			Time:           item.Time,
+			URL:            xmlText{xmlSafe(item.url())},
+			Host:           xmlHost{IP: item.IP, Value: xmlSafe(item.Host)},
+			Port:           item.Port,
+			Protocol:       item.Protocol,
+			Method:         xmlText{xmlSafe(item.Method)},
+			Path:           xmlText{xmlSafe(item.Path)},
+			Extension:      xmlSafe(item.Extension),
+			Request:        xmlData{Base64: "true", Value: base64.StdEncoding.EncodeToString(item.Request)},
+			Status:         item.Status,
+			ResponseLength: item.ResponseLength,
+			MIMEType:       item.MIMEType,
+			Response:       xmlData{Base64: "false"},
+			Comment:        xmlSafe(item.Comment),
+		}
+		if item.Response != nil {
+			x.Response = xmlData{Base64: "true", Value: base64.StdEncoding.EncodeToString(item.Response)}
+		}
+		doc.Items = append(doc.Items, x)
+	}
+
+	if _, err := io.WriteString(w, xmlHeader); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// ReadRawRequestFile reads a raw request file, see ReadRawRequest
+func ReadRawRequestFile(path, scheme string) (*Item, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ReadRawRequest(bytes.NewReader(data), scheme)
+}
+
+// ReadRawRequest reads a raw request as saved from Burp ("Copy to file") or
+// pasted into Repeater. Raw requests carry no scheme, so it is given; host
+// and port come from the Host header. Everything in r is kept as the
+// request bytes, including data after a body without Content-Length.
+func ReadRawRequest(r io.Reader, scheme string) (*Item, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	item := &Item{Protocol: strings.ToLower(scheme), Request: data}
+	if item.parse(); item.Err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidItem, item.Err)
+	}
+	item.setExtension()
+	item.Host = item.URL.Hostname
+	item.Port = item.URL.EffectivePort()
+	return item, nil
+}
+
+// WriteRawRequest writes the request bytes of item unchanged, in the form
+// Repeater accepts when pasted or loaded from a file
+func WriteRawRequest(w io.Writer, item *Item) error {
+	_, err := w.Write(item.Request)
+	return err
+}
+
+// WritePayloads writes the request-target of every URL on its own line, as
+// an Intruder payload list. Targets containing CR or LF cannot be listed.
+func WritePayloads(w io.Writer, urls []*rawurlparser.RawURL) error {
+	var buf bytes.Buffer
+	for _, u := range urls {
+		target := u.GetRawRequestURI()
+		if strings.ContainsAny(target, "\r\n") {
+			return fmt.Errorf("%w: payload %q contains a line break", ErrInvalidItem, target)
+		}
+		buf.WriteString(target)
+		buf.WriteByte('\n')
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// parse parses Request and fills Parsed, URL and the fields Burp derives
+// from the request when they are empty. Failures are recorded in Err.
+func (item *Item) parse() {
+	if len(item.Request) == 0 {
+		item.Err = errors.New("empty request")
+		return
+	}
+	scheme := item.Protocol
+	if scheme == "" {
+		scheme = "http"
+	}
+	req, err := rawurlparser.ParseRequestWithScheme(bytes.NewReader(item.Request), scheme)
+	if err != nil {
+		item.Err = err
+		return
+	}
+
+	item.Parsed = req
+	if item.Method == "" {
+		item.Method = req.Method
+	}
+	if item.Path == "" {
+		item.Path = req.Target
+	}
+
+	u, err := req.URL, req.URLError
+	if req.Host == "" && item.Host != "" {
+		host := item.Host
+		if item.Port != "" {
+			host += ":" + item.Port
+		}
+		u, err = rawurlparser.FromRequestTarget(scheme, host, req.Target)
+	}
+	item.URL, item.Err = u, err
+}
+
+// setExtension fills Extension from the URL's path the way Burp does
+func (item *Item) setExtension() {
+	if item.Extension != "" {
+		return
+	}
+	item.Extension = "null"
+	if item.URL == nil {
+		return
+	}
+	if ext := path.Ext(item.URL.Path); len(ext) > 1 {
+		item.Extension = ext[1:]
+	}
+}
+
+// url returns the value of the url element: the request-target in
+// absolute-form, with the port only when it is not the default
+func (item *Item) url() string {
+	if strings.Contains(item.Path, "://") {
+		return item.Path
+	}
+	host := item.Host
+	if item.Port != "" && !(item.Protocol == "http" && item.Port == "80") && !(item.Protocol == "https" && item.Port == "443") {
+		host += ":" + item.Port
+	}
+	return item.Protocol + "://" + host + item.Path
+}
+
+// decode returns the element's bytes, decoding base64 when marked
+func (d xmlData) decode() ([]byte, error) {
+	if d.Base64 != "true" {
+		if d.Value == "" {
+			return nil, nil
+		}
+		return []byte(d.Value), nil
+	}
+	return base64.StdEncoding.DecodeString(strings.TrimSpace(d.Value))
+}
+
+// xmlSafe percent-encodes the bytes XML 1.0 cannot represent. Only the
+// informational fields pass through it; requests are base64 encoded.
+func xmlSafe(s string) string {
+	var buf strings.Builder
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		valid := !(r == utf8.RuneError && size == 1) &&
+			(r == '\t' || r == '\n' || r == '\r' || r >= 0x20 && r <= 0xD7FF || r >= 0xE000 && r <= 0xFFFD || r >= 0x10000)
+		if valid {
+			buf.WriteString(s[i : i+size])
+		} else {
+			for j := i; j < i+size; j++ {
+				fmt.Fprintf(&buf, "%%%02X", s[j])
+			}
+		}
+		i += size
+	}
+	return buf.String()
+}
@@ -0,0 +1,210 @@
+package burp
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/slicingmelon/go-rawurlparser"
+)
+
+// burpExport is trimmed from a real "Save items" export
+func burpExport(request, response string) string {
+	return `<?xml version="1.0"?>
+<!DOCTYPE items [
+<!ELEMENT items (item*)>
+]>
+<items burpVersion="2024.1.1.4" exportTime="Wed May 01 10:00:00 UTC 2024">
+  <item>
+    <time>Wed May 01 09:59:58 UTC 2024</time>
+    <url><![CDATA[https://example.com/admin/..;/users?id=1]]></url>
+    <host ip="93.184.216.34">example.com</host>
+    <port>443</port>
+    <protocol>https</protocol>
+    <method><![CDATA[GET]]></method>
+    <path><![CDATA[/admin/..;/users?id=1]]></path>
+    <extension>null</extension>
+    <request base64="true"><![CDATA[` + base64.StdEncoding.EncodeToString([]byte(request)) + `]]></request>
+    <status>200</status>
+    <responselength>31</responselength>
+    <mimetype>JSON</mimetype>
+    <response base64="false"><![CDATA[` + response + `]]></response>
+    <comment>found via fuzzing</comment>
+  </item>
+</items>
+`
+}
+
+func TestReadItems(t *testing.T) {
+	request := "GET /admin/..;/users?id=1 HTTP/1.1\r\nHost: example.com\r\nX-A:  b\r\n\r\n"
+	response := "HTTP/1.1 200 OK\n\n{}"
+	items, err := ReadItems(strings.NewReader(burpExport(request, response)))
+	if err != nil {
+		t.Fatalf("ReadItems() error = %v", err)
+	}
+	if len(items) != 1 {
+		t.Fatalf("ReadItems() returned %d items", len(items))
+	}
+
+	item := items[0]
+	if string(item.Request) != request {
+		t.Errorf("Request = %q, want %q", item.Request, request)
+	}
+	if string(item.Response) != response {
+		t.Errorf("Response = %q, want %q", item.Response, response)
+	}
+	if got := item.URL.String(); got != "https://example.com/admin/..;/users?id=1" {
+		t.Errorf("URL = %q", got)
+	}
+	if got := item.Parsed.Get("x-a"); got != "b" {
+		t.Errorf("Parsed.Get() = %q", got)
+	}
+	if item.Host != "example.com" || item.IP != "93.184.216.34" || item.Port != "443" || item.Method != "GET" ||
+		item.Status != "200" || item.MIMEType != "JSON" || item.Comment != "found via fuzzing" {
+		t.Errorf("item = %+v", item)
+	}
+}
+
+func TestReadItemsErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+	}{
+		{"not xml", "{}"},
+		{"bad base64", strings.Replace(burpExport("GET / HTTP/1.1\r\n\r\n", ""), `<request base64="true"><![CDATA[`, `<request base64="true"><![CDATA[!!`, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ReadItems(strings.NewReader(tt.doc)); !errors.Is(err, ErrInvalidItem) {
+				t.Errorf("ReadItems() error = %v, want ErrInvalidItem", err)
+			}
+		})
+	}
+}
+
+func TestReadItemsUnparsed(t *testing.T) {
+	for _, request := range []string{"GARBAGE\r\n\r\n", ""} {
+		items, err := ReadItems(strings.NewReader(burpExport(request, "")))
+		if err != nil {
+			t.Fatalf("ReadItems() error = %v", err)
+		}
+		if item := items[0]; item.Err == nil || item.Parsed != nil || item.URL != nil || string(item.Request) != request {
+			t.Errorf("item = %+v, want Err set", item)
+		}
+	}
+}
+
+func TestWriteItemsRoundTrip(t *testing.T) {
+	targets := []string{
+		"https://example.com/admin/..;/users?id=1",
+		"https://example.com/%2e%2e/%zz/a b]]>c",
+		"http://[::1]:8080/\x00\xff/\r\nX-Injected: 1",
+		"http://example.com/file.php?x=<script>&y=\"'",
+	}
+
+	var items []*Item
+	for _, target := range targets {
+		u, err := rawurlparser.RawURLParseStrict(target)
+		if err != nil {
+			t.Fatal(err)
+		}
+		item, err := NewItem(u, &rawurlparser.RequestOptions{Method: "POST", Body: []byte("a=1")})
+		if err != nil {
+			t.Fatalf("NewItem(%q) error = %v", target, err)
+		}
+		items = append(items, item)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteItems(&buf, items); err != nil {
+		t.Fatalf("WriteItems() error = %v", err)
+	}
+	got, err := ReadItems(&buf)
+	if err != nil {
+		t.Fatalf("ReadItems() error = %v", err)
+	}
+	if len(got) != len(items) {
+		t.Fatalf("ReadItems() returned %d items, want %d", len(got), len(items))
+	}
+
+	for i, item := range got {
+		want := items[i]
+		if !bytes.Equal(item.Request, want.Request) {
+			t.Errorf("Request = %q, want %q", item.Request, want.Request)
+		}
+		if (item.Err != nil) != (want.Err != nil) {
+			t.Errorf("Err = %v, want %v", item.Err, want.Err)
+		}
+		if item.Err == nil && item.URL.GetRawRequestURI() != want.URL.GetRawRequestURI() {
+			t.Errorf("GetRawRequestURI() = %q, want %q", item.URL.GetRawRequestURI(), want.URL.GetRawRequestURI())
+		}
+		if item.Host != want.Host || item.Port != want.Port || item.Protocol != want.Protocol || item.Method != "POST" {
+			t.Errorf("item = %+v, want %+v", item, want)
+		}
+		if item.Response != nil {
+			t.Errorf("Response = %q, want nil", item.Response)
+		}
+	}
+	if items[2].Err == nil || items[2].URL.GetRawRequestURI() != "/\x00\xff/\r\nX-Injected: 1" {
+		t.Errorf("CRLF item = %+v", items[2])
+	}
+	if got[3].Extension != "php" || got[0].Extension != "null" {
+		t.Errorf("Extension = %q, %q", got[3].Extension, got[0].Extension)
+	}
+}
+
+func TestRawRequest(t *testing.T) {
+	raw := "GET /a/%2e%2e/b;x HTTP/1.1\nHost: example.com:8443\nCookie: a=b\n\n"
+	item, err := ReadRawRequest(strings.NewReader(raw), "HTTPS")
+	if err != nil {
+		t.Fatalf("ReadRawRequest() error = %v", err)
+	}
+	if got := item.URL.String(); got != "https://example.com:8443/a/%2e%2e/b;x" {
+		t.Errorf("URL = %q", got)
+	}
+	if item.Host != "example.com" || item.Port != "8443" || item.Protocol != "https" || item.Path != "/a/%2e%2e/b;x" {
+		t.Errorf("item = %+v", item)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteRawRequest(&buf, item); err != nil || buf.String() != raw {
+		t.Errorf("WriteRawRequest() = %q, %v", buf.String(), err)
+	}
+
+	path := filepath.Join(t.TempDir(), "request.txt")
+	if err := os.WriteFile(path, []byte(raw), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if item, err := ReadRawRequestFile(path, "http"); err != nil || item.URL.String() != "http://example.com:8443/a/%2e%2e/b;x" {
+		t.Errorf("ReadRawRequestFile() = %v, %v", item, err)
+	}
+	if _, err := ReadRawRequest(strings.NewReader("garbage"), "http"); !errors.Is(err, ErrInvalidItem) {
+		t.Errorf("ReadRawRequest() error = %v, want ErrInvalidItem", err)
+	}
+}
+
+func TestWritePayloads(t *testing.T) {
+	var urls []*rawurlparser.RawURL
+	for _, s := range []string{"https://a/x/..;/y?z", "https://a/%2e%2e/"} {
+		u, _ := rawurlparser.RawURLParseStrict(s)
+		urls = append(urls, u)
+	}
+
+	var buf bytes.Buffer
+	if err := WritePayloads(&buf, urls); err != nil {
+		t.Fatal(err)
+	}
+	if want := "/x/..;/y?z\n/%2e%2e/\n"; buf.String() != want {
+		t.Errorf("WritePayloads() = %q, want %q", buf.String(), want)
+	}
+
+	bad, _ := rawurlparser.RawURLParseStrict("https://a/x\r\ny")
+	if err := WritePayloads(&buf, []*rawurlparser.RawURL{bad}); !errors.Is(err, ErrInvalidItem) {
+		t.Errorf("WritePayloads() error = %v, want ErrInvalidItem", err)
+	}
+}
@@ -0,0 +1,143 @@
+package rawurlparser
+
+import (
+	"net/netip"
+	"strings"
+)
+
+// HostKind describes what kind of host a URL points to
+type HostKind int
+
+const (
+	HostEmpty     HostKind = iota // No host at all (e.g. opaque or relative URLs)
+	HostDNS                       // A registered (DNS) name
+	HostIPv4                      // An IPv4 literal
+	HostIPv6                      // A bracketed IPv6 literal, with or without zone
+	HostIPvFuture                 // A bracketed IPvFuture literal, e.g. [v1.fe80::a+en1]
+)
+
+func (k HostKind) String() string {
+	switch k {
+	case HostEmpty:
+		return "empty"
+	case HostDNS:
+		return "dns"
+	case HostIPv4:
+		return "ipv4"
+	case HostIPv6:
+		return "ipv6"
+	case HostIPvFuture:
+		return "ipvfuture"
+	}
+	return "unknown"
+}
+
+// HostClass is the parser's view of a host, computed without DNS resolution
+type HostClass struct {
+	Host          string     // The hostname that was classified, as provided
+	Kind          HostKind   // Literal type of the host
+	Addr          netip.Addr // The address for IP literals (without zone)
+	Zone          string     // IPv6 zone identifier, already percent-decoded
+	NonCanonical  bool       // IPv4 written in an alternate notation (see ParseIPv4)
+	Localhost     bool       // "localhost" or a name under ".localhost"
+	Loopback      bool       // 127.0.0.0/8 or ::1
+	Private       bool       // RFC 1918 and RFC 4193 ranges
+	LinkLocal     bool       // 169.254.0.0/16 or fe80::/10
+	CloudMetadata bool       // Well-known cloud metadata endpoints
+	Multicast     bool       // 224.0.0.0/4 or ff00::/8
+	Unspecified   bool       // 0.0.0.0 or ::
+}
+
+// cloudMetadataAddrs lists the metadata service addresses of the major cloud providers
+var cloudMetadataAddrs = []netip.Addr{
+	netip.MustParseAddr("169.254.169.254"), // AWS, GCP, Azure, OCI, DigitalOcean
+	netip.MustParseAddr("169.254.170.2"),   // AWS ECS task metadata
+	netip.MustParseAddr("100.100.100.200"), // Alibaba Cloud
+	netip.MustParseAddr("fd00:ec2::254"),   // AWS IMDS over IPv6
+}
+
+// cloudMetadataNames lists DNS names that resolve to metadata services
+var cloudMetadataNames = []string{
+	"metadata.google.internal",
+	"metadata.goog",
+	"metadata.azure.com",
+	"instance-data.ec2.internal",
+}
+
+// IsIP reports whether the host is an IPv4 or IPv6 literal
+func (c HostClass) IsIP() bool {
+	return c.Kind == HostIPv4 || c.Kind == HostIPv6
+}
+
+// IsInternal reports whether the host points to something that should not be
+// reachable from the outside: localhost, loopback, private, link-local,
+// cloud metadata or unspecified addresses.
+func (c HostClass) IsInternal() bool {
+	return c.Localhost || c.Loopback || c.Private || c.LinkLocal || c.CloudMetadata || c.Unspecified
+}
+
+// Classify returns the classification of the URL's Hostname
+func (u *RawURL) Classify() HostClass {
+	return ClassifyHost(u.Hostname)
+}
+
+// ClassifyHost classifies a hostname as found in RawURL.Hostname.
+// IPv6 literals are expected in brackets, zones may be written as "%25zone"
+// (RFC 6874) or "%zone". IPv4 hosts in the alternate notations accepted by
+// ParseIPv4 are classified as IPv4 with NonCanonical set.
+// No DNS resolution is performed.
+func ClassifyHost(host string) HostClass {
+	c := HostClass{Host: host}
+	if host == "" {
+		return c
+	}
+
+	if lit, err := ParseIPv6Literal(host); err == nil {
+		if lit.IsFuture() {
+			c.Kind = HostIPvFuture
+			return c
+		}
+		c.Kind = HostIPv6
+		c.Addr = lit.Addr
+		c.Zone = lit.Zone
+		c.classifyAddr()
+		return c
+	}
+
+	// Browsers resolve "0x7f.1" and friends without DNS, so do we
+	if addr, err := ParseIPv4(host); err == nil {
+		c.Kind = HostIPv4
+		c.Addr = addr
+		c.NonCanonical = !IsCanonicalIPv4(host)
+		c.classifyAddr()
+		return c
+	}
+
+	c.Kind = HostDNS
+	name := strings.ToLower(strings.TrimSuffix(host, "."))
+	c.Localhost = name == "localhost" || strings.HasSuffix(name, ".localhost")
+	for _, n := range cloudMetadataNames {
+		if name == n {
+			c.CloudMetadata = true
+			break
+		}
+	}
+	return c
+}
+
+// classifyAddr fills the range flags from c.Addr.
+// IPv4-mapped IPv6 addresses are classified by their embedded IPv4 address.
+func (c *HostClass) classifyAddr() {
+	addr := c.Addr.Unmap()
+	c.Loopback = addr.IsLoopback()
+	c.Private = addr.IsPrivate()
+	c.LinkLocal = addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast()
+	c.Multicast = addr.IsMulticast()
+	c.Unspecified = addr.IsUnspecified()
+	for _, m := range cloudMetadataAddrs {
+		if addr == m {
+			c.CloudMetadata = true
+			break
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package rawurlparser
+
+import (
+	"testing"
+)
+
+func TestClassify(t *testing.T) {
+	testCases := []struct {
+		input    string
+		kind     HostKind
+		zone     string
+		internal bool
+		check    func(HostClass) bool
+	}{
+		{"https://example.com/", HostDNS, "", false, func(c HostClass) bool { return !c.Localhost }},
+		{"http://LocalHost./admin", HostDNS, "", true, func(c HostClass) bool { return c.Localhost }},
+		{"http://api.localhost:3000/", HostDNS, "", true, func(c HostClass) bool { return c.Localhost }},
+		{"http://127.0.0.1:8080/", HostIPv4, "", true, func(c HostClass) bool { return c.Loopback }},
+		{"http://10.1.2.3/", HostIPv4, "", true, func(c HostClass) bool { return c.Private }},
+		{"http://169.254.169.254/latest/meta-data/", HostIPv4, "", true, func(c HostClass) bool { return c.CloudMetadata && c.LinkLocal }},
+		{"http://239.1.2.3/", HostIPv4, "", false, func(c HostClass) bool { return c.Multicast }},
+		{"http://0.0.0.0/", HostIPv4, "", true, func(c HostClass) bool { return c.Unspecified }},
+		{"http://8.8.8.8/", HostIPv4, "", false, func(c HostClass) bool { return !c.Private && !c.Loopback }},
+		{"http://[::1]:8080/", HostIPv6, "", true, func(c HostClass) bool { return c.Loopback }},
+		{"http://[fe80::1%25eth0]/", HostIPv6, "eth0", true, func(c HostClass) bool { return c.LinkLocal }},
+		{"http://[fd00:ec2::254]/", HostIPv6, "", true, func(c HostClass) bool { return c.CloudMetadata && c.Private }},
+		{"http://[::ffff:127.0.0.1]/", HostIPv6, "", true, func(c HostClass) bool { return c.Loopback }},
+		{"http://[::]/", HostIPv6, "", true, func(c HostClass) bool { return c.Unspecified }},
+		{"http://metadata.google.internal/", HostDNS, "", true, func(c HostClass) bool { return c.CloudMetadata }},
+		{"mailto:user@example.com", HostEmpty, "", false, func(c HostClass) bool { return true }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			u, err := RawURLParse(tc.input)
+			if err != nil {
+				t.Fatalf("Failed to parse URL %q: %v", tc.input, err)
+			}
+
+			c := u.Classify()
+			if c.Kind != tc.kind {
+				t.Errorf("Kind = %v, want %v", c.Kind, tc.kind)
+			}
+			if c.Zone != tc.zone {
+				t.Errorf("Zone = %q, want %q", c.Zone, tc.zone)
+			}
+			if c.IsInternal() != tc.internal {
+				t.Errorf("IsInternal() = %v, want %v", c.IsInternal(), tc.internal)
+			}
+			if !tc.check(c) {
+				t.Errorf("unexpected classification: %+v", c)
+			}
+		})
+	}
+}
@@ -0,0 +1,323 @@
+package rawurlparser
+
+import (
+	"sort"
+	"strings"
+)
+
+// Segment type placeholders used in route templates
+const (
+	SegmentInt    = "{int}"
+	SegmentUUID   = "{uuid}"
+	SegmentHex    = "{hex}"
+	SegmentDate   = "{date}"
+	SegmentBase64 = "{base64}"
+	SegmentSlug   = "{slug}"
+)
+
+// RouteCluster groups URLs sharing the same route shape
+type RouteCluster struct {
+	Template     string    // Full template, e.g. https://example.com/users/{int}?id&sort
+	Origin       string    // scheme://host of the members (empty when hosts are ignored)
+	PathTemplate string    // Path with typed segments, e.g. /users/{int}/orders/{int}
+	QueryKeys    []string  // Sorted, de-duplicated query keys shared by all members
+	Count        int       // Total number of URLs that fell into this cluster
+	Examples     []*RawURL // Up to ClusterOptions.MaxExamples members, in input order
+}
+
+// ClusterOptions contains configuration options for route clustering
+type ClusterOptions struct {
+	MaxExamples  int  // Maximum example members kept per cluster (0 keeps all)
+	IgnoreHost   bool // If true, URLs from different hosts share clusters
+	IgnoreQuery  bool // If true, query-key sets are not part of the cluster key
+	KeepFragment bool // If true, the fragment is typed and kept in the template
+}
+
+// DefaultClusterOptions returns the default clustering options
+func DefaultClusterOptions() *ClusterOptions {
+	return &ClusterOptions{
+		MaxExamples: 3,
+	}
+}
+
+// ClusterRoutes groups URLs by route shape using the default options
+func ClusterRoutes(urls []*RawURL) []*RouteCluster {
+	return ClusterRoutesWithOptions(urls, DefaultClusterOptions())
+}
+
+// ClusterRoutesWithOptions groups URLs by route shape.
+// Path segments are replaced by typed placeholders (see ClassifySegment) and
+// query strings are reduced to their sorted key sets, so /users/1?a=1&b=2 and
+// /users/2?b=3&a=4 end up in the same cluster. Clusters are returned in the
+// order their first member appeared.
+func ClusterRoutesWithOptions(urls []*RawURL, opts *ClusterOptions) []*RouteCluster {
+	if opts == nil {
+		opts = DefaultClusterOptions()
+	}
+
+	index := make(map[string]*RouteCluster)
+	var clusters []*RouteCluster
+
+	for _, u := range urls {
+		if u == nil {
+			continue
+		}
+
+		origin := ""
+		pathTemplate := PathTemplate(u.Path)
+		if u.Opaque != "" {
+			// Opaque URLs have no host, the scheme is part of the template
+			pathTemplate = u.Scheme + ":" + ClassifySegment(u.Opaque)
+		} else if !opts.IgnoreHost {
+			origin = u.BaseURL()
+		}
+
+		var keys []string
+		if !opts.IgnoreQuery {
+			keys = queryKeySet(u.Query)
+		}
+
+		template := origin + pathTemplate
+		if len(keys) > 0 {
+			template += "?" + strings.Join(keys, "&")
+		}
+		if opts.KeepFragment && u.Fragment != "" {
+			template += "#" + ClassifySegment(u.Fragment)
+		}
+
+		c, ok := index[template]
+		if !ok {
+			c = &RouteCluster{
+				Template:     template,
+				Origin:       origin,
+				PathTemplate: pathTemplate,
+				QueryKeys:    keys,
+			}
+			index[template] = c
+			clusters = append(clusters, c)
+		}
+
+		c.Count++
+		if opts.MaxExamples <= 0 || len(c.Examples) < opts.MaxExamples {
+			c.Examples = append(c.Examples, u)
+		}
+	}
+
+	return clusters
+}
+
+// PathTemplate replaces every typed segment of a raw path with its placeholder.
+// Separators are kept exactly as provided, so "//a/1/" stays "//a/{int}/".
+func PathTemplate(path string) string {
+	segments := strings.Split(path, "/")
+	for i, seg := range segments {
+		segments[i] = ClassifySegment(seg)
+	}
+	return strings.Join(segments, "/")
+}
+
+// ClassifySegment returns the placeholder for a single path segment,
+// or the segment itself when no type could be inferred.
+// Matrix parameters (";jsessionid=...") and file extensions are preserved
+// around the typed part, e.g. "123.json" becomes "{int}.json".
+func ClassifySegment(seg string) string {
+	if seg == "" {
+		return seg
+	}
+
+	// Keep matrix parameters untouched
+	if i := strings.IndexByte(seg, ';'); i != -1 {
+		return ClassifySegment(seg[:i]) + seg[i:]
+	}
+
+	if t := segmentType(seg); t != "" {
+		return t
+	}
+
+	// Retry without a short alphabetic extension
+	if i := strings.LastIndexByte(seg, '.'); i > 0 && isExtension(seg[i+1:]) {
+		if t := segmentType(seg[:i]); t != "" {
+			return t + seg[i:]
+		}
+	}
+
+	return seg
+}
+
+// segmentType infers the type of a segment, returns empty string if unknown
+func segmentType(seg string) string {
+	switch {
+	case isDigits(seg):
+		return SegmentInt
+	case isUUID(seg):
+		return SegmentUUID
+	case isDate(seg):
+		return SegmentDate
+	case isHexHash(seg):
+		return SegmentHex
+	case isBase64Token(seg):
+		return SegmentBase64
+	case isSlug(seg):
+		return SegmentSlug
+	}
+	return ""
+}
+
+// queryKeySet returns the sorted, de-duplicated keys of a raw query string
+func queryKeySet(query string) []string {
+	if query == "" {
+		return nil
+	}
+	seen := make(map[string]struct{})
+	var keys []string
+	for _, pair := range strings.Split(query, "&") {
+		if pair == "" {
+			continue
+		}
+		key := pair
+		if i := strings.IndexByte(pair, '='); i != -1 {
+			key = pair[:i]
+		}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isHexDigit(c byte) bool {
+	return ('0' <= c && c <= '9') || ('a' <= c && c <= 'f') || ('A' <= c && c <= 'F')
+}
+
+// isUUID matches the 8-4-4-4-12 hex form
+func isUUID(s string) bool {
+	if len(s) != 36 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		switch i {
+		case 8, 13, 18, 23:
+			if s[i] != '-' {
+				return false
+			}
+		default:
+			if !isHexDigit(s[i]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isDate matches YYYY-MM-DD, YYYY_MM_DD and YYYY.MM.DD
+func isDate(s string) bool {
+	if len(s) != 10 {
+		return false
+	}
+	sep := s[4]
+	if (sep != '-' && sep != '_' && sep != '.') || s[7] != sep {
+		return false
+	}
+	if !isDigits(s[:4]) || !isDigits(s[5:7]) || !isDigits(s[8:]) {
+		return false
+	}
+	month := (s[5]-'0')*10 + (s[6] - '0')
+	day := (s[8]-'0')*10 + (s[9] - '0')
+	return month >= 1 && month <= 12 && day >= 1 && day <= 31
+}
+
+// isHexHash matches hex strings of hash-like length (at least 16 chars)
+// that contain at least one letter, so plain numbers stay {int}
+func isHexHash(s string) bool {
+	if len(s) < 16 || len(s)%2 != 0 {
+		return false
+	}
+	hasLetter := false
+	for i := 0; i < len(s); i++ {
+		if !isHexDigit(s[i]) {
+			return false
+		}
+		if s[i] > '9' {
+			hasLetter = true
+		}
+	}
+	return hasLetter
+}
+
+// isBase64Token matches standard and URL-safe base64 tokens of at least
+// 16 chars that mix upper case, lower case and digits
+func isBase64Token(s string) bool {
+	if len(s) < 16 {
+		return false
+	}
+	body := strings.TrimRight(s, "=")
+	if len(s)-len(body) > 2 {
+		return false
+	}
+	var upper, lower, digit bool
+	for i := 0; i < len(body); i++ {
+		c := body[i]
+		switch {
+		case 'A' <= c && c <= 'Z':
+			upper = true
+		case 'a' <= c && c <= 'z':
+			lower = true
+		case '0' <= c && c <= '9':
+			digit = true
+		case c == '+' || c == '-' || c == '_':
+		default:
+			return false
+		}
+	}
+	return upper && lower && digit
+}
+
+// isSlug matches lower case words joined by at least two hyphens,
+// e.g. "my-first-post", leaving short names such as "user-profile" alone
+func isSlug(s string) bool {
+	words := strings.Split(s, "-")
+	if len(words) < 3 || isDigits(strings.Join(words, "")) {
+		return false
+	}
+	for _, w := range words {
+		if w == "" {
+			return false
+		}
+		for i := 0; i < len(w); i++ {
+			c := w[i]
+			if !('a' <= c && c <= 'z') && !('0' <= c && c <= '9') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isExtension reports whether s looks like a file extension (e.g. "json")
+func isExtension(s string) bool {
+	if len(s) == 0 || len(s) > 5 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !('a' <= c && c <= 'z') && !('A' <= c && c <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
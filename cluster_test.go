@@ -0,0 +1,108 @@
+package rawurlparser
+
+import (
+	"testing"
+)
+
+func TestClassifySegment(t *testing.T) {
+	testCases := []struct {
+		input string
+		want  string
+	}{
+		{"123", SegmentInt},
+		{"550e8400-e29b-41d4-a716-446655440000", SegmentUUID},
+		{"2024-02-29", SegmentDate},
+		{"2024-13-01", "2024-13-01"},
+		{"d41d8cd98f00b204e9800998ecf8427e", SegmentHex},
+		{"eyJhbGciOiJIUzI1NiJ9", SegmentBase64},
+		{"my-first-blog-post", SegmentSlug},
+		{"user-profile", "user-profile"},
+		{"users", "users"},
+		{"123.json", SegmentInt + ".json"},
+		{"42;jsessionid=abc", SegmentInt + ";jsessionid=abc"},
+		{"..;", "..;"},
+		{"%2e%2e", "%2e%2e"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			if got := ClassifySegment(tc.input); got != tc.want {
+				t.Errorf("ClassifySegment(%q) = %q, want %q", tc.input, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestClusterRoutes(t *testing.T) {
+	inputs := []string{
+		"https://example.com/users/123/orders/456",
+		"https://example.com/users/7/orders/8?sort=asc&page=2",
+		"https://example.com/users/9/orders/10?page=1&sort=desc",
+		"https://example.com/users/11/orders/12",
+		"https://example.com/files/d41d8cd98f00b204e9800998ecf8427e",
+		"https://other.example.com/users/1/orders/2",
+	}
+
+	var urls []*RawURL
+	for _, in := range inputs {
+		u, err := RawURLParse(in)
+		if err != nil {
+			t.Fatalf("Failed to parse URL %q: %v", in, err)
+		}
+		urls = append(urls, u)
+	}
+
+	clusters := ClusterRoutes(urls)
+
+	wantTemplates := []string{
+		"https://example.com/users/{int}/orders/{int}",
+		"https://example.com/users/{int}/orders/{int}?page&sort",
+		"https://example.com/files/{hex}",
+		"https://other.example.com/users/{int}/orders/{int}",
+	}
+	wantCounts := []int{2, 2, 1, 1}
+
+	if len(clusters) != len(wantTemplates) {
+		t.Fatalf("got %d clusters, want %d", len(clusters), len(wantTemplates))
+	}
+	for i, c := range clusters {
+		if c.Template != wantTemplates[i] {
+			t.Errorf("cluster %d Template = %q, want %q", i, c.Template, wantTemplates[i])
+		}
+		if c.Count != wantCounts[i] {
+			t.Errorf("cluster %d Count = %d, want %d", i, c.Count, wantCounts[i])
+		}
+	}
+
+	ignoreHost := ClusterRoutesWithOptions(urls, &ClusterOptions{IgnoreHost: true, IgnoreQuery: true, MaxExamples: 1})
+	if len(ignoreHost) != 2 {
+		t.Fatalf("got %d clusters ignoring host and query, want 2", len(ignoreHost))
+	}
+	if ignoreHost[0].Count != 5 || len(ignoreHost[0].Examples) != 1 {
+		t.Errorf("Count = %d, Examples = %d, want 5 and 1", ignoreHost[0].Count, len(ignoreHost[0].Examples))
+	}
+}
+
+func TestClusterRoutesOpaque(t *testing.T) {
+	var urls []*RawURL
+	for _, in := range []string{"mailto:alice@example.com", "tel:123456", "tel:987654"} {
+		u, err := RawURLParse(in)
+		if err != nil {
+			t.Fatalf("Failed to parse URL %q: %v", in, err)
+		}
+		urls = append(urls, u)
+	}
+
+	for _, opts := range []*ClusterOptions{DefaultClusterOptions(), {IgnoreHost: true}} {
+		clusters := ClusterRoutesWithOptions(urls, opts)
+		if len(clusters) != 2 {
+			t.Fatalf("got %d clusters, want 2", len(clusters))
+		}
+		if c := clusters[0]; c.Template != "mailto:alice@example.com" || c.Origin != "" {
+			t.Errorf("Template, Origin = %q, %q", c.Template, c.Origin)
+		}
+		if c := clusters[1]; c.Template != "tel:{int}" || c.Count != 2 {
+			t.Errorf("Template, Count = %q, %d", c.Template, c.Count)
+		}
+	}
+}
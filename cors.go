@@ -0,0 +1,111 @@
+package rawurlparser
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrOpaqueOrigin = errors.New("URL has an opaque origin")
+
+// Validator flaws targeted by CORS origin candidates
+const (
+	CORSFlawPrefixMatch     = "prefix-match"        // startsWith(origin, trusted)
+	CORSFlawSuffixMatch     = "suffix-match"        // endsWith(origin, trusted) without a leading dot
+	CORSFlawSubstringMatch  = "substring-match"     // contains(origin, trusted)
+	CORSFlawUnescapedDot    = "unescaped-dot"       // Regex with "." instead of "\."
+	CORSFlawNullOrigin      = "null-origin"         // "null" on the allow list (sandboxed iframes, data: URLs)
+	CORSFlawSpecialChars    = "special-chars"       // Host characters browsers send but parsers split on
+	CORSFlawSchemeDowngrade = "scheme-downgrade"    // Scheme not checked, http:// trusted on https sites
+	CORSFlawPort            = "port"                // Port not checked or default port not normalized
+	CORSFlawAnySubdomain    = "arbitrary-subdomain" // Every subdomain trusted
+	CORSFlawUserinfo        = "userinfo-confusion"  // Regex matching up to '@'
+)
+
+// CORSCandidate is an Origin header value together with the flaw it targets
+type CORSCandidate struct {
+	Origin string // Value for the Origin header
+	Flaw   string // One of the CORSFlaw* constants
+}
+
+// corsSpecialChars are accepted in hosts by at least one major browser but
+// make naive validators stop reading the host, e.g. "trusted.com_.evil.com"
+var corsSpecialChars = []string{"_", "-", "!", "$", "&", "'", "(", ")", "*", "+", ",", ";", "=", "`", "{", "}", "|", "~"}
+
+// GenerateCORSOrigins returns Origin header values that naive validators
+// commonly accept for the trusted URL's origin. attacker is a domain the
+// tester controls, "evil.com" when empty. The trusted origin itself is not
+// returned.
+func GenerateCORSOrigins(trusted *RawURL, attacker string) ([]CORSCandidate, error) {
+	o := trusted.Origin()
+	if o.Opaque {
+		return nil, ErrOpaqueOrigin
+	}
+	if attacker == "" {
+		attacker = "evil.com"
+	}
+
+	host := o.Host
+	port := ""
+	if o.Port != "" {
+		port = ":" + o.Port
+	}
+	base := o.Scheme + "://"
+	label := host
+	if i := strings.IndexByte(host, '.'); i != -1 {
+		label = host[:i]
+	}
+
+	candidates := []CORSCandidate{
+		{base + host + "." + attacker + port, CORSFlawPrefixMatch},
+		{base + host + port + "." + attacker, CORSFlawPrefixMatch},
+		{base + host + "-" + attacker + port, CORSFlawPrefixMatch},
+		{base + "evil" + host + port, CORSFlawSuffixMatch},
+		{base + "evil-" + host + port, CORSFlawSuffixMatch},
+		{base + attacker + "." + host + "." + attacker + port, CORSFlawSubstringMatch},
+		{base + label + attacker + port, CORSFlawSubstringMatch},
+	}
+
+	// One candidate per dot, each replaced by a letter the regex "." accepts
+	for i := 0; i < len(host); i++ {
+		if host[i] == '.' {
+			candidates = append(candidates, CORSCandidate{base + host[:i] + "x" + host[i+1:] + port, CORSFlawUnescapedDot})
+		}
+	}
+
+	candidates = append(candidates, CORSCandidate{"null", CORSFlawNullOrigin})
+
+	for _, c := range corsSpecialChars {
+		candidates = append(candidates, CORSCandidate{base + host + c + "." + attacker + port, CORSFlawSpecialChars})
+	}
+
+	switch o.Scheme {
+	case "https":
+		candidates = append(candidates, CORSCandidate{"http://" + host + port, CORSFlawSchemeDowngrade})
+	case "wss":
+		candidates = append(candidates, CORSCandidate{"ws://" + host + port, CORSFlawSchemeDowngrade})
+	}
+
+	info, _ := LookupScheme(o.Scheme)
+	for _, p := range []string{info.DefaultPort, "80", "443", "8080", "8443", "1337"} {
+		if p != "" && ":"+p != port {
+			candidates = append(candidates, CORSCandidate{base + host + ":" + p, CORSFlawPort})
+		}
+	}
+
+	candidates = append(candidates,
+		CORSCandidate{base + "evil." + host + port, CORSFlawAnySubdomain},
+		CORSCandidate{base + host + "@" + attacker + port, CORSFlawUserinfo},
+		CORSCandidate{base + host + "%40" + attacker + port, CORSFlawUserinfo},
+	)
+
+	seen := map[string]struct{}{o.String(): {}}
+	var result []CORSCandidate
+	for _, c := range candidates {
+		if _, ok := seen[c.Origin]; ok {
+			continue
+		}
+		seen[c.Origin] = struct{}{}
+		result = append(result, c)
+	}
+	return result, nil
+}
@@ -0,0 +1,87 @@
+package rawurlparser
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestGenerateCORSOrigins(t *testing.T) {
+	u, err := RawURLParse("https://user@www.Trusted.com:443/api")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	candidates, err := GenerateCORSOrigins(u, "")
+	if err != nil {
+		t.Fatalf("GenerateCORSOrigins() error = %v", err)
+	}
+
+	want := map[string]string{
+		"https://www.trusted.com.evil.com":  CORSFlawPrefixMatch,
+		"https://evilwww.trusted.com":       CORSFlawSuffixMatch,
+		"https://wwwxtrusted.com":           CORSFlawUnescapedDot,
+		"https://www.trustedxcom":           CORSFlawUnescapedDot,
+		"null":                              CORSFlawNullOrigin,
+		"https://www.trusted.com_.evil.com": CORSFlawSpecialChars,
+		"https://www.trusted.com}.evil.com": CORSFlawSpecialChars,
+		"http://www.trusted.com":            CORSFlawSchemeDowngrade,
+		"https://www.trusted.com:443":       CORSFlawPort,
+		"https://www.trusted.com:8443":      CORSFlawPort,
+		"https://evil.www.trusted.com":      CORSFlawAnySubdomain,
+		"https://www.trusted.com@evil.com":  CORSFlawUserinfo,
+	}
+
+	got := map[string]string{}
+	for _, c := range candidates {
+		if _, dup := got[c.Origin]; dup {
+			t.Errorf("duplicate candidate %q", c.Origin)
+		}
+		got[c.Origin] = c.Flaw
+		if c.Origin == "https://www.trusted.com" {
+			t.Errorf("trusted origin returned as candidate")
+		}
+		if c.Flaw == CORSFlawSpecialChars && strings.Contains(c.Origin, "%") {
+			t.Errorf("percent-encoded special-chars candidate %q", c.Origin)
+		}
+	}
+	for origin, flaw := range want {
+		if got[origin] != flaw {
+			t.Errorf("candidate %q: flaw = %q, want %q", origin, got[origin], flaw)
+		}
+	}
+}
+
+func TestGenerateCORSOriginsPort(t *testing.T) {
+	u, err := RawURLParse("http://app.local:8080/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	candidates, err := GenerateCORSOrigins(u, "attacker.net")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found := map[string]bool{}
+	for _, c := range candidates {
+		found[c.Origin] = true
+	}
+	for _, origin := range []string{"http://app.local.attacker.net:8080", "http://app.local:8080.attacker.net", "http://app.local:80"} {
+		if !found[origin] {
+			t.Errorf("missing candidate %q", origin)
+		}
+	}
+	if found["http://app.local:8080"] {
+		t.Errorf("trusted origin returned as candidate")
+	}
+}
+
+func TestGenerateCORSOriginsOpaque(t *testing.T) {
+	u, err := RawURLParse("data:text/html,hi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := GenerateCORSOrigins(u, ""); !errors.Is(err, ErrOpaqueOrigin) {
+		t.Errorf("GenerateCORSOrigins() error = %v, want ErrOpaqueOrigin", err)
+	}
+}
@@ -0,0 +1,567 @@
+package rawurlparser
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var ErrInvalidCurl = errors.New("invalid curl command")
+
+// CurlCommand is the request described by a curl command line
+type CurlCommand struct {
+	URL           *RawURL       // URL with the request-target applied, see FromRequestTarget
+	Method        string        // -X, or GET, POST (with data) and HEAD (-I)
+	Header        []HeaderField // -H and the headers curl adds for -A, -e, -b, in order
+	Body          []byte        // -d and friends, joined with '&' like curl does
+	RequestTarget string        // --request-target, empty when not given
+	PathAsIs      bool          // --path-as-is
+	Insecure      bool          // -k
+	Proto         string        // "HTTP/1.0", "HTTP/1.1" or "HTTP/2" when forced
+}
+
+// ToCurl returns a curl command that sends the same request as WriteRequest.
+// --path-as-is keeps curl from squashing dot segments, the protocol is forced
+// with --http1.0, --http1.1 or --http2, and the User-Agent, Accept and
+// Content-Type headers curl adds on its own are removed unless opts.Header
+// sets them. Userinfo is left out, WriteRequest does not send it
+// either. Targets curl would rewrite or reject (spaces, '#', control or
+// non-ASCII bytes, non origin-forms) are passed with --request-target and the
+// URL is cut down to the scheme and host. Arguments are quoted for POSIX shells; bytes that
+// cannot be written literally use bash's $'...' quoting. NUL bytes cannot be
+// passed to curl at all, the shell cuts the argument there.
+func ToCurl(u *RawURL, opts *RequestOptions) string {
+	opts = requestOptions(opts)
+	args := []string{"curl", "--path-as-is"}
+
+	switch opts.Proto {
+	case "HTTP/1.0":
+		args = append(args, "--http1.0")
+	case "HTTP/1.1":
+		// Without it curl negotiates HTTP/2 for https
+		args = append(args, "--http1.1")
+	case "HTTP/2", "HTTP/2.0":
+		args = append(args, "--http2")
+	}
+
+	// curl sends POST when there is a body and GET otherwise
+	implied := "GET"
+	if opts.Body != nil {
+		implied = "POST"
+	}
+	if opts.Method != implied {
+		args = append(args, "-X", shellQuote(opts.Method))
+	}
+
+	target := u.RequestTarget(opts.Form)
+	base := GetScheme(u) + u.Host
+	url := base + target
+	if opts.Form != OriginForm || !curlSafeTarget(target) {
+		args = append(args, "--request-target", shellQuote(target))
+		url = base + "/"
+	}
+	if strings.ContainsAny(url, "[]{}") {
+		args = append(args, "--globoff")
+	}
+
+	switch {
+	case opts.OmitHost:
+		args = append(args, "-H", shellQuote("Host:"))
+	case opts.Host != "" && opts.Host != u.Host:
+		args = append(args, "-H", shellQuote("Host: "+opts.Host))
+	}
+	defaults := []string{"User-Agent", "Accept"}
+	if opts.Body != nil {
+		defaults = append(defaults, "Content-Type")
+	}
+	for _, name := range defaults {
+		if !curlHasHeader(opts.Header, name) {
+			args = append(args, "-H", shellQuote(name+":"))
+		}
+	}
+	for _, h := range opts.Header {
+		if h.Value == "" {
+			// "Name:" would remove the header, "Name;" sends it empty
+			args = append(args, "-H", shellQuote(h.Name+";"))
+			continue
+		}
+		args = append(args, "-H", shellQuote(h.Name+": "+h.Value))
+	}
+	if opts.Body != nil {
+		args = append(args, "--data-binary", shellQuote(string(opts.Body)))
+	}
+
+	args = append(args, shellQuote(url))
+	return strings.Join(args, " ")
+}
+
+func curlHasHeader(header []HeaderField, name string) bool {
+	for _, h := range header {
+		if strings.EqualFold(h.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// curlSafeTarget reports whether curl sends target unchanged as part of the URL
+func curlSafeTarget(target string) bool {
+	if !strings.HasPrefix(target, "/") {
+		return false
+	}
+	for i := 0; i < len(target); i++ {
+		if c := target[i]; c <= ' ' || c >= 0x7f || c == '#' || c == '\\' {
+			return false
+		}
+	}
+	return true
+}
+
+// shellQuote quotes s as a single shell word
+func shellQuote(s string) string {
+	if s == "" {
+		return "''"
+	}
+	plain, printable := true, utf8.ValidString(s)
+	for _, r := range s {
+		switch {
+		case r < ' ' || r == 0x7f:
+			printable = false
+		case r >= 0x80:
+			plain = false
+		case !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("_-.,:/@%+=", r)):
+			plain = false
+		}
+	}
+	if !printable {
+		return ansiQuote(s)
+	}
+	if plain {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// ansiQuote quotes s with bash's $'...', escaping every byte it must
+func ansiQuote(s string) string {
+	var buf strings.Builder
+	buf.WriteString("$'")
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\'' || c == '\\':
+			buf.WriteByte('\\')
+			buf.WriteByte(c)
+		case c == '\n':
+			buf.WriteString(`\n`)
+		case c == '\r':
+			buf.WriteString(`\r`)
+		case c == '\t':
+			buf.WriteString(`\t`)
+		case c < ' ' || c >= 0x7f:
+			fmt.Fprintf(&buf, `\x%02X`, c)
+		default:
+			buf.WriteByte(c)
+		}
+	}
+	buf.WriteByte('\'')
+	return buf.String()
+}
+
+// curlValueFlags are the curl options that take an argument. Options not
+// listed here are treated as switches.
+var curlValueFlags = map[string]bool{
+	"-A": true, "-b": true, "-c": true, "-C": true, "-d": true, "-D": true,
+	"-e": true, "-E": true, "-F": true, "-H": true, "-K": true, "-m": true,
+	"-o": true, "-P": true, "-Q": true, "-r": true, "-t": true, "-T": true,
+	"-u": true, "-U": true, "-w": true, "-x": true, "-X": true, "-y": true,
+	"-Y": true, "-z": true,
+
+	"--abstract-unix-socket": true, "--alt-svc": true, "--aws-sigv4": true,
+	"--cacert": true, "--capath": true, "--cert": true, "--cert-type": true,
+	"--ciphers": true, "--config": true, "--connect-timeout": true,
+	"--connect-to": true, "--continue-at": true, "--cookie": true,
+	"--cookie-jar": true, "--create-file-mode": true, "--crlfile": true,
+	"--curves": true, "--data": true, "--data-ascii": true,
+	"--data-binary": true, "--data-raw": true, "--data-urlencode": true,
+	"--delegation": true, "--dns-interface": true, "--dns-ipv4-addr": true,
+	"--dns-ipv6-addr": true, "--dns-servers": true, "--doh-url": true,
+	"--dump-header": true, "--ech": true, "--egd-file": true, "--engine": true,
+	"--etag-compare": true, "--etag-save": true, "--expect100-timeout": true,
+	"--form": true, "--form-string": true, "--ftp-account": true,
+	"--ftp-alternative-to-user": true, "--ftp-method": true, "--ftp-port": true,
+	"--ftp-ssl-ccc-mode": true, "--happy-eyeballs-timeout-ms": true,
+	"--haproxy-clientip": true, "--header": true, "--hostpubmd5": true,
+	"--hostpubsha256": true, "--hsts": true, "--interface": true,
+	"--ip-tos": true, "--ipfs-gateway": true, "--json": true,
+	"--keepalive-cnt": true, "--keepalive-time": true, "--key": true,
+	"--key-type": true, "--krb": true, "--libcurl": true, "--limit-rate": true,
+	"--local-port": true, "--login-options": true, "--mail-auth": true,
+	"--mail-from": true, "--mail-rcpt": true, "--max-filesize": true,
+	"--max-redirs": true, "--max-time": true, "--netrc-file": true,
+	"--noproxy": true, "--oauth2-bearer": true, "--output": true,
+	"--output-dir": true, "--parallel-max": true, "--pass": true,
+	"--pinnedpubkey": true, "--preproxy": true, "--proto": true,
+	"--proto-default": true, "--proto-redir": true, "--proxy": true,
+	"--proxy-cacert": true, "--proxy-capath": true, "--proxy-cert": true,
+	"--proxy-cert-type": true, "--proxy-ciphers": true, "--proxy-crlfile": true,
+	"--proxy-header": true, "--proxy-key": true, "--proxy-key-type": true,
+	"--proxy-pass": true, "--proxy-pinnedpubkey": true,
+	"--proxy-service-name": true, "--proxy-tls13-ciphers": true,
+	"--proxy-tlsauthtype": true, "--proxy-tlspassword": true,
+	"--proxy-tlsuser": true, "--proxy-user": true, "--proxy1.0": true,
+	"--pubkey": true, "--quote": true, "--random-file": true, "--range": true,
+	"--rate": true, "--referer": true, "--request": true,
+	"--request-target": true, "--resolve": true, "--retry": true,
+	"--retry-delay": true, "--retry-max-time": true, "--sasl-authzid": true,
+	"--service-name": true, "--socks4": true, "--socks4a": true,
+	"--socks5": true, "--socks5-gssapi-service": true,
+	"--socks5-hostname": true, "--speed-limit": true, "--speed-time": true,
+	"--stderr": true, "--telnet-option": true, "--tftp-blksize": true,
+	"--time-cond": true, "--tls-max": true, "--tls13-ciphers": true,
+	"--tlsauthtype": true, "--tlspassword": true, "--tlsuser": true,
+	"--trace": true, "--trace-ascii": true, "--trace-config": true,
+	"--unix-socket": true, "--upload-file": true, "--url": true,
+	"--url-query": true, "--user": true, "--user-agent": true,
+	"--variable": true, "--write-out": true,
+}
+
+// ParseCurl extracts the request from a curl command line, as copied from a
+// browser's "Copy as cURL" or written by ToCurl. The line is split with shell
+// quoting rules: single and double quotes, backslash escapes, line
+// continuations and bash's $'...'. A --request-target replaces the URL's
+// path, query and fragment byte for byte. With -G the data is appended to
+// the query instead of being sent as the body. Data read from files
+// ("@file") is kept as the literal argument.
+func ParseCurl(cmdline string) (*CurlCommand, error) {
+	args, err := shellSplit(cmdline)
+	if err != nil {
+		return nil, err
+	}
+	if len(args) == 0 || (args[0] != "curl" && !strings.HasSuffix(args[0], "/curl") && !strings.HasSuffix(args[0], "curl.exe")) {
+		return nil, fmt.Errorf("%w: not a curl command", ErrInvalidCurl)
+	}
+
+	cmd := &CurlCommand{}
+	var rawURL string
+	var data []string
+	var head, get bool
+
+	for i := 1; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			rawURL = arg
+			continue
+		}
+
+		name, value, hasValue := arg, "", false
+		switch {
+		case strings.HasPrefix(arg, "--"):
+			if n, v, ok := strings.Cut(arg, "="); ok && curlValueFlags[n] {
+				name, value, hasValue = n, v, true
+			}
+		case len(arg) > 2:
+			// Grouped short options: "-sk" are switches, "-sXPOST" ends
+			// with -X and its value
+			j := 1
+			for ; j < len(arg) && !curlValueFlags["-"+arg[j:j+1]]; j++ {
+				cmd.curlSwitch("-"+arg[j:j+1], &head, &get)
+			}
+			if j == len(arg) {
+				continue
+			}
+			name = "-" + arg[j:j+1]
+			if j+1 < len(arg) {
+				value, hasValue = arg[j+1:], true
+			}
+		}
+
+		if !curlValueFlags[name] {
+			cmd.curlSwitch(name, &head, &get)
+			continue
+		}
+		if !hasValue {
+			if i+1 >= len(args) {
+				return nil, fmt.Errorf("%w: %s needs an argument", ErrInvalidCurl, name)
+			}
+			i++
+			value = args[i]
+		}
+
+		switch name {
+		case "-X", "--request":
+			cmd.Method = value
+		case "-H", "--header":
+			if h, ok := curlHeader(value); ok {
+				cmd.Header = append(cmd.Header, h)
+			}
+		case "-d", "--data", "--data-raw", "--data-binary", "--data-ascii":
+			data = append(data, value)
+		case "--data-urlencode":
+			data = append(data, curlURLEncode(value))
+		case "--json":
+			data = append(data, value)
+			cmd.Header = append(cmd.Header, HeaderField{Name: "Content-Type", Value: "application/json"})
+		case "-A", "--user-agent":
+			cmd.Header = append(cmd.Header, HeaderField{Name: "User-Agent", Value: value})
+		case "-e", "--referer":
+			cmd.Header = append(cmd.Header, HeaderField{Name: "Referer", Value: value})
+		case "-b", "--cookie":
+			if strings.Contains(value, "=") {
+				cmd.Header = append(cmd.Header, HeaderField{Name: "Cookie", Value: value})
+			}
+		case "--url":
+			rawURL = value
+		case "--request-target":
+			cmd.RequestTarget = value
+		}
+	}
+
+	if rawURL == "" {
+		return nil, fmt.Errorf("%w: no URL", ErrInvalidCurl)
+	}
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "http://" + rawURL
+	}
+	u, err := RawURLParseStrict(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if cmd.RequestTarget != "" {
+		if u, err = FromRequestTarget(u.Scheme, u.Host, cmd.RequestTarget); err != nil {
+			return nil, err
+		}
+	}
+	cmd.URL = u
+
+	switch {
+	case data != nil && get:
+		// The data goes into the URL, which --request-target overrides
+		if cmd.RequestTarget == "" {
+			if u.Query != "" {
+				u.Query += "&"
+			}
+			u.Query += strings.Join(data, "&")
+			u.RawRequestURI = u.Path + "?" + u.Query
+			if u.Fragment != "" {
+				u.RawRequestURI += "#" + u.Fragment
+			}
+			u.Original = u.String()
+		}
+	case data != nil:
+		cmd.Body = []byte(strings.Join(data, "&"))
+	}
+	if cmd.Method == "" {
+		switch {
+		case head:
+			cmd.Method = "HEAD"
+		case cmd.Body != nil:
+			cmd.Method = "POST"
+		default:
+			cmd.Method = "GET"
+		}
+	}
+	return cmd, nil
+}
+
+// curlSwitch applies a curl option without argument
+func (cmd *CurlCommand) curlSwitch(name string, head, get *bool) {
+	switch name {
+	case "--path-as-is":
+		cmd.PathAsIs = true
+	case "-k", "--insecure":
+		cmd.Insecure = true
+	case "-I", "--head":
+		*head = true
+	case "-G", "--get":
+		*get = true
+	case "-0", "--http1.0":
+		cmd.Proto = "HTTP/1.0"
+	case "--http1.1":
+		cmd.Proto = "HTTP/1.1"
+	case "--http2", "--http2-prior-knowledge":
+		cmd.Proto = "HTTP/2"
+	}
+}
+
+// curlURLEncode applies --data-urlencode to its argument: "content" and
+// "=content" give the encoded content, "name=content" keeps the name.
+// The "@file" forms are returned unchanged.
+func curlURLEncode(s string) string {
+	name, content, ok := strings.Cut(s, "=")
+	if !ok {
+		if strings.Contains(s, "@") {
+			return s
+		}
+		return curlEscape(s)
+	}
+	if name == "" {
+		return curlEscape(content)
+	}
+	return name + "=" + curlEscape(content)
+}
+
+// curlEscape percent-encodes every byte but the unreserved characters, as
+// curl_easy_escape does
+func curlEscape(s string) string {
+	var buf strings.Builder
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; isUnreserved(c) {
+			buf.WriteByte(c)
+		} else {
+			fmt.Fprintf(&buf, "%%%02X", c)
+		}
+	}
+	return buf.String()
+}
+
+// curlHeader parses a -H argument. "Name:" (which removes a header in curl)
+// is not returned, "Name;" is a header with an empty value.
+func curlHeader(s string) (HeaderField, bool) {
+	if name, value, ok := strings.Cut(s, ":"); ok {
+		value = strings.TrimLeft(value, " \t")
+		return HeaderField{Name: name, Value: value}, value != ""
+	}
+	if name, ok := strings.CutSuffix(s, ";"); ok {
+		return HeaderField{Name: name}, true
+	}
+	return HeaderField{}, false
+}
+
+// shellSplit splits a command line into words the way a POSIX shell does,
+// plus bash's $'...' quoting. Expansions and operators are not interpreted.
+func shellSplit(s string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	inWord := false
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+
+		case c == '\\':
+			if i+1 < len(s) && s[i+1] == '\n' {
+				i++
+				continue
+			}
+			if i+2 < len(s) && s[i+1] == '\r' && s[i+2] == '\n' {
+				i += 2
+				continue
+			}
+			if i+1 < len(s) {
+				i++
+				word.WriteByte(s[i])
+			}
+			inWord = true
+
+		case c == '\'':
+			end := strings.IndexByte(s[i+1:], '\'')
+			if end == -1 {
+				return nil, fmt.Errorf("%w: unterminated single quote", ErrInvalidCurl)
+			}
+			word.WriteString(s[i+1 : i+1+end])
+			i += end + 1
+			inWord = true
+
+		case c == '$' && i+1 < len(s) && s[i+1] == '\'':
+			n, err := ansiUnquote(s[i+2:], &word)
+			if err != nil {
+				return nil, err
+			}
+			i += n + 1
+			inWord = true
+
+		case c == '"':
+			i++
+			for ; i < len(s) && s[i] != '"'; i++ {
+				if s[i] == '\\' && i+1 < len(s) && strings.IndexByte("\"\\$`\n", s[i+1]) != -1 {
+					i++
+					if s[i] == '\n' {
+						continue
+					}
+				}
+				word.WriteByte(s[i])
+			}
+			if i >= len(s) {
+				return nil, fmt.Errorf("%w: unterminated double quote", ErrInvalidCurl)
+			}
+			inWord = true
+
+		default:
+			word.WriteByte(c)
+			inWord = true
+		}
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+// ansiUnquote decodes the body of a $'...' word up to and including the
+// closing quote into buf and returns the number of bytes consumed
+func ansiUnquote(s string, buf *strings.Builder) (int, error) {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '\'' {
+			return i + 1, nil
+		}
+		if c != '\\' || i+1 >= len(s) {
+			buf.WriteByte(c)
+			continue
+		}
+		i++
+		switch e := s[i]; e {
+		case 'n':
+			buf.WriteByte('\n')
+		case 'r':
+			buf.WriteByte('\r')
+		case 't':
+			buf.WriteByte('\t')
+		case 'a':
+			buf.WriteByte('\a')
+		case 'b':
+			buf.WriteByte('\b')
+		case 'e', 'E':
+			buf.WriteByte(0x1b)
+		case 'f':
+			buf.WriteByte('\f')
+		case 'v':
+			buf.WriteByte('\v')
+		case 'x':
+			j := i + 1
+			for j < len(s) && j < i+3 && isHexDigit(s[j]) {
+				j++
+			}
+			if j == i+1 {
+				buf.WriteString(`\x`)
+				continue
+			}
+			v, _ := strconv.ParseUint(s[i+1:j], 16, 8)
+			buf.WriteByte(byte(v))
+			i = j - 1
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			j := i
+			for j < len(s) && j < i+3 && s[j] >= '0' && s[j] <= '7' {
+				j++
+			}
+			v, _ := strconv.ParseUint(s[i:j], 8, 16)
+			buf.WriteByte(byte(v))
+			i = j - 1
+		default:
+			// \\, \', \" and unknown escapes
+			if e != '\\' && e != '\'' && e != '"' && e != '?' {
+				buf.WriteByte('\\')
+			}
+			buf.WriteByte(e)
+		}
+	}
+	return 0, fmt.Errorf("%w: unterminated $' quote", ErrInvalidCurl)
+}
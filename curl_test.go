@@ -0,0 +1,231 @@
+package rawurlparser
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestToCurl(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		opts *RequestOptions
+		want string
+	}{
+		{
+			name: "plain target in URL",
+			url:  "https://example.com/admin/..;/users?id=1",
+			want: "curl --path-as-is --http1.1 -H User-Agent: -H Accept: 'https://example.com/admin/..;/users?id=1'",
+		},
+		{
+			name: "shell characters are quoted",
+			url:  "https://example.com/a/%2e%2e/b?x=1&y=$(id)",
+			want: "curl --path-as-is --http1.1 -H User-Agent: -H Accept: 'https://example.com/a/%2e%2e/b?x=1&y=$(id)'",
+		},
+		{
+			name: "no quoting needed",
+			url:  "https://example.com/a/%2e%2e/b.txt",
+			want: "curl --path-as-is --http1.1 -H User-Agent: -H Accept: https://example.com/a/%2e%2e/b.txt",
+		},
+		{
+			name: "fragment needs request-target",
+			url:  "https://example.com/a#frag",
+			want: "curl --path-as-is --http1.1 --request-target '/a#frag' -H User-Agent: -H Accept: https://example.com/",
+		},
+		{
+			name: "control bytes use ansi quoting",
+			url:  "http://example.com/a\r\nX: y",
+			want: `curl --path-as-is --http1.1 --request-target $'/a\r\nX: y' -H User-Agent: -H Accept: http://example.com/`,
+		},
+		{
+			name: "method headers host and body",
+			url:  "http://user:pw@10.0.0.1:8080/it's[0]",
+			opts: &RequestOptions{
+				Method: "PUT",
+				Host:   "internal",
+				Header: []HeaderField{{"X-Empty", ""}, {"X-Quote", "a'b"}},
+				Body:   []byte("a=1&b=2"),
+			},
+			want: `curl --path-as-is --http1.1 -X PUT --globoff -H 'Host: internal' -H User-Agent: -H Accept: -H Content-Type: -H 'X-Empty;' -H 'X-Quote: a'\''b' --data-binary 'a=1&b=2' 'http://10.0.0.1:8080/it'\''s[0]'`,
+		},
+		{
+			name: "get with body and post without",
+			url:  "http://example.com/",
+			opts: &RequestOptions{Body: []byte("x")},
+			want: "curl --path-as-is --http1.1 -X GET -H User-Agent: -H Accept: -H Content-Type: --data-binary x http://example.com/",
+		},
+		{
+			name: "absolute-form and omitted host",
+			url:  "http://target.com/x",
+			opts: &RequestOptions{Form: AbsoluteForm, OmitHost: true, Proto: "HTTP/1.0"},
+			want: "curl --path-as-is --http1.0 --request-target http://target.com/x -H Host: -H User-Agent: -H Accept: http://target.com/",
+		},
+		{
+			name: "authority-form",
+			url:  "https://internal",
+			opts: &RequestOptions{Method: "CONNECT", Form: AuthorityForm},
+			want: "curl --path-as-is --http1.1 -X CONNECT --request-target internal:443 -H User-Agent: -H Accept: https://internal/",
+		},
+		{
+			name: "default headers set by the caller",
+			url:  "http://example.com/",
+			opts: &RequestOptions{Method: "POST", Header: []HeaderField{{"user-agent", "x"}, {"Content-Type", "text/plain"}}, Body: []byte("b")},
+			want: "curl --path-as-is --http1.1 -H Accept: -H 'user-agent: x' -H 'Content-Type: text/plain' --data-binary b http://example.com/",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := RawURLParseStrict(tt.url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := ToCurl(u, tt.opts); got != tt.want {
+				t.Errorf("ToCurl() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToCurlRoundTrip(t *testing.T) {
+	urls := []string{
+		"https://example.com/admin/..;/users?id=1",
+		"https://example.com/a b/%zz?q=\"x\"#frag",
+		"http://example.com/\x00\xff/ü",
+		"http://[::1]:8080/{a}/$HOME/`id`",
+	}
+	opts := &RequestOptions{
+		Method: "POST",
+		Header: []HeaderField{{"X-A", `"quoted" \ 'single'`}, {"X-B", "tab\there"}},
+		Body:   []byte("line1\nline2"),
+	}
+
+	for _, raw := range urls {
+		t.Run(raw, func(t *testing.T) {
+			u, err := RawURLParseStrict(raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cmdline := ToCurl(u, opts)
+			cmd, err := ParseCurl(cmdline)
+			if err != nil {
+				t.Fatalf("ParseCurl(%s) error = %v", cmdline, err)
+			}
+			if got := cmd.URL.GetRawRequestURI(); got != u.GetRawRequestURI() {
+				t.Errorf("GetRawRequestURI() = %q, want %q", got, u.GetRawRequestURI())
+			}
+			if cmd.URL.Host != u.Host || cmd.Method != "POST" || string(cmd.Body) != string(opts.Body) || !cmd.PathAsIs {
+				t.Errorf("ParseCurl() = %+v", cmd)
+			}
+			if !reflect.DeepEqual(cmd.Header, opts.Header) {
+				t.Errorf("Header = %q, want %q", cmd.Header, opts.Header)
+			}
+		})
+	}
+}
+
+func TestParseCurl(t *testing.T) {
+	tests := []struct {
+		name       string
+		cmdline    string
+		wantURL    string
+		wantMethod string
+		wantHeader []HeaderField
+		wantBody   string
+	}{
+		{
+			name: "browser copy as curl",
+			cmdline: `curl 'https://example.com/api?x=1' \
+  -H 'accept: */*' \
+  -H $'cookie: a=\'1\'; b=2' \
+  -H "X-Dq: \"v\" \$x" \
+  --data-raw '{"a":1}' \
+  --compressed`,
+			wantURL:    "https://example.com/api?x=1",
+			wantMethod: "POST",
+			wantHeader: []HeaderField{{"accept", "*/*"}, {"cookie", "a='1'; b=2"}, {"X-Dq", `"v" $x`}},
+			wantBody:   `{"a":1}`,
+		},
+		{
+			name:       "short flags and attached values",
+			cmdline:    `curl -skXPUT -HX-A:1 -d a -d b -A agent example.com/x`,
+			wantURL:    "http://example.com/x",
+			wantMethod: "PUT",
+			wantHeader: []HeaderField{{"X-A", "1"}, {"User-Agent", "agent"}},
+			wantBody:   "a&b",
+		},
+		{
+			name:       "request target and long options with equals",
+			cmdline:    `/usr/bin/curl --url=http://h/ --request-target='/a/../b c' --header='Host: x' -I`,
+			wantURL:    "http://h/a/../b c",
+			wantMethod: "HEAD",
+			wantHeader: []HeaderField{{"Host", "x"}},
+		},
+		{
+			name:       "get with data and removed header",
+			cmdline:    `curl -G -d q=1 -H 'Accept:' -H 'X-E;' http://h/`,
+			wantURL:    "http://h/?q=1",
+			wantMethod: "GET",
+			wantHeader: []HeaderField{{"X-E", ""}},
+		},
+		{
+			name:       "get appends to the query",
+			cmdline:    `curl --get 'http://h/s?x=1' --data-urlencode 'q=a b' -d y=2`,
+			wantURL:    "http://h/s?x=1&q=a%20b&y=2",
+			wantMethod: "GET",
+		},
+		{
+			name:       "data-urlencode forms",
+			cmdline:    `curl http://h/ --data-urlencode 'q=a b&c' --data-urlencode '=x/y' --data-urlencode 'plain ü' --data-urlencode 'f@file.txt'`,
+			wantURL:    "http://h/",
+			wantMethod: "POST",
+			wantBody:   "q=a%20b%26c&x%2Fy&plain%20%C3%BC&f@file.txt",
+		},
+		{
+			name:       "unknown options with values",
+			cmdline:    `curl --retry 3 --max-redirs 5 -C - -o out.txt --compressed -Y 100 http://h/x`,
+			wantURL:    "http://h/x",
+			wantMethod: "GET",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, err := ParseCurl(tt.cmdline)
+			if err != nil {
+				t.Fatalf("ParseCurl() error = %v", err)
+			}
+			if got := cmd.URL.String(); got != tt.wantURL {
+				t.Errorf("URL = %q, want %q", got, tt.wantURL)
+			}
+			if cmd.Method != tt.wantMethod {
+				t.Errorf("Method = %q, want %q", cmd.Method, tt.wantMethod)
+			}
+			if !reflect.DeepEqual(cmd.Header, tt.wantHeader) {
+				t.Errorf("Header = %q, want %q", cmd.Header, tt.wantHeader)
+			}
+			if string(cmd.Body) != tt.wantBody {
+				t.Errorf("Body = %q, want %q", cmd.Body, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestParseCurlErrors(t *testing.T) {
+	for _, cmdline := range []string{
+		"",
+		"wget http://h/",
+		"curl 'http://h/",
+		`curl "http://h/`,
+		"curl $'http://h/",
+		"curl -H",
+		"curl -s",
+	} {
+		t.Run(cmdline, func(t *testing.T) {
+			if _, err := ParseCurl(cmdline); !errors.Is(err, ErrInvalidCurl) {
+				t.Errorf("ParseCurl() error = %v, want ErrInvalidCurl", err)
+			}
+		})
+	}
+}
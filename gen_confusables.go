@@ -0,0 +1,127 @@
+//go:build ignore
+
+// Command gen_confusables writes homograph_tables.go from the UTS #39
+// confusables.txt. The file is downloaded from unicode.org unless -dir names
+// a directory that already holds it.
+//
+//	go run gen_confusables.go -version 17.0.0
+//	go run gen_confusables.go -version 17.0.0 -dir ./security
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	version = flag.String("version", "17.0.0", "Unicode version")
+	dir     = flag.String("dir", "", "read confusables.txt from this directory instead of unicode.org")
+	output  = flag.String("output", "homograph_tables.go", "file to write")
+)
+
+func main() {
+	flag.Parse()
+
+	prototypes := parseConfusables(open("confusables.txt"))
+	sources := make([]rune, 0, len(prototypes))
+	for r := range prototypes {
+		sources = append(sources, r)
+	}
+	sort.Slice(sources, func(i, j int) bool { return sources[i] < sources[j] })
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen_confusables.go from Unicode %s data; DO NOT EDIT.\n\n", *version)
+	fmt.Fprintf(&buf, "package rawurlparser\n\n")
+	fmt.Fprintf(&buf, "// confusablesVersion is the Unicode version of confusablePrototypes\n")
+	fmt.Fprintf(&buf, "const confusablesVersion = %q\n\n", *version)
+	fmt.Fprintf(&buf, "// confusablePrototypes is confusables.txt: every source character mapped\n")
+	fmt.Fprintf(&buf, "// to its prototype\n")
+	fmt.Fprintf(&buf, "var confusablePrototypes = map[rune]string{\n")
+	for _, r := range sources {
+		fmt.Fprintf(&buf, "0x%04X: %s,\n", r, strconv.QuoteToASCII(prototypes[r]))
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// open returns confusables.txt from -dir or from the Unicode site
+func open(name string) io.Reader {
+	if *dir != "" {
+		b, err := os.ReadFile(filepath.Join(*dir, name))
+		if err != nil {
+			log.Fatal(err)
+		}
+		return bytes.NewReader(b)
+	}
+	url := "https://www.unicode.org/Public/security/" + *version + "/" + name
+	resp, err := http.Get(url)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		log.Fatalf("%s: %s", url, resp.Status)
+	}
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return bytes.NewReader(b)
+}
+
+// parseConfusables reads "source ; prototype ; MA" lines. Since Unicode 9
+// every line is MA, other types are rejected so a format change is noticed.
+func parseConfusables(r io.Reader) map[rune]string {
+	prototypes := map[rune]string{}
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line, _, _ := strings.Cut(sc.Text(), "#")
+		line = strings.TrimPrefix(line, "\uFEFF")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		f := strings.Split(line, ";")
+		if len(f) < 3 || strings.TrimSpace(f[2]) != "MA" {
+			log.Fatalf("confusables.txt: unexpected line %q", sc.Text())
+		}
+		source := []rune(parseCodePoints(f[0]))
+		if len(source) != 1 {
+			log.Fatalf("confusables.txt: source %q is not a single code point", f[0])
+		}
+		prototypes[source[0]] = parseCodePoints(f[1])
+	}
+	if err := sc.Err(); err != nil {
+		log.Fatal(err)
+	}
+	return prototypes
+}
+
+func parseCodePoints(s string) string {
+	var b strings.Builder
+	for _, f := range strings.Fields(s) {
+		n, err := strconv.ParseUint(f, 16, 32)
+		if err != nil {
+			log.Fatalf("bad code point %q", f)
+		}
+		b.WriteRune(rune(n))
+	}
+	return b.String()
+}
@@ -0,0 +1,285 @@
+//go:build ignore
+
+// Command gen_idna_tables writes idna_tables.go from the Unicode data files:
+// the UTS #46 IdnaMappingTable.txt and, for NFC, UnicodeData.txt and
+// CompositionExclusions.txt. The files are downloaded from unicode.org unless
+// -dir names a directory that already holds them.
+//
+//	go run gen_idna_tables.go -version 17.0.0
+//	go run gen_idna_tables.go -version 17.0.0 -dir ./ucd
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	version = flag.String("version", "17.0.0", "Unicode version")
+	dir     = flag.String("dir", "", "read the data files from this directory instead of unicode.org")
+	output  = flag.String("output", "idna_tables.go", "file to write")
+)
+
+// statuses maps IdnaMappingTable.txt statuses to the names used in idna.go
+var statuses = map[string]string{
+	"valid":                  "idnaStatusValid",
+	"mapped":                 "idnaStatusMapped",
+	"deviation":              "idnaStatusDeviation",
+	"ignored":                "idnaStatusIgnored",
+	"disallowed":             "idnaStatusDisallowed",
+	"disallowed_STD3_valid":  "idnaStatusSTD3Valid",
+	"disallowed_STD3_mapped": "idnaStatusSTD3Mapped",
+}
+
+type idnaEntry struct {
+	lo, hi  rune
+	status  string
+	mapping string
+}
+
+type cccRange struct {
+	lo, hi rune
+	ccc    int
+}
+
+func main() {
+	flag.Parse()
+
+	entries := parseIDNA(open("idna", "IdnaMappingTable.txt"))
+	ccc, decomp := parseUnicodeData(open("ucd", "UnicodeData.txt"))
+	exclusions := parseExclusions(open("ucd", "CompositionExclusions.txt"))
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen_idna_tables.go from Unicode %s data; DO NOT EDIT.\n\n", *version)
+	fmt.Fprintf(&buf, "package rawurlparser\n\n")
+	fmt.Fprintf(&buf, "// idnaUnicodeVersion is the Unicode version of the tables below\n")
+	fmt.Fprintf(&buf, "const idnaUnicodeVersion = %q\n\n", *version)
+
+	fmt.Fprintf(&buf, "// idnaTable is IdnaMappingTable.txt. Each entry applies from lo up to the\n")
+	fmt.Fprintf(&buf, "// next entry's lo; mapping is set for mapped and deviation entries.\n")
+	fmt.Fprintf(&buf, "var idnaTable = []idnaTableEntry{\n")
+	for _, e := range entries {
+		fmt.Fprintf(&buf, "{0x%04X, %s, %s},\n", e.lo, statuses[e.status], strconv.QuoteToASCII(e.mapping))
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+
+	fmt.Fprintf(&buf, "// canonicalCombiningClasses lists the code points with a non-zero\n")
+	fmt.Fprintf(&buf, "// Canonical_Combining_Class\n")
+	fmt.Fprintf(&buf, "var canonicalCombiningClasses = []combiningClassRange{\n")
+	for _, c := range ccc {
+		fmt.Fprintf(&buf, "{0x%04X, 0x%04X, %d},\n", c.lo, c.hi, c.ccc)
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+
+	// Full decompositions, and the primary composites of the pairs
+	full := map[rune]string{}
+	var expand func(r rune) string
+	expand = func(r rune) string {
+		d, ok := decomp[r]
+		if !ok {
+			return string(r)
+		}
+		var s strings.Builder
+		for _, c := range d {
+			s.WriteString(expand(c))
+		}
+		return s.String()
+	}
+	compositions := map[[2]rune]rune{}
+	for r, d := range decomp {
+		full[r] = expand(r)
+		if len(d) == 2 && !exclusions[r] && cccOf(ccc, r) == 0 && cccOf(ccc, d[0]) == 0 {
+			compositions[[2]rune{d[0], d[1]}] = r
+		}
+	}
+
+	fmt.Fprintf(&buf, "// canonicalDecompositions holds the full canonical decomposition of every\n")
+	fmt.Fprintf(&buf, "// code point that has one, except Hangul syllables (see decompose)\n")
+	fmt.Fprintf(&buf, "var canonicalDecompositions = map[rune]string{\n")
+	for _, r := range sortedKeys(full) {
+		fmt.Fprintf(&buf, "0x%04X: %s,\n", r, strconv.QuoteToASCII(full[r]))
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+
+	pairs := make([][2]rune, 0, len(compositions))
+	for p := range compositions {
+		pairs = append(pairs, p)
+	}
+	sort.Slice(pairs, func(i, j int) bool { return compositions[pairs[i]] < compositions[pairs[j]] })
+	fmt.Fprintf(&buf, "// nfcCompositions maps starter pairs to their primary composite, except\n")
+	fmt.Fprintf(&buf, "// Hangul syllables (see compose)\n")
+	fmt.Fprintf(&buf, "var nfcCompositions = map[[2]rune]rune{\n")
+	for _, p := range pairs {
+		fmt.Fprintf(&buf, "{0x%04X, 0x%04X}: 0x%04X,\n", p[0], p[1], compositions[p])
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// open returns a data file from -dir or from the Unicode site. Since Unicode
+// 16.0.0 the IDNA files live next to the UCD, before that under /idna/.
+func open(sub, name string) io.Reader {
+	if *dir != "" {
+		b, err := os.ReadFile(filepath.Join(*dir, name))
+		if err != nil {
+			log.Fatal(err)
+		}
+		return bytes.NewReader(b)
+	}
+	url := "https://www.unicode.org/Public/" + *version + "/" + sub + "/" + name
+	if major, _ := strconv.Atoi(strings.Split(*version, ".")[0]); sub == "idna" && major < 16 {
+		url = "https://www.unicode.org/Public/idna/" + *version + "/" + name
+	}
+	resp, err := http.Get(url)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		log.Fatalf("%s: %s", url, resp.Status)
+	}
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return bytes.NewReader(b)
+}
+
+// lines calls fn with the semicolon-separated fields of every data line
+func lines(r io.Reader, fn func(fields []string)) {
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line, _, _ := strings.Cut(sc.Text(), "#")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		fn(fields)
+	}
+	if err := sc.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func parseIDNA(r io.Reader) []idnaEntry {
+	var entries []idnaEntry
+	next := rune(0)
+	lines(r, func(f []string) {
+		lo, hi := parseRange(f[0])
+		if lo != next {
+			log.Fatalf("IdnaMappingTable.txt: %04X follows %04X", lo, next-1)
+		}
+		next = hi + 1
+		if _, ok := statuses[f[1]]; !ok {
+			log.Fatalf("IdnaMappingTable.txt: unknown status %q", f[1])
+		}
+		e := idnaEntry{lo: lo, hi: hi, status: f[1]}
+		if len(f) > 2 && f[1] != "valid" {
+			e.mapping = parseCodePoints(f[2])
+		}
+		if n := len(entries); n > 0 && entries[n-1].status == e.status && entries[n-1].mapping == e.mapping {
+			entries[n-1].hi = hi
+			return
+		}
+		entries = append(entries, e)
+	})
+	if next != 0x110000 {
+		log.Fatalf("IdnaMappingTable.txt ends at %04X", next-1)
+	}
+	return entries
+}
+
+// parseUnicodeData returns the non-zero combining classes and the
+// single-level canonical decompositions
+func parseUnicodeData(r io.Reader) ([]cccRange, map[rune][]rune) {
+	var ccc []cccRange
+	decomp := map[rune][]rune{}
+	lines(r, func(f []string) {
+		cp := parseCodePoint(f[0])
+		class, err := strconv.Atoi(f[3])
+		if err != nil {
+			log.Fatalf("UnicodeData.txt: %04X: %v", cp, err)
+		}
+		if class != 0 {
+			if n := len(ccc); n > 0 && ccc[n-1].hi == cp-1 && ccc[n-1].ccc == class {
+				ccc[n-1].hi = cp
+			} else {
+				ccc = append(ccc, cccRange{cp, cp, class})
+			}
+		}
+		if f[5] != "" && !strings.HasPrefix(f[5], "<") {
+			decomp[cp] = []rune(parseCodePoints(f[5]))
+		}
+	})
+	return ccc, decomp
+}
+
+func parseExclusions(r io.Reader) map[rune]bool {
+	excluded := map[rune]bool{}
+	lines(r, func(f []string) {
+		excluded[parseCodePoint(f[0])] = true
+	})
+	return excluded
+}
+
+func cccOf(ccc []cccRange, r rune) int {
+	i := sort.Search(len(ccc), func(i int) bool { return ccc[i].hi >= r })
+	if i < len(ccc) && ccc[i].lo <= r {
+		return ccc[i].ccc
+	}
+	return 0
+}
+
+func parseRange(s string) (rune, rune) {
+	lo, hi, ok := strings.Cut(s, "..")
+	if !ok {
+		return parseCodePoint(lo), parseCodePoint(lo)
+	}
+	return parseCodePoint(lo), parseCodePoint(hi)
+}
+
+func parseCodePoints(s string) string {
+	var b strings.Builder
+	for _, f := range strings.Fields(s) {
+		b.WriteRune(parseCodePoint(f))
+	}
+	return b.String()
+}
+
+func parseCodePoint(s string) rune {
+	n, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		log.Fatalf("bad code point %q", s)
+	}
+	return rune(n)
+}
+
+func sortedKeys(m map[rune]string) []rune {
+	keys := make([]rune, 0, len(m))
+	for r := range m {
+		keys = append(keys, r)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
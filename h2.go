@@ -0,0 +1,276 @@
+package rawurlparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PseudoHeaderOptions controls how a RawURL is mapped to HTTP/2
+// pseudo-headers. The zero value gives a valid request; the other fields
+// produce deliberately inconsistent ones.
+type PseudoHeaderOptions struct {
+	Method             string // :method, "GET" when empty
+	Authority          string // :authority instead of the URL's Host
+	OmitAuthority      bool   // Do not send :authority at all
+	Userinfo           bool   // Keep userinfo in :authority, see GetAuthority
+	Host               string // Adds a regular host header with this value
+	Path               string // :path instead of GetRawRequestURI(), sent as is
+	NoLeadingSlash     bool   // Strip the leading "/" from :path
+	EmptyPath          bool   // Send an empty :path
+	Header             []HeaderField
+	KeepHeaderNameCase bool // Do not lowercase the names in Header
+}
+
+// H2Violation is a pseudo-header or field combination RFC 9113 forbids
+type H2Violation struct {
+	Field   string // Offending field name, empty for missing fields
+	Section string // RFC 9113 section
+	Message string
+}
+
+func (v H2Violation) String() string {
+	if v.Field == "" {
+		return "RFC 9113 " + v.Section + ": " + v.Message
+	}
+	return "RFC 9113 " + v.Section + ": " + v.Field + ": " + v.Message
+}
+
+// PseudoHeaders maps u to the :method, :scheme, :authority and :path
+// pseudo-headers followed by opts.Header. :authority is u.Host (GetAuthority
+// with opts.Userinfo) and :path is GetRawRequestURI() byte for byte without
+// the fragment (RFC 9113, section 8.3.1), "/" when the URL has none. CONNECT
+// requests get only :method and :authority.
+// The result is not validated, see ValidatePseudoHeaders.
+func (u *RawURL) PseudoHeaders(opts *PseudoHeaderOptions) []HeaderField {
+	if opts == nil {
+		opts = &PseudoHeaderOptions{}
+	}
+	method := opts.Method
+	if method == "" {
+		method = "GET"
+	}
+
+	authority := u.Host
+	if opts.Userinfo {
+		authority = GetAuthority(u)
+	}
+	if opts.Authority != "" {
+		authority = opts.Authority
+	}
+
+	path := u.GetRawRequestURI()
+	if i := strings.IndexByte(path, '#'); i != -1 {
+		path = path[:i]
+	}
+	if path == "" {
+		path = "/"
+	}
+	if opts.Path != "" {
+		path = opts.Path
+	}
+	if opts.NoLeadingSlash {
+		path = strings.TrimPrefix(path, "/")
+	}
+	if opts.EmptyPath {
+		path = ""
+	}
+
+	fields := []HeaderField{{Name: ":method", Value: method}}
+	if method != "CONNECT" {
+		fields = append(fields, HeaderField{Name: ":scheme", Value: u.Scheme})
+	}
+	if !opts.OmitAuthority {
+		fields = append(fields, HeaderField{Name: ":authority", Value: authority})
+	}
+	if method != "CONNECT" {
+		fields = append(fields, HeaderField{Name: ":path", Value: path})
+	}
+
+	if opts.Host != "" {
+		fields = append(fields, HeaderField{Name: "host", Value: opts.Host})
+	}
+	for _, h := range opts.Header {
+		if !opts.KeepHeaderNameCase {
+			h.Name = strings.ToLower(h.Name)
+		}
+		fields = append(fields, h)
+	}
+	return fields
+}
+
+// FromPseudoHeaders rebuilds a RawURL from HTTP/2 request fields. :path is
+// always taken as an origin-form target and kept byte for byte, even when it
+// is empty or has no leading "/"; the host header is used when :authority is
+// missing. CONNECT requests give an authority-only URL.
+func FromPseudoHeaders(fields []HeaderField) (*RawURL, error) {
+	var method, scheme, authority, path, host string
+	var hasPath bool
+	for _, f := range fields {
+		switch strings.ToLower(f.Name) {
+		case ":method":
+			method = f.Value
+		case ":scheme":
+			scheme = f.Value
+		case ":authority":
+			authority = f.Value
+		case ":path":
+			path, hasPath = f.Value, true
+		case "host":
+			if host == "" {
+				host = f.Value
+			}
+		}
+	}
+	if authority == "" {
+		authority = host
+	}
+
+	if method == "CONNECT" && !hasPath {
+		if authority == "" {
+			return nil, fmt.Errorf("%w: CONNECT without :authority", ErrInvalidRequest)
+		}
+		if scheme == "" {
+			scheme = "https"
+		}
+		return FromRequestTarget(scheme, authority, authority)
+	}
+	if scheme == "" {
+		return nil, fmt.Errorf("%w: missing :scheme", ErrInvalidRequest)
+	}
+	if !hasPath {
+		return nil, fmt.Errorf("%w: missing :path", ErrInvalidRequest)
+	}
+	return originFormURL(scheme, authority, path)
+}
+
+// h2ConnectionHeaders are connection-specific fields (RFC 9113, section 8.2.2)
+var h2ConnectionHeaders = map[string]bool{
+	"connection":        true,
+	"keep-alive":        true,
+	"proxy-connection":  true,
+	"transfer-encoding": true,
+	"upgrade":           true,
+}
+
+// ValidatePseudoHeaders returns every rule of RFC 9113 the request fields
+// break: problems with single fields first, then missing or inconsistent
+// pseudo-headers. An empty result means the request is well-formed.
+func ValidatePseudoHeaders(fields []HeaderField) []H2Violation {
+	var violations []H2Violation
+	report := func(field, section, format string, args ...any) {
+		violations = append(violations, H2Violation{Field: field, Section: section, Message: fmt.Sprintf(format, args...)})
+	}
+
+	pseudo := map[string]string{}
+	seen := map[string]int{}
+	var host string
+	var hasHost, regularSeen bool
+
+	for _, f := range fields {
+		if f.Name == "" {
+			report("", "8.2.1", "empty field name")
+			continue
+		}
+		if strings.ToLower(f.Name) != f.Name {
+			report(f.Name, "8.2.1", "field names must be lowercase")
+		}
+		if strings.ContainsAny(f.Value, "\x00\r\n") {
+			report(f.Name, "8.2.1", "value contains NUL, CR or LF")
+		}
+		if v := strings.Trim(f.Value, " \t"); v != f.Value {
+			report(f.Name, "8.2.1", "value starts or ends with whitespace")
+		}
+
+		name := strings.ToLower(f.Name)
+		if strings.HasPrefix(name, ":") {
+			switch name {
+			case ":method", ":scheme", ":authority", ":path", ":protocol":
+			default:
+				report(f.Name, "8.3", "undefined pseudo-header for a request")
+				continue
+			}
+			if regularSeen {
+				report(f.Name, "8.3", "pseudo-header after a regular field")
+			}
+			if seen[name]++; seen[name] == 2 {
+				report(f.Name, "8.3.1", "pseudo-header repeated")
+			}
+			if _, ok := pseudo[name]; !ok {
+				pseudo[name] = f.Value
+			}
+			continue
+		}
+
+		regularSeen = true
+		switch {
+		case h2ConnectionHeaders[name]:
+			report(f.Name, "8.2.2", "connection-specific field")
+		case name == "te" && !strings.EqualFold(f.Value, "trailers"):
+			report(f.Name, "8.2.2", "te may only be \"trailers\"")
+		case name == "host" && !hasHost:
+			host, hasHost = f.Value, true
+		}
+	}
+
+	method, hasMethod := pseudo[":method"]
+	scheme, hasScheme := pseudo[":scheme"]
+	authority, hasAuthority := pseudo[":authority"]
+	path, hasPath := pseudo[":path"]
+	_, hasProtocol := pseudo[":protocol"]
+
+	if !hasMethod {
+		report("", "8.3.1", "missing :method")
+	}
+
+	if method == "CONNECT" && !hasProtocol {
+		// Plain CONNECT (section 8.5)
+		if hasScheme {
+			report(":scheme", "8.5", "must be omitted for CONNECT")
+		}
+		if hasPath {
+			report(":path", "8.5", "must be omitted for CONNECT")
+		}
+		if !hasAuthority {
+			report("", "8.5", "CONNECT requires :authority")
+		} else if _, port := splitHostPortLast(authority); !isDigits(port) {
+			report(":authority", "8.5", "CONNECT :authority must be host:port")
+		}
+	} else {
+		if hasProtocol && method != "CONNECT" {
+			report(":protocol", "8.3", "only defined for extended CONNECT (RFC 8441)")
+		}
+		if !hasScheme {
+			report("", "8.3.1", "missing :scheme")
+		}
+		if !hasPath {
+			report("", "8.3.1", "missing :path")
+		}
+		web := strings.EqualFold(scheme, "http") || strings.EqualFold(scheme, "https")
+		switch {
+		case !hasPath:
+		case path == "":
+			if web {
+				report(":path", "8.3.1", "must not be empty for http or https, use \"/\"")
+			}
+		case path == "*":
+			if method != "OPTIONS" {
+				report(":path", "8.3.1", "\"*\" is only allowed for OPTIONS")
+			}
+		case !strings.HasPrefix(path, "/") && web:
+			report(":path", "8.3.1", "must start with \"/\" for http or https")
+		}
+		if web && !hasAuthority && !hasHost {
+			report("", "8.3.1", "http or https request without :authority or host")
+		}
+	}
+
+	if hasAuthority {
+		if strings.Contains(authority, "@") && (strings.EqualFold(scheme, "http") || strings.EqualFold(scheme, "https") || method == "CONNECT") {
+			report(":authority", "8.3.1", "must not include userinfo")
+		}
+		if hasHost && host != authority {
+			report("host", "8.3.1", "host %q differs from :authority %q", host, authority)
+		}
+	}
+	return violations
+}
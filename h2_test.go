@@ -0,0 +1,271 @@
+package rawurlparser
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPseudoHeaders(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		opts *PseudoHeaderOptions
+		want []HeaderField
+	}{
+		{
+			name: "raw path kept",
+			url:  "https://example.com:8443/a/..%2f/b;x?q=%zz",
+			want: []HeaderField{
+				{":method", "GET"}, {":scheme", "https"}, {":authority", "example.com:8443"}, {":path", "/a/..%2f/b;x?q=%zz"},
+			},
+		},
+		{
+			name: "fragment stripped",
+			url:  "https://example.com/a?q=1#frag",
+			want: []HeaderField{
+				{":method", "GET"}, {":scheme", "https"}, {":authority", "example.com"}, {":path", "/a?q=1"},
+			},
+		},
+		{
+			name: "fragment only",
+			url:  "https://example.com/#frag",
+			want: []HeaderField{
+				{":method", "GET"}, {":scheme", "https"}, {":authority", "example.com"}, {":path", "/"},
+			},
+		},
+		{
+			name: "no path becomes slash",
+			url:  "http://example.com",
+			want: []HeaderField{
+				{":method", "GET"}, {":scheme", "http"}, {":authority", "example.com"}, {":path", "/"},
+			},
+		},
+		{
+			name: "authority differs from host",
+			url:  "https://user:pw@example.com/admin",
+			opts: &PseudoHeaderOptions{Method: "POST", Userinfo: true, Host: "internal", Header: []HeaderField{{"X-Test", "1"}}},
+			want: []HeaderField{
+				{":method", "POST"}, {":scheme", "https"}, {":authority", "user:pw@example.com"}, {":path", "/admin"},
+				{"host", "internal"}, {"x-test", "1"},
+			},
+		},
+		{
+			name: "no leading slash",
+			url:  "https://example.com//admin",
+			opts: &PseudoHeaderOptions{NoLeadingSlash: true, OmitAuthority: true, Host: "example.com"},
+			want: []HeaderField{
+				{":method", "GET"}, {":scheme", "https"}, {":path", "/admin"}, {"host", "example.com"},
+			},
+		},
+		{
+			name: "empty path and override",
+			url:  "https://example.com/x",
+			opts: &PseudoHeaderOptions{Authority: "evil.com", EmptyPath: true},
+			want: []HeaderField{
+				{":method", "GET"}, {":scheme", "https"}, {":authority", "evil.com"}, {":path", ""},
+			},
+		},
+		{
+			name: "connect",
+			url:  "https://example.com:443/ignored",
+			opts: &PseudoHeaderOptions{Method: "CONNECT"},
+			want: []HeaderField{{":method", "CONNECT"}, {":authority", "example.com:443"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := RawURLParseStrict(tt.url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := u.PseudoHeaders(tt.opts); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PseudoHeaders() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromPseudoHeaders(t *testing.T) {
+	tests := []struct {
+		name    string
+		fields  []HeaderField
+		wantURL string
+		wantURI string
+		wantErr bool
+	}{
+		{
+			name:    "round trip",
+			fields:  []HeaderField{{":method", "GET"}, {":scheme", "https"}, {":authority", "example.com"}, {":path", "/a/%2e%2e/b?x#f"}},
+			wantURL: "https://example.com/a/%2e%2e/b?x#f",
+			wantURI: "/a/%2e%2e/b?x#f",
+		},
+		{
+			name:    "host header fallback",
+			fields:  []HeaderField{{":method", "GET"}, {":scheme", "http"}, {":path", "/x"}, {"host", "h.test"}},
+			wantURL: "http://h.test/x",
+			wantURI: "/x",
+		},
+		{
+			// String() joins the path to the host as it is
+			name:    "no leading slash",
+			fields:  []HeaderField{{":scheme", "https"}, {":authority", "example.com"}, {":path", "admin"}},
+			wantURL: "https://example.comadmin",
+			wantURI: "admin",
+		},
+		{
+			name:    "absolute path kept",
+			fields:  []HeaderField{{":scheme", "https"}, {":authority", "example.com"}, {":path", "http://evil.com/x"}},
+			wantURL: "https://example.comhttp://evil.com/x",
+			wantURI: "http://evil.com/x",
+		},
+		{
+			name:    "authority-like path kept",
+			fields:  []HeaderField{{":scheme", "https"}, {":authority", "example.com"}, {":path", "evil.com:80"}},
+			wantURL: "https://example.comevil.com:80",
+			wantURI: "evil.com:80",
+		},
+		{
+			name:    "connect",
+			fields:  []HeaderField{{":method", "CONNECT"}, {":authority", "internal:8443"}},
+			wantURL: "https://internal:8443",
+		},
+		{
+			name:    "empty path",
+			fields:  []HeaderField{{":scheme", "foo"}, {":authority", "a"}, {":path", ""}},
+			wantURL: "foo://a",
+			wantURI: "",
+		},
+		{name: "missing path", fields: []HeaderField{{":scheme", "https"}, {":authority", "a"}}, wantErr: true},
+		{name: "no scheme", fields: []HeaderField{{":authority", "a"}, {":path", "/"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := FromPseudoHeaders(tt.fields)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromPseudoHeaders() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if u.String() != tt.wantURL {
+				t.Errorf("String() = %q, want %q", u.String(), tt.wantURL)
+			}
+			if got := u.GetRawRequestURI(); got != tt.wantURI {
+				t.Errorf("GetRawRequestURI() = %q, want %q", got, tt.wantURI)
+			}
+		})
+	}
+}
+
+func TestPseudoHeadersRoundTrip(t *testing.T) {
+	u, err := RawURLParseStrict("https://example.com//admin?x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		opts    *PseudoHeaderOptions
+		wantURI string
+	}{
+		{nil, "//admin?x"},
+		{&PseudoHeaderOptions{NoLeadingSlash: true}, "/admin?x"},
+		{&PseudoHeaderOptions{EmptyPath: true}, ""},
+	} {
+		back, err := FromPseudoHeaders(u.PseudoHeaders(tt.opts))
+		if err != nil {
+			t.Errorf("%+v: FromPseudoHeaders() error = %v", tt.opts, err)
+			continue
+		}
+		if got := back.GetRawRequestURI(); got != tt.wantURI || back.Host != "example.com" {
+			t.Errorf("%+v: request-target = %q, Host = %q, want %q", tt.opts, got, back.Host, tt.wantURI)
+		}
+	}
+}
+
+func TestValidatePseudoHeaders(t *testing.T) {
+	base := func(extra ...HeaderField) []HeaderField {
+		return append([]HeaderField{{":method", "GET"}, {":scheme", "https"}, {":authority", "example.com"}, {":path", "/"}}, extra...)
+	}
+
+	tests := []struct {
+		name   string
+		fields []HeaderField
+		want   []string // substrings of the violations, in order
+	}{
+		{name: "valid", fields: base(HeaderField{"accept", "*/*"})},
+		{name: "valid host equal", fields: base(HeaderField{"host", "example.com"})},
+		{name: "valid options asterisk", fields: []HeaderField{{":method", "OPTIONS"}, {":scheme", "https"}, {":authority", "a"}, {":path", "*"}}},
+		{name: "valid connect", fields: []HeaderField{{":method", "CONNECT"}, {":authority", "[::1]:443"}}},
+		{
+			name:   "host differs",
+			fields: base(HeaderField{"host", "internal"}),
+			want:   []string{`8.3.1: host: host "internal" differs`},
+		},
+		{
+			name:   "empty path",
+			fields: []HeaderField{{":method", "GET"}, {":scheme", "https"}, {":authority", "a"}, {":path", ""}},
+			want:   []string{":path: must not be empty"},
+		},
+		{
+			name:   "no leading slash",
+			fields: []HeaderField{{":method", "GET"}, {":scheme", "http"}, {":authority", "a"}, {":path", "admin"}},
+			want:   []string{`:path: must start with "/"`},
+		},
+		{
+			name:   "asterisk without options",
+			fields: []HeaderField{{":method", "GET"}, {":scheme", "http"}, {":authority", "a"}, {":path", "*"}},
+			want:   []string{`"*" is only allowed for OPTIONS`},
+		},
+		{
+			name:   "missing fields",
+			fields: []HeaderField{{":path", "/"}},
+			want:   []string{"missing :method", "missing :scheme"},
+		},
+		{
+			name:   "no authority",
+			fields: []HeaderField{{":method", "GET"}, {":scheme", "https"}, {":path", "/"}},
+			want:   []string{"without :authority or host"},
+		},
+		{
+			name:   "userinfo",
+			fields: []HeaderField{{":method", "GET"}, {":scheme", "https"}, {":authority", "u@a"}, {":path", "/"}},
+			want:   []string{"must not include userinfo"},
+		},
+		{
+			name:   "order duplicates and case",
+			fields: []HeaderField{{":method", "GET"}, {"accept", "*/*"}, {":scheme", "https"}, {":Path", "/"}, {":path", "/x"}, {":authority", "a"}},
+			want:   []string{"after a regular field", "must be lowercase", "after a regular field", "after a regular field", "repeated", "after a regular field"},
+		},
+		{
+			name:   "connection-specific and values",
+			fields: base(HeaderField{"connection", "close"}, HeaderField{"te", "gzip"}, HeaderField{"x", " a\r\n"}, HeaderField{":status", "200"}),
+			want:   []string{"connection-specific", `te may only be "trailers"`, "NUL, CR or LF", "whitespace", "undefined pseudo-header"},
+		},
+		{
+			name:   "connect with path",
+			fields: []HeaderField{{":method", "CONNECT"}, {":scheme", "https"}, {":authority", "a"}, {":path", "/"}},
+			want:   []string{":scheme: must be omitted", ":path: must be omitted", "must be host:port"},
+		},
+		{
+			name:   "protocol without connect",
+			fields: base(HeaderField{":protocol", "websocket"}),
+			want:   []string{"extended CONNECT"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidatePseudoHeaders(tt.fields)
+			if len(got) != len(tt.want) {
+				t.Fatalf("ValidatePseudoHeaders() = %v, want %d violations", got, len(tt.want))
+			}
+			for i, v := range got {
+				if !strings.Contains(v.String(), tt.want[i]) {
+					t.Errorf("violation %d = %q, want it to contain %q", i, v.String(), tt.want[i])
+				}
+			}
+		})
+	}
+}
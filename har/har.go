@@ -0,0 +1,204 @@
+// File: har/har.go
+/*
+Package har reads HTTP Archive (HAR 1.2) files, as exported by browser
+developer tools and intercepting proxies, into raw URLs.
+
+Browsers normalize the URL they store in request.url. For HTTP/2 and HTTP/3
+requests they also record the pseudo-headers that were actually sent; when a
+:path pseudo-header is present it is preferred over request.url, so the
+request-target is the one that went onto the wire.
+*/
+package har
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/slicingmelon/go-rawurlparser"
+)
+
+var ErrInvalidHAR = errors.New("invalid HAR")
+
+// Entry is a single request from a HAR file
+type Entry struct {
+	URL             *rawurlparser.RawURL       // Parsed request URL, see RawTarget
+	Method          string                     // request.method
+	HTTPVersion     string                     // request.httpVersion, e.g. "HTTP/1.1" or "h2"
+	Header          []rawurlparser.HeaderField // request.headers in order, pseudo-headers included
+	PostData        *PostData                  // request.postData, nil when absent
+	StartedDateTime string                     // ISO 8601 timestamp as in the file
+	HARURL          string                     // request.url exactly as in the file
+
+	// RawTarget is set when the request-target was taken from the :path
+	// pseudo-header instead of request.url
+	RawTarget bool
+	// Normalized is set when request.url holds a different request-target
+	// than :path, i.e. the URL in the file is not the one that was sent
+	Normalized bool
+}
+
+// PostData is the request body as recorded in the HAR file
+type PostData struct {
+	MimeType string  `json:"mimeType"`
+	Text     string  `json:"text"`
+	Params   []Param `json:"params,omitempty"`
+}
+
+// Param is a posted form parameter
+type Param struct {
+	Name        string `json:"name"`
+	Value       string `json:"value,omitempty"`
+	FileName    string `json:"fileName,omitempty"`
+	ContentType string `json:"contentType,omitempty"`
+}
+
+// EntryError is a HAR entry that could not be turned into a request
+type EntryError struct {
+	Index int // Position in log.entries, starting at 0
+	Err   error
+}
+
+func (e *EntryError) Error() string {
+	return fmt.Sprintf("entry %d: %v", e.Index, e.Err)
+}
+
+func (e *EntryError) Unwrap() error {
+	return e.Err
+}
+
+// harFile is the part of the HAR format that is read
+type harFile struct {
+	Log *struct {
+		Version string     `json:"version"`
+		Entries []harEntry `json:"entries"`
+	} `json:"log"`
+}
+
+type harEntry struct {
+	StartedDateTime string `json:"startedDateTime"`
+	Request         struct {
+		Method      string      `json:"method"`
+		URL         string      `json:"url"`
+		HTTPVersion string      `json:"httpVersion"`
+		Headers     []harHeader `json:"headers"`
+		PostData    *PostData   `json:"postData"`
+	} `json:"request"`
+}
+
+type harHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// LoadFile reads the HAR file at path
+func LoadFile(path string) ([]*Entry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Load(f)
+}
+
+// Load reads a HAR document and returns its requests in file order. Entries
+// that cannot be parsed are skipped; the others are still returned, together
+// with an error joining an *EntryError for each skipped entry.
+func Load(r io.Reader) ([]*Entry, error) {
+	var file harFile
+	if err := json.NewDecoder(r).Decode(&file); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidHAR, err)
+	}
+	if file.Log == nil {
+		return nil, fmt.Errorf("%w: no log object", ErrInvalidHAR)
+	}
+
+	entries := make([]*Entry, 0, len(file.Log.Entries))
+	var errs []error
+	for i, e := range file.Log.Entries {
+		entry, err := newEntry(e)
+		if err != nil {
+			errs = append(errs, &EntryError{Index: i, Err: fmt.Errorf("%w: %v", ErrInvalidHAR, err)})
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, errors.Join(errs...)
+}
+
+// newEntry parses request.url and applies the :path pseudo-header
+func newEntry(e harEntry) (*Entry, error) {
+	req := e.Request
+	if req.URL == "" {
+		return nil, errors.New("empty request url")
+	}
+	u, err := rawurlparser.RawURLParse(req.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &Entry{
+		URL:             u,
+		Method:          req.Method,
+		HTTPVersion:     req.HTTPVersion,
+		PostData:        req.PostData,
+		StartedDateTime: e.StartedDateTime,
+		HARURL:          req.URL,
+	}
+	var path, authority string
+	var hasPath bool
+	for _, h := range req.Headers {
+		entry.Header = append(entry.Header, rawurlparser.HeaderField{Name: h.Name, Value: h.Value})
+		switch strings.ToLower(h.Name) {
+		case ":path":
+			if !hasPath {
+				path, hasPath = h.Value, true
+			}
+		case ":authority":
+			if authority == "" {
+				authority = h.Value
+			}
+		}
+	}
+
+	if !hasPath || path == "" || u.Opaque != "" {
+		return entry, nil
+	}
+	if authority == "" {
+		authority = u.Host
+	}
+	if !strings.Contains(authority, "@") {
+		// :authority never carries userinfo, request.url may
+		authority = rawurlparser.GetUserInfo(u) + authority
+	}
+	// :path is always origin-form, even when it looks like an authority or URL
+	raw, err := rawurlparser.FromPseudoHeaders([]rawurlparser.HeaderField{
+		{Name: ":scheme", Value: u.Scheme},
+		{Name: ":authority", Value: authority},
+		{Name: ":path", Value: path},
+	})
+	if err != nil {
+		return nil, err
+	}
+	entry.URL = raw
+	entry.RawTarget = true
+	entry.Normalized = path != harTarget(u, req.URL)
+	return entry, nil
+}
+
+// harTarget is the request-target request.url stands for, without fragment.
+// rawURL is request.url, to tell "/x?" from "/x".
+func harTarget(u *rawurlparser.RawURL, rawURL string) string {
+	target := u.Path
+	if target == "" {
+		target = "/"
+	}
+	noFragment, _, _ := strings.Cut(rawURL, "#")
+	if u.Query != "" || strings.HasSuffix(noFragment, "?") {
+		target += "?" + u.Query
+	}
+	return target
+}
@@ -0,0 +1,218 @@
+package har
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/slicingmelon/go-rawurlparser"
+)
+
+const testHAR = `{
+  "log": {
+    "version": "1.2",
+    "creator": {"name": "WebInspector", "version": "537.36"},
+    "entries": [
+      {
+        "startedDateTime": "2024-05-01T10:00:00.000Z",
+        "request": {
+          "method": "GET",
+          "url": "https://example.com/admin?x=1",
+          "httpVersion": "h2",
+          "headers": [
+            {"name": ":method", "value": "GET"},
+            {"name": ":authority", "value": "example.com"},
+            {"name": ":scheme", "value": "https"},
+            {"name": ":path", "value": "/static/%2e%2e/admin?x=1"},
+            {"name": "accept", "value": "*/*"}
+          ]
+        },
+        "response": {"status": 200}
+      },
+      {
+        "startedDateTime": "2024-05-01T10:00:01.000Z",
+        "request": {
+          "method": "POST",
+          "url": "http://example.com:8080/api/..;/login",
+          "httpVersion": "HTTP/1.1",
+          "headers": [
+            {"name": "Host", "value": "example.com:8080"},
+            {"name": "Content-Type", "value": "application/x-www-form-urlencoded"}
+          ],
+          "postData": {
+            "mimeType": "application/x-www-form-urlencoded",
+            "text": "user=a&pass=b",
+            "params": [{"name": "user", "value": "a"}, {"name": "pass", "value": "b"}]
+          }
+        }
+      },
+      {
+        "startedDateTime": "2024-05-01T10:00:02.000Z",
+        "request": {
+          "method": "GET",
+          "url": "https://example.com/same",
+          "httpVersion": "h2",
+          "headers": [{"name": ":path", "value": "/same"}]
+        }
+      }
+    ]
+  }
+}`
+
+func TestLoad(t *testing.T) {
+	entries, err := Load(strings.NewReader(testHAR))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("Load() returned %d entries, want 3", len(entries))
+	}
+
+	tests := []struct {
+		name           string
+		entry          *Entry
+		wantURL        string
+		wantURI        string
+		wantMethod     string
+		wantRaw        bool
+		wantNormalized bool
+	}{
+		{"pseudo-header path preferred", entries[0], "https://example.com/static/%2e%2e/admin?x=1", "/static/%2e%2e/admin?x=1", "GET", true, true},
+		{"http/1.1 keeps url", entries[1], "http://example.com:8080/api/..;/login", "/api/..;/login", "POST", false, false},
+		{"path equal to url", entries[2], "https://example.com/same", "/same", "GET", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := tt.entry
+			if got := e.URL.String(); got != tt.wantURL {
+				t.Errorf("URL = %q, want %q", got, tt.wantURL)
+			}
+			if got := e.URL.GetRawRequestURI(); got != tt.wantURI {
+				t.Errorf("GetRawRequestURI() = %q, want %q", got, tt.wantURI)
+			}
+			if e.Method != tt.wantMethod {
+				t.Errorf("Method = %q, want %q", e.Method, tt.wantMethod)
+			}
+			if e.RawTarget != tt.wantRaw || e.Normalized != tt.wantNormalized {
+				t.Errorf("RawTarget, Normalized = %v, %v, want %v, %v", e.RawTarget, e.Normalized, tt.wantRaw, tt.wantNormalized)
+			}
+		})
+	}
+
+	e := entries[1]
+	wantHeader := []rawurlparser.HeaderField{
+		{Name: "Host", Value: "example.com:8080"},
+		{Name: "Content-Type", Value: "application/x-www-form-urlencoded"},
+	}
+	if !reflect.DeepEqual(e.Header, wantHeader) {
+		t.Errorf("Header = %q, want %q", e.Header, wantHeader)
+	}
+	if e.PostData == nil || e.PostData.Text != "user=a&pass=b" || len(e.PostData.Params) != 2 {
+		t.Errorf("PostData = %+v", e.PostData)
+	}
+	if e.HTTPVersion != "HTTP/1.1" || e.StartedDateTime != "2024-05-01T10:00:01.000Z" || e.HARURL != "http://example.com:8080/api/..;/login" {
+		t.Errorf("entry = %+v", e)
+	}
+	if entries[0].PostData != nil {
+		t.Errorf("PostData = %+v, want nil", entries[0].PostData)
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.har")
+	if err := os.WriteFile(path, []byte(testHAR), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	entries, err := LoadFile(path)
+	if err != nil || len(entries) != 3 {
+		t.Fatalf("LoadFile() = %d entries, %v", len(entries), err)
+	}
+	if _, err := LoadFile(filepath.Join(t.TempDir(), "missing.har")); err == nil {
+		t.Error("LoadFile() on a missing file returned no error")
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		har  string
+	}{
+		{"not json", "<html>"},
+		{"no log", `{"entries": []}`},
+		{"empty url", `{"log": {"entries": [{"request": {"method": "GET", "url": ""}}]}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Load(strings.NewReader(tt.har)); !errors.Is(err, ErrInvalidHAR) {
+				t.Errorf("Load() error = %v, want ErrInvalidHAR", err)
+			}
+		})
+	}
+}
+
+func TestLoadPartial(t *testing.T) {
+	har := `{"log": {"entries": [
+		{"request": {"method": "GET", "url": "https://a.com/1"}},
+		{"request": {"method": "GET", "url": ""}},
+		{"request": {"method": "GET", "url": "https://a.com/3"}}
+	]}}`
+	entries, err := Load(strings.NewReader(har))
+	if len(entries) != 2 || entries[0].URL.Path != "/1" || entries[1].URL.Path != "/3" {
+		t.Errorf("Load() entries = %v", entries)
+	}
+	var ee *EntryError
+	if !errors.As(err, &ee) || ee.Index != 1 || !errors.Is(err, ErrInvalidHAR) {
+		t.Errorf("Load() error = %v, want *EntryError for entry 1", err)
+	}
+}
+
+func TestLoadKeepsUserinfo(t *testing.T) {
+	har := `{"log": {"entries": [{"request": {
+		"method": "GET", "url": "https://user:pw@example.com/a", "httpVersion": "h2",
+		"headers": [{"name": ":authority", "value": "example.com"}, {"name": ":path", "value": "/%2e/a"}]
+	}}]}}`
+	entries, err := Load(strings.NewReader(har))
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := entries[0].URL
+	if got := u.String(); got != "https://user:pw@example.com/%2e/a" || u.Host != "example.com" {
+		t.Errorf("URL = %q, Host = %q", got, u.Host)
+	}
+}
+
+func TestLoadOriginFormPath(t *testing.T) {
+	tests := []struct {
+		url, path      string
+		wantNormalized bool
+	}{
+		{"https://example.com/x", "evil.com:80", true},
+		{"https://example.com/x", "http://evil/x", true},
+		{"https://example.com/x?", "/x?", false},
+		{"https://example.com/x?#top", "/x?", false},
+		{"https://example.com/x", "/x?", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			har := `{"log": {"entries": [{"request": {"method": "GET", "url": ` + strconv.Quote(tt.url) + `, "httpVersion": "h2",
+				"headers": [{"name": ":authority", "value": "example.com"}, {"name": ":path", "value": ` + strconv.Quote(tt.path) + `}]}}]}}`
+			entries, err := Load(strings.NewReader(har))
+			if err != nil {
+				t.Fatal(err)
+			}
+			e := entries[0]
+			if e.URL.Host != "example.com" || e.URL.GetRawRequestURI() != tt.path {
+				t.Errorf("URL Host = %q, request-target = %q", e.URL.Host, e.URL.GetRawRequestURI())
+			}
+			if e.Normalized != tt.wantNormalized {
+				t.Errorf("Normalized = %v, want %v", e.Normalized, tt.wantNormalized)
+			}
+		})
+	}
+}
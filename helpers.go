@@ -223,3 +223,20 @@ func GetRuneMap(runes []rune) map[rune]struct{} {
 	}
 	return x
 }
+
+// withHostname returns a copy of u pointing to hostname, keeping the port
+// and every other component. Original is rebuilt from the new components.
+func withHostname(u *RawURL, hostname string) *RawURL {
+	clone := *u
+	if u.User != nil {
+		user := *u.User
+		clone.User = &user
+	}
+	clone.Hostname = hostname
+	clone.Host = hostname
+	if u.Port != "" {
+		clone.Host += ":" + u.Port
+	}
+	clone.Original = clone.String()
+	return &clone
+}
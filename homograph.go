@@ -0,0 +1,223 @@
+package rawurlparser
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+//go:generate go run gen_confusables.go
+
+// Homograph finding kinds
+const (
+	FindingMixedScript      = "mixed-script"       // A label mixes scripts not normally used together
+	FindingWholeScript      = "whole-script"       // A non-Latin label consists only of Latin look-alikes
+	FindingSkeletonMatch    = "skeleton-match"     // The host looks like a protected domain
+	FindingSubdomainOfMatch = "skeleton-subdomain" // The host looks like a subdomain of a protected domain
+)
+
+// HomographFinding is a single reason a hostname looks deceptive
+type HomographFinding struct {
+	Kind    string   // One of the Finding* constants
+	Label   int      // Index of the offending label, -1 for host-level findings
+	Scripts []string // Scripts found in the label
+	Target  string   // The protected domain the host was confused with
+}
+
+// HomographReport is the result of checking a hostname
+type HomographReport struct {
+	Host     string             // The hostname as provided
+	Unicode  string             // Display form after IDNA processing
+	ASCII    string             // ASCII (Punycode) form after IDNA processing
+	Skeleton string             // UTS #39 skeleton of the Unicode form
+	Findings []HomographFinding // Everything suspicious about the host
+}
+
+// Suspicious reports whether any finding was recorded
+func (r *HomographReport) Suspicious() bool {
+	return len(r.Findings) > 0
+}
+
+// HomographDetector checks hostnames against a list of protected domains
+type HomographDetector struct {
+	protected []protectedDomain
+}
+
+// protectedDomain is a protected domain with its precomputed skeleton
+type protectedDomain struct {
+	domain   string
+	skeleton string
+}
+
+// allowedScriptSets are the script combinations UTS #39 allows in
+// "Highly Restrictive" identifiers
+var allowedScriptSets = [][]string{
+	{"Latin", "Han", "Hiragana", "Katakana"},
+	{"Latin", "Han", "Bopomofo"},
+	{"Latin", "Han", "Hangul"},
+}
+
+// scriptOrder lists the scripts checked first, everything else falls back
+// to a scan of unicode.Scripts
+var scriptOrder = []string{"Latin", "Cyrillic", "Greek", "Armenian", "Cherokee", "Han", "Hiragana", "Katakana", "Hangul", "Arabic", "Hebrew"}
+
+// NewHomographDetector returns a detector for the given protected domains.
+// Domains may be given in Unicode or Punycode form.
+func NewHomographDetector(protected []string) *HomographDetector {
+	d := &HomographDetector{}
+	for _, domain := range protected {
+		res, _ := ToUnicode(strings.TrimSuffix(domain, "."))
+		d.protected = append(d.protected, protectedDomain{domain: res.Output, skeleton: Skeleton(res.Output)})
+	}
+	return d
+}
+
+// Check checks the URL's Hostname
+func (d *HomographDetector) Check(u *RawURL) *HomographReport {
+	return d.CheckHost(u.Hostname)
+}
+
+// CheckHost flags mixed-script labels, whole-script confusables and hosts
+// whose skeleton matches a protected domain (or a subdomain of one).
+// Punycode labels are decoded first, so "xn--pple-43d.com" is checked as
+// "аpple.com". The protected domains themselves are never flagged.
+func (d *HomographDetector) CheckHost(host string) *HomographReport {
+	report := &HomographReport{Host: host}
+
+	uni, _ := ToUnicode(strings.TrimSuffix(host, "."))
+	ascii, _ := ToASCII(strings.TrimSuffix(host, "."))
+	report.Unicode = uni.Output
+	report.ASCII = ascii.Output
+	report.Skeleton = Skeleton(report.Unicode)
+
+	for i, label := range strings.Split(report.Unicode, ".") {
+		scripts := LabelScripts(label)
+		if len(scripts) > 1 && !isAllowedScriptSet(scripts) {
+			report.Findings = append(report.Findings, HomographFinding{Kind: FindingMixedScript, Label: i, Scripts: scripts})
+		}
+		if len(scripts) == 1 && scripts[0] != "Latin" && isASCII(Skeleton(label)) {
+			report.Findings = append(report.Findings, HomographFinding{Kind: FindingWholeScript, Label: i, Scripts: scripts})
+		}
+	}
+
+	if d == nil {
+		return report
+	}
+	for _, p := range d.protected {
+		switch {
+		case report.Unicode == p.domain || strings.HasSuffix(report.Unicode, "."+p.domain):
+			continue
+		case report.Skeleton == p.skeleton:
+			report.Findings = append(report.Findings, HomographFinding{Kind: FindingSkeletonMatch, Label: -1, Target: p.domain})
+		case strings.HasSuffix(report.Skeleton, "."+p.skeleton):
+			report.Findings = append(report.Findings, HomographFinding{Kind: FindingSubdomainOfMatch, Label: -1, Target: p.domain})
+		}
+	}
+	// Label findings first, host-level findings last
+	sort.SliceStable(report.Findings, func(i, j int) bool {
+		return report.Findings[i].Label >= 0 && report.Findings[j].Label < 0
+	})
+
+	return report
+}
+
+// Skeleton computes the UTS #39 skeleton of s: decompose, drop default
+// ignorable code points, replace every confusable character with its
+// prototype from confusables.txt, decompose again. Two strings with the same
+// skeleton are visually confusable. Prototypes are not case-folded, so
+// "paypa1.com" matches "paypal.com" but "g00gle.com" ("gOOgle.com") does not
+// match "google.com".
+func Skeleton(s string) string {
+	var buf strings.Builder
+	for _, r := range nfd(s) {
+		if isDefaultIgnorable(r) {
+			continue
+		}
+		if proto, ok := confusablePrototypes[r]; ok {
+			buf.WriteString(proto)
+			continue
+		}
+		buf.WriteRune(r)
+	}
+	return nfd(buf.String())
+}
+
+// LabelScripts returns the sorted scripts used in a label.
+// Common and Inherited characters (digits, hyphens, combining marks) are ignored.
+func LabelScripts(label string) []string {
+	seen := make(map[string]struct{})
+	for _, r := range label {
+		if s := scriptOf(r); s != "" {
+			seen[s] = struct{}{}
+		}
+	}
+	scripts := make([]string, 0, len(seen))
+	for s := range seen {
+		scripts = append(scripts, s)
+	}
+	sort.Strings(scripts)
+	return scripts
+}
+
+// scriptOf returns the script of r, or empty string for Common and Inherited
+func scriptOf(r rune) string {
+	if unicode.In(r, unicode.Common, unicode.Inherited) {
+		return ""
+	}
+	for _, name := range scriptOrder {
+		if unicode.Is(unicode.Scripts[name], r) {
+			return name
+		}
+	}
+	for name, table := range unicode.Scripts {
+		if unicode.Is(table, r) {
+			return name
+		}
+	}
+	return "Unknown"
+}
+
+// isAllowedScriptSet reports whether scripts is a subset of an allowed combination
+func isAllowedScriptSet(scripts []string) bool {
+	for _, set := range allowedScriptSets {
+		ok := true
+		for _, s := range scripts {
+			found := false
+			for _, a := range set {
+				if s == a {
+					found = true
+					break
+				}
+			}
+			if !found {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+// isDefaultIgnorable reports whether r has the Default_Ignorable_Code_Point
+// property, derived as in DerivedCoreProperties.txt
+func isDefaultIgnorable(r rune) bool {
+	switch {
+	case r >= 0xFFF9 && r <= 0xFFFB, r >= 0x13430 && r <= 0x1343F:
+		return false
+	case unicode.In(r, unicode.White_Space, unicode.Prepended_Concatenation_Mark):
+		return false
+	}
+	return unicode.In(r, unicode.Other_Default_Ignorable_Code_Point, unicode.Cf, unicode.Variation_Selector)
+}
+
+// nfd returns the canonical decomposition of every code point in s
+func nfd(s string) string {
+	var d []rune
+	for _, r := range s {
+		d = decompose(d, r)
+	}
+	return string(d)
+}
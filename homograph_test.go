@@ -0,0 +1,95 @@
+package rawurlparser
+
+import (
+	"testing"
+)
+
+func TestSkeleton(t *testing.T) {
+	testCases := []struct {
+		a, b string
+		same bool
+	}{
+		{"аpple.com", "apple.com", true},
+		{"rnicrosoft.com", "microsoft.com", true},
+		{"paypa1.com", "paypal.com", true},
+		{"gооgle.com", "google.com", true},
+		{"bücher.de", "bucher.de", false},
+		{"example.com", "examp1e.org", false},
+		{"g00gle.com", "google.com", false},
+		{"a\u200bpple.com", "apple.com", true},
+		{"ѕсоре.com", "scope.com", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.a, func(t *testing.T) {
+			if got := Skeleton(tc.a) == Skeleton(tc.b); got != tc.same {
+				t.Errorf("Skeleton(%q) == Skeleton(%q) is %v, want %v", tc.a, tc.b, got, tc.same)
+			}
+		})
+	}
+}
+
+func TestHomographDetector(t *testing.T) {
+	d := NewHomographDetector([]string{"apple.com", "paypal.com", "bücher.de", "scope.com"})
+
+	testCases := []struct {
+		input     string
+		wantKinds []string
+	}{
+		{"https://apple.com/", nil},
+		{"https://www.apple.com/", nil},
+		{"https://xn--bcher-kva.de/", nil},
+		{"https://аpple.com/", []string{FindingMixedScript, FindingSkeletonMatch}},
+		{"https://xn--pple-43d.com/", []string{FindingMixedScript, FindingSkeletonMatch}},
+		{"https://login.аpple.com/", []string{FindingMixedScript, FindingSubdomainOfMatch}},
+		{"https://ѕсоре.com/", []string{FindingWholeScript, FindingSkeletonMatch}},
+		{"https://раураӏ.com/", []string{FindingWholeScript}},
+		{"https://paypa1.com/", []string{FindingSkeletonMatch}},
+		{"https://例え.テスト/", nil},
+		{"https://пример.рф/", nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			u, err := RawURLParse(tc.input)
+			if err != nil {
+				t.Fatalf("Failed to parse URL %q: %v", tc.input, err)
+			}
+			report := d.Check(u)
+
+			var got []string
+			for _, f := range report.Findings {
+				got = append(got, f.Kind)
+			}
+			if len(got) != len(tc.wantKinds) {
+				t.Fatalf("findings = %v, want %v (report %+v)", got, tc.wantKinds, report)
+			}
+			for i := range got {
+				if got[i] != tc.wantKinds[i] {
+					t.Errorf("findings = %v, want %v", got, tc.wantKinds)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestConfusablePrototypes(t *testing.T) {
+	// Prototypes as in confusables.txt, not case-folded
+	for r, want := range map[rune]string{
+		'0': "O", '1': "l", 'I': "l", 'm': "rn",
+		0x043D: "\u029c", // н → ʜ
+		0x0432: "\u0299", // в → ʙ
+		0x03B2: "\u00df", // β → ß
+		0x03B5: "\ua793", // ε → ꞓ
+		0x044C: "\u0185", // ь → ƅ
+		0x0430: "a",      // а
+	} {
+		if got := confusablePrototypes[r]; got != want {
+			t.Errorf("confusablePrototypes[%U] = %q, want %q", r, got, want)
+		}
+	}
+	if _, ok := confusablePrototypes['l']; ok {
+		t.Error("prototype l has a mapping")
+	}
+}
@@ -0,0 +1,447 @@
+package rawurlparser
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+//go:generate go run gen_idna_tables.go
+
+var ErrInvalidIDNA = errors.New("invalid internationalized domain name")
+
+// acePrefix marks Punycode-encoded labels
+const acePrefix = "xn--"
+
+// IDNAStepKind names a single UTS #46 processing step
+type IDNAStepKind string
+
+const (
+	IDNAMapped          IDNAStepKind = "mapped"           // Code point replaced by its mapping
+	IDNAIgnored         IDNAStepKind = "ignored"          // Code point removed
+	IDNADeviation       IDNAStepKind = "deviation"        // ß, ς, ZWJ or ZWNJ (mapped only in transitional processing)
+	IDNADisallowed      IDNAStepKind = "disallowed"       // Code point not allowed in a domain name
+	IDNANormalized      IDNAStepKind = "normalized"       // Label rewritten to NFC
+	IDNAPunycodeDecoded IDNAStepKind = "punycode-decoded" // "xn--" label decoded to Unicode
+	IDNAPunycodeEncoded IDNAStepKind = "punycode-encoded" // Unicode label encoded to "xn--"
+)
+
+// IDNAStep records one change made while processing a hostname
+type IDNAStep struct {
+	Kind   IDNAStepKind
+	Label  int    // Index of the label the step applies to
+	Offset int    // Byte offset in the input, -1 for label-level steps
+	From   string // What was there before the step
+	To     string // What was there after the step (equal to From when kept)
+}
+
+// IDNAResult is the outcome of ToASCII or ToUnicode
+type IDNAResult struct {
+	Input  string     // The hostname as provided
+	Output string     // The converted hostname
+	Steps  []IDNAStep // Every mapping, normalization and conversion step, in order
+	Errors []error    // Every validation error found, all wrapping ErrInvalidIDNA
+}
+
+// IDNAOptions contains the UTS #46 processing flags
+type IDNAOptions struct {
+	Transitional    bool // Map deviation characters (IDNA2003 behaviour)
+	UseSTD3Rules    bool // Only allow letters, digits and hyphens in ASCII
+	CheckHyphens    bool // Reject labels with hyphens at positions 3-4, start or end
+	VerifyDNSLength bool // Enforce 1-63 byte labels and 253 byte names
+}
+
+// DefaultIDNAOptions returns the options browsers use (WHATWG URL "domain to ASCII")
+func DefaultIDNAOptions() *IDNAOptions {
+	return &IDNAOptions{}
+}
+
+// ToASCII returns the ASCII form of the URL's Hostname
+func (u *RawURL) ToASCII() (*IDNAResult, error) {
+	return ToASCII(u.Hostname)
+}
+
+// ToUnicode returns the Unicode form of the URL's Hostname
+func (u *RawURL) ToUnicode() (*IDNAResult, error) {
+	return ToUnicode(u.Hostname)
+}
+
+// ToASCII converts a hostname to the ASCII form a resolver would use
+func ToASCII(host string) (*IDNAResult, error) {
+	return ToASCIIWithOptions(host, DefaultIDNAOptions())
+}
+
+// ToUnicode converts a hostname to its Unicode display form
+func ToUnicode(host string) (*IDNAResult, error) {
+	return ToUnicodeWithOptions(host, DefaultIDNAOptions())
+}
+
+// ToASCIIWithOptions implements UTS #46 ToASCII: the host is mapped,
+// normalized, split into labels and every non-ASCII label is Punycode encoded.
+// The result is returned even when err != nil, so the steps that led to an
+// error can be inspected. IP literals in brackets are returned unchanged.
+func ToASCIIWithOptions(host string, opts *IDNAOptions) (*IDNAResult, error) {
+	if opts == nil {
+		opts = DefaultIDNAOptions()
+	}
+	res, labels := idnaProcess(host, opts)
+	if labels == nil {
+		return res, nil
+	}
+
+	for i, label := range labels {
+		if isASCII(label) {
+			continue
+		}
+		encoded, err := PunycodeEncode(label)
+		if err != nil {
+			res.addError("label %d: %v", i, err)
+			continue
+		}
+		labels[i] = acePrefix + encoded
+		res.Steps = append(res.Steps, IDNAStep{Kind: IDNAPunycodeEncoded, Label: i, Offset: -1, From: label, To: labels[i]})
+	}
+	res.Output = strings.Join(labels, ".")
+
+	if opts.VerifyDNSLength {
+		name := strings.TrimSuffix(res.Output, ".")
+		if len(name) > 253 {
+			res.addError("name is %d bytes long", len(name))
+		}
+		for i, label := range strings.Split(name, ".") {
+			if len(label) == 0 || len(label) > 63 {
+				res.addError("label %d is %d bytes long", i, len(label))
+			}
+		}
+	}
+
+	return res, res.err()
+}
+
+// ToUnicodeWithOptions implements UTS #46 ToUnicode: the host is mapped,
+// normalized and every "xn--" label is decoded. Labels that fail to decode
+// are kept as they are. See ToASCIIWithOptions for error handling.
+func ToUnicodeWithOptions(host string, opts *IDNAOptions) (*IDNAResult, error) {
+	if opts == nil {
+		opts = DefaultIDNAOptions()
+	}
+	res, labels := idnaProcess(host, opts)
+	if labels == nil {
+		return res, nil
+	}
+	res.Output = strings.Join(labels, ".")
+	return res, res.err()
+}
+
+// idnaProcess runs the UTS #46 Processing steps shared by ToASCII and ToUnicode
+// and returns the Unicode labels. Labels is nil for hosts that are not processed.
+func idnaProcess(host string, opts *IDNAOptions) (*IDNAResult, []string) {
+	res := &IDNAResult{Input: host, Output: host}
+	if host == "" || strings.HasPrefix(host, "[") {
+		return res, nil
+	}
+
+	// Map
+	var mapped []rune
+	var offsets []int
+	label := 0
+	for offset, r := range host {
+		kind, to := idnaStatus(r, opts)
+		switch kind {
+		case IDNAMapped, IDNAIgnored:
+			res.Steps = append(res.Steps, IDNAStep{Kind: kind, Label: label, Offset: offset, From: string(r), To: to})
+			if opts.UseSTD3Rules && !isSTD3(to) {
+				res.Steps = append(res.Steps, IDNAStep{Kind: IDNADisallowed, Label: label, Offset: offset, From: to, To: to})
+				res.addError("disallowed mapping %q -> %q at offset %d", r, to, offset)
+			}
+		case IDNADeviation:
+			res.Steps = append(res.Steps, IDNAStep{Kind: kind, Label: label, Offset: offset, From: string(r), To: to})
+		case IDNADisallowed:
+			res.Steps = append(res.Steps, IDNAStep{Kind: kind, Label: label, Offset: offset, From: string(r), To: to})
+			res.addError("disallowed code point %U at offset %d", r, offset)
+		}
+		for _, m := range to {
+			mapped = append(mapped, m)
+			offsets = append(offsets, offset)
+			if m == '.' {
+				label++
+			}
+		}
+	}
+
+	// Normalize, label by label as nothing composes with "."
+	var normalized []rune
+	label = 0
+	for start := 0; start <= len(mapped); start++ {
+		end := start
+		for end < len(mapped) && mapped[end] != '.' {
+			end++
+		}
+		from := mapped[start:end]
+		to := nfc(from)
+		if string(to) != string(from) {
+			// Record only the part that changed
+			p, q := 0, 0
+			for p < len(from) && p < len(to) && from[p] == to[p] {
+				p++
+			}
+			for q < len(from)-p && q < len(to)-p && from[len(from)-1-q] == to[len(to)-1-q] {
+				q++
+			}
+			offset := offsets[min(start+p, end-1)]
+			res.Steps = append(res.Steps, IDNAStep{Kind: IDNANormalized, Label: label, Offset: offset, From: string(from[p : len(from)-q]), To: string(to[p : len(to)-q])})
+		}
+		normalized = append(normalized, to...)
+		if end < len(mapped) {
+			normalized = append(normalized, '.')
+		}
+		start = end
+		label++
+	}
+
+	// Break and convert/validate
+	labels := strings.Split(string(normalized), ".")
+	for i, l := range labels {
+		if strings.HasPrefix(l, acePrefix) {
+			decoded, err := PunycodeDecode(l[len(acePrefix):])
+			if err != nil {
+				res.addError("label %d: %v", i, err)
+				continue
+			}
+			res.Steps = append(res.Steps, IDNAStep{Kind: IDNAPunycodeDecoded, Label: i, Offset: -1, From: l, To: decoded})
+			labels[i] = decoded
+			res.validateDecoded(i, decoded)
+		}
+		res.validateLabel(i, labels[i], opts)
+	}
+
+	return res, labels
+}
+
+// idnaTableStatus is a status from the UTS #46 IdnaMappingTable.txt
+type idnaTableStatus uint8
+
+const (
+	idnaStatusValid idnaTableStatus = iota
+	idnaStatusMapped
+	idnaStatusDeviation
+	idnaStatusIgnored
+	idnaStatusDisallowed
+	idnaStatusSTD3Valid  // disallowed_STD3_valid, before Unicode 16.0
+	idnaStatusSTD3Mapped // disallowed_STD3_mapped, before Unicode 16.0
+)
+
+// idnaTableEntry is a range of idnaTable, see gen_idna_tables.go
+type idnaTableEntry struct {
+	lo      rune
+	status  idnaTableStatus
+	mapping string
+}
+
+// idnaStatus returns the UTS #46 status of r and what it maps to
+func idnaStatus(r rune, opts *IDNAOptions) (IDNAStepKind, string) {
+	s := string(r)
+	i := sort.Search(len(idnaTable), func(i int) bool { return idnaTable[i].lo > r }) - 1
+	if i < 0 {
+		return IDNADisallowed, s
+	}
+	e := idnaTable[i]
+
+	switch e.status {
+	case idnaStatusMapped:
+		if r == 0x1E9E && opts.Transitional {
+			// ẞ maps to ß, which transitional processing turns into "ss"
+			return IDNAMapped, "ss"
+		}
+		return IDNAMapped, e.mapping
+	case idnaStatusIgnored:
+		return IDNAIgnored, ""
+	case idnaStatusDeviation:
+		if opts.Transitional {
+			return IDNADeviation, e.mapping
+		}
+		return IDNADeviation, s
+	case idnaStatusDisallowed:
+		return IDNADisallowed, s
+	case idnaStatusSTD3Mapped:
+		if opts.UseSTD3Rules {
+			return IDNADisallowed, s
+		}
+		return IDNAMapped, e.mapping
+	case idnaStatusSTD3Valid:
+		if opts.UseSTD3Rules {
+			return IDNADisallowed, s
+		}
+	}
+	// Since Unicode 16.0 UseSTD3ASCIIRules is applied to ASCII directly
+	if r < utf8.RuneSelf && opts.UseSTD3Rules && !isSTD3(s) {
+		return IDNADisallowed, s
+	}
+	return "", s
+}
+
+// validateLabel applies the UTS #46 validity criteria to a Unicode label
+func (res *IDNAResult) validateLabel(i int, label string, opts *IDNAOptions) {
+	if label == "" {
+		return
+	}
+	if opts.CheckHyphens {
+		if len(label) >= 4 && label[2:4] == "--" {
+			res.addError("label %d has hyphens in the third and fourth position", i)
+		}
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			res.addError("label %d starts or ends with a hyphen", i)
+		}
+	}
+	if r, _ := utf8.DecodeRuneInString(label); unicode.IsMark(r) {
+		res.addError("label %d starts with combining mark %U", i, r)
+	}
+}
+
+// validateDecoded checks that a decoded "xn--" label is already mapped and
+// normalized, as a Punycode label may hide code points mapping would remove
+func (res *IDNAResult) validateDecoded(i int, label string) {
+	if isASCII(label) {
+		res.addError("label %d is an \"xn--\" label that decodes to ASCII", i)
+	}
+	for _, r := range label {
+		kind, _ := idnaStatus(r, &IDNAOptions{})
+		if kind != "" && kind != IDNADeviation {
+			res.addError("label %d decodes to %s code point %U", i, kind, r)
+		}
+	}
+	if string(nfc([]rune(label))) != label {
+		res.addError("label %d decodes to a string that is not NFC", i)
+	}
+}
+
+// Hangul syllables are composed and decomposed algorithmically (Unicode
+// section 3.12) and are not in the generated tables
+const (
+	hangulSBase  = 0xAC00
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11A7
+	hangulLCount = 19
+	hangulVCount = 21
+	hangulTCount = 28
+	hangulNCount = hangulVCount * hangulTCount
+	hangulSCount = hangulLCount * hangulNCount
+)
+
+// combiningClassRange is a range of canonicalCombiningClasses
+type combiningClassRange struct {
+	lo, hi rune
+	ccc    uint8
+}
+
+// combiningClass returns the Canonical_Combining_Class of r
+func combiningClass(r rune) uint8 {
+	i := sort.Search(len(canonicalCombiningClasses), func(i int) bool { return canonicalCombiningClasses[i].hi >= r })
+	if i < len(canonicalCombiningClasses) && canonicalCombiningClasses[i].lo <= r {
+		return canonicalCombiningClasses[i].ccc
+	}
+	return 0
+}
+
+// decompose appends the full canonical decomposition of r to dst
+func decompose(dst []rune, r rune) []rune {
+	if s := r - hangulSBase; s >= 0 && s < hangulSCount {
+		dst = append(dst, hangulLBase+s/hangulNCount, hangulVBase+s%hangulNCount/hangulTCount)
+		if t := s % hangulTCount; t != 0 {
+			dst = append(dst, hangulTBase+t)
+		}
+		return dst
+	}
+	if d, ok := canonicalDecompositions[r]; ok {
+		return append(dst, []rune(d)...)
+	}
+	return append(dst, r)
+}
+
+// compose returns the primary composite of a followed by b
+func compose(a, b rune) (rune, bool) {
+	if l := a - hangulLBase; l >= 0 && l < hangulLCount {
+		if v := b - hangulVBase; v >= 0 && v < hangulVCount {
+			return hangulSBase + (l*hangulVCount+v)*hangulTCount, true
+		}
+		return 0, false
+	}
+	if s := a - hangulSBase; s >= 0 && s < hangulSCount && s%hangulTCount == 0 {
+		if t := b - hangulTBase; t > 0 && t < hangulTCount {
+			return a + t, true
+		}
+		return 0, false
+	}
+	c, ok := nfcCompositions[[2]rune{a, b}]
+	return c, ok
+}
+
+// nfc returns the Normalization Form C of s (UAX #15): canonical
+// decomposition, canonical ordering, then canonical composition
+func nfc(s []rune) []rune {
+	var d []rune
+	for _, r := range s {
+		d = decompose(d, r)
+	}
+	for i := 1; i < len(d); i++ {
+		c := combiningClass(d[i])
+		for j := i; c != 0 && j > 0 && combiningClass(d[j-1]) > c; j-- {
+			d[j-1], d[j] = d[j], d[j-1]
+		}
+	}
+
+	out := d[:0]
+	starter, last := -1, -1 // Index of the last starter in out, class of the last rune after it
+	for _, r := range d {
+		c := int(combiningClass(r))
+		if starter >= 0 && (last == -1 || last < c) {
+			if p, ok := compose(out[starter], r); ok {
+				out[starter] = p
+				continue
+			}
+		}
+		if c == 0 {
+			starter, last = len(out), -1
+		} else {
+			last = c
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+func (res *IDNAResult) addError(format string, args ...any) {
+	res.Errors = append(res.Errors, fmt.Errorf("%w: "+format, append([]any{ErrInvalidIDNA}, args...)...))
+}
+
+func (res *IDNAResult) err() error {
+	return errors.Join(res.Errors...)
+}
+
+// isSTD3 reports whether every ASCII character of s is a letter, digit,
+// hyphen or dot. Non-ASCII characters are not checked.
+func isSTD3(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= utf8.RuneSelf {
+			continue
+		}
+		if !('a' <= c && c <= 'z') && !('A' <= c && c <= 'Z') && !('0' <= c && c <= '9') && c != '-' && c != '.' {
+			return false
+		}
+	}
+	return true
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
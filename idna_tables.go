@@ -0,0 +1,11672 @@
+// Code generated by gen_idna_tables.go from Unicode 17.0.0 data; DO NOT EDIT.
+
+package rawurlparser
+
+// idnaUnicodeVersion is the Unicode version of the tables below
+const idnaUnicodeVersion = "17.0.0"
+
+// idnaTable is IdnaMappingTable.txt. Each entry applies from lo up to the
+// next entry's lo; mapping is set for mapped and deviation entries.
+var idnaTable = []idnaTableEntry{
+	{0x0000, idnaStatusValid, ""},
+	{0x0041, idnaStatusMapped, "a"},
+	{0x0042, idnaStatusMapped, "b"},
+	{0x0043, idnaStatusMapped, "c"},
+	{0x0044, idnaStatusMapped, "d"},
+	{0x0045, idnaStatusMapped, "e"},
+	{0x0046, idnaStatusMapped, "f"},
+	{0x0047, idnaStatusMapped, "g"},
+	{0x0048, idnaStatusMapped, "h"},
+	{0x0049, idnaStatusMapped, "i"},
+	{0x004A, idnaStatusMapped, "j"},
+	{0x004B, idnaStatusMapped, "k"},
+	{0x004C, idnaStatusMapped, "l"},
+	{0x004D, idnaStatusMapped, "m"},
+	{0x004E, idnaStatusMapped, "n"},
+	{0x004F, idnaStatusMapped, "o"},
+	{0x0050, idnaStatusMapped, "p"},
+	{0x0051, idnaStatusMapped, "q"},
+	{0x0052, idnaStatusMapped, "r"},
+	{0x0053, idnaStatusMapped, "s"},
+	{0x0054, idnaStatusMapped, "t"},
+	{0x0055, idnaStatusMapped, "u"},
+	{0x0056, idnaStatusMapped, "v"},
+	{0x0057, idnaStatusMapped, "w"},
+	{0x0058, idnaStatusMapped, "x"},
+	{0x0059, idnaStatusMapped, "y"},
+	{0x005A, idnaStatusMapped, "z"},
+	{0x005B, idnaStatusValid, ""},
+	{0x0080, idnaStatusDisallowed, ""},
+	{0x00A0, idnaStatusMapped, " "},
+	{0x00A1, idnaStatusValid, ""},
+	{0x00A8, idnaStatusMapped, " \u0308"},
+	{0x00A9, idnaStatusValid, ""},
+	{0x00AA, idnaStatusMapped, "a"},
+	{0x00AB, idnaStatusValid, ""},
+	{0x00AD, idnaStatusIgnored, ""},
+	{0x00AE, idnaStatusValid, ""},
+	{0x00AF, idnaStatusMapped, " \u0304"},
+	{0x00B0, idnaStatusValid, ""},
+	{0x00B2, idnaStatusMapped, "2"},
+	{0x00B3, idnaStatusMapped, "3"},
+	{0x00B4, idnaStatusMapped, " \u0301"},
+	{0x00B5, idnaStatusMapped, "\u03bc"},
+	{0x00B6, idnaStatusValid, ""},
+	{0x00B8, idnaStatusMapped, " \u0327"},
+	{0x00B9, idnaStatusMapped, "1"},
+	{0x00BA, idnaStatusMapped, "o"},
+	{0x00BB, idnaStatusValid, ""},
+	{0x00BC, idnaStatusMapped, "1\u20444"},
+	{0x00BD, idnaStatusMapped, "1\u20442"},
+	{0x00BE, idnaStatusMapped, "3\u20444"},
+	{0x00BF, idnaStatusValid, ""},
+	{0x00C0, idnaStatusMapped, "\u00e0"},
+	{0x00C1, idnaStatusMapped, "\u00e1"},
+	{0x00C2, idnaStatusMapped, "\u00e2"},
+	{0x00C3, idnaStatusMapped, "\u00e3"},
+	{0x00C4, idnaStatusMapped, "\u00e4"},
+	{0x00C5, idnaStatusMapped, "\u00e5"},
+	{0x00C6, idnaStatusMapped, "\u00e6"},
+	{0x00C7, idnaStatusMapped, "\u00e7"},
+	{0x00C8, idnaStatusMapped, "\u00e8"},
+	{0x00C9, idnaStatusMapped, "\u00e9"},
+	{0x00CA, idnaStatusMapped, "\u00ea"},
+	{0x00CB, idnaStatusMapped, "\u00eb"},
+	{0x00CC, idnaStatusMapped, "\u00ec"},
+	{0x00CD, idnaStatusMapped, "\u00ed"},
+	{0x00CE, idnaStatusMapped, "\u00ee"},
+	{0x00CF, idnaStatusMapped, "\u00ef"},
+	{0x00D0, idnaStatusMapped, "\u00f0"},
+	{0x00D1, idnaStatusMapped, "\u00f1"},
+	{0x00D2, idnaStatusMapped, "\u00f2"},
+	{0x00D3, idnaStatusMapped, "\u00f3"},
+	{0x00D4, idnaStatusMapped, "\u00f4"},
+	{0x00D5, idnaStatusMapped, "\u00f5"},
+	{0x00D6, idnaStatusMapped, "\u00f6"},
+	{0x00D7, idnaStatusValid, ""},
+	{0x00D8, idnaStatusMapped, "\u00f8"},
+	{0x00D9, idnaStatusMapped, "\u00f9"},
+	{0x00DA, idnaStatusMapped, "\u00fa"},
+	{0x00DB, idnaStatusMapped, "\u00fb"},
+	{0x00DC, idnaStatusMapped, "\u00fc"},
+	{0x00DD, idnaStatusMapped, "\u00fd"},
+	{0x00DE, idnaStatusMapped, "\u00fe"},
+	{0x00DF, idnaStatusDeviation, "ss"},
+	{0x00E0, idnaStatusValid, ""},
+	{0x0100, idnaStatusMapped, "\u0101"},
+	{0x0101, idnaStatusValid, ""},
+	{0x0102, idnaStatusMapped, "\u0103"},
+	{0x0103, idnaStatusValid, ""},
+	{0x0104, idnaStatusMapped, "\u0105"},
+	{0x0105, idnaStatusValid, ""},
+	{0x0106, idnaStatusMapped, "\u0107"},
+	{0x0107, idnaStatusValid, ""},
+	{0x0108, idnaStatusMapped, "\u0109"},
+	{0x0109, idnaStatusValid, ""},
+	{0x010A, idnaStatusMapped, "\u010b"},
+	{0x010B, idnaStatusValid, ""},
+	{0x010C, idnaStatusMapped, "\u010d"},
+	{0x010D, idnaStatusValid, ""},
+	{0x010E, idnaStatusMapped, "\u010f"},
+	{0x010F, idnaStatusValid, ""},
+	{0x0110, idnaStatusMapped, "\u0111"},
+	{0x0111, idnaStatusValid, ""},
+	{0x0112, idnaStatusMapped, "\u0113"},
+	{0x0113, idnaStatusValid, ""},
+	{0x0114, idnaStatusMapped, "\u0115"},
+	{0x0115, idnaStatusValid, ""},
+	{0x0116, idnaStatusMapped, "\u0117"},
+	{0x0117, idnaStatusValid, ""},
+	{0x0118, idnaStatusMapped, "\u0119"},
+	{0x0119, idnaStatusValid, ""},
+	{0x011A, idnaStatusMapped, "\u011b"},
+	{0x011B, idnaStatusValid, ""},
+	{0x011C, idnaStatusMapped, "\u011d"},
+	{0x011D, idnaStatusValid, ""},
+	{0x011E, idnaStatusMapped, "\u011f"},
+	{0x011F, idnaStatusValid, ""},
+	{0x0120, idnaStatusMapped, "\u0121"},
+	{0x0121, idnaStatusValid, ""},
+	{0x0122, idnaStatusMapped, "\u0123"},
+	{0x0123, idnaStatusValid, ""},
+	{0x0124, idnaStatusMapped, "\u0125"},
+	{0x0125, idnaStatusValid, ""},
+	{0x0126, idnaStatusMapped, "\u0127"},
+	{0x0127, idnaStatusValid, ""},
+	{0x0128, idnaStatusMapped, "\u0129"},
+	{0x0129, idnaStatusValid, ""},
+	{0x012A, idnaStatusMapped, "\u012b"},
+	{0x012B, idnaStatusValid, ""},
+	{0x012C, idnaStatusMapped, "\u012d"},
+	{0x012D, idnaStatusValid, ""},
+	{0x012E, idnaStatusMapped, "\u012f"},
+	{0x012F, idnaStatusValid, ""},
+	{0x0130, idnaStatusMapped, "i\u0307"},
+	{0x0131, idnaStatusValid, ""},
+	{0x0132, idnaStatusMapped, "ij"},
+	{0x0134, idnaStatusMapped, "\u0135"},
+	{0x0135, idnaStatusValid, ""},
+	{0x0136, idnaStatusMapped, "\u0137"},
+	{0x0137, idnaStatusValid, ""},
+	{0x0139, idnaStatusMapped, "\u013a"},
+	{0x013A, idnaStatusValid, ""},
+	{0x013B, idnaStatusMapped, "\u013c"},
+	{0x013C, idnaStatusValid, ""},
+	{0x013D, idnaStatusMapped, "\u013e"},
+	{0x013E, idnaStatusValid, ""},
+	{0x013F, idnaStatusMapped, "l\u00b7"},
+	{0x0141, idnaStatusMapped, "\u0142"},
+	{0x0142, idnaStatusValid, ""},
+	{0x0143, idnaStatusMapped, "\u0144"},
+	{0x0144, idnaStatusValid, ""},
+	{0x0145, idnaStatusMapped, "\u0146"},
+	{0x0146, idnaStatusValid, ""},
+	{0x0147, idnaStatusMapped, "\u0148"},
+	{0x0148, idnaStatusValid, ""},
+	{0x0149, idnaStatusMapped, "\u02bcn"},
+	{0x014A, idnaStatusMapped, "\u014b"},
+	{0x014B, idnaStatusValid, ""},
+	{0x014C, idnaStatusMapped, "\u014d"},
+	{0x014D, idnaStatusValid, ""},
+	{0x014E, idnaStatusMapped, "\u014f"},
+	{0x014F, idnaStatusValid, ""},
+	{0x0150, idnaStatusMapped, "\u0151"},
+	{0x0151, idnaStatusValid, ""},
+	{0x0152, idnaStatusMapped, "\u0153"},
+	{0x0153, idnaStatusValid, ""},
+	{0x0154, idnaStatusMapped, "\u0155"},
+	{0x0155, idnaStatusValid, ""},
+	{0x0156, idnaStatusMapped, "\u0157"},
+	{0x0157, idnaStatusValid, ""},
+	{0x0158, idnaStatusMapped, "\u0159"},
+	{0x0159, idnaStatusValid, ""},
+	{0x015A, idnaStatusMapped, "\u015b"},
+	{0x015B, idnaStatusValid, ""},
+	{0x015C, idnaStatusMapped, "\u015d"},
+	{0x015D, idnaStatusValid, ""},
+	{0x015E, idnaStatusMapped, "\u015f"},
+	{0x015F, idnaStatusValid, ""},
+	{0x0160, idnaStatusMapped, "\u0161"},
+	{0x0161, idnaStatusValid, ""},
+	{0x0162, idnaStatusMapped, "\u0163"},
+	{0x0163, idnaStatusValid, ""},
+	{0x0164, idnaStatusMapped, "\u0165"},
+	{0x0165, idnaStatusValid, ""},
+	{0x0166, idnaStatusMapped, "\u0167"},
+	{0x0167, idnaStatusValid, ""},
+	{0x0168, idnaStatusMapped, "\u0169"},
+	{0x0169, idnaStatusValid, ""},
+	{0x016A, idnaStatusMapped, "\u016b"},
+	{0x016B, idnaStatusValid, ""},
+	{0x016C, idnaStatusMapped, "\u016d"},
+	{0x016D, idnaStatusValid, ""},
+	{0x016E, idnaStatusMapped, "\u016f"},
+	{0x016F, idnaStatusValid, ""},
+	{0x0170, idnaStatusMapped, "\u0171"},
+	{0x0171, idnaStatusValid, ""},
+	{0x0172, idnaStatusMapped, "\u0173"},
+	{0x0173, idnaStatusValid, ""},
+	{0x0174, idnaStatusMapped, "\u0175"},
+	{0x0175, idnaStatusValid, ""},
+	{0x0176, idnaStatusMapped, "\u0177"},
+	{0x0177, idnaStatusValid, ""},
+	{0x0178, idnaStatusMapped, "\u00ff"},
+	{0x0179, idnaStatusMapped, "\u017a"},
+	{0x017A, idnaStatusValid, ""},
+	{0x017B, idnaStatusMapped, "\u017c"},
+	{0x017C, idnaStatusValid, ""},
+	{0x017D, idnaStatusMapped, "\u017e"},
+	{0x017E, idnaStatusValid, ""},
+	{0x017F, idnaStatusMapped, "s"},
+	{0x0180, idnaStatusValid, ""},
+	{0x0181, idnaStatusMapped, "\u0253"},
+	{0x0182, idnaStatusMapped, "\u0183"},
+	{0x0183, idnaStatusValid, ""},
+	{0x0184, idnaStatusMapped, "\u0185"},
+	{0x0185, idnaStatusValid, ""},
+	{0x0186, idnaStatusMapped, "\u0254"},
+	{0x0187, idnaStatusMapped, "\u0188"},
+	{0x0188, idnaStatusValid, ""},
+	{0x0189, idnaStatusMapped, "\u0256"},
+	{0x018A, idnaStatusMapped, "\u0257"},
+	{0x018B, idnaStatusMapped, "\u018c"},
+	{0x018C, idnaStatusValid, ""},
+	{0x018E, idnaStatusMapped, "\u01dd"},
+	{0x018F, idnaStatusMapped, "\u0259"},
+	{0x0190, idnaStatusMapped, "\u025b"},
+	{0x0191, idnaStatusMapped, "\u0192"},
+	{0x0192, idnaStatusValid, ""},
+	{0x0193, idnaStatusMapped, "\u0260"},
+	{0x0194, idnaStatusMapped, "\u0263"},
+	{0x0195, idnaStatusValid, ""},
+	{0x0196, idnaStatusMapped, "\u0269"},
+	{0x0197, idnaStatusMapped, "\u0268"},
+	{0x0198, idnaStatusMapped, "\u0199"},
+	{0x0199, idnaStatusValid, ""},
+	{0x019C, idnaStatusMapped, "\u026f"},
+	{0x019D, idnaStatusMapped, "\u0272"},
+	{0x019E, idnaStatusValid, ""},
+	{0x019F, idnaStatusMapped, "\u0275"},
+	{0x01A0, idnaStatusMapped, "\u01a1"},
+	{0x01A1, idnaStatusValid, ""},
+	{0x01A2, idnaStatusMapped, "\u01a3"},
+	{0x01A3, idnaStatusValid, ""},
+	{0x01A4, idnaStatusMapped, "\u01a5"},
+	{0x01A5, idnaStatusValid, ""},
+	{0x01A6, idnaStatusMapped, "\u0280"},
+	{0x01A7, idnaStatusMapped, "\u01a8"},
+	{0x01A8, idnaStatusValid, ""},
+	{0x01A9, idnaStatusMapped, "\u0283"},
+	{0x01AA, idnaStatusValid, ""},
+	{0x01AC, idnaStatusMapped, "\u01ad"},
+	{0x01AD, idnaStatusValid, ""},
+	{0x01AE, idnaStatusMapped, "\u0288"},
+	{0x01AF, idnaStatusMapped, "\u01b0"},
+	{0x01B0, idnaStatusValid, ""},
+	{0x01B1, idnaStatusMapped, "\u028a"},
+	{0x01B2, idnaStatusMapped, "\u028b"},
+	{0x01B3, idnaStatusMapped, "\u01b4"},
+	{0x01B4, idnaStatusValid, ""},
+	{0x01B5, idnaStatusMapped, "\u01b6"},
+	{0x01B6, idnaStatusValid, ""},
+	{0x01B7, idnaStatusMapped, "\u0292"},
+	{0x01B8, idnaStatusMapped, "\u01b9"},
+	{0x01B9, idnaStatusValid, ""},
+	{0x01BC, idnaStatusMapped, "\u01bd"},
+	{0x01BD, idnaStatusValid, ""},
+	{0x01C4, idnaStatusMapped, "d\u017e"},
+	{0x01C7, idnaStatusMapped, "lj"},
+	{0x01CA, idnaStatusMapped, "nj"},
+	{0x01CD, idnaStatusMapped, "\u01ce"},
+	{0x01CE, idnaStatusValid, ""},
+	{0x01CF, idnaStatusMapped, "\u01d0"},
+	{0x01D0, idnaStatusValid, ""},
+	{0x01D1, idnaStatusMapped, "\u01d2"},
+	{0x01D2, idnaStatusValid, ""},
+	{0x01D3, idnaStatusMapped, "\u01d4"},
+	{0x01D4, idnaStatusValid, ""},
+	{0x01D5, idnaStatusMapped, "\u01d6"},
+	{0x01D6, idnaStatusValid, ""},
+	{0x01D7, idnaStatusMapped, "\u01d8"},
+	{0x01D8, idnaStatusValid, ""},
+	{0x01D9, idnaStatusMapped, "\u01da"},
+	{0x01DA, idnaStatusValid, ""},
+	{0x01DB, idnaStatusMapped, "\u01dc"},
+	{0x01DC, idnaStatusValid, ""},
+	{0x01DE, idnaStatusMapped, "\u01df"},
+	{0x01DF, idnaStatusValid, ""},
+	{0x01E0, idnaStatusMapped, "\u01e1"},
+	{0x01E1, idnaStatusValid, ""},
+	{0x01E2, idnaStatusMapped, "\u01e3"},
+	{0x01E3, idnaStatusValid, ""},
+	{0x01E4, idnaStatusMapped, "\u01e5"},
+	{0x01E5, idnaStatusValid, ""},
+	{0x01E6, idnaStatusMapped, "\u01e7"},
+	{0x01E7, idnaStatusValid, ""},
+	{0x01E8, idnaStatusMapped, "\u01e9"},
+	{0x01E9, idnaStatusValid, ""},
+	{0x01EA, idnaStatusMapped, "\u01eb"},
+	{0x01EB, idnaStatusValid, ""},
+	{0x01EC, idnaStatusMapped, "\u01ed"},
+	{0x01ED, idnaStatusValid, ""},
+	{0x01EE, idnaStatusMapped, "\u01ef"},
+	{0x01EF, idnaStatusValid, ""},
+	{0x01F1, idnaStatusMapped, "dz"},
+	{0x01F4, idnaStatusMapped, "\u01f5"},
+	{0x01F5, idnaStatusValid, ""},
+	{0x01F6, idnaStatusMapped, "\u0195"},
+	{0x01F7, idnaStatusMapped, "\u01bf"},
+	{0x01F8, idnaStatusMapped, "\u01f9"},
+	{0x01F9, idnaStatusValid, ""},
+	{0x01FA, idnaStatusMapped, "\u01fb"},
+	{0x01FB, idnaStatusValid, ""},
+	{0x01FC, idnaStatusMapped, "\u01fd"},
+	{0x01FD, idnaStatusValid, ""},
+	{0x01FE, idnaStatusMapped, "\u01ff"},
+	{0x01FF, idnaStatusValid, ""},
+	{0x0200, idnaStatusMapped, "\u0201"},
+	{0x0201, idnaStatusValid, ""},
+	{0x0202, idnaStatusMapped, "\u0203"},
+	{0x0203, idnaStatusValid, ""},
+	{0x0204, idnaStatusMapped, "\u0205"},
+	{0x0205, idnaStatusValid, ""},
+	{0x0206, idnaStatusMapped, "\u0207"},
+	{0x0207, idnaStatusValid, ""},
+	{0x0208, idnaStatusMapped, "\u0209"},
+	{0x0209, idnaStatusValid, ""},
+	{0x020A, idnaStatusMapped, "\u020b"},
+	{0x020B, idnaStatusValid, ""},
+	{0x020C, idnaStatusMapped, "\u020d"},
+	{0x020D, idnaStatusValid, ""},
+	{0x020E, idnaStatusMapped, "\u020f"},
+	{0x020F, idnaStatusValid, ""},
+	{0x0210, idnaStatusMapped, "\u0211"},
+	{0x0211, idnaStatusValid, ""},
+	{0x0212, idnaStatusMapped, "\u0213"},
+	{0x0213, idnaStatusValid, ""},
+	{0x0214, idnaStatusMapped, "\u0215"},
+	{0x0215, idnaStatusValid, ""},
+	{0x0216, idnaStatusMapped, "\u0217"},
+	{0x0217, idnaStatusValid, ""},
+	{0x0218, idnaStatusMapped, "\u0219"},
+	{0x0219, idnaStatusValid, ""},
+	{0x021A, idnaStatusMapped, "\u021b"},
+	{0x021B, idnaStatusValid, ""},
+	{0x021C, idnaStatusMapped, "\u021d"},
+	{0x021D, idnaStatusValid, ""},
+	{0x021E, idnaStatusMapped, "\u021f"},
+	{0x021F, idnaStatusValid, ""},
+	{0x0220, idnaStatusMapped, "\u019e"},
+	{0x0221, idnaStatusValid, ""},
+	{0x0222, idnaStatusMapped, "\u0223"},
+	{0x0223, idnaStatusValid, ""},
+	{0x0224, idnaStatusMapped, "\u0225"},
+	{0x0225, idnaStatusValid, ""},
+	{0x0226, idnaStatusMapped, "\u0227"},
+	{0x0227, idnaStatusValid, ""},
+	{0x0228, idnaStatusMapped, "\u0229"},
+	{0x0229, idnaStatusValid, ""},
+	{0x022A, idnaStatusMapped, "\u022b"},
+	{0x022B, idnaStatusValid, ""},
+	{0x022C, idnaStatusMapped, "\u022d"},
+	{0x022D, idnaStatusValid, ""},
+	{0x022E, idnaStatusMapped, "\u022f"},
+	{0x022F, idnaStatusValid, ""},
+	{0x0230, idnaStatusMapped, "\u0231"},
+	{0x0231, idnaStatusValid, ""},
+	{0x0232, idnaStatusMapped, "\u0233"},
+	{0x0233, idnaStatusValid, ""},
+	{0x023A, idnaStatusMapped, "\u2c65"},
+	{0x023B, idnaStatusMapped, "\u023c"},
+	{0x023C, idnaStatusValid, ""},
+	{0x023D, idnaStatusMapped, "\u019a"},
+	{0x023E, idnaStatusMapped, "\u2c66"},
+	{0x023F, idnaStatusValid, ""},
+	{0x0241, idnaStatusMapped, "\u0242"},
+	{0x0242, idnaStatusValid, ""},
+	{0x0243, idnaStatusMapped, "\u0180"},
+	{0x0244, idnaStatusMapped, "\u0289"},
+	{0x0245, idnaStatusMapped, "\u028c"},
+	{0x0246, idnaStatusMapped, "\u0247"},
+	{0x0247, idnaStatusValid, ""},
+	{0x0248, idnaStatusMapped, "\u0249"},
+	{0x0249, idnaStatusValid, ""},
+	{0x024A, idnaStatusMapped, "\u024b"},
+	{0x024B, idnaStatusValid, ""},
+	{0x024C, idnaStatusMapped, "\u024d"},
+	{0x024D, idnaStatusValid, ""},
+	{0x024E, idnaStatusMapped, "\u024f"},
+	{0x024F, idnaStatusValid, ""},
+	{0x02B0, idnaStatusMapped, "h"},
+	{0x02B1, idnaStatusMapped, "\u0266"},
+	{0x02B2, idnaStatusMapped, "j"},
+	{0x02B3, idnaStatusMapped, "r"},
+	{0x02B4, idnaStatusMapped, "\u0279"},
+	{0x02B5, idnaStatusMapped, "\u027b"},
+	{0x02B6, idnaStatusMapped, "\u0281"},
+	{0x02B7, idnaStatusMapped, "w"},
+	{0x02B8, idnaStatusMapped, "y"},
+	{0x02B9, idnaStatusValid, ""},
+	{0x02D8, idnaStatusMapped, " \u0306"},
+	{0x02D9, idnaStatusMapped, " \u0307"},
+	{0x02DA, idnaStatusMapped, " \u030a"},
+	{0x02DB, idnaStatusMapped, " \u0328"},
+	{0x02DC, idnaStatusMapped, " \u0303"},
+	{0x02DD, idnaStatusMapped, " \u030b"},
+	{0x02DE, idnaStatusValid, ""},
+	{0x02E0, idnaStatusMapped, "\u0263"},
+	{0x02E1, idnaStatusMapped, "l"},
+	{0x02E2, idnaStatusMapped, "s"},
+	{0x02E3, idnaStatusMapped, "x"},
+	{0x02E4, idnaStatusMapped, "\u0295"},
+	{0x02E5, idnaStatusValid, ""},
+	{0x0340, idnaStatusMapped, "\u0300"},
+	{0x0341, idnaStatusMapped, "\u0301"},
+	{0x0342, idnaStatusValid, ""},
+	{0x0343, idnaStatusMapped, "\u0313"},
+	{0x0344, idnaStatusMapped, "\u0308\u0301"},
+	{0x0345, idnaStatusMapped, "\u03b9"},
+	{0x0346, idnaStatusValid, ""},
+	{0x034F, idnaStatusIgnored, ""},
+	{0x0350, idnaStatusValid, ""},
+	{0x0370, idnaStatusMapped, "\u0371"},
+	{0x0371, idnaStatusValid, ""},
+	{0x0372, idnaStatusMapped, "\u0373"},
+	{0x0373, idnaStatusValid, ""},
+	{0x0374, idnaStatusMapped, "\u02b9"},
+	{0x0375, idnaStatusValid, ""},
+	{0x0376, idnaStatusMapped, "\u0377"},
+	{0x0377, idnaStatusValid, ""},
+	{0x0378, idnaStatusDisallowed, ""},
+	{0x037A, idnaStatusMapped, " \u03b9"},
+	{0x037B, idnaStatusValid, ""},
+	{0x037E, idnaStatusMapped, ";"},
+	{0x037F, idnaStatusMapped, "\u03f3"},
+	{0x0380, idnaStatusDisallowed, ""},
+	{0x0384, idnaStatusMapped, " \u0301"},
+	{0x0385, idnaStatusMapped, " \u0308\u0301"},
+	{0x0386, idnaStatusMapped, "\u03ac"},
+	{0x0387, idnaStatusMapped, "\u00b7"},
+	{0x0388, idnaStatusMapped, "\u03ad"},
+	{0x0389, idnaStatusMapped, "\u03ae"},
+	{0x038A, idnaStatusMapped, "\u03af"},
+	{0x038B, idnaStatusDisallowed, ""},
+	{0x038C, idnaStatusMapped, "\u03cc"},
+	{0x038D, idnaStatusDisallowed, ""},
+	{0x038E, idnaStatusMapped, "\u03cd"},
+	{0x038F, idnaStatusMapped, "\u03ce"},
+	{0x0390, idnaStatusValid, ""},
+	{0x0391, idnaStatusMapped, "\u03b1"},
+	{0x0392, idnaStatusMapped, "\u03b2"},
+	{0x0393, idnaStatusMapped, "\u03b3"},
+	{0x0394, idnaStatusMapped, "\u03b4"},
+	{0x0395, idnaStatusMapped, "\u03b5"},
+	{0x0396, idnaStatusMapped, "\u03b6"},
+	{0x0397, idnaStatusMapped, "\u03b7"},
+	{0x0398, idnaStatusMapped, "\u03b8"},
+	{0x0399, idnaStatusMapped, "\u03b9"},
+	{0x039A, idnaStatusMapped, "\u03ba"},
+	{0x039B, idnaStatusMapped, "\u03bb"},
+	{0x039C, idnaStatusMapped, "\u03bc"},
+	{0x039D, idnaStatusMapped, "\u03bd"},
+	{0x039E, idnaStatusMapped, "\u03be"},
+	{0x039F, idnaStatusMapped, "\u03bf"},
+	{0x03A0, idnaStatusMapped, "\u03c0"},
+	{0x03A1, idnaStatusMapped, "\u03c1"},
+	{0x03A2, idnaStatusDisallowed, ""},
+	{0x03A3, idnaStatusMapped, "\u03c3"},
+	{0x03A4, idnaStatusMapped, "\u03c4"},
+	{0x03A5, idnaStatusMapped, "\u03c5"},
+	{0x03A6, idnaStatusMapped, "\u03c6"},
+	{0x03A7, idnaStatusMapped, "\u03c7"},
+	{0x03A8, idnaStatusMapped, "\u03c8"},
+	{0x03A9, idnaStatusMapped, "\u03c9"},
+	{0x03AA, idnaStatusMapped, "\u03ca"},
+	{0x03AB, idnaStatusMapped, "\u03cb"},
+	{0x03AC, idnaStatusValid, ""},
+	{0x03C2, idnaStatusDeviation, "\u03c3"},
+	{0x03C3, idnaStatusValid, ""},
+	{0x03CF, idnaStatusMapped, "\u03d7"},
+	{0x03D0, idnaStatusMapped, "\u03b2"},
+	{0x03D1, idnaStatusMapped, "\u03b8"},
+	{0x03D2, idnaStatusMapped, "\u03c5"},
+	{0x03D3, idnaStatusMapped, "\u03cd"},
+	{0x03D4, idnaStatusMapped, "\u03cb"},
+	{0x03D5, idnaStatusMapped, "\u03c6"},
+	{0x03D6, idnaStatusMapped, "\u03c0"},
+	{0x03D7, idnaStatusValid, ""},
+	{0x03D8, idnaStatusMapped, "\u03d9"},
+	{0x03D9, idnaStatusValid, ""},
+	{0x03DA, idnaStatusMapped, "\u03db"},
+	{0x03DB, idnaStatusValid, ""},
+	{0x03DC, idnaStatusMapped, "\u03dd"},
+	{0x03DD, idnaStatusValid, ""},
+	{0x03DE, idnaStatusMapped, "\u03df"},
+	{0x03DF, idnaStatusValid, ""},
+	{0x03E0, idnaStatusMapped, "\u03e1"},
+	{0x03E1, idnaStatusValid, ""},
+	{0x03E2, idnaStatusMapped, "\u03e3"},
+	{0x03E3, idnaStatusValid, ""},
+	{0x03E4, idnaStatusMapped, "\u03e5"},
+	{0x03E5, idnaStatusValid, ""},
+	{0x03E6, idnaStatusMapped, "\u03e7"},
+	{0x03E7, idnaStatusValid, ""},
+	{0x03E8, idnaStatusMapped, "\u03e9"},
+	{0x03E9, idnaStatusValid, ""},
+	{0x03EA, idnaStatusMapped, "\u03eb"},
+	{0x03EB, idnaStatusValid, ""},
+	{0x03EC, idnaStatusMapped, "\u03ed"},
+	{0x03ED, idnaStatusValid, ""},
+	{0x03EE, idnaStatusMapped, "\u03ef"},
+	{0x03EF, idnaStatusValid, ""},
+	{0x03F0, idnaStatusMapped, "\u03ba"},
+	{0x03F1, idnaStatusMapped, "\u03c1"},
+	{0x03F2, idnaStatusMapped, "\u03c3"},
+	{0x03F3, idnaStatusValid, ""},
+	{0x03F4, idnaStatusMapped, "\u03b8"},
+	{0x03F5, idnaStatusMapped, "\u03b5"},
+	{0x03F6, idnaStatusValid, ""},
+	{0x03F7, idnaStatusMapped, "\u03f8"},
+	{0x03F8, idnaStatusValid, ""},
+	{0x03F9, idnaStatusMapped, "\u03c3"},
+	{0x03FA, idnaStatusMapped, "\u03fb"},
+	{0x03FB, idnaStatusValid, ""},
+	{0x03FD, idnaStatusMapped, "\u037b"},
+	{0x03FE, idnaStatusMapped, "\u037c"},
+	{0x03FF, idnaStatusMapped, "\u037d"},
+	{0x0400, idnaStatusMapped, "\u0450"},
+	{0x0401, idnaStatusMapped, "\u0451"},
+	{0x0402, idnaStatusMapped, "\u0452"},
+	{0x0403, idnaStatusMapped, "\u0453"},
+	{0x0404, idnaStatusMapped, "\u0454"},
+	{0x0405, idnaStatusMapped, "\u0455"},
+	{0x0406, idnaStatusMapped, "\u0456"},
+	{0x0407, idnaStatusMapped, "\u0457"},
+	{0x0408, idnaStatusMapped, "\u0458"},
+	{0x0409, idnaStatusMapped, "\u0459"},
+	{0x040A, idnaStatusMapped, "\u045a"},
+	{0x040B, idnaStatusMapped, "\u045b"},
+	{0x040C, idnaStatusMapped, "\u045c"},
+	{0x040D, idnaStatusMapped, "\u045d"},
+	{0x040E, idnaStatusMapped, "\u045e"},
+	{0x040F, idnaStatusMapped, "\u045f"},
+	{0x0410, idnaStatusMapped, "\u0430"},
+	{0x0411, idnaStatusMapped, "\u0431"},
+	{0x0412, idnaStatusMapped, "\u0432"},
+	{0x0413, idnaStatusMapped, "\u0433"},
+	{0x0414, idnaStatusMapped, "\u0434"},
+	{0x0415, idnaStatusMapped, "\u0435"},
+	{0x0416, idnaStatusMapped, "\u0436"},
+	{0x0417, idnaStatusMapped, "\u0437"},
+	{0x0418, idnaStatusMapped, "\u0438"},
+	{0x0419, idnaStatusMapped, "\u0439"},
+	{0x041A, idnaStatusMapped, "\u043a"},
+	{0x041B, idnaStatusMapped, "\u043b"},
+	{0x041C, idnaStatusMapped, "\u043c"},
+	{0x041D, idnaStatusMapped, "\u043d"},
+	{0x041E, idnaStatusMapped, "\u043e"},
+	{0x041F, idnaStatusMapped, "\u043f"},
+	{0x0420, idnaStatusMapped, "\u0440"},
+	{0x0421, idnaStatusMapped, "\u0441"},
+	{0x0422, idnaStatusMapped, "\u0442"},
+	{0x0423, idnaStatusMapped, "\u0443"},
+	{0x0424, idnaStatusMapped, "\u0444"},
+	{0x0425, idnaStatusMapped, "\u0445"},
+	{0x0426, idnaStatusMapped, "\u0446"},
+	{0x0427, idnaStatusMapped, "\u0447"},
+	{0x0428, idnaStatusMapped, "\u0448"},
+	{0x0429, idnaStatusMapped, "\u0449"},
+	{0x042A, idnaStatusMapped, "\u044a"},
+	{0x042B, idnaStatusMapped, "\u044b"},
+	{0x042C, idnaStatusMapped, "\u044c"},
+	{0x042D, idnaStatusMapped, "\u044d"},
+	{0x042E, idnaStatusMapped, "\u044e"},
+	{0x042F, idnaStatusMapped, "\u044f"},
+	{0x0430, idnaStatusValid, ""},
+	{0x0460, idnaStatusMapped, "\u0461"},
+	{0x0461, idnaStatusValid, ""},
+	{0x0462, idnaStatusMapped, "\u0463"},
+	{0x0463, idnaStatusValid, ""},
+	{0x0464, idnaStatusMapped, "\u0465"},
+	{0x0465, idnaStatusValid, ""},
+	{0x0466, idnaStatusMapped, "\u0467"},
+	{0x0467, idnaStatusValid, ""},
+	{0x0468, idnaStatusMapped, "\u0469"},
+	{0x0469, idnaStatusValid, ""},
+	{0x046A, idnaStatusMapped, "\u046b"},
+	{0x046B, idnaStatusValid, ""},
+	{0x046C, idnaStatusMapped, "\u046d"},
+	{0x046D, idnaStatusValid, ""},
+	{0x046E, idnaStatusMapped, "\u046f"},
+	{0x046F, idnaStatusValid, ""},
+	{0x0470, idnaStatusMapped, "\u0471"},
+	{0x0471, idnaStatusValid, ""},
+	{0x0472, idnaStatusMapped, "\u0473"},
+	{0x0473, idnaStatusValid, ""},
+	{0x0474, idnaStatusMapped, "\u0475"},
+	{0x0475, idnaStatusValid, ""},
+	{0x0476, idnaStatusMapped, "\u0477"},
+	{0x0477, idnaStatusValid, ""},
+	{0x0478, idnaStatusMapped, "\u0479"},
+	{0x0479, idnaStatusValid, ""},
+	{0x047A, idnaStatusMapped, "\u047b"},
+	{0x047B, idnaStatusValid, ""},
+	{0x047C, idnaStatusMapped, "\u047d"},
+	{0x047D, idnaStatusValid, ""},
+	{0x047E, idnaStatusMapped, "\u047f"},
+	{0x047F, idnaStatusValid, ""},
+	{0x0480, idnaStatusMapped, "\u0481"},
+	{0x0481, idnaStatusValid, ""},
+	{0x048A, idnaStatusMapped, "\u048b"},
+	{0x048B, idnaStatusValid, ""},
+	{0x048C, idnaStatusMapped, "\u048d"},
+	{0x048D, idnaStatusValid, ""},
+	{0x048E, idnaStatusMapped, "\u048f"},
+	{0x048F, idnaStatusValid, ""},
+	{0x0490, idnaStatusMapped, "\u0491"},
+	{0x0491, idnaStatusValid, ""},
+	{0x0492, idnaStatusMapped, "\u0493"},
+	{0x0493, idnaStatusValid, ""},
+	{0x0494, idnaStatusMapped, "\u0495"},
+	{0x0495, idnaStatusValid, ""},
+	{0x0496, idnaStatusMapped, "\u0497"},
+	{0x0497, idnaStatusValid, ""},
+	{0x0498, idnaStatusMapped, "\u0499"},
+	{0x0499, idnaStatusValid, ""},
+	{0x049A, idnaStatusMapped, "\u049b"},
+	{0x049B, idnaStatusValid, ""},
+	{0x049C, idnaStatusMapped, "\u049d"},
+	{0x049D, idnaStatusValid, ""},
+	{0x049E, idnaStatusMapped, "\u049f"},
+	{0x049F, idnaStatusValid, ""},
+	{0x04A0, idnaStatusMapped, "\u04a1"},
+	{0x04A1, idnaStatusValid, ""},
+	{0x04A2, idnaStatusMapped, "\u04a3"},
+	{0x04A3, idnaStatusValid, ""},
+	{0x04A4, idnaStatusMapped, "\u04a5"},
+	{0x04A5, idnaStatusValid, ""},
+	{0x04A6, idnaStatusMapped, "\u04a7"},
+	{0x04A7, idnaStatusValid, ""},
+	{0x04A8, idnaStatusMapped, "\u04a9"},
+	{0x04A9, idnaStatusValid, ""},
+	{0x04AA, idnaStatusMapped, "\u04ab"},
+	{0x04AB, idnaStatusValid, ""},
+	{0x04AC, idnaStatusMapped, "\u04ad"},
+	{0x04AD, idnaStatusValid, ""},
+	{0x04AE, idnaStatusMapped, "\u04af"},
+	{0x04AF, idnaStatusValid, ""},
+	{0x04B0, idnaStatusMapped, "\u04b1"},
+	{0x04B1, idnaStatusValid, ""},
+	{0x04B2, idnaStatusMapped, "\u04b3"},
+	{0x04B3, idnaStatusValid, ""},
+	{0x04B4, idnaStatusMapped, "\u04b5"},
+	{0x04B5, idnaStatusValid, ""},
+	{0x04B6, idnaStatusMapped, "\u04b7"},
+	{0x04B7, idnaStatusValid, ""},
+	{0x04B8, idnaStatusMapped, "\u04b9"},
+	{0x04B9, idnaStatusValid, ""},
+	{0x04BA, idnaStatusMapped, "\u04bb"},
+	{0x04BB, idnaStatusValid, ""},
+	{0x04BC, idnaStatusMapped, "\u04bd"},
+	{0x04BD, idnaStatusValid, ""},
+	{0x04BE, idnaStatusMapped, "\u04bf"},
+	{0x04BF, idnaStatusValid, ""},
+	{0x04C0, idnaStatusMapped, "\u04cf"},
+	{0x04C1, idnaStatusMapped, "\u04c2"},
+	{0x04C2, idnaStatusValid, ""},
+	{0x04C3, idnaStatusMapped, "\u04c4"},
+	{0x04C4, idnaStatusValid, ""},
+	{0x04C5, idnaStatusMapped, "\u04c6"},
+	{0x04C6, idnaStatusValid, ""},
+	{0x04C7, idnaStatusMapped, "\u04c8"},
+	{0x04C8, idnaStatusValid, ""},
+	{0x04C9, idnaStatusMapped, "\u04ca"},
+	{0x04CA, idnaStatusValid, ""},
+	{0x04CB, idnaStatusMapped, "\u04cc"},
+	{0x04CC, idnaStatusValid, ""},
+	{0x04CD, idnaStatusMapped, "\u04ce"},
+	{0x04CE, idnaStatusValid, ""},
+	{0x04D0, idnaStatusMapped, "\u04d1"},
+	{0x04D1, idnaStatusValid, ""},
+	{0x04D2, idnaStatusMapped, "\u04d3"},
+	{0x04D3, idnaStatusValid, ""},
+	{0x04D4, idnaStatusMapped, "\u04d5"},
+	{0x04D5, idnaStatusValid, ""},
+	{0x04D6, idnaStatusMapped, "\u04d7"},
+	{0x04D7, idnaStatusValid, ""},
+	{0x04D8, idnaStatusMapped, "\u04d9"},
+	{0x04D9, idnaStatusValid, ""},
+	{0x04DA, idnaStatusMapped, "\u04db"},
+	{0x04DB, idnaStatusValid, ""},
+	{0x04DC, idnaStatusMapped, "\u04dd"},
+	{0x04DD, idnaStatusValid, ""},
+	{0x04DE, idnaStatusMapped, "\u04df"},
+	{0x04DF, idnaStatusValid, ""},
+	{0x04E0, idnaStatusMapped, "\u04e1"},
+	{0x04E1, idnaStatusValid, ""},
+	{0x04E2, idnaStatusMapped, "\u04e3"},
+	{0x04E3, idnaStatusValid, ""},
+	{0x04E4, idnaStatusMapped, "\u04e5"},
+	{0x04E5, idnaStatusValid, ""},
+	{0x04E6, idnaStatusMapped, "\u04e7"},
+	{0x04E7, idnaStatusValid, ""},
+	{0x04E8, idnaStatusMapped, "\u04e9"},
+	{0x04E9, idnaStatusValid, ""},
+	{0x04EA, idnaStatusMapped, "\u04eb"},
+	{0x04EB, idnaStatusValid, ""},
+	{0x04EC, idnaStatusMapped, "\u04ed"},
+	{0x04ED, idnaStatusValid, ""},
+	{0x04EE, idnaStatusMapped, "\u04ef"},
+	{0x04EF, idnaStatusValid, ""},
+	{0x04F0, idnaStatusMapped, "\u04f1"},
+	{0x04F1, idnaStatusValid, ""},
+	{0x04F2, idnaStatusMapped, "\u04f3"},
+	{0x04F3, idnaStatusValid, ""},
+	{0x04F4, idnaStatusMapped, "\u04f5"},
+	{0x04F5, idnaStatusValid, ""},
+	{0x04F6, idnaStatusMapped, "\u04f7"},
+	{0x04F7, idnaStatusValid, ""},
+	{0x04F8, idnaStatusMapped, "\u04f9"},
+	{0x04F9, idnaStatusValid, ""},
+	{0x04FA, idnaStatusMapped, "\u04fb"},
+	{0x04FB, idnaStatusValid, ""},
+	{0x04FC, idnaStatusMapped, "\u04fd"},
+	{0x04FD, idnaStatusValid, ""},
+	{0x04FE, idnaStatusMapped, "\u04ff"},
+	{0x04FF, idnaStatusValid, ""},
+	{0x0500, idnaStatusMapped, "\u0501"},
+	{0x0501, idnaStatusValid, ""},
+	{0x0502, idnaStatusMapped, "\u0503"},
+	{0x0503, idnaStatusValid, ""},
+	{0x0504, idnaStatusMapped, "\u0505"},
+	{0x0505, idnaStatusValid, ""},
+	{0x0506, idnaStatusMapped, "\u0507"},
+	{0x0507, idnaStatusValid, ""},
+	{0x0508, idnaStatusMapped, "\u0509"},
+	{0x0509, idnaStatusValid, ""},
+	{0x050A, idnaStatusMapped, "\u050b"},
+	{0x050B, idnaStatusValid, ""},
+	{0x050C, idnaStatusMapped, "\u050d"},
+	{0x050D, idnaStatusValid, ""},
+	{0x050E, idnaStatusMapped, "\u050f"},
+	{0x050F, idnaStatusValid, ""},
+	{0x0510, idnaStatusMapped, "\u0511"},
+	{0x0511, idnaStatusValid, ""},
+	{0x0512, idnaStatusMapped, "\u0513"},
+	{0x0513, idnaStatusValid, ""},
+	{0x0514, idnaStatusMapped, "\u0515"},
+	{0x0515, idnaStatusValid, ""},
+	{0x0516, idnaStatusMapped, "\u0517"},
+	{0x0517, idnaStatusValid, ""},
+	{0x0518, idnaStatusMapped, "\u0519"},
+	{0x0519, idnaStatusValid, ""},
+	{0x051A, idnaStatusMapped, "\u051b"},
+	{0x051B, idnaStatusValid, ""},
+	{0x051C, idnaStatusMapped, "\u051d"},
+	{0x051D, idnaStatusValid, ""},
+	{0x051E, idnaStatusMapped, "\u051f"},
+	{0x051F, idnaStatusValid, ""},
+	{0x0520, idnaStatusMapped, "\u0521"},
+	{0x0521, idnaStatusValid, ""},
+	{0x0522, idnaStatusMapped, "\u0523"},
+	{0x0523, idnaStatusValid, ""},
+	{0x0524, idnaStatusMapped, "\u0525"},
+	{0x0525, idnaStatusValid, ""},
+	{0x0526, idnaStatusMapped, "\u0527"},
+	{0x0527, idnaStatusValid, ""},
+	{0x0528, idnaStatusMapped, "\u0529"},
+	{0x0529, idnaStatusValid, ""},
+	{0x052A, idnaStatusMapped, "\u052b"},
+	{0x052B, idnaStatusValid, ""},
+	{0x052C, idnaStatusMapped, "\u052d"},
+	{0x052D, idnaStatusValid, ""},
+	{0x052E, idnaStatusMapped, "\u052f"},
+	{0x052F, idnaStatusValid, ""},
+	{0x0530, idnaStatusDisallowed, ""},
+	{0x0531, idnaStatusMapped, "\u0561"},
+	{0x0532, idnaStatusMapped, "\u0562"},
+	{0x0533, idnaStatusMapped, "\u0563"},
+	{0x0534, idnaStatusMapped, "\u0564"},
+	{0x0535, idnaStatusMapped, "\u0565"},
+	{0x0536, idnaStatusMapped, "\u0566"},
+	{0x0537, idnaStatusMapped, "\u0567"},
+	{0x0538, idnaStatusMapped, "\u0568"},
+	{0x0539, idnaStatusMapped, "\u0569"},
+	{0x053A, idnaStatusMapped, "\u056a"},
+	{0x053B, idnaStatusMapped, "\u056b"},
+	{0x053C, idnaStatusMapped, "\u056c"},
+	{0x053D, idnaStatusMapped, "\u056d"},
+	{0x053E, idnaStatusMapped, "\u056e"},
+	{0x053F, idnaStatusMapped, "\u056f"},
+	{0x0540, idnaStatusMapped, "\u0570"},
+	{0x0541, idnaStatusMapped, "\u0571"},
+	{0x0542, idnaStatusMapped, "\u0572"},
+	{0x0543, idnaStatusMapped, "\u0573"},
+	{0x0544, idnaStatusMapped, "\u0574"},
+	{0x0545, idnaStatusMapped, "\u0575"},
+	{0x0546, idnaStatusMapped, "\u0576"},
+	{0x0547, idnaStatusMapped, "\u0577"},
+	{0x0548, idnaStatusMapped, "\u0578"},
+	{0x0549, idnaStatusMapped, "\u0579"},
+	{0x054A, idnaStatusMapped, "\u057a"},
+	{0x054B, idnaStatusMapped, "\u057b"},
+	{0x054C, idnaStatusMapped, "\u057c"},
+	{0x054D, idnaStatusMapped, "\u057d"},
+	{0x054E, idnaStatusMapped, "\u057e"},
+	{0x054F, idnaStatusMapped, "\u057f"},
+	{0x0550, idnaStatusMapped, "\u0580"},
+	{0x0551, idnaStatusMapped, "\u0581"},
+	{0x0552, idnaStatusMapped, "\u0582"},
+	{0x0553, idnaStatusMapped, "\u0583"},
+	{0x0554, idnaStatusMapped, "\u0584"},
+	{0x0555, idnaStatusMapped, "\u0585"},
+	{0x0556, idnaStatusMapped, "\u0586"},
+	{0x0557, idnaStatusDisallowed, ""},
+	{0x0559, idnaStatusValid, ""},
+	{0x0587, idnaStatusMapped, "\u0565\u0582"},
+	{0x0588, idnaStatusValid, ""},
+	{0x058B, idnaStatusDisallowed, ""},
+	{0x058D, idnaStatusValid, ""},
+	{0x0590, idnaStatusDisallowed, ""},
+	{0x0591, idnaStatusValid, ""},
+	{0x05C8, idnaStatusDisallowed, ""},
+	{0x05D0, idnaStatusValid, ""},
+	{0x05EB, idnaStatusDisallowed, ""},
+	{0x05EF, idnaStatusValid, ""},
+	{0x05F5, idnaStatusDisallowed, ""},
+	{0x0606, idnaStatusValid, ""},
+	{0x061C, idnaStatusDisallowed, ""},
+	{0x061D, idnaStatusValid, ""},
+	{0x0675, idnaStatusMapped, "\u0627\u0674"},
+	{0x0676, idnaStatusMapped, "\u0648\u0674"},
+	{0x0677, idnaStatusMapped, "\u06c7\u0674"},
+	{0x0678, idnaStatusMapped, "\u064a\u0674"},
+	{0x0679, idnaStatusValid, ""},
+	{0x06DD, idnaStatusDisallowed, ""},
+	{0x06DE, idnaStatusValid, ""},
+	{0x070E, idnaStatusDisallowed, ""},
+	{0x0710, idnaStatusValid, ""},
+	{0x074B, idnaStatusDisallowed, ""},
+	{0x074D, idnaStatusValid, ""},
+	{0x07B2, idnaStatusDisallowed, ""},
+	{0x07C0, idnaStatusValid, ""},
+	{0x07FB, idnaStatusDisallowed, ""},
+	{0x07FD, idnaStatusValid, ""},
+	{0x082E, idnaStatusDisallowed, ""},
+	{0x0830, idnaStatusValid, ""},
+	{0x083F, idnaStatusDisallowed, ""},
+	{0x0840, idnaStatusValid, ""},
+	{0x085C, idnaStatusDisallowed, ""},
+	{0x085E, idnaStatusValid, ""},
+	{0x085F, idnaStatusDisallowed, ""},
+	{0x0860, idnaStatusValid, ""},
+	{0x086B, idnaStatusDisallowed, ""},
+	{0x0870, idnaStatusValid, ""},
+	{0x0890, idnaStatusDisallowed, ""},
+	{0x0897, idnaStatusValid, ""},
+	{0x08E2, idnaStatusDisallowed, ""},
+	{0x08E3, idnaStatusValid, ""},
+	{0x0958, idnaStatusMapped, "\u0915\u093c"},
+	{0x0959, idnaStatusMapped, "\u0916\u093c"},
+	{0x095A, idnaStatusMapped, "\u0917\u093c"},
+	{0x095B, idnaStatusMapped, "\u091c\u093c"},
+	{0x095C, idnaStatusMapped, "\u0921\u093c"},
+	{0x095D, idnaStatusMapped, "\u0922\u093c"},
+	{0x095E, idnaStatusMapped, "\u092b\u093c"},
+	{0x095F, idnaStatusMapped, "\u092f\u093c"},
+	{0x0960, idnaStatusValid, ""},
+	{0x0984, idnaStatusDisallowed, ""},
+	{0x0985, idnaStatusValid, ""},
+	{0x098D, idnaStatusDisallowed, ""},
+	{0x098F, idnaStatusValid, ""},
+	{0x0991, idnaStatusDisallowed, ""},
+	{0x0993, idnaStatusValid, ""},
+	{0x09A9, idnaStatusDisallowed, ""},
+	{0x09AA, idnaStatusValid, ""},
+	{0x09B1, idnaStatusDisallowed, ""},
+	{0x09B2, idnaStatusValid, ""},
+	{0x09B3, idnaStatusDisallowed, ""},
+	{0x09B6, idnaStatusValid, ""},
+	{0x09BA, idnaStatusDisallowed, ""},
+	{0x09BC, idnaStatusValid, ""},
+	{0x09C5, idnaStatusDisallowed, ""},
+	{0x09C7, idnaStatusValid, ""},
+	{0x09C9, idnaStatusDisallowed, ""},
+	{0x09CB, idnaStatusValid, ""},
+	{0x09CF, idnaStatusDisallowed, ""},
+	{0x09D7, idnaStatusValid, ""},
+	{0x09D8, idnaStatusDisallowed, ""},
+	{0x09DC, idnaStatusMapped, "\u09a1\u09bc"},
+	{0x09DD, idnaStatusMapped, "\u09a2\u09bc"},
+	{0x09DE, idnaStatusDisallowed, ""},
+	{0x09DF, idnaStatusMapped, "\u09af\u09bc"},
+	{0x09E0, idnaStatusValid, ""},
+	{0x09E4, idnaStatusDisallowed, ""},
+	{0x09E6, idnaStatusValid, ""},
+	{0x09FF, idnaStatusDisallowed, ""},
+	{0x0A01, idnaStatusValid, ""},
+	{0x0A04, idnaStatusDisallowed, ""},
+	{0x0A05, idnaStatusValid, ""},
+	{0x0A0B, idnaStatusDisallowed, ""},
+	{0x0A0F, idnaStatusValid, ""},
+	{0x0A11, idnaStatusDisallowed, ""},
+	{0x0A13, idnaStatusValid, ""},
+	{0x0A29, idnaStatusDisallowed, ""},
+	{0x0A2A, idnaStatusValid, ""},
+	{0x0A31, idnaStatusDisallowed, ""},
+	{0x0A32, idnaStatusValid, ""},
+	{0x0A33, idnaStatusMapped, "\u0a32\u0a3c"},
+	{0x0A34, idnaStatusDisallowed, ""},
+	{0x0A35, idnaStatusValid, ""},
+	{0x0A36, idnaStatusMapped, "\u0a38\u0a3c"},
+	{0x0A37, idnaStatusDisallowed, ""},
+	{0x0A38, idnaStatusValid, ""},
+	{0x0A3A, idnaStatusDisallowed, ""},
+	{0x0A3C, idnaStatusValid, ""},
+	{0x0A3D, idnaStatusDisallowed, ""},
+	{0x0A3E, idnaStatusValid, ""},
+	{0x0A43, idnaStatusDisallowed, ""},
+	{0x0A47, idnaStatusValid, ""},
+	{0x0A49, idnaStatusDisallowed, ""},
+	{0x0A4B, idnaStatusValid, ""},
+	{0x0A4E, idnaStatusDisallowed, ""},
+	{0x0A51, idnaStatusValid, ""},
+	{0x0A52, idnaStatusDisallowed, ""},
+	{0x0A59, idnaStatusMapped, "\u0a16\u0a3c"},
+	{0x0A5A, idnaStatusMapped, "\u0a17\u0a3c"},
+	{0x0A5B, idnaStatusMapped, "\u0a1c\u0a3c"},
+	{0x0A5C, idnaStatusValid, ""},
+	{0x0A5D, idnaStatusDisallowed, ""},
+	{0x0A5E, idnaStatusMapped, "\u0a2b\u0a3c"},
+	{0x0A5F, idnaStatusDisallowed, ""},
+	{0x0A66, idnaStatusValid, ""},
+	{0x0A77, idnaStatusDisallowed, ""},
+	{0x0A81, idnaStatusValid, ""},
+	{0x0A84, idnaStatusDisallowed, ""},
+	{0x0A85, idnaStatusValid, ""},
+	{0x0A8E, idnaStatusDisallowed, ""},
+	{0x0A8F, idnaStatusValid, ""},
+	{0x0A92, idnaStatusDisallowed, ""},
+	{0x0A93, idnaStatusValid, ""},
+	{0x0AA9, idnaStatusDisallowed, ""},
+	{0x0AAA, idnaStatusValid, ""},
+	{0x0AB1, idnaStatusDisallowed, ""},
+	{0x0AB2, idnaStatusValid, ""},
+	{0x0AB4, idnaStatusDisallowed, ""},
+	{0x0AB5, idnaStatusValid, ""},
+	{0x0ABA, idnaStatusDisallowed, ""},
+	{0x0ABC, idnaStatusValid, ""},
+	{0x0AC6, idnaStatusDisallowed, ""},
+	{0x0AC7, idnaStatusValid, ""},
+	{0x0ACA, idnaStatusDisallowed, ""},
+	{0x0ACB, idnaStatusValid, ""},
+	{0x0ACE, idnaStatusDisallowed, ""},
+	{0x0AD0, idnaStatusValid, ""},
+	{0x0AD1, idnaStatusDisallowed, ""},
+	{0x0AE0, idnaStatusValid, ""},
+	{0x0AE4, idnaStatusDisallowed, ""},
+	{0x0AE6, idnaStatusValid, ""},
+	{0x0AF2, idnaStatusDisallowed, ""},
+	{0x0AF9, idnaStatusValid, ""},
+	{0x0B00, idnaStatusDisallowed, ""},
+	{0x0B01, idnaStatusValid, ""},
+	{0x0B04, idnaStatusDisallowed, ""},
+	{0x0B05, idnaStatusValid, ""},
+	{0x0B0D, idnaStatusDisallowed, ""},
+	{0x0B0F, idnaStatusValid, ""},
+	{0x0B11, idnaStatusDisallowed, ""},
+	{0x0B13, idnaStatusValid, ""},
+	{0x0B29, idnaStatusDisallowed, ""},
+	{0x0B2A, idnaStatusValid, ""},
+	{0x0B31, idnaStatusDisallowed, ""},
+	{0x0B32, idnaStatusValid, ""},
+	{0x0B34, idnaStatusDisallowed, ""},
+	{0x0B35, idnaStatusValid, ""},
+	{0x0B3A, idnaStatusDisallowed, ""},
+	{0x0B3C, idnaStatusValid, ""},
+	{0x0B45, idnaStatusDisallowed, ""},
+	{0x0B47, idnaStatusValid, ""},
+	{0x0B49, idnaStatusDisallowed, ""},
+	{0x0B4B, idnaStatusValid, ""},
+	{0x0B4E, idnaStatusDisallowed, ""},
+	{0x0B55, idnaStatusValid, ""},
+	{0x0B58, idnaStatusDisallowed, ""},
+	{0x0B5C, idnaStatusMapped, "\u0b21\u0b3c"},
+	{0x0B5D, idnaStatusMapped, "\u0b22\u0b3c"},
+	{0x0B5E, idnaStatusDisallowed, ""},
+	{0x0B5F, idnaStatusValid, ""},
+	{0x0B64, idnaStatusDisallowed, ""},
+	{0x0B66, idnaStatusValid, ""},
+	{0x0B78, idnaStatusDisallowed, ""},
+	{0x0B82, idnaStatusValid, ""},
+	{0x0B84, idnaStatusDisallowed, ""},
+	{0x0B85, idnaStatusValid, ""},
+	{0x0B8B, idnaStatusDisallowed, ""},
+	{0x0B8E, idnaStatusValid, ""},
+	{0x0B91, idnaStatusDisallowed, ""},
+	{0x0B92, idnaStatusValid, ""},
+	{0x0B96, idnaStatusDisallowed, ""},
+	{0x0B99, idnaStatusValid, ""},
+	{0x0B9B, idnaStatusDisallowed, ""},
+	{0x0B9C, idnaStatusValid, ""},
+	{0x0B9D, idnaStatusDisallowed, ""},
+	{0x0B9E, idnaStatusValid, ""},
+	{0x0BA0, idnaStatusDisallowed, ""},
+	{0x0BA3, idnaStatusValid, ""},
+	{0x0BA5, idnaStatusDisallowed, ""},
+	{0x0BA8, idnaStatusValid, ""},
+	{0x0BAB, idnaStatusDisallowed, ""},
+	{0x0BAE, idnaStatusValid, ""},
+	{0x0BBA, idnaStatusDisallowed, ""},
+	{0x0BBE, idnaStatusValid, ""},
+	{0x0BC3, idnaStatusDisallowed, ""},
+	{0x0BC6, idnaStatusValid, ""},
+	{0x0BC9, idnaStatusDisallowed, ""},
+	{0x0BCA, idnaStatusValid, ""},
+	{0x0BCE, idnaStatusDisallowed, ""},
+	{0x0BD0, idnaStatusValid, ""},
+	{0x0BD1, idnaStatusDisallowed, ""},
+	{0x0BD7, idnaStatusValid, ""},
+	{0x0BD8, idnaStatusDisallowed, ""},
+	{0x0BE6, idnaStatusValid, ""},
+	{0x0BFB, idnaStatusDisallowed, ""},
+	{0x0C00, idnaStatusValid, ""},
+	{0x0C0D, idnaStatusDisallowed, ""},
+	{0x0C0E, idnaStatusValid, ""},
+	{0x0C11, idnaStatusDisallowed, ""},
+	{0x0C12, idnaStatusValid, ""},
+	{0x0C29, idnaStatusDisallowed, ""},
+	{0x0C2A, idnaStatusValid, ""},
+	{0x0C3A, idnaStatusDisallowed, ""},
+	{0x0C3C, idnaStatusValid, ""},
+	{0x0C45, idnaStatusDisallowed, ""},
+	{0x0C46, idnaStatusValid, ""},
+	{0x0C49, idnaStatusDisallowed, ""},
+	{0x0C4A, idnaStatusValid, ""},
+	{0x0C4E, idnaStatusDisallowed, ""},
+	{0x0C55, idnaStatusValid, ""},
+	{0x0C57, idnaStatusDisallowed, ""},
+	{0x0C58, idnaStatusValid, ""},
+	{0x0C5B, idnaStatusDisallowed, ""},
+	{0x0C5C, idnaStatusValid, ""},
+	{0x0C5E, idnaStatusDisallowed, ""},
+	{0x0C60, idnaStatusValid, ""},
+	{0x0C64, idnaStatusDisallowed, ""},
+	{0x0C66, idnaStatusValid, ""},
+	{0x0C70, idnaStatusDisallowed, ""},
+	{0x0C77, idnaStatusValid, ""},
+	{0x0C8D, idnaStatusDisallowed, ""},
+	{0x0C8E, idnaStatusValid, ""},
+	{0x0C91, idnaStatusDisallowed, ""},
+	{0x0C92, idnaStatusValid, ""},
+	{0x0CA9, idnaStatusDisallowed, ""},
+	{0x0CAA, idnaStatusValid, ""},
+	{0x0CB4, idnaStatusDisallowed, ""},
+	{0x0CB5, idnaStatusValid, ""},
+	{0x0CBA, idnaStatusDisallowed, ""},
+	{0x0CBC, idnaStatusValid, ""},
+	{0x0CC5, idnaStatusDisallowed, ""},
+	{0x0CC6, idnaStatusValid, ""},
+	{0x0CC9, idnaStatusDisallowed, ""},
+	{0x0CCA, idnaStatusValid, ""},
+	{0x0CCE, idnaStatusDisallowed, ""},
+	{0x0CD5, idnaStatusValid, ""},
+	{0x0CD7, idnaStatusDisallowed, ""},
+	{0x0CDC, idnaStatusValid, ""},
+	{0x0CDF, idnaStatusDisallowed, ""},
+	{0x0CE0, idnaStatusValid, ""},
+	{0x0CE4, idnaStatusDisallowed, ""},
+	{0x0CE6, idnaStatusValid, ""},
+	{0x0CF0, idnaStatusDisallowed, ""},
+	{0x0CF1, idnaStatusValid, ""},
+	{0x0CF4, idnaStatusDisallowed, ""},
+	{0x0D00, idnaStatusValid, ""},
+	{0x0D0D, idnaStatusDisallowed, ""},
+	{0x0D0E, idnaStatusValid, ""},
+	{0x0D11, idnaStatusDisallowed, ""},
+	{0x0D12, idnaStatusValid, ""},
+	{0x0D45, idnaStatusDisallowed, ""},
+	{0x0D46, idnaStatusValid, ""},
+	{0x0D49, idnaStatusDisallowed, ""},
+	{0x0D4A, idnaStatusValid, ""},
+	{0x0D50, idnaStatusDisallowed, ""},
+	{0x0D54, idnaStatusValid, ""},
+	{0x0D64, idnaStatusDisallowed, ""},
+	{0x0D66, idnaStatusValid, ""},
+	{0x0D80, idnaStatusDisallowed, ""},
+	{0x0D81, idnaStatusValid, ""},
+	{0x0D84, idnaStatusDisallowed, ""},
+	{0x0D85, idnaStatusValid, ""},
+	{0x0D97, idnaStatusDisallowed, ""},
+	{0x0D9A, idnaStatusValid, ""},
+	{0x0DB2, idnaStatusDisallowed, ""},
+	{0x0DB3, idnaStatusValid, ""},
+	{0x0DBC, idnaStatusDisallowed, ""},
+	{0x0DBD, idnaStatusValid, ""},
+	{0x0DBE, idnaStatusDisallowed, ""},
+	{0x0DC0, idnaStatusValid, ""},
+	{0x0DC7, idnaStatusDisallowed, ""},
+	{0x0DCA, idnaStatusValid, ""},
+	{0x0DCB, idnaStatusDisallowed, ""},
+	{0x0DCF, idnaStatusValid, ""},
+	{0x0DD5, idnaStatusDisallowed, ""},
+	{0x0DD6, idnaStatusValid, ""},
+	{0x0DD7, idnaStatusDisallowed, ""},
+	{0x0DD8, idnaStatusValid, ""},
+	{0x0DE0, idnaStatusDisallowed, ""},
+	{0x0DE6, idnaStatusValid, ""},
+	{0x0DF0, idnaStatusDisallowed, ""},
+	{0x0DF2, idnaStatusValid, ""},
+	{0x0DF5, idnaStatusDisallowed, ""},
+	{0x0E01, idnaStatusValid, ""},
+	{0x0E33, idnaStatusMapped, "\u0e4d\u0e32"},
+	{0x0E34, idnaStatusValid, ""},
+	{0x0E3B, idnaStatusDisallowed, ""},
+	{0x0E3F, idnaStatusValid, ""},
+	{0x0E5C, idnaStatusDisallowed, ""},
+	{0x0E81, idnaStatusValid, ""},
+	{0x0E83, idnaStatusDisallowed, ""},
+	{0x0E84, idnaStatusValid, ""},
+	{0x0E85, idnaStatusDisallowed, ""},
+	{0x0E86, idnaStatusValid, ""},
+	{0x0E8B, idnaStatusDisallowed, ""},
+	{0x0E8C, idnaStatusValid, ""},
+	{0x0EA4, idnaStatusDisallowed, ""},
+	{0x0EA5, idnaStatusValid, ""},
+	{0x0EA6, idnaStatusDisallowed, ""},
+	{0x0EA7, idnaStatusValid, ""},
+	{0x0EB3, idnaStatusMapped, "\u0ecd\u0eb2"},
+	{0x0EB4, idnaStatusValid, ""},
+	{0x0EBE, idnaStatusDisallowed, ""},
+	{0x0EC0, idnaStatusValid, ""},
+	{0x0EC5, idnaStatusDisallowed, ""},
+	{0x0EC6, idnaStatusValid, ""},
+	{0x0EC7, idnaStatusDisallowed, ""},
+	{0x0EC8, idnaStatusValid, ""},
+	{0x0ECF, idnaStatusDisallowed, ""},
+	{0x0ED0, idnaStatusValid, ""},
+	{0x0EDA, idnaStatusDisallowed, ""},
+	{0x0EDC, idnaStatusMapped, "\u0eab\u0e99"},
+	{0x0EDD, idnaStatusMapped, "\u0eab\u0ea1"},
+	{0x0EDE, idnaStatusValid, ""},
+	{0x0EE0, idnaStatusDisallowed, ""},
+	{0x0F00, idnaStatusValid, ""},
+	{0x0F0C, idnaStatusMapped, "\u0f0b"},
+	{0x0F0D, idnaStatusValid, ""},
+	{0x0F43, idnaStatusMapped, "\u0f42\u0fb7"},
+	{0x0F44, idnaStatusValid, ""},
+	{0x0F48, idnaStatusDisallowed, ""},
+	{0x0F49, idnaStatusValid, ""},
+	{0x0F4D, idnaStatusMapped, "\u0f4c\u0fb7"},
+	{0x0F4E, idnaStatusValid, ""},
+	{0x0F52, idnaStatusMapped, "\u0f51\u0fb7"},
+	{0x0F53, idnaStatusValid, ""},
+	{0x0F57, idnaStatusMapped, "\u0f56\u0fb7"},
+	{0x0F58, idnaStatusValid, ""},
+	{0x0F5C, idnaStatusMapped, "\u0f5b\u0fb7"},
+	{0x0F5D, idnaStatusValid, ""},
+	{0x0F69, idnaStatusMapped, "\u0f40\u0fb5"},
+	{0x0F6A, idnaStatusValid, ""},
+	{0x0F6D, idnaStatusDisallowed, ""},
+	{0x0F71, idnaStatusValid, ""},
+	{0x0F73, idnaStatusMapped, "\u0f71\u0f72"},
+	{0x0F74, idnaStatusValid, ""},
+	{0x0F75, idnaStatusMapped, "\u0f71\u0f74"},
+	{0x0F76, idnaStatusMapped, "\u0fb2\u0f80"},
+	{0x0F77, idnaStatusMapped, "\u0fb2\u0f71\u0f80"},
+	{0x0F78, idnaStatusMapped, "\u0fb3\u0f80"},
+	{0x0F79, idnaStatusMapped, "\u0fb3\u0f71\u0f80"},
+	{0x0F7A, idnaStatusValid, ""},
+	{0x0F81, idnaStatusMapped, "\u0f71\u0f80"},
+	{0x0F82, idnaStatusValid, ""},
+	{0x0F93, idnaStatusMapped, "\u0f92\u0fb7"},
+	{0x0F94, idnaStatusValid, ""},
+	{0x0F98, idnaStatusDisallowed, ""},
+	{0x0F99, idnaStatusValid, ""},
+	{0x0F9D, idnaStatusMapped, "\u0f9c\u0fb7"},
+	{0x0F9E, idnaStatusValid, ""},
+	{0x0FA2, idnaStatusMapped, "\u0fa1\u0fb7"},
+	{0x0FA3, idnaStatusValid, ""},
+	{0x0FA7, idnaStatusMapped, "\u0fa6\u0fb7"},
+	{0x0FA8, idnaStatusValid, ""},
+	{0x0FAC, idnaStatusMapped, "\u0fab\u0fb7"},
+	{0x0FAD, idnaStatusValid, ""},
+	{0x0FB9, idnaStatusMapped, "\u0f90\u0fb5"},
+	{0x0FBA, idnaStatusValid, ""},
+	{0x0FBD, idnaStatusDisallowed, ""},
+	{0x0FBE, idnaStatusValid, ""},
+	{0x0FCD, idnaStatusDisallowed, ""},
+	{0x0FCE, idnaStatusValid, ""},
+	{0x0FDB, idnaStatusDisallowed, ""},
+	{0x1000, idnaStatusValid, ""},
+	{0x10A0, idnaStatusMapped, "\u2d00"},
+	{0x10A1, idnaStatusMapped, "\u2d01"},
+	{0x10A2, idnaStatusMapped, "\u2d02"},
+	{0x10A3, idnaStatusMapped, "\u2d03"},
+	{0x10A4, idnaStatusMapped, "\u2d04"},
+	{0x10A5, idnaStatusMapped, "\u2d05"},
+	{0x10A6, idnaStatusMapped, "\u2d06"},
+	{0x10A7, idnaStatusMapped, "\u2d07"},
+	{0x10A8, idnaStatusMapped, "\u2d08"},
+	{0x10A9, idnaStatusMapped, "\u2d09"},
+	{0x10AA, idnaStatusMapped, "\u2d0a"},
+	{0x10AB, idnaStatusMapped, "\u2d0b"},
+	{0x10AC, idnaStatusMapped, "\u2d0c"},
+	{0x10AD, idnaStatusMapped, "\u2d0d"},
+	{0x10AE, idnaStatusMapped, "\u2d0e"},
+	{0x10AF, idnaStatusMapped, "\u2d0f"},
+	{0x10B0, idnaStatusMapped, "\u2d10"},
+	{0x10B1, idnaStatusMapped, "\u2d11"},
+	{0x10B2, idnaStatusMapped, "\u2d12"},
+	{0x10B3, idnaStatusMapped, "\u2d13"},
+	{0x10B4, idnaStatusMapped, "\u2d14"},
+	{0x10B5, idnaStatusMapped, "\u2d15"},
+	{0x10B6, idnaStatusMapped, "\u2d16"},
+	{0x10B7, idnaStatusMapped, "\u2d17"},
+	{0x10B8, idnaStatusMapped, "\u2d18"},
+	{0x10B9, idnaStatusMapped, "\u2d19"},
+	{0x10BA, idnaStatusMapped, "\u2d1a"},
+	{0x10BB, idnaStatusMapped, "\u2d1b"},
+	{0x10BC, idnaStatusMapped, "\u2d1c"},
+	{0x10BD, idnaStatusMapped, "\u2d1d"},
+	{0x10BE, idnaStatusMapped, "\u2d1e"},
+	{0x10BF, idnaStatusMapped, "\u2d1f"},
+	{0x10C0, idnaStatusMapped, "\u2d20"},
+	{0x10C1, idnaStatusMapped, "\u2d21"},
+	{0x10C2, idnaStatusMapped, "\u2d22"},
+	{0x10C3, idnaStatusMapped, "\u2d23"},
+	{0x10C4, idnaStatusMapped, "\u2d24"},
+	{0x10C5, idnaStatusMapped, "\u2d25"},
+	{0x10C6, idnaStatusDisallowed, ""},
+	{0x10C7, idnaStatusMapped, "\u2d27"},
+	{0x10C8, idnaStatusDisallowed, ""},
+	{0x10CD, idnaStatusMapped, "\u2d2d"},
+	{0x10CE, idnaStatusDisallowed, ""},
+	{0x10D0, idnaStatusValid, ""},
+	{0x10FC, idnaStatusMapped, "\u10dc"},
+	{0x10FD, idnaStatusValid, ""},
+	{0x115F, idnaStatusIgnored, ""},
+	{0x1161, idnaStatusValid, ""},
+	{0x1249, idnaStatusDisallowed, ""},
+	{0x124A, idnaStatusValid, ""},
+	{0x124E, idnaStatusDisallowed, ""},
+	{0x1250, idnaStatusValid, ""},
+	{0x1257, idnaStatusDisallowed, ""},
+	{0x1258, idnaStatusValid, ""},
+	{0x1259, idnaStatusDisallowed, ""},
+	{0x125A, idnaStatusValid, ""},
+	{0x125E, idnaStatusDisallowed, ""},
+	{0x1260, idnaStatusValid, ""},
+	{0x1289, idnaStatusDisallowed, ""},
+	{0x128A, idnaStatusValid, ""},
+	{0x128E, idnaStatusDisallowed, ""},
+	{0x1290, idnaStatusValid, ""},
+	{0x12B1, idnaStatusDisallowed, ""},
+	{0x12B2, idnaStatusValid, ""},
+	{0x12B6, idnaStatusDisallowed, ""},
+	{0x12B8, idnaStatusValid, ""},
+	{0x12BF, idnaStatusDisallowed, ""},
+	{0x12C0, idnaStatusValid, ""},
+	{0x12C1, idnaStatusDisallowed, ""},
+	{0x12C2, idnaStatusValid, ""},
+	{0x12C6, idnaStatusDisallowed, ""},
+	{0x12C8, idnaStatusValid, ""},
+	{0x12D7, idnaStatusDisallowed, ""},
+	{0x12D8, idnaStatusValid, ""},
+	{0x1311, idnaStatusDisallowed, ""},
+	{0x1312, idnaStatusValid, ""},
+	{0x1316, idnaStatusDisallowed, ""},
+	{0x1318, idnaStatusValid, ""},
+	{0x135B, idnaStatusDisallowed, ""},
+	{0x135D, idnaStatusValid, ""},
+	{0x137D, idnaStatusDisallowed, ""},
+	{0x1380, idnaStatusValid, ""},
+	{0x139A, idnaStatusDisallowed, ""},
+	{0x13A0, idnaStatusValid, ""},
+	{0x13F6, idnaStatusDisallowed, ""},
+	{0x13F8, idnaStatusMapped, "\u13f0"},
+	{0x13F9, idnaStatusMapped, "\u13f1"},
+	{0x13FA, idnaStatusMapped, "\u13f2"},
+	{0x13FB, idnaStatusMapped, "\u13f3"},
+	{0x13FC, idnaStatusMapped, "\u13f4"},
+	{0x13FD, idnaStatusMapped, "\u13f5"},
+	{0x13FE, idnaStatusDisallowed, ""},
+	{0x1400, idnaStatusValid, ""},
+	{0x1680, idnaStatusDisallowed, ""},
+	{0x1681, idnaStatusValid, ""},
+	{0x169D, idnaStatusDisallowed, ""},
+	{0x16A0, idnaStatusValid, ""},
+	{0x16F9, idnaStatusDisallowed, ""},
+	{0x1700, idnaStatusValid, ""},
+	{0x1716, idnaStatusDisallowed, ""},
+	{0x171F, idnaStatusValid, ""},
+	{0x1737, idnaStatusDisallowed, ""},
+	{0x1740, idnaStatusValid, ""},
+	{0x1754, idnaStatusDisallowed, ""},
+	{0x1760, idnaStatusValid, ""},
+	{0x176D, idnaStatusDisallowed, ""},
+	{0x176E, idnaStatusValid, ""},
+	{0x1771, idnaStatusDisallowed, ""},
+	{0x1772, idnaStatusValid, ""},
+	{0x1774, idnaStatusDisallowed, ""},
+	{0x1780, idnaStatusValid, ""},
+	{0x17B4, idnaStatusIgnored, ""},
+	{0x17B6, idnaStatusValid, ""},
+	{0x17DE, idnaStatusDisallowed, ""},
+	{0x17E0, idnaStatusValid, ""},
+	{0x17EA, idnaStatusDisallowed, ""},
+	{0x17F0, idnaStatusValid, ""},
+	{0x17FA, idnaStatusDisallowed, ""},
+	{0x1800, idnaStatusValid, ""},
+	{0x180B, idnaStatusIgnored, ""},
+	{0x1810, idnaStatusValid, ""},
+	{0x181A, idnaStatusDisallowed, ""},
+	{0x1820, idnaStatusValid, ""},
+	{0x1879, idnaStatusDisallowed, ""},
+	{0x1880, idnaStatusValid, ""},
+	{0x18AB, idnaStatusDisallowed, ""},
+	{0x18B0, idnaStatusValid, ""},
+	{0x18F6, idnaStatusDisallowed, ""},
+	{0x1900, idnaStatusValid, ""},
+	{0x191F, idnaStatusDisallowed, ""},
+	{0x1920, idnaStatusValid, ""},
+	{0x192C, idnaStatusDisallowed, ""},
+	{0x1930, idnaStatusValid, ""},
+	{0x193C, idnaStatusDisallowed, ""},
+	{0x1940, idnaStatusValid, ""},
+	{0x1941, idnaStatusDisallowed, ""},
+	{0x1944, idnaStatusValid, ""},
+	{0x196E, idnaStatusDisallowed, ""},
+	{0x1970, idnaStatusValid, ""},
+	{0x1975, idnaStatusDisallowed, ""},
+	{0x1980, idnaStatusValid, ""},
+	{0x19AC, idnaStatusDisallowed, ""},
+	{0x19B0, idnaStatusValid, ""},
+	{0x19CA, idnaStatusDisallowed, ""},
+	{0x19D0, idnaStatusValid, ""},
+	{0x19DB, idnaStatusDisallowed, ""},
+	{0x19DE, idnaStatusValid, ""},
+	{0x1A1C, idnaStatusDisallowed, ""},
+	{0x1A1E, idnaStatusValid, ""},
+	{0x1A5F, idnaStatusDisallowed, ""},
+	{0x1A60, idnaStatusValid, ""},
+	{0x1A7D, idnaStatusDisallowed, ""},
+	{0x1A7F, idnaStatusValid, ""},
+	{0x1A8A, idnaStatusDisallowed, ""},
+	{0x1A90, idnaStatusValid, ""},
+	{0x1A9A, idnaStatusDisallowed, ""},
+	{0x1AA0, idnaStatusValid, ""},
+	{0x1AAE, idnaStatusDisallowed, ""},
+	{0x1AB0, idnaStatusValid, ""},
+	{0x1ADE, idnaStatusDisallowed, ""},
+	{0x1AE0, idnaStatusValid, ""},
+	{0x1AEC, idnaStatusDisallowed, ""},
+	{0x1B00, idnaStatusValid, ""},
+	{0x1B4D, idnaStatusDisallowed, ""},
+	{0x1B4E, idnaStatusValid, ""},
+	{0x1BF4, idnaStatusDisallowed, ""},
+	{0x1BFC, idnaStatusValid, ""},
+	{0x1C38, idnaStatusDisallowed, ""},
+	{0x1C3B, idnaStatusValid, ""},
+	{0x1C4A, idnaStatusDisallowed, ""},
+	{0x1C4D, idnaStatusValid, ""},
+	{0x1C80, idnaStatusMapped, "\u0432"},
+	{0x1C81, idnaStatusMapped, "\u0434"},
+	{0x1C82, idnaStatusMapped, "\u043e"},
+	{0x1C83, idnaStatusMapped, "\u0441"},
+	{0x1C84, idnaStatusMapped, "\u0442"},
+	{0x1C86, idnaStatusMapped, "\u044a"},
+	{0x1C87, idnaStatusMapped, "\u0463"},
+	{0x1C88, idnaStatusMapped, "\ua64b"},
+	{0x1C89, idnaStatusMapped, "\u1c8a"},
+	{0x1C8A, idnaStatusValid, ""},
+	{0x1C8B, idnaStatusDisallowed, ""},
+	{0x1C90, idnaStatusMapped, "\u10d0"},
+	{0x1C91, idnaStatusMapped, "\u10d1"},
+	{0x1C92, idnaStatusMapped, "\u10d2"},
+	{0x1C93, idnaStatusMapped, "\u10d3"},
+	{0x1C94, idnaStatusMapped, "\u10d4"},
+	{0x1C95, idnaStatusMapped, "\u10d5"},
+	{0x1C96, idnaStatusMapped, "\u10d6"},
+	{0x1C97, idnaStatusMapped, "\u10d7"},
+	{0x1C98, idnaStatusMapped, "\u10d8"},
+	{0x1C99, idnaStatusMapped, "\u10d9"},
+	{0x1C9A, idnaStatusMapped, "\u10da"},
+	{0x1C9B, idnaStatusMapped, "\u10db"},
+	{0x1C9C, idnaStatusMapped, "\u10dc"},
+	{0x1C9D, idnaStatusMapped, "\u10dd"},
+	{0x1C9E, idnaStatusMapped, "\u10de"},
+	{0x1C9F, idnaStatusMapped, "\u10df"},
+	{0x1CA0, idnaStatusMapped, "\u10e0"},
+	{0x1CA1, idnaStatusMapped, "\u10e1"},
+	{0x1CA2, idnaStatusMapped, "\u10e2"},
+	{0x1CA3, idnaStatusMapped, "\u10e3"},
+	{0x1CA4, idnaStatusMapped, "\u10e4"},
+	{0x1CA5, idnaStatusMapped, "\u10e5"},
+	{0x1CA6, idnaStatusMapped, "\u10e6"},
+	{0x1CA7, idnaStatusMapped, "\u10e7"},
+	{0x1CA8, idnaStatusMapped, "\u10e8"},
+	{0x1CA9, idnaStatusMapped, "\u10e9"},
+	{0x1CAA, idnaStatusMapped, "\u10ea"},
+	{0x1CAB, idnaStatusMapped, "\u10eb"},
+	{0x1CAC, idnaStatusMapped, "\u10ec"},
+	{0x1CAD, idnaStatusMapped, "\u10ed"},
+	{0x1CAE, idnaStatusMapped, "\u10ee"},
+	{0x1CAF, idnaStatusMapped, "\u10ef"},
+	{0x1CB0, idnaStatusMapped, "\u10f0"},
+	{0x1CB1, idnaStatusMapped, "\u10f1"},
+	{0x1CB2, idnaStatusMapped, "\u10f2"},
+	{0x1CB3, idnaStatusMapped, "\u10f3"},
+	{0x1CB4, idnaStatusMapped, "\u10f4"},
+	{0x1CB5, idnaStatusMapped, "\u10f5"},
+	{0x1CB6, idnaStatusMapped, "\u10f6"},
+	{0x1CB7, idnaStatusMapped, "\u10f7"},
+	{0x1CB8, idnaStatusMapped, "\u10f8"},
+	{0x1CB9, idnaStatusMapped, "\u10f9"},
+	{0x1CBA, idnaStatusMapped, "\u10fa"},
+	{0x1CBB, idnaStatusDisallowed, ""},
+	{0x1CBD, idnaStatusMapped, "\u10fd"},
+	{0x1CBE, idnaStatusMapped, "\u10fe"},
+	{0x1CBF, idnaStatusMapped, "\u10ff"},
+	{0x1CC0, idnaStatusValid, ""},
+	{0x1CC8, idnaStatusDisallowed, ""},
+	{0x1CD0, idnaStatusValid, ""},
+	{0x1CFB, idnaStatusDisallowed, ""},
+	{0x1D00, idnaStatusValid, ""},
+	{0x1D2C, idnaStatusMapped, "a"},
+	{0x1D2D, idnaStatusMapped, "\u00e6"},
+	{0x1D2E, idnaStatusMapped, "b"},
+	{0x1D2F, idnaStatusValid, ""},
+	{0x1D30, idnaStatusMapped, "d"},
+	{0x1D31, idnaStatusMapped, "e"},
+	{0x1D32, idnaStatusMapped, "\u01dd"},
+	{0x1D33, idnaStatusMapped, "g"},
+	{0x1D34, idnaStatusMapped, "h"},
+	{0x1D35, idnaStatusMapped, "i"},
+	{0x1D36, idnaStatusMapped, "j"},
+	{0x1D37, idnaStatusMapped, "k"},
+	{0x1D38, idnaStatusMapped, "l"},
+	{0x1D39, idnaStatusMapped, "m"},
+	{0x1D3A, idnaStatusMapped, "n"},
+	{0x1D3B, idnaStatusValid, ""},
+	{0x1D3C, idnaStatusMapped, "o"},
+	{0x1D3D, idnaStatusMapped, "\u0223"},
+	{0x1D3E, idnaStatusMapped, "p"},
+	{0x1D3F, idnaStatusMapped, "r"},
+	{0x1D40, idnaStatusMapped, "t"},
+	{0x1D41, idnaStatusMapped, "u"},
+	{0x1D42, idnaStatusMapped, "w"},
+	{0x1D43, idnaStatusMapped, "a"},
+	{0x1D44, idnaStatusMapped, "\u0250"},
+	{0x1D45, idnaStatusMapped, "\u0251"},
+	{0x1D46, idnaStatusMapped, "\u1d02"},
+	{0x1D47, idnaStatusMapped, "b"},
+	{0x1D48, idnaStatusMapped, "d"},
+	{0x1D49, idnaStatusMapped, "e"},
+	{0x1D4A, idnaStatusMapped, "\u0259"},
+	{0x1D4B, idnaStatusMapped, "\u025b"},
+	{0x1D4C, idnaStatusMapped, "\u025c"},
+	{0x1D4D, idnaStatusMapped, "g"},
+	{0x1D4E, idnaStatusValid, ""},
+	{0x1D4F, idnaStatusMapped, "k"},
+	{0x1D50, idnaStatusMapped, "m"},
+	{0x1D51, idnaStatusMapped, "\u014b"},
+	{0x1D52, idnaStatusMapped, "o"},
+	{0x1D53, idnaStatusMapped, "\u0254"},
+	{0x1D54, idnaStatusMapped, "\u1d16"},
+	{0x1D55, idnaStatusMapped, "\u1d17"},
+	{0x1D56, idnaStatusMapped, "p"},
+	{0x1D57, idnaStatusMapped, "t"},
+	{0x1D58, idnaStatusMapped, "u"},
+	{0x1D59, idnaStatusMapped, "\u1d1d"},
+	{0x1D5A, idnaStatusMapped, "\u026f"},
+	{0x1D5B, idnaStatusMapped, "v"},
+	{0x1D5C, idnaStatusMapped, "\u1d25"},
+	{0x1D5D, idnaStatusMapped, "\u03b2"},
+	{0x1D5E, idnaStatusMapped, "\u03b3"},
+	{0x1D5F, idnaStatusMapped, "\u03b4"},
+	{0x1D60, idnaStatusMapped, "\u03c6"},
+	{0x1D61, idnaStatusMapped, "\u03c7"},
+	{0x1D62, idnaStatusMapped, "i"},
+	{0x1D63, idnaStatusMapped, "r"},
+	{0x1D64, idnaStatusMapped, "u"},
+	{0x1D65, idnaStatusMapped, "v"},
+	{0x1D66, idnaStatusMapped, "\u03b2"},
+	{0x1D67, idnaStatusMapped, "\u03b3"},
+	{0x1D68, idnaStatusMapped, "\u03c1"},
+	{0x1D69, idnaStatusMapped, "\u03c6"},
+	{0x1D6A, idnaStatusMapped, "\u03c7"},
+	{0x1D6B, idnaStatusValid, ""},
+	{0x1D78, idnaStatusMapped, "\u043d"},
+	{0x1D79, idnaStatusValid, ""},
+	{0x1D9B, idnaStatusMapped, "\u0252"},
+	{0x1D9C, idnaStatusMapped, "c"},
+	{0x1D9D, idnaStatusMapped, "\u0255"},
+	{0x1D9E, idnaStatusMapped, "\u00f0"},
+	{0x1D9F, idnaStatusMapped, "\u025c"},
+	{0x1DA0, idnaStatusMapped, "f"},
+	{0x1DA1, idnaStatusMapped, "\u025f"},
+	{0x1DA2, idnaStatusMapped, "\u0261"},
+	{0x1DA3, idnaStatusMapped, "\u0265"},
+	{0x1DA4, idnaStatusMapped, "\u0268"},
+	{0x1DA5, idnaStatusMapped, "\u0269"},
+	{0x1DA6, idnaStatusMapped, "\u026a"},
+	{0x1DA7, idnaStatusMapped, "\u1d7b"},
+	{0x1DA8, idnaStatusMapped, "\u029d"},
+	{0x1DA9, idnaStatusMapped, "\u026d"},
+	{0x1DAA, idnaStatusMapped, "\u1d85"},
+	{0x1DAB, idnaStatusMapped, "\u029f"},
+	{0x1DAC, idnaStatusMapped, "\u0271"},
+	{0x1DAD, idnaStatusMapped, "\u0270"},
+	{0x1DAE, idnaStatusMapped, "\u0272"},
+	{0x1DAF, idnaStatusMapped, "\u0273"},
+	{0x1DB0, idnaStatusMapped, "\u0274"},
+	{0x1DB1, idnaStatusMapped, "\u0275"},
+	{0x1DB2, idnaStatusMapped, "\u0278"},
+	{0x1DB3, idnaStatusMapped, "\u0282"},
+	{0x1DB4, idnaStatusMapped, "\u0283"},
+	{0x1DB5, idnaStatusMapped, "\u01ab"},
+	{0x1DB6, idnaStatusMapped, "\u0289"},
+	{0x1DB7, idnaStatusMapped, "\u028a"},
+	{0x1DB8, idnaStatusMapped, "\u1d1c"},
+	{0x1DB9, idnaStatusMapped, "\u028b"},
+	{0x1DBA, idnaStatusMapped, "\u028c"},
+	{0x1DBB, idnaStatusMapped, "z"},
+	{0x1DBC, idnaStatusMapped, "\u0290"},
+	{0x1DBD, idnaStatusMapped, "\u0291"},
+	{0x1DBE, idnaStatusMapped, "\u0292"},
+	{0x1DBF, idnaStatusMapped, "\u03b8"},
+	{0x1DC0, idnaStatusValid, ""},
+	{0x1E00, idnaStatusMapped, "\u1e01"},
+	{0x1E01, idnaStatusValid, ""},
+	{0x1E02, idnaStatusMapped, "\u1e03"},
+	{0x1E03, idnaStatusValid, ""},
+	{0x1E04, idnaStatusMapped, "\u1e05"},
+	{0x1E05, idnaStatusValid, ""},
+	{0x1E06, idnaStatusMapped, "\u1e07"},
+	{0x1E07, idnaStatusValid, ""},
+	{0x1E08, idnaStatusMapped, "\u1e09"},
+	{0x1E09, idnaStatusValid, ""},
+	{0x1E0A, idnaStatusMapped, "\u1e0b"},
+	{0x1E0B, idnaStatusValid, ""},
+	{0x1E0C, idnaStatusMapped, "\u1e0d"},
+	{0x1E0D, idnaStatusValid, ""},
+	{0x1E0E, idnaStatusMapped, "\u1e0f"},
+	{0x1E0F, idnaStatusValid, ""},
+	{0x1E10, idnaStatusMapped, "\u1e11"},
+	{0x1E11, idnaStatusValid, ""},
+	{0x1E12, idnaStatusMapped, "\u1e13"},
+	{0x1E13, idnaStatusValid, ""},
+	{0x1E14, idnaStatusMapped, "\u1e15"},
+	{0x1E15, idnaStatusValid, ""},
+	{0x1E16, idnaStatusMapped, "\u1e17"},
+	{0x1E17, idnaStatusValid, ""},
+	{0x1E18, idnaStatusMapped, "\u1e19"},
+	{0x1E19, idnaStatusValid, ""},
+	{0x1E1A, idnaStatusMapped, "\u1e1b"},
+	{0x1E1B, idnaStatusValid, ""},
+	{0x1E1C, idnaStatusMapped, "\u1e1d"},
+	{0x1E1D, idnaStatusValid, ""},
+	{0x1E1E, idnaStatusMapped, "\u1e1f"},
+	{0x1E1F, idnaStatusValid, ""},
+	{0x1E20, idnaStatusMapped, "\u1e21"},
+	{0x1E21, idnaStatusValid, ""},
+	{0x1E22, idnaStatusMapped, "\u1e23"},
+	{0x1E23, idnaStatusValid, ""},
+	{0x1E24, idnaStatusMapped, "\u1e25"},
+	{0x1E25, idnaStatusValid, ""},
+	{0x1E26, idnaStatusMapped, "\u1e27"},
+	{0x1E27, idnaStatusValid, ""},
+	{0x1E28, idnaStatusMapped, "\u1e29"},
+	{0x1E29, idnaStatusValid, ""},
+	{0x1E2A, idnaStatusMapped, "\u1e2b"},
+	{0x1E2B, idnaStatusValid, ""},
+	{0x1E2C, idnaStatusMapped, "\u1e2d"},
+	{0x1E2D, idnaStatusValid, ""},
+	{0x1E2E, idnaStatusMapped, "\u1e2f"},
+	{0x1E2F, idnaStatusValid, ""},
+	{0x1E30, idnaStatusMapped, "\u1e31"},
+	{0x1E31, idnaStatusValid, ""},
+	{0x1E32, idnaStatusMapped, "\u1e33"},
+	{0x1E33, idnaStatusValid, ""},
+	{0x1E34, idnaStatusMapped, "\u1e35"},
+	{0x1E35, idnaStatusValid, ""},
+	{0x1E36, idnaStatusMapped, "\u1e37"},
+	{0x1E37, idnaStatusValid, ""},
+	{0x1E38, idnaStatusMapped, "\u1e39"},
+	{0x1E39, idnaStatusValid, ""},
+	{0x1E3A, idnaStatusMapped, "\u1e3b"},
+	{0x1E3B, idnaStatusValid, ""},
+	{0x1E3C, idnaStatusMapped, "\u1e3d"},
+	{0x1E3D, idnaStatusValid, ""},
+	{0x1E3E, idnaStatusMapped, "\u1e3f"},
+	{0x1E3F, idnaStatusValid, ""},
+	{0x1E40, idnaStatusMapped, "\u1e41"},
+	{0x1E41, idnaStatusValid, ""},
+	{0x1E42, idnaStatusMapped, "\u1e43"},
+	{0x1E43, idnaStatusValid, ""},
+	{0x1E44, idnaStatusMapped, "\u1e45"},
+	{0x1E45, idnaStatusValid, ""},
+	{0x1E46, idnaStatusMapped, "\u1e47"},
+	{0x1E47, idnaStatusValid, ""},
+	{0x1E48, idnaStatusMapped, "\u1e49"},
+	{0x1E49, idnaStatusValid, ""},
+	{0x1E4A, idnaStatusMapped, "\u1e4b"},
+	{0x1E4B, idnaStatusValid, ""},
+	{0x1E4C, idnaStatusMapped, "\u1e4d"},
+	{0x1E4D, idnaStatusValid, ""},
+	{0x1E4E, idnaStatusMapped, "\u1e4f"},
+	{0x1E4F, idnaStatusValid, ""},
+	{0x1E50, idnaStatusMapped, "\u1e51"},
+	{0x1E51, idnaStatusValid, ""},
+	{0x1E52, idnaStatusMapped, "\u1e53"},
+	{0x1E53, idnaStatusValid, ""},
+	{0x1E54, idnaStatusMapped, "\u1e55"},
+	{0x1E55, idnaStatusValid, ""},
+	{0x1E56, idnaStatusMapped, "\u1e57"},
+	{0x1E57, idnaStatusValid, ""},
+	{0x1E58, idnaStatusMapped, "\u1e59"},
+	{0x1E59, idnaStatusValid, ""},
+	{0x1E5A, idnaStatusMapped, "\u1e5b"},
+	{0x1E5B, idnaStatusValid, ""},
+	{0x1E5C, idnaStatusMapped, "\u1e5d"},
+	{0x1E5D, idnaStatusValid, ""},
+	{0x1E5E, idnaStatusMapped, "\u1e5f"},
+	{0x1E5F, idnaStatusValid, ""},
+	{0x1E60, idnaStatusMapped, "\u1e61"},
+	{0x1E61, idnaStatusValid, ""},
+	{0x1E62, idnaStatusMapped, "\u1e63"},
+	{0x1E63, idnaStatusValid, ""},
+	{0x1E64, idnaStatusMapped, "\u1e65"},
+	{0x1E65, idnaStatusValid, ""},
+	{0x1E66, idnaStatusMapped, "\u1e67"},
+	{0x1E67, idnaStatusValid, ""},
+	{0x1E68, idnaStatusMapped, "\u1e69"},
+	{0x1E69, idnaStatusValid, ""},
+	{0x1E6A, idnaStatusMapped, "\u1e6b"},
+	{0x1E6B, idnaStatusValid, ""},
+	{0x1E6C, idnaStatusMapped, "\u1e6d"},
+	{0x1E6D, idnaStatusValid, ""},
+	{0x1E6E, idnaStatusMapped, "\u1e6f"},
+	{0x1E6F, idnaStatusValid, ""},
+	{0x1E70, idnaStatusMapped, "\u1e71"},
+	{0x1E71, idnaStatusValid, ""},
+	{0x1E72, idnaStatusMapped, "\u1e73"},
+	{0x1E73, idnaStatusValid, ""},
+	{0x1E74, idnaStatusMapped, "\u1e75"},
+	{0x1E75, idnaStatusValid, ""},
+	{0x1E76, idnaStatusMapped, "\u1e77"},
+	{0x1E77, idnaStatusValid, ""},
+	{0x1E78, idnaStatusMapped, "\u1e79"},
+	{0x1E79, idnaStatusValid, ""},
+	{0x1E7A, idnaStatusMapped, "\u1e7b"},
+	{0x1E7B, idnaStatusValid, ""},
+	{0x1E7C, idnaStatusMapped, "\u1e7d"},
+	{0x1E7D, idnaStatusValid, ""},
+	{0x1E7E, idnaStatusMapped, "\u1e7f"},
+	{0x1E7F, idnaStatusValid, ""},
+	{0x1E80, idnaStatusMapped, "\u1e81"},
+	{0x1E81, idnaStatusValid, ""},
+	{0x1E82, idnaStatusMapped, "\u1e83"},
+	{0x1E83, idnaStatusValid, ""},
+	{0x1E84, idnaStatusMapped, "\u1e85"},
+	{0x1E85, idnaStatusValid, ""},
+	{0x1E86, idnaStatusMapped, "\u1e87"},
+	{0x1E87, idnaStatusValid, ""},
+	{0x1E88, idnaStatusMapped, "\u1e89"},
+	{0x1E89, idnaStatusValid, ""},
+	{0x1E8A, idnaStatusMapped, "\u1e8b"},
+	{0x1E8B, idnaStatusValid, ""},
+	{0x1E8C, idnaStatusMapped, "\u1e8d"},
+	{0x1E8D, idnaStatusValid, ""},
+	{0x1E8E, idnaStatusMapped, "\u1e8f"},
+	{0x1E8F, idnaStatusValid, ""},
+	{0x1E90, idnaStatusMapped, "\u1e91"},
+	{0x1E91, idnaStatusValid, ""},
+	{0x1E92, idnaStatusMapped, "\u1e93"},
+	{0x1E93, idnaStatusValid, ""},
+	{0x1E94, idnaStatusMapped, "\u1e95"},
+	{0x1E95, idnaStatusValid, ""},
+	{0x1E9A, idnaStatusMapped, "a\u02be"},
+	{0x1E9B, idnaStatusMapped, "\u1e61"},
+	{0x1E9C, idnaStatusValid, ""},
+	{0x1E9E, idnaStatusMapped, "\u00df"},
+	{0x1E9F, idnaStatusValid, ""},
+	{0x1EA0, idnaStatusMapped, "\u1ea1"},
+	{0x1EA1, idnaStatusValid, ""},
+	{0x1EA2, idnaStatusMapped, "\u1ea3"},
+	{0x1EA3, idnaStatusValid, ""},
+	{0x1EA4, idnaStatusMapped, "\u1ea5"},
+	{0x1EA5, idnaStatusValid, ""},
+	{0x1EA6, idnaStatusMapped, "\u1ea7"},
+	{0x1EA7, idnaStatusValid, ""},
+	{0x1EA8, idnaStatusMapped, "\u1ea9"},
+	{0x1EA9, idnaStatusValid, ""},
+	{0x1EAA, idnaStatusMapped, "\u1eab"},
+	{0x1EAB, idnaStatusValid, ""},
+	{0x1EAC, idnaStatusMapped, "\u1ead"},
+	{0x1EAD, idnaStatusValid, ""},
+	{0x1EAE, idnaStatusMapped, "\u1eaf"},
+	{0x1EAF, idnaStatusValid, ""},
+	{0x1EB0, idnaStatusMapped, "\u1eb1"},
+	{0x1EB1, idnaStatusValid, ""},
+	{0x1EB2, idnaStatusMapped, "\u1eb3"},
+	{0x1EB3, idnaStatusValid, ""},
+	{0x1EB4, idnaStatusMapped, "\u1eb5"},
+	{0x1EB5, idnaStatusValid, ""},
+	{0x1EB6, idnaStatusMapped, "\u1eb7"},
+	{0x1EB7, idnaStatusValid, ""},
+	{0x1EB8, idnaStatusMapped, "\u1eb9"},
+	{0x1EB9, idnaStatusValid, ""},
+	{0x1EBA, idnaStatusMapped, "\u1ebb"},
+	{0x1EBB, idnaStatusValid, ""},
+	{0x1EBC, idnaStatusMapped, "\u1ebd"},
+	{0x1EBD, idnaStatusValid, ""},
+	{0x1EBE, idnaStatusMapped, "\u1ebf"},
+	{0x1EBF, idnaStatusValid, ""},
+	{0x1EC0, idnaStatusMapped, "\u1ec1"},
+	{0x1EC1, idnaStatusValid, ""},
+	{0x1EC2, idnaStatusMapped, "\u1ec3"},
+	{0x1EC3, idnaStatusValid, ""},
+	{0x1EC4, idnaStatusMapped, "\u1ec5"},
+	{0x1EC5, idnaStatusValid, ""},
+	{0x1EC6, idnaStatusMapped, "\u1ec7"},
+	{0x1EC7, idnaStatusValid, ""},
+	{0x1EC8, idnaStatusMapped, "\u1ec9"},
+	{0x1EC9, idnaStatusValid, ""},
+	{0x1ECA, idnaStatusMapped, "\u1ecb"},
+	{0x1ECB, idnaStatusValid, ""},
+	{0x1ECC, idnaStatusMapped, "\u1ecd"},
+	{0x1ECD, idnaStatusValid, ""},
+	{0x1ECE, idnaStatusMapped, "\u1ecf"},
+	{0x1ECF, idnaStatusValid, ""},
+	{0x1ED0, idnaStatusMapped, "\u1ed1"},
+	{0x1ED1, idnaStatusValid, ""},
+	{0x1ED2, idnaStatusMapped, "\u1ed3"},
+	{0x1ED3, idnaStatusValid, ""},
+	{0x1ED4, idnaStatusMapped, "\u1ed5"},
+	{0x1ED5, idnaStatusValid, ""},
+	{0x1ED6, idnaStatusMapped, "\u1ed7"},
+	{0x1ED7, idnaStatusValid, ""},
+	{0x1ED8, idnaStatusMapped, "\u1ed9"},
+	{0x1ED9, idnaStatusValid, ""},
+	{0x1EDA, idnaStatusMapped, "\u1edb"},
+	{0x1EDB, idnaStatusValid, ""},
+	{0x1EDC, idnaStatusMapped, "\u1edd"},
+	{0x1EDD, idnaStatusValid, ""},
+	{0x1EDE, idnaStatusMapped, "\u1edf"},
+	{0x1EDF, idnaStatusValid, ""},
+	{0x1EE0, idnaStatusMapped, "\u1ee1"},
+	{0x1EE1, idnaStatusValid, ""},
+	{0x1EE2, idnaStatusMapped, "\u1ee3"},
+	{0x1EE3, idnaStatusValid, ""},
+	{0x1EE4, idnaStatusMapped, "\u1ee5"},
+	{0x1EE5, idnaStatusValid, ""},
+	{0x1EE6, idnaStatusMapped, "\u1ee7"},
+	{0x1EE7, idnaStatusValid, ""},
+	{0x1EE8, idnaStatusMapped, "\u1ee9"},
+	{0x1EE9, idnaStatusValid, ""},
+	{0x1EEA, idnaStatusMapped, "\u1eeb"},
+	{0x1EEB, idnaStatusValid, ""},
+	{0x1EEC, idnaStatusMapped, "\u1eed"},
+	{0x1EED, idnaStatusValid, ""},
+	{0x1EEE, idnaStatusMapped, "\u1eef"},
+	{0x1EEF, idnaStatusValid, ""},
+	{0x1EF0, idnaStatusMapped, "\u1ef1"},
+	{0x1EF1, idnaStatusValid, ""},
+	{0x1EF2, idnaStatusMapped, "\u1ef3"},
+	{0x1EF3, idnaStatusValid, ""},
+	{0x1EF4, idnaStatusMapped, "\u1ef5"},
+	{0x1EF5, idnaStatusValid, ""},
+	{0x1EF6, idnaStatusMapped, "\u1ef7"},
+	{0x1EF7, idnaStatusValid, ""},
+	{0x1EF8, idnaStatusMapped, "\u1ef9"},
+	{0x1EF9, idnaStatusValid, ""},
+	{0x1EFA, idnaStatusMapped, "\u1efb"},
+	{0x1EFB, idnaStatusValid, ""},
+	{0x1EFC, idnaStatusMapped, "\u1efd"},
+	{0x1EFD, idnaStatusValid, ""},
+	{0x1EFE, idnaStatusMapped, "\u1eff"},
+	{0x1EFF, idnaStatusValid, ""},
+	{0x1F08, idnaStatusMapped, "\u1f00"},
+	{0x1F09, idnaStatusMapped, "\u1f01"},
+	{0x1F0A, idnaStatusMapped, "\u1f02"},
+	{0x1F0B, idnaStatusMapped, "\u1f03"},
+	{0x1F0C, idnaStatusMapped, "\u1f04"},
+	{0x1F0D, idnaStatusMapped, "\u1f05"},
+	{0x1F0E, idnaStatusMapped, "\u1f06"},
+	{0x1F0F, idnaStatusMapped, "\u1f07"},
+	{0x1F10, idnaStatusValid, ""},
+	{0x1F16, idnaStatusDisallowed, ""},
+	{0x1F18, idnaStatusMapped, "\u1f10"},
+	{0x1F19, idnaStatusMapped, "\u1f11"},
+	{0x1F1A, idnaStatusMapped, "\u1f12"},
+	{0x1F1B, idnaStatusMapped, "\u1f13"},
+	{0x1F1C, idnaStatusMapped, "\u1f14"},
+	{0x1F1D, idnaStatusMapped, "\u1f15"},
+	{0x1F1E, idnaStatusDisallowed, ""},
+	{0x1F20, idnaStatusValid, ""},
+	{0x1F28, idnaStatusMapped, "\u1f20"},
+	{0x1F29, idnaStatusMapped, "\u1f21"},
+	{0x1F2A, idnaStatusMapped, "\u1f22"},
+	{0x1F2B, idnaStatusMapped, "\u1f23"},
+	{0x1F2C, idnaStatusMapped, "\u1f24"},
+	{0x1F2D, idnaStatusMapped, "\u1f25"},
+	{0x1F2E, idnaStatusMapped, "\u1f26"},
+	{0x1F2F, idnaStatusMapped, "\u1f27"},
+	{0x1F30, idnaStatusValid, ""},
+	{0x1F38, idnaStatusMapped, "\u1f30"},
+	{0x1F39, idnaStatusMapped, "\u1f31"},
+	{0x1F3A, idnaStatusMapped, "\u1f32"},
+	{0x1F3B, idnaStatusMapped, "\u1f33"},
+	{0x1F3C, idnaStatusMapped, "\u1f34"},
+	{0x1F3D, idnaStatusMapped, "\u1f35"},
+	{0x1F3E, idnaStatusMapped, "\u1f36"},
+	{0x1F3F, idnaStatusMapped, "\u1f37"},
+	{0x1F40, idnaStatusValid, ""},
+	{0x1F46, idnaStatusDisallowed, ""},
+	{0x1F48, idnaStatusMapped, "\u1f40"},
+	{0x1F49, idnaStatusMapped, "\u1f41"},
+	{0x1F4A, idnaStatusMapped, "\u1f42"},
+	{0x1F4B, idnaStatusMapped, "\u1f43"},
+	{0x1F4C, idnaStatusMapped, "\u1f44"},
+	{0x1F4D, idnaStatusMapped, "\u1f45"},
+	{0x1F4E, idnaStatusDisallowed, ""},
+	{0x1F50, idnaStatusValid, ""},
+	{0x1F58, idnaStatusDisallowed, ""},
+	{0x1F59, idnaStatusMapped, "\u1f51"},
+	{0x1F5A, idnaStatusDisallowed, ""},
+	{0x1F5B, idnaStatusMapped, "\u1f53"},
+	{0x1F5C, idnaStatusDisallowed, ""},
+	{0x1F5D, idnaStatusMapped, "\u1f55"},
+	{0x1F5E, idnaStatusDisallowed, ""},
+	{0x1F5F, idnaStatusMapped, "\u1f57"},
+	{0x1F60, idnaStatusValid, ""},
+	{0x1F68, idnaStatusMapped, "\u1f60"},
+	{0x1F69, idnaStatusMapped, "\u1f61"},
+	{0x1F6A, idnaStatusMapped, "\u1f62"},
+	{0x1F6B, idnaStatusMapped, "\u1f63"},
+	{0x1F6C, idnaStatusMapped, "\u1f64"},
+	{0x1F6D, idnaStatusMapped, "\u1f65"},
+	{0x1F6E, idnaStatusMapped, "\u1f66"},
+	{0x1F6F, idnaStatusMapped, "\u1f67"},
+	{0x1F70, idnaStatusValid, ""},
+	{0x1F71, idnaStatusMapped, "\u03ac"},
+	{0x1F72, idnaStatusValid, ""},
+	{0x1F73, idnaStatusMapped, "\u03ad"},
+	{0x1F74, idnaStatusValid, ""},
+	{0x1F75, idnaStatusMapped, "\u03ae"},
+	{0x1F76, idnaStatusValid, ""},
+	{0x1F77, idnaStatusMapped, "\u03af"},
+	{0x1F78, idnaStatusValid, ""},
+	{0x1F79, idnaStatusMapped, "\u03cc"},
+	{0x1F7A, idnaStatusValid, ""},
+	{0x1F7B, idnaStatusMapped, "\u03cd"},
+	{0x1F7C, idnaStatusValid, ""},
+	{0x1F7D, idnaStatusMapped, "\u03ce"},
+	{0x1F7E, idnaStatusDisallowed, ""},
+	{0x1F80, idnaStatusMapped, "\u1f00\u03b9"},
+	{0x1F81, idnaStatusMapped, "\u1f01\u03b9"},
+	{0x1F82, idnaStatusMapped, "\u1f02\u03b9"},
+	{0x1F83, idnaStatusMapped, "\u1f03\u03b9"},
+	{0x1F84, idnaStatusMapped, "\u1f04\u03b9"},
+	{0x1F85, idnaStatusMapped, "\u1f05\u03b9"},
+	{0x1F86, idnaStatusMapped, "\u1f06\u03b9"},
+	{0x1F87, idnaStatusMapped, "\u1f07\u03b9"},
+	{0x1F88, idnaStatusMapped, "\u1f00\u03b9"},
+	{0x1F89, idnaStatusMapped, "\u1f01\u03b9"},
+	{0x1F8A, idnaStatusMapped, "\u1f02\u03b9"},
+	{0x1F8B, idnaStatusMapped, "\u1f03\u03b9"},
+	{0x1F8C, idnaStatusMapped, "\u1f04\u03b9"},
+	{0x1F8D, idnaStatusMapped, "\u1f05\u03b9"},
+	{0x1F8E, idnaStatusMapped, "\u1f06\u03b9"},
+	{0x1F8F, idnaStatusMapped, "\u1f07\u03b9"},
+	{0x1F90, idnaStatusMapped, "\u1f20\u03b9"},
+	{0x1F91, idnaStatusMapped, "\u1f21\u03b9"},
+	{0x1F92, idnaStatusMapped, "\u1f22\u03b9"},
+	{0x1F93, idnaStatusMapped, "\u1f23\u03b9"},
+	{0x1F94, idnaStatusMapped, "\u1f24\u03b9"},
+	{0x1F95, idnaStatusMapped, "\u1f25\u03b9"},
+	{0x1F96, idnaStatusMapped, "\u1f26\u03b9"},
+	{0x1F97, idnaStatusMapped, "\u1f27\u03b9"},
+	{0x1F98, idnaStatusMapped, "\u1f20\u03b9"},
+	{0x1F99, idnaStatusMapped, "\u1f21\u03b9"},
+	{0x1F9A, idnaStatusMapped, "\u1f22\u03b9"},
+	{0x1F9B, idnaStatusMapped, "\u1f23\u03b9"},
+	{0x1F9C, idnaStatusMapped, "\u1f24\u03b9"},
+	{0x1F9D, idnaStatusMapped, "\u1f25\u03b9"},
+	{0x1F9E, idnaStatusMapped, "\u1f26\u03b9"},
+	{0x1F9F, idnaStatusMapped, "\u1f27\u03b9"},
+	{0x1FA0, idnaStatusMapped, "\u1f60\u03b9"},
+	{0x1FA1, idnaStatusMapped, "\u1f61\u03b9"},
+	{0x1FA2, idnaStatusMapped, "\u1f62\u03b9"},
+	{0x1FA3, idnaStatusMapped, "\u1f63\u03b9"},
+	{0x1FA4, idnaStatusMapped, "\u1f64\u03b9"},
+	{0x1FA5, idnaStatusMapped, "\u1f65\u03b9"},
+	{0x1FA6, idnaStatusMapped, "\u1f66\u03b9"},
+	{0x1FA7, idnaStatusMapped, "\u1f67\u03b9"},
+	{0x1FA8, idnaStatusMapped, "\u1f60\u03b9"},
+	{0x1FA9, idnaStatusMapped, "\u1f61\u03b9"},
+	{0x1FAA, idnaStatusMapped, "\u1f62\u03b9"},
+	{0x1FAB, idnaStatusMapped, "\u1f63\u03b9"},
+	{0x1FAC, idnaStatusMapped, "\u1f64\u03b9"},
+	{0x1FAD, idnaStatusMapped, "\u1f65\u03b9"},
+	{0x1FAE, idnaStatusMapped, "\u1f66\u03b9"},
+	{0x1FAF, idnaStatusMapped, "\u1f67\u03b9"},
+	{0x1FB0, idnaStatusValid, ""},
+	{0x1FB2, idnaStatusMapped, "\u1f70\u03b9"},
+	{0x1FB3, idnaStatusMapped, "\u03b1\u03b9"},
+	{0x1FB4, idnaStatusMapped, "\u03ac\u03b9"},
+	{0x1FB5, idnaStatusDisallowed, ""},
+	{0x1FB6, idnaStatusValid, ""},
+	{0x1FB7, idnaStatusMapped, "\u1fb6\u03b9"},
+	{0x1FB8, idnaStatusMapped, "\u1fb0"},
+	{0x1FB9, idnaStatusMapped, "\u1fb1"},
+	{0x1FBA, idnaStatusMapped, "\u1f70"},
+	{0x1FBB, idnaStatusMapped, "\u03ac"},
+	{0x1FBC, idnaStatusMapped, "\u03b1\u03b9"},
+	{0x1FBD, idnaStatusMapped, " \u0313"},
+	{0x1FBE, idnaStatusMapped, "\u03b9"},
+	{0x1FBF, idnaStatusMapped, " \u0313"},
+	{0x1FC0, idnaStatusMapped, " \u0342"},
+	{0x1FC1, idnaStatusMapped, " \u0308\u0342"},
+	{0x1FC2, idnaStatusMapped, "\u1f74\u03b9"},
+	{0x1FC3, idnaStatusMapped, "\u03b7\u03b9"},
+	{0x1FC4, idnaStatusMapped, "\u03ae\u03b9"},
+	{0x1FC5, idnaStatusDisallowed, ""},
+	{0x1FC6, idnaStatusValid, ""},
+	{0x1FC7, idnaStatusMapped, "\u1fc6\u03b9"},
+	{0x1FC8, idnaStatusMapped, "\u1f72"},
+	{0x1FC9, idnaStatusMapped, "\u03ad"},
+	{0x1FCA, idnaStatusMapped, "\u1f74"},
+	{0x1FCB, idnaStatusMapped, "\u03ae"},
+	{0x1FCC, idnaStatusMapped, "\u03b7\u03b9"},
+	{0x1FCD, idnaStatusMapped, " \u0313\u0300"},
+	{0x1FCE, idnaStatusMapped, " \u0313\u0301"},
+	{0x1FCF, idnaStatusMapped, " \u0313\u0342"},
+	{0x1FD0, idnaStatusValid, ""},
+	{0x1FD3, idnaStatusMapped, "\u0390"},
+	{0x1FD4, idnaStatusDisallowed, ""},
+	{0x1FD6, idnaStatusValid, ""},
+	{0x1FD8, idnaStatusMapped, "\u1fd0"},
+	{0x1FD9, idnaStatusMapped, "\u1fd1"},
+	{0x1FDA, idnaStatusMapped, "\u1f76"},
+	{0x1FDB, idnaStatusMapped, "\u03af"},
+	{0x1FDC, idnaStatusDisallowed, ""},
+	{0x1FDD, idnaStatusMapped, " \u0314\u0300"},
+	{0x1FDE, idnaStatusMapped, " \u0314\u0301"},
+	{0x1FDF, idnaStatusMapped, " \u0314\u0342"},
+	{0x1FE0, idnaStatusValid, ""},
+	{0x1FE3, idnaStatusMapped, "\u03b0"},
+	{0x1FE4, idnaStatusValid, ""},
+	{0x1FE8, idnaStatusMapped, "\u1fe0"},
+	{0x1FE9, idnaStatusMapped, "\u1fe1"},
+	{0x1FEA, idnaStatusMapped, "\u1f7a"},
+	{0x1FEB, idnaStatusMapped, "\u03cd"},
+	{0x1FEC, idnaStatusMapped, "\u1fe5"},
+	{0x1FED, idnaStatusMapped, " \u0308\u0300"},
+	{0x1FEE, idnaStatusMapped, " \u0308\u0301"},
+	{0x1FEF, idnaStatusMapped, "`"},
+	{0x1FF0, idnaStatusDisallowed, ""},
+	{0x1FF2, idnaStatusMapped, "\u1f7c\u03b9"},
+	{0x1FF3, idnaStatusMapped, "\u03c9\u03b9"},
+	{0x1FF4, idnaStatusMapped, "\u03ce\u03b9"},
+	{0x1FF5, idnaStatusDisallowed, ""},
+	{0x1FF6, idnaStatusValid, ""},
+	{0x1FF7, idnaStatusMapped, "\u1ff6\u03b9"},
+	{0x1FF8, idnaStatusMapped, "\u1f78"},
+	{0x1FF9, idnaStatusMapped, "\u03cc"},
+	{0x1FFA, idnaStatusMapped, "\u1f7c"},
+	{0x1FFB, idnaStatusMapped, "\u03ce"},
+	{0x1FFC, idnaStatusMapped, "\u03c9\u03b9"},
+	{0x1FFD, idnaStatusMapped, " \u0301"},
+	{0x1FFE, idnaStatusMapped, " \u0314"},
+	{0x1FFF, idnaStatusDisallowed, ""},
+	{0x2000, idnaStatusMapped, " "},
+	{0x200B, idnaStatusIgnored, ""},
+	{0x200C, idnaStatusDeviation, ""},
+	{0x200E, idnaStatusDisallowed, ""},
+	{0x2010, idnaStatusValid, ""},
+	{0x2011, idnaStatusMapped, "\u2010"},
+	{0x2012, idnaStatusValid, ""},
+	{0x2017, idnaStatusMapped, " \u0333"},
+	{0x2018, idnaStatusValid, ""},
+	{0x2024, idnaStatusDisallowed, ""},
+	{0x2027, idnaStatusValid, ""},
+	{0x2028, idnaStatusDisallowed, ""},
+	{0x202F, idnaStatusMapped, " "},
+	{0x2030, idnaStatusValid, ""},
+	{0x2033, idnaStatusMapped, "\u2032\u2032"},
+	{0x2034, idnaStatusMapped, "\u2032\u2032\u2032"},
+	{0x2035, idnaStatusValid, ""},
+	{0x2036, idnaStatusMapped, "\u2035\u2035"},
+	{0x2037, idnaStatusMapped, "\u2035\u2035\u2035"},
+	{0x2038, idnaStatusValid, ""},
+	{0x203C, idnaStatusMapped, "!!"},
+	{0x203D, idnaStatusValid, ""},
+	{0x203E, idnaStatusMapped, " \u0305"},
+	{0x203F, idnaStatusValid, ""},
+	{0x2047, idnaStatusMapped, "??"},
+	{0x2048, idnaStatusMapped, "?!"},
+	{0x2049, idnaStatusMapped, "!?"},
+	{0x204A, idnaStatusValid, ""},
+	{0x2057, idnaStatusMapped, "\u2032\u2032\u2032\u2032"},
+	{0x2058, idnaStatusValid, ""},
+	{0x205F, idnaStatusMapped, " "},
+	{0x2060, idnaStatusIgnored, ""},
+	{0x2065, idnaStatusDisallowed, ""},
+	{0x206A, idnaStatusIgnored, ""},
+	{0x2070, idnaStatusMapped, "0"},
+	{0x2071, idnaStatusMapped, "i"},
+	{0x2072, idnaStatusDisallowed, ""},
+	{0x2074, idnaStatusMapped, "4"},
+	{0x2075, idnaStatusMapped, "5"},
+	{0x2076, idnaStatusMapped, "6"},
+	{0x2077, idnaStatusMapped, "7"},
+	{0x2078, idnaStatusMapped, "8"},
+	{0x2079, idnaStatusMapped, "9"},
+	{0x207A, idnaStatusMapped, "+"},
+	{0x207B, idnaStatusMapped, "\u2212"},
+	{0x207C, idnaStatusMapped, "="},
+	{0x207D, idnaStatusMapped, "("},
+	{0x207E, idnaStatusMapped, ")"},
+	{0x207F, idnaStatusMapped, "n"},
+	{0x2080, idnaStatusMapped, "0"},
+	{0x2081, idnaStatusMapped, "1"},
+	{0x2082, idnaStatusMapped, "2"},
+	{0x2083, idnaStatusMapped, "3"},
+	{0x2084, idnaStatusMapped, "4"},
+	{0x2085, idnaStatusMapped, "5"},
+	{0x2086, idnaStatusMapped, "6"},
+	{0x2087, idnaStatusMapped, "7"},
+	{0x2088, idnaStatusMapped, "8"},
+	{0x2089, idnaStatusMapped, "9"},
+	{0x208A, idnaStatusMapped, "+"},
+	{0x208B, idnaStatusMapped, "\u2212"},
+	{0x208C, idnaStatusMapped, "="},
+	{0x208D, idnaStatusMapped, "("},
+	{0x208E, idnaStatusMapped, ")"},
+	{0x208F, idnaStatusDisallowed, ""},
+	{0x2090, idnaStatusMapped, "a"},
+	{0x2091, idnaStatusMapped, "e"},
+	{0x2092, idnaStatusMapped, "o"},
+	{0x2093, idnaStatusMapped, "x"},
+	{0x2094, idnaStatusMapped, "\u0259"},
+	{0x2095, idnaStatusMapped, "h"},
+	{0x2096, idnaStatusMapped, "k"},
+	{0x2097, idnaStatusMapped, "l"},
+	{0x2098, idnaStatusMapped, "m"},
+	{0x2099, idnaStatusMapped, "n"},
+	{0x209A, idnaStatusMapped, "p"},
+	{0x209B, idnaStatusMapped, "s"},
+	{0x209C, idnaStatusMapped, "t"},
+	{0x209D, idnaStatusDisallowed, ""},
+	{0x20A0, idnaStatusValid, ""},
+	{0x20A8, idnaStatusMapped, "rs"},
+	{0x20A9, idnaStatusValid, ""},
+	{0x20C2, idnaStatusDisallowed, ""},
+	{0x20D0, idnaStatusValid, ""},
+	{0x20F1, idnaStatusDisallowed, ""},
+	{0x2100, idnaStatusMapped, "a/c"},
+	{0x2101, idnaStatusMapped, "a/s"},
+	{0x2102, idnaStatusMapped, "c"},
+	{0x2103, idnaStatusMapped, "\u00b0c"},
+	{0x2104, idnaStatusValid, ""},
+	{0x2105, idnaStatusMapped, "c/o"},
+	{0x2106, idnaStatusMapped, "c/u"},
+	{0x2107, idnaStatusMapped, "\u025b"},
+	{0x2108, idnaStatusValid, ""},
+	{0x2109, idnaStatusMapped, "\u00b0f"},
+	{0x210A, idnaStatusMapped, "g"},
+	{0x210B, idnaStatusMapped, "h"},
+	{0x210F, idnaStatusMapped, "\u0127"},
+	{0x2110, idnaStatusMapped, "i"},
+	{0x2112, idnaStatusMapped, "l"},
+	{0x2114, idnaStatusValid, ""},
+	{0x2115, idnaStatusMapped, "n"},
+	{0x2116, idnaStatusMapped, "no"},
+	{0x2117, idnaStatusValid, ""},
+	{0x2119, idnaStatusMapped, "p"},
+	{0x211A, idnaStatusMapped, "q"},
+	{0x211B, idnaStatusMapped, "r"},
+	{0x211E, idnaStatusValid, ""},
+	{0x2120, idnaStatusMapped, "sm"},
+	{0x2121, idnaStatusMapped, "tel"},
+	{0x2122, idnaStatusMapped, "tm"},
+	{0x2123, idnaStatusValid, ""},
+	{0x2124, idnaStatusMapped, "z"},
+	{0x2125, idnaStatusValid, ""},
+	{0x2126, idnaStatusMapped, "\u03c9"},
+	{0x2127, idnaStatusValid, ""},
+	{0x2128, idnaStatusMapped, "z"},
+	{0x2129, idnaStatusValid, ""},
+	{0x212A, idnaStatusMapped, "k"},
+	{0x212B, idnaStatusMapped, "\u00e5"},
+	{0x212C, idnaStatusMapped, "b"},
+	{0x212D, idnaStatusMapped, "c"},
+	{0x212E, idnaStatusValid, ""},
+	{0x212F, idnaStatusMapped, "e"},
+	{0x2131, idnaStatusMapped, "f"},
+	{0x2132, idnaStatusMapped, "\u214e"},
+	{0x2133, idnaStatusMapped, "m"},
+	{0x2134, idnaStatusMapped, "o"},
+	{0x2135, idnaStatusMapped, "\u05d0"},
+	{0x2136, idnaStatusMapped, "\u05d1"},
+	{0x2137, idnaStatusMapped, "\u05d2"},
+	{0x2138, idnaStatusMapped, "\u05d3"},
+	{0x2139, idnaStatusMapped, "i"},
+	{0x213A, idnaStatusValid, ""},
+	{0x213B, idnaStatusMapped, "fax"},
+	{0x213C, idnaStatusMapped, "\u03c0"},
+	{0x213D, idnaStatusMapped, "\u03b3"},
+	{0x213F, idnaStatusMapped, "\u03c0"},
+	{0x2140, idnaStatusMapped, "\u2211"},
+	{0x2141, idnaStatusValid, ""},
+	{0x2145, idnaStatusMapped, "d"},
+	{0x2147, idnaStatusMapped, "e"},
+	{0x2148, idnaStatusMapped, "i"},
+	{0x2149, idnaStatusMapped, "j"},
+	{0x214A, idnaStatusValid, ""},
+	{0x2150, idnaStatusMapped, "1\u20447"},
+	{0x2151, idnaStatusMapped, "1\u20449"},
+	{0x2152, idnaStatusMapped, "1\u204410"},
+	{0x2153, idnaStatusMapped, "1\u20443"},
+	{0x2154, idnaStatusMapped, "2\u20443"},
+	{0x2155, idnaStatusMapped, "1\u20445"},
+	{0x2156, idnaStatusMapped, "2\u20445"},
+	{0x2157, idnaStatusMapped, "3\u20445"},
+	{0x2158, idnaStatusMapped, "4\u20445"},
+	{0x2159, idnaStatusMapped, "1\u20446"},
+	{0x215A, idnaStatusMapped, "5\u20446"},
+	{0x215B, idnaStatusMapped, "1\u20448"},
+	{0x215C, idnaStatusMapped, "3\u20448"},
+	{0x215D, idnaStatusMapped, "5\u20448"},
+	{0x215E, idnaStatusMapped, "7\u20448"},
+	{0x215F, idnaStatusMapped, "1\u2044"},
+	{0x2160, idnaStatusMapped, "i"},
+	{0x2161, idnaStatusMapped, "ii"},
+	{0x2162, idnaStatusMapped, "iii"},
+	{0x2163, idnaStatusMapped, "iv"},
+	{0x2164, idnaStatusMapped, "v"},
+	{0x2165, idnaStatusMapped, "vi"},
+	{0x2166, idnaStatusMapped, "vii"},
+	{0x2167, idnaStatusMapped, "viii"},
+	{0x2168, idnaStatusMapped, "ix"},
+	{0x2169, idnaStatusMapped, "x"},
+	{0x216A, idnaStatusMapped, "xi"},
+	{0x216B, idnaStatusMapped, "xii"},
+	{0x216C, idnaStatusMapped, "l"},
+	{0x216D, idnaStatusMapped, "c"},
+	{0x216E, idnaStatusMapped, "d"},
+	{0x216F, idnaStatusMapped, "m"},
+	{0x2170, idnaStatusMapped, "i"},
+	{0x2171, idnaStatusMapped, "ii"},
+	{0x2172, idnaStatusMapped, "iii"},
+	{0x2173, idnaStatusMapped, "iv"},
+	{0x2174, idnaStatusMapped, "v"},
+	{0x2175, idnaStatusMapped, "vi"},
+	{0x2176, idnaStatusMapped, "vii"},
+	{0x2177, idnaStatusMapped, "viii"},
+	{0x2178, idnaStatusMapped, "ix"},
+	{0x2179, idnaStatusMapped, "x"},
+	{0x217A, idnaStatusMapped, "xi"},
+	{0x217B, idnaStatusMapped, "xii"},
+	{0x217C, idnaStatusMapped, "l"},
+	{0x217D, idnaStatusMapped, "c"},
+	{0x217E, idnaStatusMapped, "d"},
+	{0x217F, idnaStatusMapped, "m"},
+	{0x2180, idnaStatusValid, ""},
+	{0x2183, idnaStatusMapped, "\u2184"},
+	{0x2184, idnaStatusValid, ""},
+	{0x2189, idnaStatusMapped, "0\u20443"},
+	{0x218A, idnaStatusValid, ""},
+	{0x218C, idnaStatusDisallowed, ""},
+	{0x2190, idnaStatusValid, ""},
+	{0x222C, idnaStatusMapped, "\u222b\u222b"},
+	{0x222D, idnaStatusMapped, "\u222b\u222b\u222b"},
+	{0x222E, idnaStatusValid, ""},
+	{0x222F, idnaStatusMapped, "\u222e\u222e"},
+	{0x2230, idnaStatusMapped, "\u222e\u222e\u222e"},
+	{0x2231, idnaStatusValid, ""},
+	{0x2329, idnaStatusMapped, "\u3008"},
+	{0x232A, idnaStatusMapped, "\u3009"},
+	{0x232B, idnaStatusValid, ""},
+	{0x242A, idnaStatusDisallowed, ""},
+	{0x2440, idnaStatusValid, ""},
+	{0x244B, idnaStatusDisallowed, ""},
+	{0x2460, idnaStatusMapped, "1"},
+	{0x2461, idnaStatusMapped, "2"},
+	{0x2462, idnaStatusMapped, "3"},
+	{0x2463, idnaStatusMapped, "4"},
+	{0x2464, idnaStatusMapped, "5"},
+	{0x2465, idnaStatusMapped, "6"},
+	{0x2466, idnaStatusMapped, "7"},
+	{0x2467, idnaStatusMapped, "8"},
+	{0x2468, idnaStatusMapped, "9"},
+	{0x2469, idnaStatusMapped, "10"},
+	{0x246A, idnaStatusMapped, "11"},
+	{0x246B, idnaStatusMapped, "12"},
+	{0x246C, idnaStatusMapped, "13"},
+	{0x246D, idnaStatusMapped, "14"},
+	{0x246E, idnaStatusMapped, "15"},
+	{0x246F, idnaStatusMapped, "16"},
+	{0x2470, idnaStatusMapped, "17"},
+	{0x2471, idnaStatusMapped, "18"},
+	{0x2472, idnaStatusMapped, "19"},
+	{0x2473, idnaStatusMapped, "20"},
+	{0x2474, idnaStatusMapped, "(1)"},
+	{0x2475, idnaStatusMapped, "(2)"},
+	{0x2476, idnaStatusMapped, "(3)"},
+	{0x2477, idnaStatusMapped, "(4)"},
+	{0x2478, idnaStatusMapped, "(5)"},
+	{0x2479, idnaStatusMapped, "(6)"},
+	{0x247A, idnaStatusMapped, "(7)"},
+	{0x247B, idnaStatusMapped, "(8)"},
+	{0x247C, idnaStatusMapped, "(9)"},
+	{0x247D, idnaStatusMapped, "(10)"},
+	{0x247E, idnaStatusMapped, "(11)"},
+	{0x247F, idnaStatusMapped, "(12)"},
+	{0x2480, idnaStatusMapped, "(13)"},
+	{0x2481, idnaStatusMapped, "(14)"},
+	{0x2482, idnaStatusMapped, "(15)"},
+	{0x2483, idnaStatusMapped, "(16)"},
+	{0x2484, idnaStatusMapped, "(17)"},
+	{0x2485, idnaStatusMapped, "(18)"},
+	{0x2486, idnaStatusMapped, "(19)"},
+	{0x2487, idnaStatusMapped, "(20)"},
+	{0x2488, idnaStatusDisallowed, ""},
+	{0x249C, idnaStatusMapped, "(a)"},
+	{0x249D, idnaStatusMapped, "(b)"},
+	{0x249E, idnaStatusMapped, "(c)"},
+	{0x249F, idnaStatusMapped, "(d)"},
+	{0x24A0, idnaStatusMapped, "(e)"},
+	{0x24A1, idnaStatusMapped, "(f)"},
+	{0x24A2, idnaStatusMapped, "(g)"},
+	{0x24A3, idnaStatusMapped, "(h)"},
+	{0x24A4, idnaStatusMapped, "(i)"},
+	{0x24A5, idnaStatusMapped, "(j)"},
+	{0x24A6, idnaStatusMapped, "(k)"},
+	{0x24A7, idnaStatusMapped, "(l)"},
+	{0x24A8, idnaStatusMapped, "(m)"},
+	{0x24A9, idnaStatusMapped, "(n)"},
+	{0x24AA, idnaStatusMapped, "(o)"},
+	{0x24AB, idnaStatusMapped, "(p)"},
+	{0x24AC, idnaStatusMapped, "(q)"},
+	{0x24AD, idnaStatusMapped, "(r)"},
+	{0x24AE, idnaStatusMapped, "(s)"},
+	{0x24AF, idnaStatusMapped, "(t)"},
+	{0x24B0, idnaStatusMapped, "(u)"},
+	{0x24B1, idnaStatusMapped, "(v)"},
+	{0x24B2, idnaStatusMapped, "(w)"},
+	{0x24B3, idnaStatusMapped, "(x)"},
+	{0x24B4, idnaStatusMapped, "(y)"},
+	{0x24B5, idnaStatusMapped, "(z)"},
+	{0x24B6, idnaStatusMapped, "a"},
+	{0x24B7, idnaStatusMapped, "b"},
+	{0x24B8, idnaStatusMapped, "c"},
+	{0x24B9, idnaStatusMapped, "d"},
+	{0x24BA, idnaStatusMapped, "e"},
+	{0x24BB, idnaStatusMapped, "f"},
+	{0x24BC, idnaStatusMapped, "g"},
+	{0x24BD, idnaStatusMapped, "h"},
+	{0x24BE, idnaStatusMapped, "i"},
+	{0x24BF, idnaStatusMapped, "j"},
+	{0x24C0, idnaStatusMapped, "k"},
+	{0x24C1, idnaStatusMapped, "l"},
+	{0x24C2, idnaStatusMapped, "m"},
+	{0x24C3, idnaStatusMapped, "n"},
+	{0x24C4, idnaStatusMapped, "o"},
+	{0x24C5, idnaStatusMapped, "p"},
+	{0x24C6, idnaStatusMapped, "q"},
+	{0x24C7, idnaStatusMapped, "r"},
+	{0x24C8, idnaStatusMapped, "s"},
+	{0x24C9, idnaStatusMapped, "t"},
+	{0x24CA, idnaStatusMapped, "u"},
+	{0x24CB, idnaStatusMapped, "v"},
+	{0x24CC, idnaStatusMapped, "w"},
+	{0x24CD, idnaStatusMapped, "x"},
+	{0x24CE, idnaStatusMapped, "y"},
+	{0x24CF, idnaStatusMapped, "z"},
+	{0x24D0, idnaStatusMapped, "a"},
+	{0x24D1, idnaStatusMapped, "b"},
+	{0x24D2, idnaStatusMapped, "c"},
+	{0x24D3, idnaStatusMapped, "d"},
+	{0x24D4, idnaStatusMapped, "e"},
+	{0x24D5, idnaStatusMapped, "f"},
+	{0x24D6, idnaStatusMapped, "g"},
+	{0x24D7, idnaStatusMapped, "h"},
+	{0x24D8, idnaStatusMapped, "i"},
+	{0x24D9, idnaStatusMapped, "j"},
+	{0x24DA, idnaStatusMapped, "k"},
+	{0x24DB, idnaStatusMapped, "l"},
+	{0x24DC, idnaStatusMapped, "m"},
+	{0x24DD, idnaStatusMapped, "n"},
+	{0x24DE, idnaStatusMapped, "o"},
+	{0x24DF, idnaStatusMapped, "p"},
+	{0x24E0, idnaStatusMapped, "q"},
+	{0x24E1, idnaStatusMapped, "r"},
+	{0x24E2, idnaStatusMapped, "s"},
+	{0x24E3, idnaStatusMapped, "t"},
+	{0x24E4, idnaStatusMapped, "u"},
+	{0x24E5, idnaStatusMapped, "v"},
+	{0x24E6, idnaStatusMapped, "w"},
+	{0x24E7, idnaStatusMapped, "x"},
+	{0x24E8, idnaStatusMapped, "y"},
+	{0x24E9, idnaStatusMapped, "z"},
+	{0x24EA, idnaStatusMapped, "0"},
+	{0x24EB, idnaStatusValid, ""},
+	{0x2A0C, idnaStatusMapped, "\u222b\u222b\u222b\u222b"},
+	{0x2A0D, idnaStatusValid, ""},
+	{0x2A74, idnaStatusMapped, "::="},
+	{0x2A75, idnaStatusMapped, "=="},
+	{0x2A76, idnaStatusMapped, "==="},
+	{0x2A77, idnaStatusValid, ""},
+	{0x2ADC, idnaStatusMapped, "\u2add\u0338"},
+	{0x2ADD, idnaStatusValid, ""},
+	{0x2B74, idnaStatusDisallowed, ""},
+	{0x2B76, idnaStatusValid, ""},
+	{0x2C00, idnaStatusMapped, "\u2c30"},
+	{0x2C01, idnaStatusMapped, "\u2c31"},
+	{0x2C02, idnaStatusMapped, "\u2c32"},
+	{0x2C03, idnaStatusMapped, "\u2c33"},
+	{0x2C04, idnaStatusMapped, "\u2c34"},
+	{0x2C05, idnaStatusMapped, "\u2c35"},
+	{0x2C06, idnaStatusMapped, "\u2c36"},
+	{0x2C07, idnaStatusMapped, "\u2c37"},
+	{0x2C08, idnaStatusMapped, "\u2c38"},
+	{0x2C09, idnaStatusMapped, "\u2c39"},
+	{0x2C0A, idnaStatusMapped, "\u2c3a"},
+	{0x2C0B, idnaStatusMapped, "\u2c3b"},
+	{0x2C0C, idnaStatusMapped, "\u2c3c"},
+	{0x2C0D, idnaStatusMapped, "\u2c3d"},
+	{0x2C0E, idnaStatusMapped, "\u2c3e"},
+	{0x2C0F, idnaStatusMapped, "\u2c3f"},
+	{0x2C10, idnaStatusMapped, "\u2c40"},
+	{0x2C11, idnaStatusMapped, "\u2c41"},
+	{0x2C12, idnaStatusMapped, "\u2c42"},
+	{0x2C13, idnaStatusMapped, "\u2c43"},
+	{0x2C14, idnaStatusMapped, "\u2c44"},
+	{0x2C15, idnaStatusMapped, "\u2c45"},
+	{0x2C16, idnaStatusMapped, "\u2c46"},
+	{0x2C17, idnaStatusMapped, "\u2c47"},
+	{0x2C18, idnaStatusMapped, "\u2c48"},
+	{0x2C19, idnaStatusMapped, "\u2c49"},
+	{0x2C1A, idnaStatusMapped, "\u2c4a"},
+	{0x2C1B, idnaStatusMapped, "\u2c4b"},
+	{0x2C1C, idnaStatusMapped, "\u2c4c"},
+	{0x2C1D, idnaStatusMapped, "\u2c4d"},
+	{0x2C1E, idnaStatusMapped, "\u2c4e"},
+	{0x2C1F, idnaStatusMapped, "\u2c4f"},
+	{0x2C20, idnaStatusMapped, "\u2c50"},
+	{0x2C21, idnaStatusMapped, "\u2c51"},
+	{0x2C22, idnaStatusMapped, "\u2c52"},
+	{0x2C23, idnaStatusMapped, "\u2c53"},
+	{0x2C24, idnaStatusMapped, "\u2c54"},
+	{0x2C25, idnaStatusMapped, "\u2c55"},
+	{0x2C26, idnaStatusMapped, "\u2c56"},
+	{0x2C27, idnaStatusMapped, "\u2c57"},
+	{0x2C28, idnaStatusMapped, "\u2c58"},
+	{0x2C29, idnaStatusMapped, "\u2c59"},
+	{0x2C2A, idnaStatusMapped, "\u2c5a"},
+	{0x2C2B, idnaStatusMapped, "\u2c5b"},
+	{0x2C2C, idnaStatusMapped, "\u2c5c"},
+	{0x2C2D, idnaStatusMapped, "\u2c5d"},
+	{0x2C2E, idnaStatusMapped, "\u2c5e"},
+	{0x2C2F, idnaStatusMapped, "\u2c5f"},
+	{0x2C30, idnaStatusValid, ""},
+	{0x2C60, idnaStatusMapped, "\u2c61"},
+	{0x2C61, idnaStatusValid, ""},
+	{0x2C62, idnaStatusMapped, "\u026b"},
+	{0x2C63, idnaStatusMapped, "\u1d7d"},
+	{0x2C64, idnaStatusMapped, "\u027d"},
+	{0x2C65, idnaStatusValid, ""},
+	{0x2C67, idnaStatusMapped, "\u2c68"},
+	{0x2C68, idnaStatusValid, ""},
+	{0x2C69, idnaStatusMapped, "\u2c6a"},
+	{0x2C6A, idnaStatusValid, ""},
+	{0x2C6B, idnaStatusMapped, "\u2c6c"},
+	{0x2C6C, idnaStatusValid, ""},
+	{0x2C6D, idnaStatusMapped, "\u0251"},
+	{0x2C6E, idnaStatusMapped, "\u0271"},
+	{0x2C6F, idnaStatusMapped, "\u0250"},
+	{0x2C70, idnaStatusMapped, "\u0252"},
+	{0x2C71, idnaStatusValid, ""},
+	{0x2C72, idnaStatusMapped, "\u2c73"},
+	{0x2C73, idnaStatusValid, ""},
+	{0x2C75, idnaStatusMapped, "\u2c76"},
+	{0x2C76, idnaStatusValid, ""},
+	{0x2C7C, idnaStatusMapped, "j"},
+	{0x2C7D, idnaStatusMapped, "v"},
+	{0x2C7E, idnaStatusMapped, "\u023f"},
+	{0x2C7F, idnaStatusMapped, "\u0240"},
+	{0x2C80, idnaStatusMapped, "\u2c81"},
+	{0x2C81, idnaStatusValid, ""},
+	{0x2C82, idnaStatusMapped, "\u2c83"},
+	{0x2C83, idnaStatusValid, ""},
+	{0x2C84, idnaStatusMapped, "\u2c85"},
+	{0x2C85, idnaStatusValid, ""},
+	{0x2C86, idnaStatusMapped, "\u2c87"},
+	{0x2C87, idnaStatusValid, ""},
+	{0x2C88, idnaStatusMapped, "\u2c89"},
+	{0x2C89, idnaStatusValid, ""},
+	{0x2C8A, idnaStatusMapped, "\u2c8b"},
+	{0x2C8B, idnaStatusValid, ""},
+	{0x2C8C, idnaStatusMapped, "\u2c8d"},
+	{0x2C8D, idnaStatusValid, ""},
+	{0x2C8E, idnaStatusMapped, "\u2c8f"},
+	{0x2C8F, idnaStatusValid, ""},
+	{0x2C90, idnaStatusMapped, "\u2c91"},
+	{0x2C91, idnaStatusValid, ""},
+	{0x2C92, idnaStatusMapped, "\u2c93"},
+	{0x2C93, idnaStatusValid, ""},
+	{0x2C94, idnaStatusMapped, "\u2c95"},
+	{0x2C95, idnaStatusValid, ""},
+	{0x2C96, idnaStatusMapped, "\u2c97"},
+	{0x2C97, idnaStatusValid, ""},
+	{0x2C98, idnaStatusMapped, "\u2c99"},
+	{0x2C99, idnaStatusValid, ""},
+	{0x2C9A, idnaStatusMapped, "\u2c9b"},
+	{0x2C9B, idnaStatusValid, ""},
+	{0x2C9C, idnaStatusMapped, "\u2c9d"},
+	{0x2C9D, idnaStatusValid, ""},
+	{0x2C9E, idnaStatusMapped, "\u2c9f"},
+	{0x2C9F, idnaStatusValid, ""},
+	{0x2CA0, idnaStatusMapped, "\u2ca1"},
+	{0x2CA1, idnaStatusValid, ""},
+	{0x2CA2, idnaStatusMapped, "\u2ca3"},
+	{0x2CA3, idnaStatusValid, ""},
+	{0x2CA4, idnaStatusMapped, "\u2ca5"},
+	{0x2CA5, idnaStatusValid, ""},
+	{0x2CA6, idnaStatusMapped, "\u2ca7"},
+	{0x2CA7, idnaStatusValid, ""},
+	{0x2CA8, idnaStatusMapped, "\u2ca9"},
+	{0x2CA9, idnaStatusValid, ""},
+	{0x2CAA, idnaStatusMapped, "\u2cab"},
+	{0x2CAB, idnaStatusValid, ""},
+	{0x2CAC, idnaStatusMapped, "\u2cad"},
+	{0x2CAD, idnaStatusValid, ""},
+	{0x2CAE, idnaStatusMapped, "\u2caf"},
+	{0x2CAF, idnaStatusValid, ""},
+	{0x2CB0, idnaStatusMapped, "\u2cb1"},
+	{0x2CB1, idnaStatusValid, ""},
+	{0x2CB2, idnaStatusMapped, "\u2cb3"},
+	{0x2CB3, idnaStatusValid, ""},
+	{0x2CB4, idnaStatusMapped, "\u2cb5"},
+	{0x2CB5, idnaStatusValid, ""},
+	{0x2CB6, idnaStatusMapped, "\u2cb7"},
+	{0x2CB7, idnaStatusValid, ""},
+	{0x2CB8, idnaStatusMapped, "\u2cb9"},
+	{0x2CB9, idnaStatusValid, ""},
+	{0x2CBA, idnaStatusMapped, "\u2cbb"},
+	{0x2CBB, idnaStatusValid, ""},
+	{0x2CBC, idnaStatusMapped, "\u2cbd"},
+	{0x2CBD, idnaStatusValid, ""},
+	{0x2CBE, idnaStatusMapped, "\u2cbf"},
+	{0x2CBF, idnaStatusValid, ""},
+	{0x2CC0, idnaStatusMapped, "\u2cc1"},
+	{0x2CC1, idnaStatusValid, ""},
+	{0x2CC2, idnaStatusMapped, "\u2cc3"},
+	{0x2CC3, idnaStatusValid, ""},
+	{0x2CC4, idnaStatusMapped, "\u2cc5"},
+	{0x2CC5, idnaStatusValid, ""},
+	{0x2CC6, idnaStatusMapped, "\u2cc7"},
+	{0x2CC7, idnaStatusValid, ""},
+	{0x2CC8, idnaStatusMapped, "\u2cc9"},
+	{0x2CC9, idnaStatusValid, ""},
+	{0x2CCA, idnaStatusMapped, "\u2ccb"},
+	{0x2CCB, idnaStatusValid, ""},
+	{0x2CCC, idnaStatusMapped, "\u2ccd"},
+	{0x2CCD, idnaStatusValid, ""},
+	{0x2CCE, idnaStatusMapped, "\u2ccf"},
+	{0x2CCF, idnaStatusValid, ""},
+	{0x2CD0, idnaStatusMapped, "\u2cd1"},
+	{0x2CD1, idnaStatusValid, ""},
+	{0x2CD2, idnaStatusMapped, "\u2cd3"},
+	{0x2CD3, idnaStatusValid, ""},
+	{0x2CD4, idnaStatusMapped, "\u2cd5"},
+	{0x2CD5, idnaStatusValid, ""},
+	{0x2CD6, idnaStatusMapped, "\u2cd7"},
+	{0x2CD7, idnaStatusValid, ""},
+	{0x2CD8, idnaStatusMapped, "\u2cd9"},
+	{0x2CD9, idnaStatusValid, ""},
+	{0x2CDA, idnaStatusMapped, "\u2cdb"},
+	{0x2CDB, idnaStatusValid, ""},
+	{0x2CDC, idnaStatusMapped, "\u2cdd"},
+	{0x2CDD, idnaStatusValid, ""},
+	{0x2CDE, idnaStatusMapped, "\u2cdf"},
+	{0x2CDF, idnaStatusValid, ""},
+	{0x2CE0, idnaStatusMapped, "\u2ce1"},
+	{0x2CE1, idnaStatusValid, ""},
+	{0x2CE2, idnaStatusMapped, "\u2ce3"},
+	{0x2CE3, idnaStatusValid, ""},
+	{0x2CEB, idnaStatusMapped, "\u2cec"},
+	{0x2CEC, idnaStatusValid, ""},
+	{0x2CED, idnaStatusMapped, "\u2cee"},
+	{0x2CEE, idnaStatusValid, ""},
+	{0x2CF2, idnaStatusMapped, "\u2cf3"},
+	{0x2CF3, idnaStatusValid, ""},
+	{0x2CF4, idnaStatusDisallowed, ""},
+	{0x2CF9, idnaStatusValid, ""},
+	{0x2D26, idnaStatusDisallowed, ""},
+	{0x2D27, idnaStatusValid, ""},
+	{0x2D28, idnaStatusDisallowed, ""},
+	{0x2D2D, idnaStatusValid, ""},
+	{0x2D2E, idnaStatusDisallowed, ""},
+	{0x2D30, idnaStatusValid, ""},
+	{0x2D68, idnaStatusDisallowed, ""},
+	{0x2D6F, idnaStatusMapped, "\u2d61"},
+	{0x2D70, idnaStatusValid, ""},
+	{0x2D71, idnaStatusDisallowed, ""},
+	{0x2D7F, idnaStatusValid, ""},
+	{0x2D97, idnaStatusDisallowed, ""},
+	{0x2DA0, idnaStatusValid, ""},
+	{0x2DA7, idnaStatusDisallowed, ""},
+	{0x2DA8, idnaStatusValid, ""},
+	{0x2DAF, idnaStatusDisallowed, ""},
+	{0x2DB0, idnaStatusValid, ""},
+	{0x2DB7, idnaStatusDisallowed, ""},
+	{0x2DB8, idnaStatusValid, ""},
+	{0x2DBF, idnaStatusDisallowed, ""},
+	{0x2DC0, idnaStatusValid, ""},
+	{0x2DC7, idnaStatusDisallowed, ""},
+	{0x2DC8, idnaStatusValid, ""},
+	{0x2DCF, idnaStatusDisallowed, ""},
+	{0x2DD0, idnaStatusValid, ""},
+	{0x2DD7, idnaStatusDisallowed, ""},
+	{0x2DD8, idnaStatusValid, ""},
+	{0x2DDF, idnaStatusDisallowed, ""},
+	{0x2DE0, idnaStatusValid, ""},
+	{0x2E5E, idnaStatusDisallowed, ""},
+	{0x2E80, idnaStatusValid, ""},
+	{0x2E9A, idnaStatusDisallowed, ""},
+	{0x2E9B, idnaStatusValid, ""},
+	{0x2E9F, idnaStatusMapped, "\u6bcd"},
+	{0x2EA0, idnaStatusValid, ""},
+	{0x2EF3, idnaStatusMapped, "\u9f9f"},
+	{0x2EF4, idnaStatusDisallowed, ""},
+	{0x2F00, idnaStatusMapped, "\u4e00"},
+	{0x2F01, idnaStatusMapped, "\u4e28"},
+	{0x2F02, idnaStatusMapped, "\u4e36"},
+	{0x2F03, idnaStatusMapped, "\u4e3f"},
+	{0x2F04, idnaStatusMapped, "\u4e59"},
+	{0x2F05, idnaStatusMapped, "\u4e85"},
+	{0x2F06, idnaStatusMapped, "\u4e8c"},
+	{0x2F07, idnaStatusMapped, "\u4ea0"},
+	{0x2F08, idnaStatusMapped, "\u4eba"},
+	{0x2F09, idnaStatusMapped, "\u513f"},
+	{0x2F0A, idnaStatusMapped, "\u5165"},
+	{0x2F0B, idnaStatusMapped, "\u516b"},
+	{0x2F0C, idnaStatusMapped, "\u5182"},
+	{0x2F0D, idnaStatusMapped, "\u5196"},
+	{0x2F0E, idnaStatusMapped, "\u51ab"},
+	{0x2F0F, idnaStatusMapped, "\u51e0"},
+	{0x2F10, idnaStatusMapped, "\u51f5"},
+	{0x2F11, idnaStatusMapped, "\u5200"},
+	{0x2F12, idnaStatusMapped, "\u529b"},
+	{0x2F13, idnaStatusMapped, "\u52f9"},
+	{0x2F14, idnaStatusMapped, "\u5315"},
+	{0x2F15, idnaStatusMapped, "\u531a"},
+	{0x2F16, idnaStatusMapped, "\u5338"},
+	{0x2F17, idnaStatusMapped, "\u5341"},
+	{0x2F18, idnaStatusMapped, "\u535c"},
+	{0x2F19, idnaStatusMapped, "\u5369"},
+	{0x2F1A, idnaStatusMapped, "\u5382"},
+	{0x2F1B, idnaStatusMapped, "\u53b6"},
+	{0x2F1C, idnaStatusMapped, "\u53c8"},
+	{0x2F1D, idnaStatusMapped, "\u53e3"},
+	{0x2F1E, idnaStatusMapped, "\u56d7"},
+	{0x2F1F, idnaStatusMapped, "\u571f"},
+	{0x2F20, idnaStatusMapped, "\u58eb"},
+	{0x2F21, idnaStatusMapped, "\u5902"},
+	{0x2F22, idnaStatusMapped, "\u590a"},
+	{0x2F23, idnaStatusMapped, "\u5915"},
+	{0x2F24, idnaStatusMapped, "\u5927"},
+	{0x2F25, idnaStatusMapped, "\u5973"},
+	{0x2F26, idnaStatusMapped, "\u5b50"},
+	{0x2F27, idnaStatusMapped, "\u5b80"},
+	{0x2F28, idnaStatusMapped, "\u5bf8"},
+	{0x2F29, idnaStatusMapped, "\u5c0f"},
+	{0x2F2A, idnaStatusMapped, "\u5c22"},
+	{0x2F2B, idnaStatusMapped, "\u5c38"},
+	{0x2F2C, idnaStatusMapped, "\u5c6e"},
+	{0x2F2D, idnaStatusMapped, "\u5c71"},
+	{0x2F2E, idnaStatusMapped, "\u5ddb"},
+	{0x2F2F, idnaStatusMapped, "\u5de5"},
+	{0x2F30, idnaStatusMapped, "\u5df1"},
+	{0x2F31, idnaStatusMapped, "\u5dfe"},
+	{0x2F32, idnaStatusMapped, "\u5e72"},
+	{0x2F33, idnaStatusMapped, "\u5e7a"},
+	{0x2F34, idnaStatusMapped, "\u5e7f"},
+	{0x2F35, idnaStatusMapped, "\u5ef4"},
+	{0x2F36, idnaStatusMapped, "\u5efe"},
+	{0x2F37, idnaStatusMapped, "\u5f0b"},
+	{0x2F38, idnaStatusMapped, "\u5f13"},
+	{0x2F39, idnaStatusMapped, "\u5f50"},
+	{0x2F3A, idnaStatusMapped, "\u5f61"},
+	{0x2F3B, idnaStatusMapped, "\u5f73"},
+	{0x2F3C, idnaStatusMapped, "\u5fc3"},
+	{0x2F3D, idnaStatusMapped, "\u6208"},
+	{0x2F3E, idnaStatusMapped, "\u6236"},
+	{0x2F3F, idnaStatusMapped, "\u624b"},
+	{0x2F40, idnaStatusMapped, "\u652f"},
+	{0x2F41, idnaStatusMapped, "\u6534"},
+	{0x2F42, idnaStatusMapped, "\u6587"},
+	{0x2F43, idnaStatusMapped, "\u6597"},
+	{0x2F44, idnaStatusMapped, "\u65a4"},
+	{0x2F45, idnaStatusMapped, "\u65b9"},
+	{0x2F46, idnaStatusMapped, "\u65e0"},
+	{0x2F47, idnaStatusMapped, "\u65e5"},
+	{0x2F48, idnaStatusMapped, "\u66f0"},
+	{0x2F49, idnaStatusMapped, "\u6708"},
+	{0x2F4A, idnaStatusMapped, "\u6728"},
+	{0x2F4B, idnaStatusMapped, "\u6b20"},
+	{0x2F4C, idnaStatusMapped, "\u6b62"},
+	{0x2F4D, idnaStatusMapped, "\u6b79"},
+	{0x2F4E, idnaStatusMapped, "\u6bb3"},
+	{0x2F4F, idnaStatusMapped, "\u6bcb"},
+	{0x2F50, idnaStatusMapped, "\u6bd4"},
+	{0x2F51, idnaStatusMapped, "\u6bdb"},
+	{0x2F52, idnaStatusMapped, "\u6c0f"},
+	{0x2F53, idnaStatusMapped, "\u6c14"},
+	{0x2F54, idnaStatusMapped, "\u6c34"},
+	{0x2F55, idnaStatusMapped, "\u706b"},
+	{0x2F56, idnaStatusMapped, "\u722a"},
+	{0x2F57, idnaStatusMapped, "\u7236"},
+	{0x2F58, idnaStatusMapped, "\u723b"},
+	{0x2F59, idnaStatusMapped, "\u723f"},
+	{0x2F5A, idnaStatusMapped, "\u7247"},
+	{0x2F5B, idnaStatusMapped, "\u7259"},
+	{0x2F5C, idnaStatusMapped, "\u725b"},
+	{0x2F5D, idnaStatusMapped, "\u72ac"},
+	{0x2F5E, idnaStatusMapped, "\u7384"},
+	{0x2F5F, idnaStatusMapped, "\u7389"},
+	{0x2F60, idnaStatusMapped, "\u74dc"},
+	{0x2F61, idnaStatusMapped, "\u74e6"},
+	{0x2F62, idnaStatusMapped, "\u7518"},
+	{0x2F63, idnaStatusMapped, "\u751f"},
+	{0x2F64, idnaStatusMapped, "\u7528"},
+	{0x2F65, idnaStatusMapped, "\u7530"},
+	{0x2F66, idnaStatusMapped, "\u758b"},
+	{0x2F67, idnaStatusMapped, "\u7592"},
+	{0x2F68, idnaStatusMapped, "\u7676"},
+	{0x2F69, idnaStatusMapped, "\u767d"},
+	{0x2F6A, idnaStatusMapped, "\u76ae"},
+	{0x2F6B, idnaStatusMapped, "\u76bf"},
+	{0x2F6C, idnaStatusMapped, "\u76ee"},
+	{0x2F6D, idnaStatusMapped, "\u77db"},
+	{0x2F6E, idnaStatusMapped, "\u77e2"},
+	{0x2F6F, idnaStatusMapped, "\u77f3"},
+	{0x2F70, idnaStatusMapped, "\u793a"},
+	{0x2F71, idnaStatusMapped, "\u79b8"},
+	{0x2F72, idnaStatusMapped, "\u79be"},
+	{0x2F73, idnaStatusMapped, "\u7a74"},
+	{0x2F74, idnaStatusMapped, "\u7acb"},
+	{0x2F75, idnaStatusMapped, "\u7af9"},
+	{0x2F76, idnaStatusMapped, "\u7c73"},
+	{0x2F77, idnaStatusMapped, "\u7cf8"},
+	{0x2F78, idnaStatusMapped, "\u7f36"},
+	{0x2F79, idnaStatusMapped, "\u7f51"},
+	{0x2F7A, idnaStatusMapped, "\u7f8a"},
+	{0x2F7B, idnaStatusMapped, "\u7fbd"},
+	{0x2F7C, idnaStatusMapped, "\u8001"},
+	{0x2F7D, idnaStatusMapped, "\u800c"},
+	{0x2F7E, idnaStatusMapped, "\u8012"},
+	{0x2F7F, idnaStatusMapped, "\u8033"},
+	{0x2F80, idnaStatusMapped, "\u807f"},
+	{0x2F81, idnaStatusMapped, "\u8089"},
+	{0x2F82, idnaStatusMapped, "\u81e3"},
+	{0x2F83, idnaStatusMapped, "\u81ea"},
+	{0x2F84, idnaStatusMapped, "\u81f3"},
+	{0x2F85, idnaStatusMapped, "\u81fc"},
+	{0x2F86, idnaStatusMapped, "\u820c"},
+	{0x2F87, idnaStatusMapped, "\u821b"},
+	{0x2F88, idnaStatusMapped, "\u821f"},
+	{0x2F89, idnaStatusMapped, "\u826e"},
+	{0x2F8A, idnaStatusMapped, "\u8272"},
+	{0x2F8B, idnaStatusMapped, "\u8278"},
+	{0x2F8C, idnaStatusMapped, "\u864d"},
+	{0x2F8D, idnaStatusMapped, "\u866b"},
+	{0x2F8E, idnaStatusMapped, "\u8840"},
+	{0x2F8F, idnaStatusMapped, "\u884c"},
+	{0x2F90, idnaStatusMapped, "\u8863"},
+	{0x2F91, idnaStatusMapped, "\u897e"},
+	{0x2F92, idnaStatusMapped, "\u898b"},
+	{0x2F93, idnaStatusMapped, "\u89d2"},
+	{0x2F94, idnaStatusMapped, "\u8a00"},
+	{0x2F95, idnaStatusMapped, "\u8c37"},
+	{0x2F96, idnaStatusMapped, "\u8c46"},
+	{0x2F97, idnaStatusMapped, "\u8c55"},
+	{0x2F98, idnaStatusMapped, "\u8c78"},
+	{0x2F99, idnaStatusMapped, "\u8c9d"},
+	{0x2F9A, idnaStatusMapped, "\u8d64"},
+	{0x2F9B, idnaStatusMapped, "\u8d70"},
+	{0x2F9C, idnaStatusMapped, "\u8db3"},
+	{0x2F9D, idnaStatusMapped, "\u8eab"},
+	{0x2F9E, idnaStatusMapped, "\u8eca"},
+	{0x2F9F, idnaStatusMapped, "\u8f9b"},
+	{0x2FA0, idnaStatusMapped, "\u8fb0"},
+	{0x2FA1, idnaStatusMapped, "\u8fb5"},
+	{0x2FA2, idnaStatusMapped, "\u9091"},
+	{0x2FA3, idnaStatusMapped, "\u9149"},
+	{0x2FA4, idnaStatusMapped, "\u91c6"},
+	{0x2FA5, idnaStatusMapped, "\u91cc"},
+	{0x2FA6, idnaStatusMapped, "\u91d1"},
+	{0x2FA7, idnaStatusMapped, "\u9577"},
+	{0x2FA8, idnaStatusMapped, "\u9580"},
+	{0x2FA9, idnaStatusMapped, "\u961c"},
+	{0x2FAA, idnaStatusMapped, "\u96b6"},
+	{0x2FAB, idnaStatusMapped, "\u96b9"},
+	{0x2FAC, idnaStatusMapped, "\u96e8"},
+	{0x2FAD, idnaStatusMapped, "\u9751"},
+	{0x2FAE, idnaStatusMapped, "\u975e"},
+	{0x2FAF, idnaStatusMapped, "\u9762"},
+	{0x2FB0, idnaStatusMapped, "\u9769"},
+	{0x2FB1, idnaStatusMapped, "\u97cb"},
+	{0x2FB2, idnaStatusMapped, "\u97ed"},
+	{0x2FB3, idnaStatusMapped, "\u97f3"},
+	{0x2FB4, idnaStatusMapped, "\u9801"},
+	{0x2FB5, idnaStatusMapped, "\u98a8"},
+	{0x2FB6, idnaStatusMapped, "\u98db"},
+	{0x2FB7, idnaStatusMapped, "\u98df"},
+	{0x2FB8, idnaStatusMapped, "\u9996"},
+	{0x2FB9, idnaStatusMapped, "\u9999"},
+	{0x2FBA, idnaStatusMapped, "\u99ac"},
+	{0x2FBB, idnaStatusMapped, "\u9aa8"},
+	{0x2FBC, idnaStatusMapped, "\u9ad8"},
+	{0x2FBD, idnaStatusMapped, "\u9adf"},
+	{0x2FBE, idnaStatusMapped, "\u9b25"},
+	{0x2FBF, idnaStatusMapped, "\u9b2f"},
+	{0x2FC0, idnaStatusMapped, "\u9b32"},
+	{0x2FC1, idnaStatusMapped, "\u9b3c"},
+	{0x2FC2, idnaStatusMapped, "\u9b5a"},
+	{0x2FC3, idnaStatusMapped, "\u9ce5"},
+	{0x2FC4, idnaStatusMapped, "\u9e75"},
+	{0x2FC5, idnaStatusMapped, "\u9e7f"},
+	{0x2FC6, idnaStatusMapped, "\u9ea5"},
+	{0x2FC7, idnaStatusMapped, "\u9ebb"},
+	{0x2FC8, idnaStatusMapped, "\u9ec3"},
+	{0x2FC9, idnaStatusMapped, "\u9ecd"},
+	{0x2FCA, idnaStatusMapped, "\u9ed1"},
+	{0x2FCB, idnaStatusMapped, "\u9ef9"},
+	{0x2FCC, idnaStatusMapped, "\u9efd"},
+	{0x2FCD, idnaStatusMapped, "\u9f0e"},
+	{0x2FCE, idnaStatusMapped, "\u9f13"},
+	{0x2FCF, idnaStatusMapped, "\u9f20"},
+	{0x2FD0, idnaStatusMapped, "\u9f3b"},
+	{0x2FD1, idnaStatusMapped, "\u9f4a"},
+	{0x2FD2, idnaStatusMapped, "\u9f52"},
+	{0x2FD3, idnaStatusMapped, "\u9f8d"},
+	{0x2FD4, idnaStatusMapped, "\u9f9c"},
+	{0x2FD5, idnaStatusMapped, "\u9fa0"},
+	{0x2FD6, idnaStatusDisallowed, ""},
+	{0x3000, idnaStatusMapped, " "},
+	{0x3001, idnaStatusValid, ""},
+	{0x3002, idnaStatusMapped, "."},
+	{0x3003, idnaStatusValid, ""},
+	{0x3036, idnaStatusMapped, "\u3012"},
+	{0x3037, idnaStatusValid, ""},
+	{0x3038, idnaStatusMapped, "\u5341"},
+	{0x3039, idnaStatusMapped, "\u5344"},
+	{0x303A, idnaStatusMapped, "\u5345"},
+	{0x303B, idnaStatusValid, ""},
+	{0x3040, idnaStatusDisallowed, ""},
+	{0x3041, idnaStatusValid, ""},
+	{0x3097, idnaStatusDisallowed, ""},
+	{0x3099, idnaStatusValid, ""},
+	{0x309B, idnaStatusMapped, " \u3099"},
+	{0x309C, idnaStatusMapped, " \u309a"},
+	{0x309D, idnaStatusValid, ""},
+	{0x309F, idnaStatusMapped, "\u3088\u308a"},
+	{0x30A0, idnaStatusValid, ""},
+	{0x30FF, idnaStatusMapped, "\u30b3\u30c8"},
+	{0x3100, idnaStatusDisallowed, ""},
+	{0x3105, idnaStatusValid, ""},
+	{0x3130, idnaStatusDisallowed, ""},
+	{0x3131, idnaStatusMapped, "\u1100"},
+	{0x3132, idnaStatusMapped, "\u1101"},
+	{0x3133, idnaStatusMapped, "\u11aa"},
+	{0x3134, idnaStatusMapped, "\u1102"},
+	{0x3135, idnaStatusMapped, "\u11ac"},
+	{0x3136, idnaStatusMapped, "\u11ad"},
+	{0x3137, idnaStatusMapped, "\u1103"},
+	{0x3138, idnaStatusMapped, "\u1104"},
+	{0x3139, idnaStatusMapped, "\u1105"},
+	{0x313A, idnaStatusMapped, "\u11b0"},
+	{0x313B, idnaStatusMapped, "\u11b1"},
+	{0x313C, idnaStatusMapped, "\u11b2"},
+	{0x313D, idnaStatusMapped, "\u11b3"},
+	{0x313E, idnaStatusMapped, "\u11b4"},
+	{0x313F, idnaStatusMapped, "\u11b5"},
+	{0x3140, idnaStatusMapped, "\u111a"},
+	{0x3141, idnaStatusMapped, "\u1106"},
+	{0x3142, idnaStatusMapped, "\u1107"},
+	{0x3143, idnaStatusMapped, "\u1108"},
+	{0x3144, idnaStatusMapped, "\u1121"},
+	{0x3145, idnaStatusMapped, "\u1109"},
+	{0x3146, idnaStatusMapped, "\u110a"},
+	{0x3147, idnaStatusMapped, "\u110b"},
+	{0x3148, idnaStatusMapped, "\u110c"},
+	{0x3149, idnaStatusMapped, "\u110d"},
+	{0x314A, idnaStatusMapped, "\u110e"},
+	{0x314B, idnaStatusMapped, "\u110f"},
+	{0x314C, idnaStatusMapped, "\u1110"},
+	{0x314D, idnaStatusMapped, "\u1111"},
+	{0x314E, idnaStatusMapped, "\u1112"},
+	{0x314F, idnaStatusMapped, "\u1161"},
+	{0x3150, idnaStatusMapped, "\u1162"},
+	{0x3151, idnaStatusMapped, "\u1163"},
+	{0x3152, idnaStatusMapped, "\u1164"},
+	{0x3153, idnaStatusMapped, "\u1165"},
+	{0x3154, idnaStatusMapped, "\u1166"},
+	{0x3155, idnaStatusMapped, "\u1167"},
+	{0x3156, idnaStatusMapped, "\u1168"},
+	{0x3157, idnaStatusMapped, "\u1169"},
+	{0x3158, idnaStatusMapped, "\u116a"},
+	{0x3159, idnaStatusMapped, "\u116b"},
+	{0x315A, idnaStatusMapped, "\u116c"},
+	{0x315B, idnaStatusMapped, "\u116d"},
+	{0x315C, idnaStatusMapped, "\u116e"},
+	{0x315D, idnaStatusMapped, "\u116f"},
+	{0x315E, idnaStatusMapped, "\u1170"},
+	{0x315F, idnaStatusMapped, "\u1171"},
+	{0x3160, idnaStatusMapped, "\u1172"},
+	{0x3161, idnaStatusMapped, "\u1173"},
+	{0x3162, idnaStatusMapped, "\u1174"},
+	{0x3163, idnaStatusMapped, "\u1175"},
+	{0x3164, idnaStatusIgnored, ""},
+	{0x3165, idnaStatusMapped, "\u1114"},
+	{0x3166, idnaStatusMapped, "\u1115"},
+	{0x3167, idnaStatusMapped, "\u11c7"},
+	{0x3168, idnaStatusMapped, "\u11c8"},
+	{0x3169, idnaStatusMapped, "\u11cc"},
+	{0x316A, idnaStatusMapped, "\u11ce"},
+	{0x316B, idnaStatusMapped, "\u11d3"},
+	{0x316C, idnaStatusMapped, "\u11d7"},
+	{0x316D, idnaStatusMapped, "\u11d9"},
+	{0x316E, idnaStatusMapped, "\u111c"},
+	{0x316F, idnaStatusMapped, "\u11dd"},
+	{0x3170, idnaStatusMapped, "\u11df"},
+	{0x3171, idnaStatusMapped, "\u111d"},
+	{0x3172, idnaStatusMapped, "\u111e"},
+	{0x3173, idnaStatusMapped, "\u1120"},
+	{0x3174, idnaStatusMapped, "\u1122"},
+	{0x3175, idnaStatusMapped, "\u1123"},
+	{0x3176, idnaStatusMapped, "\u1127"},
+	{0x3177, idnaStatusMapped, "\u1129"},
+	{0x3178, idnaStatusMapped, "\u112b"},
+	{0x3179, idnaStatusMapped, "\u112c"},
+	{0x317A, idnaStatusMapped, "\u112d"},
+	{0x317B, idnaStatusMapped, "\u112e"},
+	{0x317C, idnaStatusMapped, "\u112f"},
+	{0x317D, idnaStatusMapped, "\u1132"},
+	{0x317E, idnaStatusMapped, "\u1136"},
+	{0x317F, idnaStatusMapped, "\u1140"},
+	{0x3180, idnaStatusMapped, "\u1147"},
+	{0x3181, idnaStatusMapped, "\u114c"},
+	{0x3182, idnaStatusMapped, "\u11f1"},
+	{0x3183, idnaStatusMapped, "\u11f2"},
+	{0x3184, idnaStatusMapped, "\u1157"},
+	{0x3185, idnaStatusMapped, "\u1158"},
+	{0x3186, idnaStatusMapped, "\u1159"},
+	{0x3187, idnaStatusMapped, "\u1184"},
+	{0x3188, idnaStatusMapped, "\u1185"},
+	{0x3189, idnaStatusMapped, "\u1188"},
+	{0x318A, idnaStatusMapped, "\u1191"},
+	{0x318B, idnaStatusMapped, "\u1192"},
+	{0x318C, idnaStatusMapped, "\u1194"},
+	{0x318D, idnaStatusMapped, "\u119e"},
+	{0x318E, idnaStatusMapped, "\u11a1"},
+	{0x318F, idnaStatusDisallowed, ""},
+	{0x3190, idnaStatusValid, ""},
+	{0x3192, idnaStatusMapped, "\u4e00"},
+	{0x3193, idnaStatusMapped, "\u4e8c"},
+	{0x3194, idnaStatusMapped, "\u4e09"},
+	{0x3195, idnaStatusMapped, "\u56db"},
+	{0x3196, idnaStatusMapped, "\u4e0a"},
+	{0x3197, idnaStatusMapped, "\u4e2d"},
+	{0x3198, idnaStatusMapped, "\u4e0b"},
+	{0x3199, idnaStatusMapped, "\u7532"},
+	{0x319A, idnaStatusMapped, "\u4e59"},
+	{0x319B, idnaStatusMapped, "\u4e19"},
+	{0x319C, idnaStatusMapped, "\u4e01"},
+	{0x319D, idnaStatusMapped, "\u5929"},
+	{0x319E, idnaStatusMapped, "\u5730"},
+	{0x319F, idnaStatusMapped, "\u4eba"},
+	{0x31A0, idnaStatusValid, ""},
+	{0x31E6, idnaStatusDisallowed, ""},
+	{0x31F0, idnaStatusValid, ""},
+	{0x3200, idnaStatusMapped, "(\u1100)"},
+	{0x3201, idnaStatusMapped, "(\u1102)"},
+	{0x3202, idnaStatusMapped, "(\u1103)"},
+	{0x3203, idnaStatusMapped, "(\u1105)"},
+	{0x3204, idnaStatusMapped, "(\u1106)"},
+	{0x3205, idnaStatusMapped, "(\u1107)"},
+	{0x3206, idnaStatusMapped, "(\u1109)"},
+	{0x3207, idnaStatusMapped, "(\u110b)"},
+	{0x3208, idnaStatusMapped, "(\u110c)"},
+	{0x3209, idnaStatusMapped, "(\u110e)"},
+	{0x320A, idnaStatusMapped, "(\u110f)"},
+	{0x320B, idnaStatusMapped, "(\u1110)"},
+	{0x320C, idnaStatusMapped, "(\u1111)"},
+	{0x320D, idnaStatusMapped, "(\u1112)"},
+	{0x320E, idnaStatusMapped, "(\uac00)"},
+	{0x320F, idnaStatusMapped, "(\ub098)"},
+	{0x3210, idnaStatusMapped, "(\ub2e4)"},
+	{0x3211, idnaStatusMapped, "(\ub77c)"},
+	{0x3212, idnaStatusMapped, "(\ub9c8)"},
+	{0x3213, idnaStatusMapped, "(\ubc14)"},
+	{0x3214, idnaStatusMapped, "(\uc0ac)"},
+	{0x3215, idnaStatusMapped, "(\uc544)"},
+	{0x3216, idnaStatusMapped, "(\uc790)"},
+	{0x3217, idnaStatusMapped, "(\ucc28)"},
+	{0x3218, idnaStatusMapped, "(\uce74)"},
+	{0x3219, idnaStatusMapped, "(\ud0c0)"},
+	{0x321A, idnaStatusMapped, "(\ud30c)"},
+	{0x321B, idnaStatusMapped, "(\ud558)"},
+	{0x321C, idnaStatusMapped, "(\uc8fc)"},
+	{0x321D, idnaStatusMapped, "(\uc624\uc804)"},
+	{0x321E, idnaStatusMapped, "(\uc624\ud6c4)"},
+	{0x321F, idnaStatusDisallowed, ""},
+	{0x3220, idnaStatusMapped, "(\u4e00)"},
+	{0x3221, idnaStatusMapped, "(\u4e8c)"},
+	{0x3222, idnaStatusMapped, "(\u4e09)"},
+	{0x3223, idnaStatusMapped, "(\u56db)"},
+	{0x3224, idnaStatusMapped, "(\u4e94)"},
+	{0x3225, idnaStatusMapped, "(\u516d)"},
+	{0x3226, idnaStatusMapped, "(\u4e03)"},
+	{0x3227, idnaStatusMapped, "(\u516b)"},
+	{0x3228, idnaStatusMapped, "(\u4e5d)"},
+	{0x3229, idnaStatusMapped, "(\u5341)"},
+	{0x322A, idnaStatusMapped, "(\u6708)"},
+	{0x322B, idnaStatusMapped, "(\u706b)"},
+	{0x322C, idnaStatusMapped, "(\u6c34)"},
+	{0x322D, idnaStatusMapped, "(\u6728)"},
+	{0x322E, idnaStatusMapped, "(\u91d1)"},
+	{0x322F, idnaStatusMapped, "(\u571f)"},
+	{0x3230, idnaStatusMapped, "(\u65e5)"},
+	{0x3231, idnaStatusMapped, "(\u682a)"},
+	{0x3232, idnaStatusMapped, "(\u6709)"},
+	{0x3233, idnaStatusMapped, "(\u793e)"},
+	{0x3234, idnaStatusMapped, "(\u540d)"},
+	{0x3235, idnaStatusMapped, "(\u7279)"},
+	{0x3236, idnaStatusMapped, "(\u8ca1)"},
+	{0x3237, idnaStatusMapped, "(\u795d)"},
+	{0x3238, idnaStatusMapped, "(\u52b4)"},
+	{0x3239, idnaStatusMapped, "(\u4ee3)"},
+	{0x323A, idnaStatusMapped, "(\u547c)"},
+	{0x323B, idnaStatusMapped, "(\u5b66)"},
+	{0x323C, idnaStatusMapped, "(\u76e3)"},
+	{0x323D, idnaStatusMapped, "(\u4f01)"},
+	{0x323E, idnaStatusMapped, "(\u8cc7)"},
+	{0x323F, idnaStatusMapped, "(\u5354)"},
+	{0x3240, idnaStatusMapped, "(\u796d)"},
+	{0x3241, idnaStatusMapped, "(\u4f11)"},
+	{0x3242, idnaStatusMapped, "(\u81ea)"},
+	{0x3243, idnaStatusMapped, "(\u81f3)"},
+	{0x3244, idnaStatusMapped, "\u554f"},
+	{0x3245, idnaStatusMapped, "\u5e7c"},
+	{0x3246, idnaStatusMapped, "\u6587"},
+	{0x3247, idnaStatusMapped, "\u7b8f"},
+	{0x3248, idnaStatusValid, ""},
+	{0x3250, idnaStatusMapped, "pte"},
+	{0x3251, idnaStatusMapped, "21"},
+	{0x3252, idnaStatusMapped, "22"},
+	{0x3253, idnaStatusMapped, "23"},
+	{0x3254, idnaStatusMapped, "24"},
+	{0x3255, idnaStatusMapped, "25"},
+	{0x3256, idnaStatusMapped, "26"},
+	{0x3257, idnaStatusMapped, "27"},
+	{0x3258, idnaStatusMapped, "28"},
+	{0x3259, idnaStatusMapped, "29"},
+	{0x325A, idnaStatusMapped, "30"},
+	{0x325B, idnaStatusMapped, "31"},
+	{0x325C, idnaStatusMapped, "32"},
+	{0x325D, idnaStatusMapped, "33"},
+	{0x325E, idnaStatusMapped, "34"},
+	{0x325F, idnaStatusMapped, "35"},
+	{0x3260, idnaStatusMapped, "\u1100"},
+	{0x3261, idnaStatusMapped, "\u1102"},
+	{0x3262, idnaStatusMapped, "\u1103"},
+	{0x3263, idnaStatusMapped, "\u1105"},
+	{0x3264, idnaStatusMapped, "\u1106"},
+	{0x3265, idnaStatusMapped, "\u1107"},
+	{0x3266, idnaStatusMapped, "\u1109"},
+	{0x3267, idnaStatusMapped, "\u110b"},
+	{0x3268, idnaStatusMapped, "\u110c"},
+	{0x3269, idnaStatusMapped, "\u110e"},
+	{0x326A, idnaStatusMapped, "\u110f"},
+	{0x326B, idnaStatusMapped, "\u1110"},
+	{0x326C, idnaStatusMapped, "\u1111"},
+	{0x326D, idnaStatusMapped, "\u1112"},
+	{0x326E, idnaStatusMapped, "\uac00"},
+	{0x326F, idnaStatusMapped, "\ub098"},
+	{0x3270, idnaStatusMapped, "\ub2e4"},
+	{0x3271, idnaStatusMapped, "\ub77c"},
+	{0x3272, idnaStatusMapped, "\ub9c8"},
+	{0x3273, idnaStatusMapped, "\ubc14"},
+	{0x3274, idnaStatusMapped, "\uc0ac"},
+	{0x3275, idnaStatusMapped, "\uc544"},
+	{0x3276, idnaStatusMapped, "\uc790"},
+	{0x3277, idnaStatusMapped, "\ucc28"},
+	{0x3278, idnaStatusMapped, "\uce74"},
+	{0x3279, idnaStatusMapped, "\ud0c0"},
+	{0x327A, idnaStatusMapped, "\ud30c"},
+	{0x327B, idnaStatusMapped, "\ud558"},
+	{0x327C, idnaStatusMapped, "\ucc38\uace0"},
+	{0x327D, idnaStatusMapped, "\uc8fc\uc758"},
+	{0x327E, idnaStatusMapped, "\uc6b0"},
+	{0x327F, idnaStatusValid, ""},
+	{0x3280, idnaStatusMapped, "\u4e00"},
+	{0x3281, idnaStatusMapped, "\u4e8c"},
+	{0x3282, idnaStatusMapped, "\u4e09"},
+	{0x3283, idnaStatusMapped, "\u56db"},
+	{0x3284, idnaStatusMapped, "\u4e94"},
+	{0x3285, idnaStatusMapped, "\u516d"},
+	{0x3286, idnaStatusMapped, "\u4e03"},
+	{0x3287, idnaStatusMapped, "\u516b"},
+	{0x3288, idnaStatusMapped, "\u4e5d"},
+	{0x3289, idnaStatusMapped, "\u5341"},
+	{0x328A, idnaStatusMapped, "\u6708"},
+	{0x328B, idnaStatusMapped, "\u706b"},
+	{0x328C, idnaStatusMapped, "\u6c34"},
+	{0x328D, idnaStatusMapped, "\u6728"},
+	{0x328E, idnaStatusMapped, "\u91d1"},
+	{0x328F, idnaStatusMapped, "\u571f"},
+	{0x3290, idnaStatusMapped, "\u65e5"},
+	{0x3291, idnaStatusMapped, "\u682a"},
+	{0x3292, idnaStatusMapped, "\u6709"},
+	{0x3293, idnaStatusMapped, "\u793e"},
+	{0x3294, idnaStatusMapped, "\u540d"},
+	{0x3295, idnaStatusMapped, "\u7279"},
+	{0x3296, idnaStatusMapped, "\u8ca1"},
+	{0x3297, idnaStatusMapped, "\u795d"},
+	{0x3298, idnaStatusMapped, "\u52b4"},
+	{0x3299, idnaStatusMapped, "\u79d8"},
+	{0x329A, idnaStatusMapped, "\u7537"},
+	{0x329B, idnaStatusMapped, "\u5973"},
+	{0x329C, idnaStatusMapped, "\u9069"},
+	{0x329D, idnaStatusMapped, "\u512a"},
+	{0x329E, idnaStatusMapped, "\u5370"},
+	{0x329F, idnaStatusMapped, "\u6ce8"},
+	{0x32A0, idnaStatusMapped, "\u9805"},
+	{0x32A1, idnaStatusMapped, "\u4f11"},
+	{0x32A2, idnaStatusMapped, "\u5199"},
+	{0x32A3, idnaStatusMapped, "\u6b63"},
+	{0x32A4, idnaStatusMapped, "\u4e0a"},
+	{0x32A5, idnaStatusMapped, "\u4e2d"},
+	{0x32A6, idnaStatusMapped, "\u4e0b"},
+	{0x32A7, idnaStatusMapped, "\u5de6"},
+	{0x32A8, idnaStatusMapped, "\u53f3"},
+	{0x32A9, idnaStatusMapped, "\u533b"},
+	{0x32AA, idnaStatusMapped, "\u5b97"},
+	{0x32AB, idnaStatusMapped, "\u5b66"},
+	{0x32AC, idnaStatusMapped, "\u76e3"},
+	{0x32AD, idnaStatusMapped, "\u4f01"},
+	{0x32AE, idnaStatusMapped, "\u8cc7"},
+	{0x32AF, idnaStatusMapped, "\u5354"},
+	{0x32B0, idnaStatusMapped, "\u591c"},
+	{0x32B1, idnaStatusMapped, "36"},
+	{0x32B2, idnaStatusMapped, "37"},
+	{0x32B3, idnaStatusMapped, "38"},
+	{0x32B4, idnaStatusMapped, "39"},
+	{0x32B5, idnaStatusMapped, "40"},
+	{0x32B6, idnaStatusMapped, "41"},
+	{0x32B7, idnaStatusMapped, "42"},
+	{0x32B8, idnaStatusMapped, "43"},
+	{0x32B9, idnaStatusMapped, "44"},
+	{0x32BA, idnaStatusMapped, "45"},
+	{0x32BB, idnaStatusMapped, "46"},
+	{0x32BC, idnaStatusMapped, "47"},
+	{0x32BD, idnaStatusMapped, "48"},
+	{0x32BE, idnaStatusMapped, "49"},
+	{0x32BF, idnaStatusMapped, "50"},
+	{0x32C0, idnaStatusMapped, "1\u6708"},
+	{0x32C1, idnaStatusMapped, "2\u6708"},
+	{0x32C2, idnaStatusMapped, "3\u6708"},
+	{0x32C3, idnaStatusMapped, "4\u6708"},
+	{0x32C4, idnaStatusMapped, "5\u6708"},
+	{0x32C5, idnaStatusMapped, "6\u6708"},
+	{0x32C6, idnaStatusMapped, "7\u6708"},
+	{0x32C7, idnaStatusMapped, "8\u6708"},
+	{0x32C8, idnaStatusMapped, "9\u6708"},
+	{0x32C9, idnaStatusMapped, "10\u6708"},
+	{0x32CA, idnaStatusMapped, "11\u6708"},
+	{0x32CB, idnaStatusMapped, "12\u6708"},
+	{0x32CC, idnaStatusMapped, "hg"},
+	{0x32CD, idnaStatusMapped, "erg"},
+	{0x32CE, idnaStatusMapped, "ev"},
+	{0x32CF, idnaStatusMapped, "ltd"},
+	{0x32D0, idnaStatusMapped, "\u30a2"},
+	{0x32D1, idnaStatusMapped, "\u30a4"},
+	{0x32D2, idnaStatusMapped, "\u30a6"},
+	{0x32D3, idnaStatusMapped, "\u30a8"},
+	{0x32D4, idnaStatusMapped, "\u30aa"},
+	{0x32D5, idnaStatusMapped, "\u30ab"},
+	{0x32D6, idnaStatusMapped, "\u30ad"},
+	{0x32D7, idnaStatusMapped, "\u30af"},
+	{0x32D8, idnaStatusMapped, "\u30b1"},
+	{0x32D9, idnaStatusMapped, "\u30b3"},
+	{0x32DA, idnaStatusMapped, "\u30b5"},
+	{0x32DB, idnaStatusMapped, "\u30b7"},
+	{0x32DC, idnaStatusMapped, "\u30b9"},
+	{0x32DD, idnaStatusMapped, "\u30bb"},
+	{0x32DE, idnaStatusMapped, "\u30bd"},
+	{0x32DF, idnaStatusMapped, "\u30bf"},
+	{0x32E0, idnaStatusMapped, "\u30c1"},
+	{0x32E1, idnaStatusMapped, "\u30c4"},
+	{0x32E2, idnaStatusMapped, "\u30c6"},
+	{0x32E3, idnaStatusMapped, "\u30c8"},
+	{0x32E4, idnaStatusMapped, "\u30ca"},
+	{0x32E5, idnaStatusMapped, "\u30cb"},
+	{0x32E6, idnaStatusMapped, "\u30cc"},
+	{0x32E7, idnaStatusMapped, "\u30cd"},
+	{0x32E8, idnaStatusMapped, "\u30ce"},
+	{0x32E9, idnaStatusMapped, "\u30cf"},
+	{0x32EA, idnaStatusMapped, "\u30d2"},
+	{0x32EB, idnaStatusMapped, "\u30d5"},
+	{0x32EC, idnaStatusMapped, "\u30d8"},
+	{0x32ED, idnaStatusMapped, "\u30db"},
+	{0x32EE, idnaStatusMapped, "\u30de"},
+	{0x32EF, idnaStatusMapped, "\u30df"},
+	{0x32F0, idnaStatusMapped, "\u30e0"},
+	{0x32F1, idnaStatusMapped, "\u30e1"},
+	{0x32F2, idnaStatusMapped, "\u30e2"},
+	{0x32F3, idnaStatusMapped, "\u30e4"},
+	{0x32F4, idnaStatusMapped, "\u30e6"},
+	{0x32F5, idnaStatusMapped, "\u30e8"},
+	{0x32F6, idnaStatusMapped, "\u30e9"},
+	{0x32F7, idnaStatusMapped, "\u30ea"},
+	{0x32F8, idnaStatusMapped, "\u30eb"},
+	{0x32F9, idnaStatusMapped, "\u30ec"},
+	{0x32FA, idnaStatusMapped, "\u30ed"},
+	{0x32FB, idnaStatusMapped, "\u30ef"},
+	{0x32FC, idnaStatusMapped, "\u30f0"},
+	{0x32FD, idnaStatusMapped, "\u30f1"},
+	{0x32FE, idnaStatusMapped, "\u30f2"},
+	{0x32FF, idnaStatusMapped, "\u4ee4\u548c"},
+	{0x3300, idnaStatusMapped, "\u30a2\u30d1\u30fc\u30c8"},
+	{0x3301, idnaStatusMapped, "\u30a2\u30eb\u30d5\u30a1"},
+	{0x3302, idnaStatusMapped, "\u30a2\u30f3\u30da\u30a2"},
+	{0x3303, idnaStatusMapped, "\u30a2\u30fc\u30eb"},
+	{0x3304, idnaStatusMapped, "\u30a4\u30cb\u30f3\u30b0"},
+	{0x3305, idnaStatusMapped, "\u30a4\u30f3\u30c1"},
+	{0x3306, idnaStatusMapped, "\u30a6\u30a9\u30f3"},
+	{0x3307, idnaStatusMapped, "\u30a8\u30b9\u30af\u30fc\u30c9"},
+	{0x3308, idnaStatusMapped, "\u30a8\u30fc\u30ab\u30fc"},
+	{0x3309, idnaStatusMapped, "\u30aa\u30f3\u30b9"},
+	{0x330A, idnaStatusMapped, "\u30aa\u30fc\u30e0"},
+	{0x330B, idnaStatusMapped, "\u30ab\u30a4\u30ea"},
+	{0x330C, idnaStatusMapped, "\u30ab\u30e9\u30c3\u30c8"},
+	{0x330D, idnaStatusMapped, "\u30ab\u30ed\u30ea\u30fc"},
+	{0x330E, idnaStatusMapped, "\u30ac\u30ed\u30f3"},
+	{0x330F, idnaStatusMapped, "\u30ac\u30f3\u30de"},
+	{0x3310, idnaStatusMapped, "\u30ae\u30ac"},
+	{0x3311, idnaStatusMapped, "\u30ae\u30cb\u30fc"},
+	{0x3312, idnaStatusMapped, "\u30ad\u30e5\u30ea\u30fc"},
+	{0x3313, idnaStatusMapped, "\u30ae\u30eb\u30c0\u30fc"},
+	{0x3314, idnaStatusMapped, "\u30ad\u30ed"},
+	{0x3315, idnaStatusMapped, "\u30ad\u30ed\u30b0\u30e9\u30e0"},
+	{0x3316, idnaStatusMapped, "\u30ad\u30ed\u30e1\u30fc\u30c8\u30eb"},
+	{0x3317, idnaStatusMapped, "\u30ad\u30ed\u30ef\u30c3\u30c8"},
+	{0x3318, idnaStatusMapped, "\u30b0\u30e9\u30e0"},
+	{0x3319, idnaStatusMapped, "\u30b0\u30e9\u30e0\u30c8\u30f3"},
+	{0x331A, idnaStatusMapped, "\u30af\u30eb\u30bc\u30a4\u30ed"},
+	{0x331B, idnaStatusMapped, "\u30af\u30ed\u30fc\u30cd"},
+	{0x331C, idnaStatusMapped, "\u30b1\u30fc\u30b9"},
+	{0x331D, idnaStatusMapped, "\u30b3\u30eb\u30ca"},
+	{0x331E, idnaStatusMapped, "\u30b3\u30fc\u30dd"},
+	{0x331F, idnaStatusMapped, "\u30b5\u30a4\u30af\u30eb"},
+	{0x3320, idnaStatusMapped, "\u30b5\u30f3\u30c1\u30fc\u30e0"},
+	{0x3321, idnaStatusMapped, "\u30b7\u30ea\u30f3\u30b0"},
+	{0x3322, idnaStatusMapped, "\u30bb\u30f3\u30c1"},
+	{0x3323, idnaStatusMapped, "\u30bb\u30f3\u30c8"},
+	{0x3324, idnaStatusMapped, "\u30c0\u30fc\u30b9"},
+	{0x3325, idnaStatusMapped, "\u30c7\u30b7"},
+	{0x3326, idnaStatusMapped, "\u30c9\u30eb"},
+	{0x3327, idnaStatusMapped, "\u30c8\u30f3"},
+	{0x3328, idnaStatusMapped, "\u30ca\u30ce"},
+	{0x3329, idnaStatusMapped, "\u30ce\u30c3\u30c8"},
+	{0x332A, idnaStatusMapped, "\u30cf\u30a4\u30c4"},
+	{0x332B, idnaStatusMapped, "\u30d1\u30fc\u30bb\u30f3\u30c8"},
+	{0x332C, idnaStatusMapped, "\u30d1\u30fc\u30c4"},
+	{0x332D, idnaStatusMapped, "\u30d0\u30fc\u30ec\u30eb"},
+	{0x332E, idnaStatusMapped, "\u30d4\u30a2\u30b9\u30c8\u30eb"},
+	{0x332F, idnaStatusMapped, "\u30d4\u30af\u30eb"},
+	{0x3330, idnaStatusMapped, "\u30d4\u30b3"},
+	{0x3331, idnaStatusMapped, "\u30d3\u30eb"},
+	{0x3332, idnaStatusMapped, "\u30d5\u30a1\u30e9\u30c3\u30c9"},
+	{0x3333, idnaStatusMapped, "\u30d5\u30a3\u30fc\u30c8"},
+	{0x3334, idnaStatusMapped, "\u30d6\u30c3\u30b7\u30a7\u30eb"},
+	{0x3335, idnaStatusMapped, "\u30d5\u30e9\u30f3"},
+	{0x3336, idnaStatusMapped, "\u30d8\u30af\u30bf\u30fc\u30eb"},
+	{0x3337, idnaStatusMapped, "\u30da\u30bd"},
+	{0x3338, idnaStatusMapped, "\u30da\u30cb\u30d2"},
+	{0x3339, idnaStatusMapped, "\u30d8\u30eb\u30c4"},
+	{0x333A, idnaStatusMapped, "\u30da\u30f3\u30b9"},
+	{0x333B, idnaStatusMapped, "\u30da\u30fc\u30b8"},
+	{0x333C, idnaStatusMapped, "\u30d9\u30fc\u30bf"},
+	{0x333D, idnaStatusMapped, "\u30dd\u30a4\u30f3\u30c8"},
+	{0x333E, idnaStatusMapped, "\u30dc\u30eb\u30c8"},
+	{0x333F, idnaStatusMapped, "\u30db\u30f3"},
+	{0x3340, idnaStatusMapped, "\u30dd\u30f3\u30c9"},
+	{0x3341, idnaStatusMapped, "\u30db\u30fc\u30eb"},
+	{0x3342, idnaStatusMapped, "\u30db\u30fc\u30f3"},
+	{0x3343, idnaStatusMapped, "\u30de\u30a4\u30af\u30ed"},
+	{0x3344, idnaStatusMapped, "\u30de\u30a4\u30eb"},
+	{0x3345, idnaStatusMapped, "\u30de\u30c3\u30cf"},
+	{0x3346, idnaStatusMapped, "\u30de\u30eb\u30af"},
+	{0x3347, idnaStatusMapped, "\u30de\u30f3\u30b7\u30e7\u30f3"},
+	{0x3348, idnaStatusMapped, "\u30df\u30af\u30ed\u30f3"},
+	{0x3349, idnaStatusMapped, "\u30df\u30ea"},
+	{0x334A, idnaStatusMapped, "\u30df\u30ea\u30d0\u30fc\u30eb"},
+	{0x334B, idnaStatusMapped, "\u30e1\u30ac"},
+	{0x334C, idnaStatusMapped, "\u30e1\u30ac\u30c8\u30f3"},
+	{0x334D, idnaStatusMapped, "\u30e1\u30fc\u30c8\u30eb"},
+	{0x334E, idnaStatusMapped, "\u30e4\u30fc\u30c9"},
+	{0x334F, idnaStatusMapped, "\u30e4\u30fc\u30eb"},
+	{0x3350, idnaStatusMapped, "\u30e6\u30a2\u30f3"},
+	{0x3351, idnaStatusMapped, "\u30ea\u30c3\u30c8\u30eb"},
+	{0x3352, idnaStatusMapped, "\u30ea\u30e9"},
+	{0x3353, idnaStatusMapped, "\u30eb\u30d4\u30fc"},
+	{0x3354, idnaStatusMapped, "\u30eb\u30fc\u30d6\u30eb"},
+	{0x3355, idnaStatusMapped, "\u30ec\u30e0"},
+	{0x3356, idnaStatusMapped, "\u30ec\u30f3\u30c8\u30b2\u30f3"},
+	{0x3357, idnaStatusMapped, "\u30ef\u30c3\u30c8"},
+	{0x3358, idnaStatusMapped, "0\u70b9"},
+	{0x3359, idnaStatusMapped, "1\u70b9"},
+	{0x335A, idnaStatusMapped, "2\u70b9"},
+	{0x335B, idnaStatusMapped, "3\u70b9"},
+	{0x335C, idnaStatusMapped, "4\u70b9"},
+	{0x335D, idnaStatusMapped, "5\u70b9"},
+	{0x335E, idnaStatusMapped, "6\u70b9"},
+	{0x335F, idnaStatusMapped, "7\u70b9"},
+	{0x3360, idnaStatusMapped, "8\u70b9"},
+	{0x3361, idnaStatusMapped, "9\u70b9"},
+	{0x3362, idnaStatusMapped, "10\u70b9"},
+	{0x3363, idnaStatusMapped, "11\u70b9"},
+	{0x3364, idnaStatusMapped, "12\u70b9"},
+	{0x3365, idnaStatusMapped, "13\u70b9"},
+	{0x3366, idnaStatusMapped, "14\u70b9"},
+	{0x3367, idnaStatusMapped, "15\u70b9"},
+	{0x3368, idnaStatusMapped, "16\u70b9"},
+	{0x3369, idnaStatusMapped, "17\u70b9"},
+	{0x336A, idnaStatusMapped, "18\u70b9"},
+	{0x336B, idnaStatusMapped, "19\u70b9"},
+	{0x336C, idnaStatusMapped, "20\u70b9"},
+	{0x336D, idnaStatusMapped, "21\u70b9"},
+	{0x336E, idnaStatusMapped, "22\u70b9"},
+	{0x336F, idnaStatusMapped, "23\u70b9"},
+	{0x3370, idnaStatusMapped, "24\u70b9"},
+	{0x3371, idnaStatusMapped, "hpa"},
+	{0x3372, idnaStatusMapped, "da"},
+	{0x3373, idnaStatusMapped, "au"},
+	{0x3374, idnaStatusMapped, "bar"},
+	{0x3375, idnaStatusMapped, "ov"},
+	{0x3376, idnaStatusMapped, "pc"},
+	{0x3377, idnaStatusMapped, "dm"},
+	{0x3378, idnaStatusMapped, "dm2"},
+	{0x3379, idnaStatusMapped, "dm3"},
+	{0x337A, idnaStatusMapped, "iu"},
+	{0x337B, idnaStatusMapped, "\u5e73\u6210"},
+	{0x337C, idnaStatusMapped, "\u662d\u548c"},
+	{0x337D, idnaStatusMapped, "\u5927\u6b63"},
+	{0x337E, idnaStatusMapped, "\u660e\u6cbb"},
+	{0x337F, idnaStatusMapped, "\u682a\u5f0f\u4f1a\u793e"},
+	{0x3380, idnaStatusMapped, "pa"},
+	{0x3381, idnaStatusMapped, "na"},
+	{0x3382, idnaStatusMapped, "\u03bca"},
+	{0x3383, idnaStatusMapped, "ma"},
+	{0x3384, idnaStatusMapped, "ka"},
+	{0x3385, idnaStatusMapped, "kb"},
+	{0x3386, idnaStatusMapped, "mb"},
+	{0x3387, idnaStatusMapped, "gb"},
+	{0x3388, idnaStatusMapped, "cal"},
+	{0x3389, idnaStatusMapped, "kcal"},
+	{0x338A, idnaStatusMapped, "pf"},
+	{0x338B, idnaStatusMapped, "nf"},
+	{0x338C, idnaStatusMapped, "\u03bcf"},
+	{0x338D, idnaStatusMapped, "\u03bcg"},
+	{0x338E, idnaStatusMapped, "mg"},
+	{0x338F, idnaStatusMapped, "kg"},
+	{0x3390, idnaStatusMapped, "hz"},
+	{0x3391, idnaStatusMapped, "khz"},
+	{0x3392, idnaStatusMapped, "mhz"},
+	{0x3393, idnaStatusMapped, "ghz"},
+	{0x3394, idnaStatusMapped, "thz"},
+	{0x3395, idnaStatusMapped, "\u03bcl"},
+	{0x3396, idnaStatusMapped, "ml"},
+	{0x3397, idnaStatusMapped, "dl"},
+	{0x3398, idnaStatusMapped, "kl"},
+	{0x3399, idnaStatusMapped, "fm"},
+	{0x339A, idnaStatusMapped, "nm"},
+	{0x339B, idnaStatusMapped, "\u03bcm"},
+	{0x339C, idnaStatusMapped, "mm"},
+	{0x339D, idnaStatusMapped, "cm"},
+	{0x339E, idnaStatusMapped, "km"},
+	{0x339F, idnaStatusMapped, "mm2"},
+	{0x33A0, idnaStatusMapped, "cm2"},
+	{0x33A1, idnaStatusMapped, "m2"},
+	{0x33A2, idnaStatusMapped, "km2"},
+	{0x33A3, idnaStatusMapped, "mm3"},
+	{0x33A4, idnaStatusMapped, "cm3"},
+	{0x33A5, idnaStatusMapped, "m3"},
+	{0x33A6, idnaStatusMapped, "km3"},
+	{0x33A7, idnaStatusMapped, "m\u2215s"},
+	{0x33A8, idnaStatusMapped, "m\u2215s2"},
+	{0x33A9, idnaStatusMapped, "pa"},
+	{0x33AA, idnaStatusMapped, "kpa"},
+	{0x33AB, idnaStatusMapped, "mpa"},
+	{0x33AC, idnaStatusMapped, "gpa"},
+	{0x33AD, idnaStatusMapped, "rad"},
+	{0x33AE, idnaStatusMapped, "rad\u2215s"},
+	{0x33AF, idnaStatusMapped, "rad\u2215s2"},
+	{0x33B0, idnaStatusMapped, "ps"},
+	{0x33B1, idnaStatusMapped, "ns"},
+	{0x33B2, idnaStatusMapped, "\u03bcs"},
+	{0x33B3, idnaStatusMapped, "ms"},
+	{0x33B4, idnaStatusMapped, "pv"},
+	{0x33B5, idnaStatusMapped, "nv"},
+	{0x33B6, idnaStatusMapped, "\u03bcv"},
+	{0x33B7, idnaStatusMapped, "mv"},
+	{0x33B8, idnaStatusMapped, "kv"},
+	{0x33B9, idnaStatusMapped, "mv"},
+	{0x33BA, idnaStatusMapped, "pw"},
+	{0x33BB, idnaStatusMapped, "nw"},
+	{0x33BC, idnaStatusMapped, "\u03bcw"},
+	{0x33BD, idnaStatusMapped, "mw"},
+	{0x33BE, idnaStatusMapped, "kw"},
+	{0x33BF, idnaStatusMapped, "mw"},
+	{0x33C0, idnaStatusMapped, "k\u03c9"},
+	{0x33C1, idnaStatusMapped, "m\u03c9"},
+	{0x33C2, idnaStatusDisallowed, ""},
+	{0x33C3, idnaStatusMapped, "bq"},
+	{0x33C4, idnaStatusMapped, "cc"},
+	{0x33C5, idnaStatusMapped, "cd"},
+	{0x33C6, idnaStatusMapped, "c\u2215kg"},
+	{0x33C7, idnaStatusDisallowed, ""},
+	{0x33C8, idnaStatusMapped, "db"},
+	{0x33C9, idnaStatusMapped, "gy"},
+	{0x33CA, idnaStatusMapped, "ha"},
+	{0x33CB, idnaStatusMapped, "hp"},
+	{0x33CC, idnaStatusMapped, "in"},
+	{0x33CD, idnaStatusMapped, "kk"},
+	{0x33CE, idnaStatusMapped, "km"},
+	{0x33CF, idnaStatusMapped, "kt"},
+	{0x33D0, idnaStatusMapped, "lm"},
+	{0x33D1, idnaStatusMapped, "ln"},
+	{0x33D2, idnaStatusMapped, "log"},
+	{0x33D3, idnaStatusMapped, "lx"},
+	{0x33D4, idnaStatusMapped, "mb"},
+	{0x33D5, idnaStatusMapped, "mil"},
+	{0x33D6, idnaStatusMapped, "mol"},
+	{0x33D7, idnaStatusMapped, "ph"},
+	{0x33D8, idnaStatusDisallowed, ""},
+	{0x33D9, idnaStatusMapped, "ppm"},
+	{0x33DA, idnaStatusMapped, "pr"},
+	{0x33DB, idnaStatusMapped, "sr"},
+	{0x33DC, idnaStatusMapped, "sv"},
+	{0x33DD, idnaStatusMapped, "wb"},
+	{0x33DE, idnaStatusMapped, "v\u2215m"},
+	{0x33DF, idnaStatusMapped, "a\u2215m"},
+	{0x33E0, idnaStatusMapped, "1\u65e5"},
+	{0x33E1, idnaStatusMapped, "2\u65e5"},
+	{0x33E2, idnaStatusMapped, "3\u65e5"},
+	{0x33E3, idnaStatusMapped, "4\u65e5"},
+	{0x33E4, idnaStatusMapped, "5\u65e5"},
+	{0x33E5, idnaStatusMapped, "6\u65e5"},
+	{0x33E6, idnaStatusMapped, "7\u65e5"},
+	{0x33E7, idnaStatusMapped, "8\u65e5"},
+	{0x33E8, idnaStatusMapped, "9\u65e5"},
+	{0x33E9, idnaStatusMapped, "10\u65e5"},
+	{0x33EA, idnaStatusMapped, "11\u65e5"},
+	{0x33EB, idnaStatusMapped, "12\u65e5"},
+	{0x33EC, idnaStatusMapped, "13\u65e5"},
+	{0x33ED, idnaStatusMapped, "14\u65e5"},
+	{0x33EE, idnaStatusMapped, "15\u65e5"},
+	{0x33EF, idnaStatusMapped, "16\u65e5"},
+	{0x33F0, idnaStatusMapped, "17\u65e5"},
+	{0x33F1, idnaStatusMapped, "18\u65e5"},
+	{0x33F2, idnaStatusMapped, "19\u65e5"},
+	{0x33F3, idnaStatusMapped, "20\u65e5"},
+	{0x33F4, idnaStatusMapped, "21\u65e5"},
+	{0x33F5, idnaStatusMapped, "22\u65e5"},
+	{0x33F6, idnaStatusMapped, "23\u65e5"},
+	{0x33F7, idnaStatusMapped, "24\u65e5"},
+	{0x33F8, idnaStatusMapped, "25\u65e5"},
+	{0x33F9, idnaStatusMapped, "26\u65e5"},
+	{0x33FA, idnaStatusMapped, "27\u65e5"},
+	{0x33FB, idnaStatusMapped, "28\u65e5"},
+	{0x33FC, idnaStatusMapped, "29\u65e5"},
+	{0x33FD, idnaStatusMapped, "30\u65e5"},
+	{0x33FE, idnaStatusMapped, "31\u65e5"},
+	{0x33FF, idnaStatusMapped, "gal"},
+	{0x3400, idnaStatusValid, ""},
+	{0xA48D, idnaStatusDisallowed, ""},
+	{0xA490, idnaStatusValid, ""},
+	{0xA4C7, idnaStatusDisallowed, ""},
+	{0xA4D0, idnaStatusValid, ""},
+	{0xA62C, idnaStatusDisallowed, ""},
+	{0xA640, idnaStatusMapped, "\ua641"},
+	{0xA641, idnaStatusValid, ""},
+	{0xA642, idnaStatusMapped, "\ua643"},
+	{0xA643, idnaStatusValid, ""},
+	{0xA644, idnaStatusMapped, "\ua645"},
+	{0xA645, idnaStatusValid, ""},
+	{0xA646, idnaStatusMapped, "\ua647"},
+	{0xA647, idnaStatusValid, ""},
+	{0xA648, idnaStatusMapped, "\ua649"},
+	{0xA649, idnaStatusValid, ""},
+	{0xA64A, idnaStatusMapped, "\ua64b"},
+	{0xA64B, idnaStatusValid, ""},
+	{0xA64C, idnaStatusMapped, "\ua64d"},
+	{0xA64D, idnaStatusValid, ""},
+	{0xA64E, idnaStatusMapped, "\ua64f"},
+	{0xA64F, idnaStatusValid, ""},
+	{0xA650, idnaStatusMapped, "\ua651"},
+	{0xA651, idnaStatusValid, ""},
+	{0xA652, idnaStatusMapped, "\ua653"},
+	{0xA653, idnaStatusValid, ""},
+	{0xA654, idnaStatusMapped, "\ua655"},
+	{0xA655, idnaStatusValid, ""},
+	{0xA656, idnaStatusMapped, "\ua657"},
+	{0xA657, idnaStatusValid, ""},
+	{0xA658, idnaStatusMapped, "\ua659"},
+	{0xA659, idnaStatusValid, ""},
+	{0xA65A, idnaStatusMapped, "\ua65b"},
+	{0xA65B, idnaStatusValid, ""},
+	{0xA65C, idnaStatusMapped, "\ua65d"},
+	{0xA65D, idnaStatusValid, ""},
+	{0xA65E, idnaStatusMapped, "\ua65f"},
+	{0xA65F, idnaStatusValid, ""},
+	{0xA660, idnaStatusMapped, "\ua661"},
+	{0xA661, idnaStatusValid, ""},
+	{0xA662, idnaStatusMapped, "\ua663"},
+	{0xA663, idnaStatusValid, ""},
+	{0xA664, idnaStatusMapped, "\ua665"},
+	{0xA665, idnaStatusValid, ""},
+	{0xA666, idnaStatusMapped, "\ua667"},
+	{0xA667, idnaStatusValid, ""},
+	{0xA668, idnaStatusMapped, "\ua669"},
+	{0xA669, idnaStatusValid, ""},
+	{0xA66A, idnaStatusMapped, "\ua66b"},
+	{0xA66B, idnaStatusValid, ""},
+	{0xA66C, idnaStatusMapped, "\ua66d"},
+	{0xA66D, idnaStatusValid, ""},
+	{0xA680, idnaStatusMapped, "\ua681"},
+	{0xA681, idnaStatusValid, ""},
+	{0xA682, idnaStatusMapped, "\ua683"},
+	{0xA683, idnaStatusValid, ""},
+	{0xA684, idnaStatusMapped, "\ua685"},
+	{0xA685, idnaStatusValid, ""},
+	{0xA686, idnaStatusMapped, "\ua687"},
+	{0xA687, idnaStatusValid, ""},
+	{0xA688, idnaStatusMapped, "\ua689"},
+	{0xA689, idnaStatusValid, ""},
+	{0xA68A, idnaStatusMapped, "\ua68b"},
+	{0xA68B, idnaStatusValid, ""},
+	{0xA68C, idnaStatusMapped, "\ua68d"},
+	{0xA68D, idnaStatusValid, ""},
+	{0xA68E, idnaStatusMapped, "\ua68f"},
+	{0xA68F, idnaStatusValid, ""},
+	{0xA690, idnaStatusMapped, "\ua691"},
+	{0xA691, idnaStatusValid, ""},
+	{0xA692, idnaStatusMapped, "\ua693"},
+	{0xA693, idnaStatusValid, ""},
+	{0xA694, idnaStatusMapped, "\ua695"},
+	{0xA695, idnaStatusValid, ""},
+	{0xA696, idnaStatusMapped, "\ua697"},
+	{0xA697, idnaStatusValid, ""},
+	{0xA698, idnaStatusMapped, "\ua699"},
+	{0xA699, idnaStatusValid, ""},
+	{0xA69A, idnaStatusMapped, "\ua69b"},
+	{0xA69B, idnaStatusValid, ""},
+	{0xA69C, idnaStatusMapped, "\u044a"},
+	{0xA69D, idnaStatusMapped, "\u044c"},
+	{0xA69E, idnaStatusValid, ""},
+	{0xA6F8, idnaStatusDisallowed, ""},
+	{0xA700, idnaStatusValid, ""},
+	{0xA722, idnaStatusMapped, "\ua723"},
+	{0xA723, idnaStatusValid, ""},
+	{0xA724, idnaStatusMapped, "\ua725"},
+	{0xA725, idnaStatusValid, ""},
+	{0xA726, idnaStatusMapped, "\ua727"},
+	{0xA727, idnaStatusValid, ""},
+	{0xA728, idnaStatusMapped, "\ua729"},
+	{0xA729, idnaStatusValid, ""},
+	{0xA72A, idnaStatusMapped, "\ua72b"},
+	{0xA72B, idnaStatusValid, ""},
+	{0xA72C, idnaStatusMapped, "\ua72d"},
+	{0xA72D, idnaStatusValid, ""},
+	{0xA72E, idnaStatusMapped, "\ua72f"},
+	{0xA72F, idnaStatusValid, ""},
+	{0xA732, idnaStatusMapped, "\ua733"},
+	{0xA733, idnaStatusValid, ""},
+	{0xA734, idnaStatusMapped, "\ua735"},
+	{0xA735, idnaStatusValid, ""},
+	{0xA736, idnaStatusMapped, "\ua737"},
+	{0xA737, idnaStatusValid, ""},
+	{0xA738, idnaStatusMapped, "\ua739"},
+	{0xA739, idnaStatusValid, ""},
+	{0xA73A, idnaStatusMapped, "\ua73b"},
+	{0xA73B, idnaStatusValid, ""},
+	{0xA73C, idnaStatusMapped, "\ua73d"},
+	{0xA73D, idnaStatusValid, ""},
+	{0xA73E, idnaStatusMapped, "\ua73f"},
+	{0xA73F, idnaStatusValid, ""},
+	{0xA740, idnaStatusMapped, "\ua741"},
+	{0xA741, idnaStatusValid, ""},
+	{0xA742, idnaStatusMapped, "\ua743"},
+	{0xA743, idnaStatusValid, ""},
+	{0xA744, idnaStatusMapped, "\ua745"},
+	{0xA745, idnaStatusValid, ""},
+	{0xA746, idnaStatusMapped, "\ua747"},
+	{0xA747, idnaStatusValid, ""},
+	{0xA748, idnaStatusMapped, "\ua749"},
+	{0xA749, idnaStatusValid, ""},
+	{0xA74A, idnaStatusMapped, "\ua74b"},
+	{0xA74B, idnaStatusValid, ""},
+	{0xA74C, idnaStatusMapped, "\ua74d"},
+	{0xA74D, idnaStatusValid, ""},
+	{0xA74E, idnaStatusMapped, "\ua74f"},
+	{0xA74F, idnaStatusValid, ""},
+	{0xA750, idnaStatusMapped, "\ua751"},
+	{0xA751, idnaStatusValid, ""},
+	{0xA752, idnaStatusMapped, "\ua753"},
+	{0xA753, idnaStatusValid, ""},
+	{0xA754, idnaStatusMapped, "\ua755"},
+	{0xA755, idnaStatusValid, ""},
+	{0xA756, idnaStatusMapped, "\ua757"},
+	{0xA757, idnaStatusValid, ""},
+	{0xA758, idnaStatusMapped, "\ua759"},
+	{0xA759, idnaStatusValid, ""},
+	{0xA75A, idnaStatusMapped, "\ua75b"},
+	{0xA75B, idnaStatusValid, ""},
+	{0xA75C, idnaStatusMapped, "\ua75d"},
+	{0xA75D, idnaStatusValid, ""},
+	{0xA75E, idnaStatusMapped, "\ua75f"},
+	{0xA75F, idnaStatusValid, ""},
+	{0xA760, idnaStatusMapped, "\ua761"},
+	{0xA761, idnaStatusValid, ""},
+	{0xA762, idnaStatusMapped, "\ua763"},
+	{0xA763, idnaStatusValid, ""},
+	{0xA764, idnaStatusMapped, "\ua765"},
+	{0xA765, idnaStatusValid, ""},
+	{0xA766, idnaStatusMapped, "\ua767"},
+	{0xA767, idnaStatusValid, ""},
+	{0xA768, idnaStatusMapped, "\ua769"},
+	{0xA769, idnaStatusValid, ""},
+	{0xA76A, idnaStatusMapped, "\ua76b"},
+	{0xA76B, idnaStatusValid, ""},
+	{0xA76C, idnaStatusMapped, "\ua76d"},
+	{0xA76D, idnaStatusValid, ""},
+	{0xA76E, idnaStatusMapped, "\ua76f"},
+	{0xA76F, idnaStatusValid, ""},
+	{0xA770, idnaStatusMapped, "\ua76f"},
+	{0xA771, idnaStatusValid, ""},
+	{0xA779, idnaStatusMapped, "\ua77a"},
+	{0xA77A, idnaStatusValid, ""},
+	{0xA77B, idnaStatusMapped, "\ua77c"},
+	{0xA77C, idnaStatusValid, ""},
+	{0xA77D, idnaStatusMapped, "\u1d79"},
+	{0xA77E, idnaStatusMapped, "\ua77f"},
+	{0xA77F, idnaStatusValid, ""},
+	{0xA780, idnaStatusMapped, "\ua781"},
+	{0xA781, idnaStatusValid, ""},
+	{0xA782, idnaStatusMapped, "\ua783"},
+	{0xA783, idnaStatusValid, ""},
+	{0xA784, idnaStatusMapped, "\ua785"},
+	{0xA785, idnaStatusValid, ""},
+	{0xA786, idnaStatusMapped, "\ua787"},
+	{0xA787, idnaStatusValid, ""},
+	{0xA78B, idnaStatusMapped, "\ua78c"},
+	{0xA78C, idnaStatusValid, ""},
+	{0xA78D, idnaStatusMapped, "\u0265"},
+	{0xA78E, idnaStatusValid, ""},
+	{0xA790, idnaStatusMapped, "\ua791"},
+	{0xA791, idnaStatusValid, ""},
+	{0xA792, idnaStatusMapped, "\ua793"},
+	{0xA793, idnaStatusValid, ""},
+	{0xA796, idnaStatusMapped, "\ua797"},
+	{0xA797, idnaStatusValid, ""},
+	{0xA798, idnaStatusMapped, "\ua799"},
+	{0xA799, idnaStatusValid, ""},
+	{0xA79A, idnaStatusMapped, "\ua79b"},
+	{0xA79B, idnaStatusValid, ""},
+	{0xA79C, idnaStatusMapped, "\ua79d"},
+	{0xA79D, idnaStatusValid, ""},
+	{0xA79E, idnaStatusMapped, "\ua79f"},
+	{0xA79F, idnaStatusValid, ""},
+	{0xA7A0, idnaStatusMapped, "\ua7a1"},
+	{0xA7A1, idnaStatusValid, ""},
+	{0xA7A2, idnaStatusMapped, "\ua7a3"},
+	{0xA7A3, idnaStatusValid, ""},
+	{0xA7A4, idnaStatusMapped, "\ua7a5"},
+	{0xA7A5, idnaStatusValid, ""},
+	{0xA7A6, idnaStatusMapped, "\ua7a7"},
+	{0xA7A7, idnaStatusValid, ""},
+	{0xA7A8, idnaStatusMapped, "\ua7a9"},
+	{0xA7A9, idnaStatusValid, ""},
+	{0xA7AA, idnaStatusMapped, "\u0266"},
+	{0xA7AB, idnaStatusMapped, "\u025c"},
+	{0xA7AC, idnaStatusMapped, "\u0261"},
+	{0xA7AD, idnaStatusMapped, "\u026c"},
+	{0xA7AE, idnaStatusMapped, "\u026a"},
+	{0xA7AF, idnaStatusValid, ""},
+	{0xA7B0, idnaStatusMapped, "\u029e"},
+	{0xA7B1, idnaStatusMapped, "\u0287"},
+	{0xA7B2, idnaStatusMapped, "\u029d"},
+	{0xA7B3, idnaStatusMapped, "\uab53"},
+	{0xA7B4, idnaStatusMapped, "\ua7b5"},
+	{0xA7B5, idnaStatusValid, ""},
+	{0xA7B6, idnaStatusMapped, "\ua7b7"},
+	{0xA7B7, idnaStatusValid, ""},
+	{0xA7B8, idnaStatusMapped, "\ua7b9"},
+	{0xA7B9, idnaStatusValid, ""},
+	{0xA7BA, idnaStatusMapped, "\ua7bb"},
+	{0xA7BB, idnaStatusValid, ""},
+	{0xA7BC, idnaStatusMapped, "\ua7bd"},
+	{0xA7BD, idnaStatusValid, ""},
+	{0xA7BE, idnaStatusMapped, "\ua7bf"},
+	{0xA7BF, idnaStatusValid, ""},
+	{0xA7C0, idnaStatusMapped, "\ua7c1"},
+	{0xA7C1, idnaStatusValid, ""},
+	{0xA7C2, idnaStatusMapped, "\ua7c3"},
+	{0xA7C3, idnaStatusValid, ""},
+	{0xA7C4, idnaStatusMapped, "\ua794"},
+	{0xA7C5, idnaStatusMapped, "\u0282"},
+	{0xA7C6, idnaStatusMapped, "\u1d8e"},
+	{0xA7C7, idnaStatusMapped, "\ua7c8"},
+	{0xA7C8, idnaStatusValid, ""},
+	{0xA7C9, idnaStatusMapped, "\ua7ca"},
+	{0xA7CA, idnaStatusValid, ""},
+	{0xA7CB, idnaStatusMapped, "\u0264"},
+	{0xA7CC, idnaStatusMapped, "\ua7cd"},
+	{0xA7CD, idnaStatusValid, ""},
+	{0xA7CE, idnaStatusMapped, "\ua7cf"},
+	{0xA7CF, idnaStatusValid, ""},
+	{0xA7D0, idnaStatusMapped, "\ua7d1"},
+	{0xA7D1, idnaStatusValid, ""},
+	{0xA7D2, idnaStatusMapped, "\ua7d3"},
+	{0xA7D3, idnaStatusValid, ""},
+	{0xA7D4, idnaStatusMapped, "\ua7d5"},
+	{0xA7D5, idnaStatusValid, ""},
+	{0xA7D6, idnaStatusMapped, "\ua7d7"},
+	{0xA7D7, idnaStatusValid, ""},
+	{0xA7D8, idnaStatusMapped, "\ua7d9"},
+	{0xA7D9, idnaStatusValid, ""},
+	{0xA7DA, idnaStatusMapped, "\ua7db"},
+	{0xA7DB, idnaStatusValid, ""},
+	{0xA7DC, idnaStatusMapped, "\u019b"},
+	{0xA7DD, idnaStatusDisallowed, ""},
+	{0xA7F1, idnaStatusMapped, "s"},
+	{0xA7F2, idnaStatusMapped, "c"},
+	{0xA7F3, idnaStatusMapped, "f"},
+	{0xA7F4, idnaStatusMapped, "q"},
+	{0xA7F5, idnaStatusMapped, "\ua7f6"},
+	{0xA7F6, idnaStatusValid, ""},
+	{0xA7F8, idnaStatusMapped, "\u0127"},
+	{0xA7F9, idnaStatusMapped, "\u0153"},
+	{0xA7FA, idnaStatusValid, ""},
+	{0xA82D, idnaStatusDisallowed, ""},
+	{0xA830, idnaStatusValid, ""},
+	{0xA83A, idnaStatusDisallowed, ""},
+	{0xA840, idnaStatusValid, ""},
+	{0xA878, idnaStatusDisallowed, ""},
+	{0xA880, idnaStatusValid, ""},
+	{0xA8C6, idnaStatusDisallowed, ""},
+	{0xA8CE, idnaStatusValid, ""},
+	{0xA8DA, idnaStatusDisallowed, ""},
+	{0xA8E0, idnaStatusValid, ""},
+	{0xA954, idnaStatusDisallowed, ""},
+	{0xA95F, idnaStatusValid, ""},
+	{0xA97D, idnaStatusDisallowed, ""},
+	{0xA980, idnaStatusValid, ""},
+	{0xA9CE, idnaStatusDisallowed, ""},
+	{0xA9CF, idnaStatusValid, ""},
+	{0xA9DA, idnaStatusDisallowed, ""},
+	{0xA9DE, idnaStatusValid, ""},
+	{0xA9FF, idnaStatusDisallowed, ""},
+	{0xAA00, idnaStatusValid, ""},
+	{0xAA37, idnaStatusDisallowed, ""},
+	{0xAA40, idnaStatusValid, ""},
+	{0xAA4E, idnaStatusDisallowed, ""},
+	{0xAA50, idnaStatusValid, ""},
+	{0xAA5A, idnaStatusDisallowed, ""},
+	{0xAA5C, idnaStatusValid, ""},
+	{0xAAC3, idnaStatusDisallowed, ""},
+	{0xAADB, idnaStatusValid, ""},
+	{0xAAF7, idnaStatusDisallowed, ""},
+	{0xAB01, idnaStatusValid, ""},
+	{0xAB07, idnaStatusDisallowed, ""},
+	{0xAB09, idnaStatusValid, ""},
+	{0xAB0F, idnaStatusDisallowed, ""},
+	{0xAB11, idnaStatusValid, ""},
+	{0xAB17, idnaStatusDisallowed, ""},
+	{0xAB20, idnaStatusValid, ""},
+	{0xAB27, idnaStatusDisallowed, ""},
+	{0xAB28, idnaStatusValid, ""},
+	{0xAB2F, idnaStatusDisallowed, ""},
+	{0xAB30, idnaStatusValid, ""},
+	{0xAB5C, idnaStatusMapped, "\ua727"},
+	{0xAB5D, idnaStatusMapped, "\uab37"},
+	{0xAB5E, idnaStatusMapped, "\u026b"},
+	{0xAB5F, idnaStatusMapped, "\uab52"},
+	{0xAB60, idnaStatusValid, ""},
+	{0xAB69, idnaStatusMapped, "\u028d"},
+	{0xAB6A, idnaStatusValid, ""},
+	{0xAB6C, idnaStatusDisallowed, ""},
+	{0xAB70, idnaStatusMapped, "\u13a0"},
+	{0xAB71, idnaStatusMapped, "\u13a1"},
+	{0xAB72, idnaStatusMapped, "\u13a2"},
+	{0xAB73, idnaStatusMapped, "\u13a3"},
+	{0xAB74, idnaStatusMapped, "\u13a4"},
+	{0xAB75, idnaStatusMapped, "\u13a5"},
+	{0xAB76, idnaStatusMapped, "\u13a6"},
+	{0xAB77, idnaStatusMapped, "\u13a7"},
+	{0xAB78, idnaStatusMapped, "\u13a8"},
+	{0xAB79, idnaStatusMapped, "\u13a9"},
+	{0xAB7A, idnaStatusMapped, "\u13aa"},
+	{0xAB7B, idnaStatusMapped, "\u13ab"},
+	{0xAB7C, idnaStatusMapped, "\u13ac"},
+	{0xAB7D, idnaStatusMapped, "\u13ad"},
+	{0xAB7E, idnaStatusMapped, "\u13ae"},
+	{0xAB7F, idnaStatusMapped, "\u13af"},
+	{0xAB80, idnaStatusMapped, "\u13b0"},
+	{0xAB81, idnaStatusMapped, "\u13b1"},
+	{0xAB82, idnaStatusMapped, "\u13b2"},
+	{0xAB83, idnaStatusMapped, "\u13b3"},
+	{0xAB84, idnaStatusMapped, "\u13b4"},
+	{0xAB85, idnaStatusMapped, "\u13b5"},
+	{0xAB86, idnaStatusMapped, "\u13b6"},
+	{0xAB87, idnaStatusMapped, "\u13b7"},
+	{0xAB88, idnaStatusMapped, "\u13b8"},
+	{0xAB89, idnaStatusMapped, "\u13b9"},
+	{0xAB8A, idnaStatusMapped, "\u13ba"},
+	{0xAB8B, idnaStatusMapped, "\u13bb"},
+	{0xAB8C, idnaStatusMapped, "\u13bc"},
+	{0xAB8D, idnaStatusMapped, "\u13bd"},
+	{0xAB8E, idnaStatusMapped, "\u13be"},
+	{0xAB8F, idnaStatusMapped, "\u13bf"},
+	{0xAB90, idnaStatusMapped, "\u13c0"},
+	{0xAB91, idnaStatusMapped, "\u13c1"},
+	{0xAB92, idnaStatusMapped, "\u13c2"},
+	{0xAB93, idnaStatusMapped, "\u13c3"},
+	{0xAB94, idnaStatusMapped, "\u13c4"},
+	{0xAB95, idnaStatusMapped, "\u13c5"},
+	{0xAB96, idnaStatusMapped, "\u13c6"},
+	{0xAB97, idnaStatusMapped, "\u13c7"},
+	{0xAB98, idnaStatusMapped, "\u13c8"},
+	{0xAB99, idnaStatusMapped, "\u13c9"},
+	{0xAB9A, idnaStatusMapped, "\u13ca"},
+	{0xAB9B, idnaStatusMapped, "\u13cb"},
+	{0xAB9C, idnaStatusMapped, "\u13cc"},
+	{0xAB9D, idnaStatusMapped, "\u13cd"},
+	{0xAB9E, idnaStatusMapped, "\u13ce"},
+	{0xAB9F, idnaStatusMapped, "\u13cf"},
+	{0xABA0, idnaStatusMapped, "\u13d0"},
+	{0xABA1, idnaStatusMapped, "\u13d1"},
+	{0xABA2, idnaStatusMapped, "\u13d2"},
+	{0xABA3, idnaStatusMapped, "\u13d3"},
+	{0xABA4, idnaStatusMapped, "\u13d4"},
+	{0xABA5, idnaStatusMapped, "\u13d5"},
+	{0xABA6, idnaStatusMapped, "\u13d6"},
+	{0xABA7, idnaStatusMapped, "\u13d7"},
+	{0xABA8, idnaStatusMapped, "\u13d8"},
+	{0xABA9, idnaStatusMapped, "\u13d9"},
+	{0xABAA, idnaStatusMapped, "\u13da"},
+	{0xABAB, idnaStatusMapped, "\u13db"},
+	{0xABAC, idnaStatusMapped, "\u13dc"},
+	{0xABAD, idnaStatusMapped, "\u13dd"},
+	{0xABAE, idnaStatusMapped, "\u13de"},
+	{0xABAF, idnaStatusMapped, "\u13df"},
+	{0xABB0, idnaStatusMapped, "\u13e0"},
+	{0xABB1, idnaStatusMapped, "\u13e1"},
+	{0xABB2, idnaStatusMapped, "\u13e2"},
+	{0xABB3, idnaStatusMapped, "\u13e3"},
+	{0xABB4, idnaStatusMapped, "\u13e4"},
+	{0xABB5, idnaStatusMapped, "\u13e5"},
+	{0xABB6, idnaStatusMapped, "\u13e6"},
+	{0xABB7, idnaStatusMapped, "\u13e7"},
+	{0xABB8, idnaStatusMapped, "\u13e8"},
+	{0xABB9, idnaStatusMapped, "\u13e9"},
+	{0xABBA, idnaStatusMapped, "\u13ea"},
+	{0xABBB, idnaStatusMapped, "\u13eb"},
+	{0xABBC, idnaStatusMapped, "\u13ec"},
+	{0xABBD, idnaStatusMapped, "\u13ed"},
+	{0xABBE, idnaStatusMapped, "\u13ee"},
+	{0xABBF, idnaStatusMapped, "\u13ef"},
+	{0xABC0, idnaStatusValid, ""},
+	{0xABEE, idnaStatusDisallowed, ""},
+	{0xABF0, idnaStatusValid, ""},
+	{0xABFA, idnaStatusDisallowed, ""},
+	{0xAC00, idnaStatusValid, ""},
+	{0xD7A4, idnaStatusDisallowed, ""},
+	{0xD7B0, idnaStatusValid, ""},
+	{0xD7C7, idnaStatusDisallowed, ""},
+	{0xD7CB, idnaStatusValid, ""},
+	{0xD7FC, idnaStatusDisallowed, ""},
+	{0xF900, idnaStatusMapped, "\u8c48"},
+	{0xF901, idnaStatusMapped, "\u66f4"},
+	{0xF902, idnaStatusMapped, "\u8eca"},
+	{0xF903, idnaStatusMapped, "\u8cc8"},
+	{0xF904, idnaStatusMapped, "\u6ed1"},
+	{0xF905, idnaStatusMapped, "\u4e32"},
+	{0xF906, idnaStatusMapped, "\u53e5"},
+	{0xF907, idnaStatusMapped, "\u9f9c"},
+	{0xF909, idnaStatusMapped, "\u5951"},
+	{0xF90A, idnaStatusMapped, "\u91d1"},
+	{0xF90B, idnaStatusMapped, "\u5587"},
+	{0xF90C, idnaStatusMapped, "\u5948"},
+	{0xF90D, idnaStatusMapped, "\u61f6"},
+	{0xF90E, idnaStatusMapped, "\u7669"},
+	{0xF90F, idnaStatusMapped, "\u7f85"},
+	{0xF910, idnaStatusMapped, "\u863f"},
+	{0xF911, idnaStatusMapped, "\u87ba"},
+	{0xF912, idnaStatusMapped, "\u88f8"},
+	{0xF913, idnaStatusMapped, "\u908f"},
+	{0xF914, idnaStatusMapped, "\u6a02"},
+	{0xF915, idnaStatusMapped, "\u6d1b"},
+	{0xF916, idnaStatusMapped, "\u70d9"},
+	{0xF917, idnaStatusMapped, "\u73de"},
+	{0xF918, idnaStatusMapped, "\u843d"},
+	{0xF919, idnaStatusMapped, "\u916a"},
+	{0xF91A, idnaStatusMapped, "\u99f1"},
+	{0xF91B, idnaStatusMapped, "\u4e82"},
+	{0xF91C, idnaStatusMapped, "\u5375"},
+	{0xF91D, idnaStatusMapped, "\u6b04"},
+	{0xF91E, idnaStatusMapped, "\u721b"},
+	{0xF91F, idnaStatusMapped, "\u862d"},
+	{0xF920, idnaStatusMapped, "\u9e1e"},
+	{0xF921, idnaStatusMapped, "\u5d50"},
+	{0xF922, idnaStatusMapped, "\u6feb"},
+	{0xF923, idnaStatusMapped, "\u85cd"},
+	{0xF924, idnaStatusMapped, "\u8964"},
+	{0xF925, idnaStatusMapped, "\u62c9"},
+	{0xF926, idnaStatusMapped, "\u81d8"},
+	{0xF927, idnaStatusMapped, "\u881f"},
+	{0xF928, idnaStatusMapped, "\u5eca"},
+	{0xF929, idnaStatusMapped, "\u6717"},
+	{0xF92A, idnaStatusMapped, "\u6d6a"},
+	{0xF92B, idnaStatusMapped, "\u72fc"},
+	{0xF92C, idnaStatusMapped, "\u90ce"},
+	{0xF92D, idnaStatusMapped, "\u4f86"},
+	{0xF92E, idnaStatusMapped, "\u51b7"},
+	{0xF92F, idnaStatusMapped, "\u52de"},
+	{0xF930, idnaStatusMapped, "\u64c4"},
+	{0xF931, idnaStatusMapped, "\u6ad3"},
+	{0xF932, idnaStatusMapped, "\u7210"},
+	{0xF933, idnaStatusMapped, "\u76e7"},
+	{0xF934, idnaStatusMapped, "\u8001"},
+	{0xF935, idnaStatusMapped, "\u8606"},
+	{0xF936, idnaStatusMapped, "\u865c"},
+	{0xF937, idnaStatusMapped, "\u8def"},
+	{0xF938, idnaStatusMapped, "\u9732"},
+	{0xF939, idnaStatusMapped, "\u9b6f"},
+	{0xF93A, idnaStatusMapped, "\u9dfa"},
+	{0xF93B, idnaStatusMapped, "\u788c"},
+	{0xF93C, idnaStatusMapped, "\u797f"},
+	{0xF93D, idnaStatusMapped, "\u7da0"},
+	{0xF93E, idnaStatusMapped, "\u83c9"},
+	{0xF93F, idnaStatusMapped, "\u9304"},
+	{0xF940, idnaStatusMapped, "\u9e7f"},
+	{0xF941, idnaStatusMapped, "\u8ad6"},
+	{0xF942, idnaStatusMapped, "\u58df"},
+	{0xF943, idnaStatusMapped, "\u5f04"},
+	{0xF944, idnaStatusMapped, "\u7c60"},
+	{0xF945, idnaStatusMapped, "\u807e"},
+	{0xF946, idnaStatusMapped, "\u7262"},
+	{0xF947, idnaStatusMapped, "\u78ca"},
+	{0xF948, idnaStatusMapped, "\u8cc2"},
+	{0xF949, idnaStatusMapped, "\u96f7"},
+	{0xF94A, idnaStatusMapped, "\u58d8"},
+	{0xF94B, idnaStatusMapped, "\u5c62"},
+	{0xF94C, idnaStatusMapped, "\u6a13"},
+	{0xF94D, idnaStatusMapped, "\u6dda"},
+	{0xF94E, idnaStatusMapped, "\u6f0f"},
+	{0xF94F, idnaStatusMapped, "\u7d2f"},
+	{0xF950, idnaStatusMapped, "\u7e37"},
+	{0xF951, idnaStatusMapped, "\u964b"},
+	{0xF952, idnaStatusMapped, "\u52d2"},
+	{0xF953, idnaStatusMapped, "\u808b"},
+	{0xF954, idnaStatusMapped, "\u51dc"},
+	{0xF955, idnaStatusMapped, "\u51cc"},
+	{0xF956, idnaStatusMapped, "\u7a1c"},
+	{0xF957, idnaStatusMapped, "\u7dbe"},
+	{0xF958, idnaStatusMapped, "\u83f1"},
+	{0xF959, idnaStatusMapped, "\u9675"},
+	{0xF95A, idnaStatusMapped, "\u8b80"},
+	{0xF95B, idnaStatusMapped, "\u62cf"},
+	{0xF95C, idnaStatusMapped, "\u6a02"},
+	{0xF95D, idnaStatusMapped, "\u8afe"},
+	{0xF95E, idnaStatusMapped, "\u4e39"},
+	{0xF95F, idnaStatusMapped, "\u5be7"},
+	{0xF960, idnaStatusMapped, "\u6012"},
+	{0xF961, idnaStatusMapped, "\u7387"},
+	{0xF962, idnaStatusMapped, "\u7570"},
+	{0xF963, idnaStatusMapped, "\u5317"},
+	{0xF964, idnaStatusMapped, "\u78fb"},
+	{0xF965, idnaStatusMapped, "\u4fbf"},
+	{0xF966, idnaStatusMapped, "\u5fa9"},
+	{0xF967, idnaStatusMapped, "\u4e0d"},
+	{0xF968, idnaStatusMapped, "\u6ccc"},
+	{0xF969, idnaStatusMapped, "\u6578"},
+	{0xF96A, idnaStatusMapped, "\u7d22"},
+	{0xF96B, idnaStatusMapped, "\u53c3"},
+	{0xF96C, idnaStatusMapped, "\u585e"},
+	{0xF96D, idnaStatusMapped, "\u7701"},
+	{0xF96E, idnaStatusMapped, "\u8449"},
+	{0xF96F, idnaStatusMapped, "\u8aaa"},
+	{0xF970, idnaStatusMapped, "\u6bba"},
+	{0xF971, idnaStatusMapped, "\u8fb0"},
+	{0xF972, idnaStatusMapped, "\u6c88"},
+	{0xF973, idnaStatusMapped, "\u62fe"},
+	{0xF974, idnaStatusMapped, "\u82e5"},
+	{0xF975, idnaStatusMapped, "\u63a0"},
+	{0xF976, idnaStatusMapped, "\u7565"},
+	{0xF977, idnaStatusMapped, "\u4eae"},
+	{0xF978, idnaStatusMapped, "\u5169"},
+	{0xF979, idnaStatusMapped, "\u51c9"},
+	{0xF97A, idnaStatusMapped, "\u6881"},
+	{0xF97B, idnaStatusMapped, "\u7ce7"},
+	{0xF97C, idnaStatusMapped, "\u826f"},
+	{0xF97D, idnaStatusMapped, "\u8ad2"},
+	{0xF97E, idnaStatusMapped, "\u91cf"},
+	{0xF97F, idnaStatusMapped, "\u52f5"},
+	{0xF980, idnaStatusMapped, "\u5442"},
+	{0xF981, idnaStatusMapped, "\u5973"},
+	{0xF982, idnaStatusMapped, "\u5eec"},
+	{0xF983, idnaStatusMapped, "\u65c5"},
+	{0xF984, idnaStatusMapped, "\u6ffe"},
+	{0xF985, idnaStatusMapped, "\u792a"},
+	{0xF986, idnaStatusMapped, "\u95ad"},
+	{0xF987, idnaStatusMapped, "\u9a6a"},
+	{0xF988, idnaStatusMapped, "\u9e97"},
+	{0xF989, idnaStatusMapped, "\u9ece"},
+	{0xF98A, idnaStatusMapped, "\u529b"},
+	{0xF98B, idnaStatusMapped, "\u66c6"},
+	{0xF98C, idnaStatusMapped, "\u6b77"},
+	{0xF98D, idnaStatusMapped, "\u8f62"},
+	{0xF98E, idnaStatusMapped, "\u5e74"},
+	{0xF98F, idnaStatusMapped, "\u6190"},
+	{0xF990, idnaStatusMapped, "\u6200"},
+	{0xF991, idnaStatusMapped, "\u649a"},
+	{0xF992, idnaStatusMapped, "\u6f23"},
+	{0xF993, idnaStatusMapped, "\u7149"},
+	{0xF994, idnaStatusMapped, "\u7489"},
+	{0xF995, idnaStatusMapped, "\u79ca"},
+	{0xF996, idnaStatusMapped, "\u7df4"},
+	{0xF997, idnaStatusMapped, "\u806f"},
+	{0xF998, idnaStatusMapped, "\u8f26"},
+	{0xF999, idnaStatusMapped, "\u84ee"},
+	{0xF99A, idnaStatusMapped, "\u9023"},
+	{0xF99B, idnaStatusMapped, "\u934a"},
+	{0xF99C, idnaStatusMapped, "\u5217"},
+	{0xF99D, idnaStatusMapped, "\u52a3"},
+	{0xF99E, idnaStatusMapped, "\u54bd"},
+	{0xF99F, idnaStatusMapped, "\u70c8"},
+	{0xF9A0, idnaStatusMapped, "\u88c2"},
+	{0xF9A1, idnaStatusMapped, "\u8aaa"},
+	{0xF9A2, idnaStatusMapped, "\u5ec9"},
+	{0xF9A3, idnaStatusMapped, "\u5ff5"},
+	{0xF9A4, idnaStatusMapped, "\u637b"},
+	{0xF9A5, idnaStatusMapped, "\u6bae"},
+	{0xF9A6, idnaStatusMapped, "\u7c3e"},
+	{0xF9A7, idnaStatusMapped, "\u7375"},
+	{0xF9A8, idnaStatusMapped, "\u4ee4"},
+	{0xF9A9, idnaStatusMapped, "\u56f9"},
+	{0xF9AA, idnaStatusMapped, "\u5be7"},
+	{0xF9AB, idnaStatusMapped, "\u5dba"},
+	{0xF9AC, idnaStatusMapped, "\u601c"},
+	{0xF9AD, idnaStatusMapped, "\u73b2"},
+	{0xF9AE, idnaStatusMapped, "\u7469"},
+	{0xF9AF, idnaStatusMapped, "\u7f9a"},
+	{0xF9B0, idnaStatusMapped, "\u8046"},
+	{0xF9B1, idnaStatusMapped, "\u9234"},
+	{0xF9B2, idnaStatusMapped, "\u96f6"},
+	{0xF9B3, idnaStatusMapped, "\u9748"},
+	{0xF9B4, idnaStatusMapped, "\u9818"},
+	{0xF9B5, idnaStatusMapped, "\u4f8b"},
+	{0xF9B6, idnaStatusMapped, "\u79ae"},
+	{0xF9B7, idnaStatusMapped, "\u91b4"},
+	{0xF9B8, idnaStatusMapped, "\u96b8"},
+	{0xF9B9, idnaStatusMapped, "\u60e1"},
+	{0xF9BA, idnaStatusMapped, "\u4e86"},
+	{0xF9BB, idnaStatusMapped, "\u50da"},
+	{0xF9BC, idnaStatusMapped, "\u5bee"},
+	{0xF9BD, idnaStatusMapped, "\u5c3f"},
+	{0xF9BE, idnaStatusMapped, "\u6599"},
+	{0xF9BF, idnaStatusMapped, "\u6a02"},
+	{0xF9C0, idnaStatusMapped, "\u71ce"},
+	{0xF9C1, idnaStatusMapped, "\u7642"},
+	{0xF9C2, idnaStatusMapped, "\u84fc"},
+	{0xF9C3, idnaStatusMapped, "\u907c"},
+	{0xF9C4, idnaStatusMapped, "\u9f8d"},
+	{0xF9C5, idnaStatusMapped, "\u6688"},
+	{0xF9C6, idnaStatusMapped, "\u962e"},
+	{0xF9C7, idnaStatusMapped, "\u5289"},
+	{0xF9C8, idnaStatusMapped, "\u677b"},
+	{0xF9C9, idnaStatusMapped, "\u67f3"},
+	{0xF9CA, idnaStatusMapped, "\u6d41"},
+	{0xF9CB, idnaStatusMapped, "\u6e9c"},
+	{0xF9CC, idnaStatusMapped, "\u7409"},
+	{0xF9CD, idnaStatusMapped, "\u7559"},
+	{0xF9CE, idnaStatusMapped, "\u786b"},
+	{0xF9CF, idnaStatusMapped, "\u7d10"},
+	{0xF9D0, idnaStatusMapped, "\u985e"},
+	{0xF9D1, idnaStatusMapped, "\u516d"},
+	{0xF9D2, idnaStatusMapped, "\u622e"},
+	{0xF9D3, idnaStatusMapped, "\u9678"},
+	{0xF9D4, idnaStatusMapped, "\u502b"},
+	{0xF9D5, idnaStatusMapped, "\u5d19"},
+	{0xF9D6, idnaStatusMapped, "\u6dea"},
+	{0xF9D7, idnaStatusMapped, "\u8f2a"},
+	{0xF9D8, idnaStatusMapped, "\u5f8b"},
+	{0xF9D9, idnaStatusMapped, "\u6144"},
+	{0xF9DA, idnaStatusMapped, "\u6817"},
+	{0xF9DB, idnaStatusMapped, "\u7387"},
+	{0xF9DC, idnaStatusMapped, "\u9686"},
+	{0xF9DD, idnaStatusMapped, "\u5229"},
+	{0xF9DE, idnaStatusMapped, "\u540f"},
+	{0xF9DF, idnaStatusMapped, "\u5c65"},
+	{0xF9E0, idnaStatusMapped, "\u6613"},
+	{0xF9E1, idnaStatusMapped, "\u674e"},
+	{0xF9E2, idnaStatusMapped, "\u68a8"},
+	{0xF9E3, idnaStatusMapped, "\u6ce5"},
+	{0xF9E4, idnaStatusMapped, "\u7406"},
+	{0xF9E5, idnaStatusMapped, "\u75e2"},
+	{0xF9E6, idnaStatusMapped, "\u7f79"},
+	{0xF9E7, idnaStatusMapped, "\u88cf"},
+	{0xF9E8, idnaStatusMapped, "\u88e1"},
+	{0xF9E9, idnaStatusMapped, "\u91cc"},
+	{0xF9EA, idnaStatusMapped, "\u96e2"},
+	{0xF9EB, idnaStatusMapped, "\u533f"},
+	{0xF9EC, idnaStatusMapped, "\u6eba"},
+	{0xF9ED, idnaStatusMapped, "\u541d"},
+	{0xF9EE, idnaStatusMapped, "\u71d0"},
+	{0xF9EF, idnaStatusMapped, "\u7498"},
+	{0xF9F0, idnaStatusMapped, "\u85fa"},
+	{0xF9F1, idnaStatusMapped, "\u96a3"},
+	{0xF9F2, idnaStatusMapped, "\u9c57"},
+	{0xF9F3, idnaStatusMapped, "\u9e9f"},
+	{0xF9F4, idnaStatusMapped, "\u6797"},
+	{0xF9F5, idnaStatusMapped, "\u6dcb"},
+	{0xF9F6, idnaStatusMapped, "\u81e8"},
+	{0xF9F7, idnaStatusMapped, "\u7acb"},
+	{0xF9F8, idnaStatusMapped, "\u7b20"},
+	{0xF9F9, idnaStatusMapped, "\u7c92"},
+	{0xF9FA, idnaStatusMapped, "\u72c0"},
+	{0xF9FB, idnaStatusMapped, "\u7099"},
+	{0xF9FC, idnaStatusMapped, "\u8b58"},
+	{0xF9FD, idnaStatusMapped, "\u4ec0"},
+	{0xF9FE, idnaStatusMapped, "\u8336"},
+	{0xF9FF, idnaStatusMapped, "\u523a"},
+	{0xFA00, idnaStatusMapped, "\u5207"},
+	{0xFA01, idnaStatusMapped, "\u5ea6"},
+	{0xFA02, idnaStatusMapped, "\u62d3"},
+	{0xFA03, idnaStatusMapped, "\u7cd6"},
+	{0xFA04, idnaStatusMapped, "\u5b85"},
+	{0xFA05, idnaStatusMapped, "\u6d1e"},
+	{0xFA06, idnaStatusMapped, "\u66b4"},
+	{0xFA07, idnaStatusMapped, "\u8f3b"},
+	{0xFA08, idnaStatusMapped, "\u884c"},
+	{0xFA09, idnaStatusMapped, "\u964d"},
+	{0xFA0A, idnaStatusMapped, "\u898b"},
+	{0xFA0B, idnaStatusMapped, "\u5ed3"},
+	{0xFA0C, idnaStatusMapped, "\u5140"},
+	{0xFA0D, idnaStatusMapped, "\u55c0"},
+	{0xFA0E, idnaStatusValid, ""},
+	{0xFA10, idnaStatusMapped, "\u585a"},
+	{0xFA11, idnaStatusValid, ""},
+	{0xFA12, idnaStatusMapped, "\u6674"},
+	{0xFA13, idnaStatusValid, ""},
+	{0xFA15, idnaStatusMapped, "\u51de"},
+	{0xFA16, idnaStatusMapped, "\u732a"},
+	{0xFA17, idnaStatusMapped, "\u76ca"},
+	{0xFA18, idnaStatusMapped, "\u793c"},
+	{0xFA19, idnaStatusMapped, "\u795e"},
+	{0xFA1A, idnaStatusMapped, "\u7965"},
+	{0xFA1B, idnaStatusMapped, "\u798f"},
+	{0xFA1C, idnaStatusMapped, "\u9756"},
+	{0xFA1D, idnaStatusMapped, "\u7cbe"},
+	{0xFA1E, idnaStatusMapped, "\u7fbd"},
+	{0xFA1F, idnaStatusValid, ""},
+	{0xFA20, idnaStatusMapped, "\u8612"},
+	{0xFA21, idnaStatusValid, ""},
+	{0xFA22, idnaStatusMapped, "\u8af8"},
+	{0xFA23, idnaStatusValid, ""},
+	{0xFA25, idnaStatusMapped, "\u9038"},
+	{0xFA26, idnaStatusMapped, "\u90fd"},
+	{0xFA27, idnaStatusValid, ""},
+	{0xFA2A, idnaStatusMapped, "\u98ef"},
+	{0xFA2B, idnaStatusMapped, "\u98fc"},
+	{0xFA2C, idnaStatusMapped, "\u9928"},
+	{0xFA2D, idnaStatusMapped, "\u9db4"},
+	{0xFA2E, idnaStatusMapped, "\u90de"},
+	{0xFA2F, idnaStatusMapped, "\u96b7"},
+	{0xFA30, idnaStatusMapped, "\u4fae"},
+	{0xFA31, idnaStatusMapped, "\u50e7"},
+	{0xFA32, idnaStatusMapped, "\u514d"},
+	{0xFA33, idnaStatusMapped, "\u52c9"},
+	{0xFA34, idnaStatusMapped, "\u52e4"},
+	{0xFA35, idnaStatusMapped, "\u5351"},
+	{0xFA36, idnaStatusMapped, "\u559d"},
+	{0xFA37, idnaStatusMapped, "\u5606"},
+	{0xFA38, idnaStatusMapped, "\u5668"},
+	{0xFA39, idnaStatusMapped, "\u5840"},
+	{0xFA3A, idnaStatusMapped, "\u58a8"},
+	{0xFA3B, idnaStatusMapped, "\u5c64"},
+	{0xFA3C, idnaStatusMapped, "\u5c6e"},
+	{0xFA3D, idnaStatusMapped, "\u6094"},
+	{0xFA3E, idnaStatusMapped, "\u6168"},
+	{0xFA3F, idnaStatusMapped, "\u618e"},
+	{0xFA40, idnaStatusMapped, "\u61f2"},
+	{0xFA41, idnaStatusMapped, "\u654f"},
+	{0xFA42, idnaStatusMapped, "\u65e2"},
+	{0xFA43, idnaStatusMapped, "\u6691"},
+	{0xFA44, idnaStatusMapped, "\u6885"},
+	{0xFA45, idnaStatusMapped, "\u6d77"},
+	{0xFA46, idnaStatusMapped, "\u6e1a"},
+	{0xFA47, idnaStatusMapped, "\u6f22"},
+	{0xFA48, idnaStatusMapped, "\u716e"},
+	{0xFA49, idnaStatusMapped, "\u722b"},
+	{0xFA4A, idnaStatusMapped, "\u7422"},
+	{0xFA4B, idnaStatusMapped, "\u7891"},
+	{0xFA4C, idnaStatusMapped, "\u793e"},
+	{0xFA4D, idnaStatusMapped, "\u7949"},
+	{0xFA4E, idnaStatusMapped, "\u7948"},
+	{0xFA4F, idnaStatusMapped, "\u7950"},
+	{0xFA50, idnaStatusMapped, "\u7956"},
+	{0xFA51, idnaStatusMapped, "\u795d"},
+	{0xFA52, idnaStatusMapped, "\u798d"},
+	{0xFA53, idnaStatusMapped, "\u798e"},
+	{0xFA54, idnaStatusMapped, "\u7a40"},
+	{0xFA55, idnaStatusMapped, "\u7a81"},
+	{0xFA56, idnaStatusMapped, "\u7bc0"},
+	{0xFA57, idnaStatusMapped, "\u7df4"},
+	{0xFA58, idnaStatusMapped, "\u7e09"},
+	{0xFA59, idnaStatusMapped, "\u7e41"},
+	{0xFA5A, idnaStatusMapped, "\u7f72"},
+	{0xFA5B, idnaStatusMapped, "\u8005"},
+	{0xFA5C, idnaStatusMapped, "\u81ed"},
+	{0xFA5D, idnaStatusMapped, "\u8279"},
+	{0xFA5F, idnaStatusMapped, "\u8457"},
+	{0xFA60, idnaStatusMapped, "\u8910"},
+	{0xFA61, idnaStatusMapped, "\u8996"},
+	{0xFA62, idnaStatusMapped, "\u8b01"},
+	{0xFA63, idnaStatusMapped, "\u8b39"},
+	{0xFA64, idnaStatusMapped, "\u8cd3"},
+	{0xFA65, idnaStatusMapped, "\u8d08"},
+	{0xFA66, idnaStatusMapped, "\u8fb6"},
+	{0xFA67, idnaStatusMapped, "\u9038"},
+	{0xFA68, idnaStatusMapped, "\u96e3"},
+	{0xFA69, idnaStatusMapped, "\u97ff"},
+	{0xFA6A, idnaStatusMapped, "\u983b"},
+	{0xFA6B, idnaStatusMapped, "\u6075"},
+	{0xFA6C, idnaStatusMapped, "\U000242ee"},
+	{0xFA6D, idnaStatusMapped, "\u8218"},
+	{0xFA6E, idnaStatusDisallowed, ""},
+	{0xFA70, idnaStatusMapped, "\u4e26"},
+	{0xFA71, idnaStatusMapped, "\u51b5"},
+	{0xFA72, idnaStatusMapped, "\u5168"},
+	{0xFA73, idnaStatusMapped, "\u4f80"},
+	{0xFA74, idnaStatusMapped, "\u5145"},
+	{0xFA75, idnaStatusMapped, "\u5180"},
+	{0xFA76, idnaStatusMapped, "\u52c7"},
+	{0xFA77, idnaStatusMapped, "\u52fa"},
+	{0xFA78, idnaStatusMapped, "\u559d"},
+	{0xFA79, idnaStatusMapped, "\u5555"},
+	{0xFA7A, idnaStatusMapped, "\u5599"},
+	{0xFA7B, idnaStatusMapped, "\u55e2"},
+	{0xFA7C, idnaStatusMapped, "\u585a"},
+	{0xFA7D, idnaStatusMapped, "\u58b3"},
+	{0xFA7E, idnaStatusMapped, "\u5944"},
+	{0xFA7F, idnaStatusMapped, "\u5954"},
+	{0xFA80, idnaStatusMapped, "\u5a62"},
+	{0xFA81, idnaStatusMapped, "\u5b28"},
+	{0xFA82, idnaStatusMapped, "\u5ed2"},
+	{0xFA83, idnaStatusMapped, "\u5ed9"},
+	{0xFA84, idnaStatusMapped, "\u5f69"},
+	{0xFA85, idnaStatusMapped, "\u5fad"},
+	{0xFA86, idnaStatusMapped, "\u60d8"},
+	{0xFA87, idnaStatusMapped, "\u614e"},
+	{0xFA88, idnaStatusMapped, "\u6108"},
+	{0xFA89, idnaStatusMapped, "\u618e"},
+	{0xFA8A, idnaStatusMapped, "\u6160"},
+	{0xFA8B, idnaStatusMapped, "\u61f2"},
+	{0xFA8C, idnaStatusMapped, "\u6234"},
+	{0xFA8D, idnaStatusMapped, "\u63c4"},
+	{0xFA8E, idnaStatusMapped, "\u641c"},
+	{0xFA8F, idnaStatusMapped, "\u6452"},
+	{0xFA90, idnaStatusMapped, "\u6556"},
+	{0xFA91, idnaStatusMapped, "\u6674"},
+	{0xFA92, idnaStatusMapped, "\u6717"},
+	{0xFA93, idnaStatusMapped, "\u671b"},
+	{0xFA94, idnaStatusMapped, "\u6756"},
+	{0xFA95, idnaStatusMapped, "\u6b79"},
+	{0xFA96, idnaStatusMapped, "\u6bba"},
+	{0xFA97, idnaStatusMapped, "\u6d41"},
+	{0xFA98, idnaStatusMapped, "\u6edb"},
+	{0xFA99, idnaStatusMapped, "\u6ecb"},
+	{0xFA9A, idnaStatusMapped, "\u6f22"},
+	{0xFA9B, idnaStatusMapped, "\u701e"},
+	{0xFA9C, idnaStatusMapped, "\u716e"},
+	{0xFA9D, idnaStatusMapped, "\u77a7"},
+	{0xFA9E, idnaStatusMapped, "\u7235"},
+	{0xFA9F, idnaStatusMapped, "\u72af"},
+	{0xFAA0, idnaStatusMapped, "\u732a"},
+	{0xFAA1, idnaStatusMapped, "\u7471"},
+	{0xFAA2, idnaStatusMapped, "\u7506"},
+	{0xFAA3, idnaStatusMapped, "\u753b"},
+	{0xFAA4, idnaStatusMapped, "\u761d"},
+	{0xFAA5, idnaStatusMapped, "\u761f"},
+	{0xFAA6, idnaStatusMapped, "\u76ca"},
+	{0xFAA7, idnaStatusMapped, "\u76db"},
+	{0xFAA8, idnaStatusMapped, "\u76f4"},
+	{0xFAA9, idnaStatusMapped, "\u774a"},
+	{0xFAAA, idnaStatusMapped, "\u7740"},
+	{0xFAAB, idnaStatusMapped, "\u78cc"},
+	{0xFAAC, idnaStatusMapped, "\u7ab1"},
+	{0xFAAD, idnaStatusMapped, "\u7bc0"},
+	{0xFAAE, idnaStatusMapped, "\u7c7b"},
+	{0xFAAF, idnaStatusMapped, "\u7d5b"},
+	{0xFAB0, idnaStatusMapped, "\u7df4"},
+	{0xFAB1, idnaStatusMapped, "\u7f3e"},
+	{0xFAB2, idnaStatusMapped, "\u8005"},
+	{0xFAB3, idnaStatusMapped, "\u8352"},
+	{0xFAB4, idnaStatusMapped, "\u83ef"},
+	{0xFAB5, idnaStatusMapped, "\u8779"},
+	{0xFAB6, idnaStatusMapped, "\u8941"},
+	{0xFAB7, idnaStatusMapped, "\u8986"},
+	{0xFAB8, idnaStatusMapped, "\u8996"},
+	{0xFAB9, idnaStatusMapped, "\u8abf"},
+	{0xFABA, idnaStatusMapped, "\u8af8"},
+	{0xFABB, idnaStatusMapped, "\u8acb"},
+	{0xFABC, idnaStatusMapped, "\u8b01"},
+	{0xFABD, idnaStatusMapped, "\u8afe"},
+	{0xFABE, idnaStatusMapped, "\u8aed"},
+	{0xFABF, idnaStatusMapped, "\u8b39"},
+	{0xFAC0, idnaStatusMapped, "\u8b8a"},
+	{0xFAC1, idnaStatusMapped, "\u8d08"},
+	{0xFAC2, idnaStatusMapped, "\u8f38"},
+	{0xFAC3, idnaStatusMapped, "\u9072"},
+	{0xFAC4, idnaStatusMapped, "\u9199"},
+	{0xFAC5, idnaStatusMapped, "\u9276"},
+	{0xFAC6, idnaStatusMapped, "\u967c"},
+	{0xFAC7, idnaStatusMapped, "\u96e3"},
+	{0xFAC8, idnaStatusMapped, "\u9756"},
+	{0xFAC9, idnaStatusMapped, "\u97db"},
+	{0xFACA, idnaStatusMapped, "\u97ff"},
+	{0xFACB, idnaStatusMapped, "\u980b"},
+	{0xFACC, idnaStatusMapped, "\u983b"},
+	{0xFACD, idnaStatusMapped, "\u9b12"},
+	{0xFACE, idnaStatusMapped, "\u9f9c"},
+	{0xFACF, idnaStatusMapped, "\U0002284a"},
+	{0xFAD0, idnaStatusMapped, "\U00022844"},
+	{0xFAD1, idnaStatusMapped, "\U000233d5"},
+	{0xFAD2, idnaStatusMapped, "\u3b9d"},
+	{0xFAD3, idnaStatusMapped, "\u4018"},
+	{0xFAD4, idnaStatusMapped, "\u4039"},
+	{0xFAD5, idnaStatusMapped, "\U00025249"},
+	{0xFAD6, idnaStatusMapped, "\U00025cd0"},
+	{0xFAD7, idnaStatusMapped, "\U00027ed3"},
+	{0xFAD8, idnaStatusMapped, "\u9f43"},
+	{0xFAD9, idnaStatusMapped, "\u9f8e"},
+	{0xFADA, idnaStatusDisallowed, ""},
+	{0xFB00, idnaStatusMapped, "ff"},
+	{0xFB01, idnaStatusMapped, "fi"},
+	{0xFB02, idnaStatusMapped, "fl"},
+	{0xFB03, idnaStatusMapped, "ffi"},
+	{0xFB04, idnaStatusMapped, "ffl"},
+	{0xFB05, idnaStatusMapped, "st"},
+	{0xFB07, idnaStatusDisallowed, ""},
+	{0xFB13, idnaStatusMapped, "\u0574\u0576"},
+	{0xFB14, idnaStatusMapped, "\u0574\u0565"},
+	{0xFB15, idnaStatusMapped, "\u0574\u056b"},
+	{0xFB16, idnaStatusMapped, "\u057e\u0576"},
+	{0xFB17, idnaStatusMapped, "\u0574\u056d"},
+	{0xFB18, idnaStatusDisallowed, ""},
+	{0xFB1D, idnaStatusMapped, "\u05d9\u05b4"},
+	{0xFB1E, idnaStatusValid, ""},
+	{0xFB1F, idnaStatusMapped, "\u05f2\u05b7"},
+	{0xFB20, idnaStatusMapped, "\u05e2"},
+	{0xFB21, idnaStatusMapped, "\u05d0"},
+	{0xFB22, idnaStatusMapped, "\u05d3"},
+	{0xFB23, idnaStatusMapped, "\u05d4"},
+	{0xFB24, idnaStatusMapped, "\u05db"},
+	{0xFB25, idnaStatusMapped, "\u05dc"},
+	{0xFB26, idnaStatusMapped, "\u05dd"},
+	{0xFB27, idnaStatusMapped, "\u05e8"},
+	{0xFB28, idnaStatusMapped, "\u05ea"},
+	{0xFB29, idnaStatusMapped, "+"},
+	{0xFB2A, idnaStatusMapped, "\u05e9\u05c1"},
+	{0xFB2B, idnaStatusMapped, "\u05e9\u05c2"},
+	{0xFB2C, idnaStatusMapped, "\u05e9\u05bc\u05c1"},
+	{0xFB2D, idnaStatusMapped, "\u05e9\u05bc\u05c2"},
+	{0xFB2E, idnaStatusMapped, "\u05d0\u05b7"},
+	{0xFB2F, idnaStatusMapped, "\u05d0\u05b8"},
+	{0xFB30, idnaStatusMapped, "\u05d0\u05bc"},
+	{0xFB31, idnaStatusMapped, "\u05d1\u05bc"},
+	{0xFB32, idnaStatusMapped, "\u05d2\u05bc"},
+	{0xFB33, idnaStatusMapped, "\u05d3\u05bc"},
+	{0xFB34, idnaStatusMapped, "\u05d4\u05bc"},
+	{0xFB35, idnaStatusMapped, "\u05d5\u05bc"},
+	{0xFB36, idnaStatusMapped, "\u05d6\u05bc"},
+	{0xFB37, idnaStatusDisallowed, ""},
+	{0xFB38, idnaStatusMapped, "\u05d8\u05bc"},
+	{0xFB39, idnaStatusMapped, "\u05d9\u05bc"},
+	{0xFB3A, idnaStatusMapped, "\u05da\u05bc"},
+	{0xFB3B, idnaStatusMapped, "\u05db\u05bc"},
+	{0xFB3C, idnaStatusMapped, "\u05dc\u05bc"},
+	{0xFB3D, idnaStatusDisallowed, ""},
+	{0xFB3E, idnaStatusMapped, "\u05de\u05bc"},
+	{0xFB3F, idnaStatusDisallowed, ""},
+	{0xFB40, idnaStatusMapped, "\u05e0\u05bc"},
+	{0xFB41, idnaStatusMapped, "\u05e1\u05bc"},
+	{0xFB42, idnaStatusDisallowed, ""},
+	{0xFB43, idnaStatusMapped, "\u05e3\u05bc"},
+	{0xFB44, idnaStatusMapped, "\u05e4\u05bc"},
+	{0xFB45, idnaStatusDisallowed, ""},
+	{0xFB46, idnaStatusMapped, "\u05e6\u05bc"},
+	{0xFB47, idnaStatusMapped, "\u05e7\u05bc"},
+	{0xFB48, idnaStatusMapped, "\u05e8\u05bc"},
+	{0xFB49, idnaStatusMapped, "\u05e9\u05bc"},
+	{0xFB4A, idnaStatusMapped, "\u05ea\u05bc"},
+	{0xFB4B, idnaStatusMapped, "\u05d5\u05b9"},
+	{0xFB4C, idnaStatusMapped, "\u05d1\u05bf"},
+	{0xFB4D, idnaStatusMapped, "\u05db\u05bf"},
+	{0xFB4E, idnaStatusMapped, "\u05e4\u05bf"},
+	{0xFB4F, idnaStatusMapped, "\u05d0\u05dc"},
+	{0xFB50, idnaStatusMapped, "\u0671"},
+	{0xFB52, idnaStatusMapped, "\u067b"},
+	{0xFB56, idnaStatusMapped, "\u067e"},
+	{0xFB5A, idnaStatusMapped, "\u0680"},
+	{0xFB5E, idnaStatusMapped, "\u067a"},
+	{0xFB62, idnaStatusMapped, "\u067f"},
+	{0xFB66, idnaStatusMapped, "\u0679"},
+	{0xFB6A, idnaStatusMapped, "\u06a4"},
+	{0xFB6E, idnaStatusMapped, "\u06a6"},
+	{0xFB72, idnaStatusMapped, "\u0684"},
+	{0xFB76, idnaStatusMapped, "\u0683"},
+	{0xFB7A, idnaStatusMapped, "\u0686"},
+	{0xFB7E, idnaStatusMapped, "\u0687"},
+	{0xFB82, idnaStatusMapped, "\u068d"},
+	{0xFB84, idnaStatusMapped, "\u068c"},
+	{0xFB86, idnaStatusMapped, "\u068e"},
+	{0xFB88, idnaStatusMapped, "\u0688"},
+	{0xFB8A, idnaStatusMapped, "\u0698"},
+	{0xFB8C, idnaStatusMapped, "\u0691"},
+	{0xFB8E, idnaStatusMapped, "\u06a9"},
+	{0xFB92, idnaStatusMapped, "\u06af"},
+	{0xFB96, idnaStatusMapped, "\u06b3"},
+	{0xFB9A, idnaStatusMapped, "\u06b1"},
+	{0xFB9E, idnaStatusMapped, "\u06ba"},
+	{0xFBA0, idnaStatusMapped, "\u06bb"},
+	{0xFBA4, idnaStatusMapped, "\u06c0"},
+	{0xFBA6, idnaStatusMapped, "\u06c1"},
+	{0xFBAA, idnaStatusMapped, "\u06be"},
+	{0xFBAE, idnaStatusMapped, "\u06d2"},
+	{0xFBB0, idnaStatusMapped, "\u06d3"},
+	{0xFBB2, idnaStatusValid, ""},
+	{0xFBD3, idnaStatusMapped, "\u06ad"},
+	{0xFBD7, idnaStatusMapped, "\u06c7"},
+	{0xFBD9, idnaStatusMapped, "\u06c6"},
+	{0xFBDB, idnaStatusMapped, "\u06c8"},
+	{0xFBDD, idnaStatusMapped, "\u06c7\u0674"},
+	{0xFBDE, idnaStatusMapped, "\u06cb"},
+	{0xFBE0, idnaStatusMapped, "\u06c5"},
+	{0xFBE2, idnaStatusMapped, "\u06c9"},
+	{0xFBE4, idnaStatusMapped, "\u06d0"},
+	{0xFBE8, idnaStatusMapped, "\u0649"},
+	{0xFBEA, idnaStatusMapped, "\u0626\u0627"},
+	{0xFBEC, idnaStatusMapped, "\u0626\u06d5"},
+	{0xFBEE, idnaStatusMapped, "\u0626\u0648"},
+	{0xFBF0, idnaStatusMapped, "\u0626\u06c7"},
+	{0xFBF2, idnaStatusMapped, "\u0626\u06c6"},
+	{0xFBF4, idnaStatusMapped, "\u0626\u06c8"},
+	{0xFBF6, idnaStatusMapped, "\u0626\u06d0"},
+	{0xFBF9, idnaStatusMapped, "\u0626\u0649"},
+	{0xFBFC, idnaStatusMapped, "\u06cc"},
+	{0xFC00, idnaStatusMapped, "\u0626\u062c"},
+	{0xFC01, idnaStatusMapped, "\u0626\u062d"},
+	{0xFC02, idnaStatusMapped, "\u0626\u0645"},
+	{0xFC03, idnaStatusMapped, "\u0626\u0649"},
+	{0xFC04, idnaStatusMapped, "\u0626\u064a"},
+	{0xFC05, idnaStatusMapped, "\u0628\u062c"},
+	{0xFC06, idnaStatusMapped, "\u0628\u062d"},
+	{0xFC07, idnaStatusMapped, "\u0628\u062e"},
+	{0xFC08, idnaStatusMapped, "\u0628\u0645"},
+	{0xFC09, idnaStatusMapped, "\u0628\u0649"},
+	{0xFC0A, idnaStatusMapped, "\u0628\u064a"},
+	{0xFC0B, idnaStatusMapped, "\u062a\u062c"},
+	{0xFC0C, idnaStatusMapped, "\u062a\u062d"},
+	{0xFC0D, idnaStatusMapped, "\u062a\u062e"},
+	{0xFC0E, idnaStatusMapped, "\u062a\u0645"},
+	{0xFC0F, idnaStatusMapped, "\u062a\u0649"},
+	{0xFC10, idnaStatusMapped, "\u062a\u064a"},
+	{0xFC11, idnaStatusMapped, "\u062b\u062c"},
+	{0xFC12, idnaStatusMapped, "\u062b\u0645"},
+	{0xFC13, idnaStatusMapped, "\u062b\u0649"},
+	{0xFC14, idnaStatusMapped, "\u062b\u064a"},
+	{0xFC15, idnaStatusMapped, "\u062c\u062d"},
+	{0xFC16, idnaStatusMapped, "\u062c\u0645"},
+	{0xFC17, idnaStatusMapped, "\u062d\u062c"},
+	{0xFC18, idnaStatusMapped, "\u062d\u0645"},
+	{0xFC19, idnaStatusMapped, "\u062e\u062c"},
+	{0xFC1A, idnaStatusMapped, "\u062e\u062d"},
+	{0xFC1B, idnaStatusMapped, "\u062e\u0645"},
+	{0xFC1C, idnaStatusMapped, "\u0633\u062c"},
+	{0xFC1D, idnaStatusMapped, "\u0633\u062d"},
+	{0xFC1E, idnaStatusMapped, "\u0633\u062e"},
+	{0xFC1F, idnaStatusMapped, "\u0633\u0645"},
+	{0xFC20, idnaStatusMapped, "\u0635\u062d"},
+	{0xFC21, idnaStatusMapped, "\u0635\u0645"},
+	{0xFC22, idnaStatusMapped, "\u0636\u062c"},
+	{0xFC23, idnaStatusMapped, "\u0636\u062d"},
+	{0xFC24, idnaStatusMapped, "\u0636\u062e"},
+	{0xFC25, idnaStatusMapped, "\u0636\u0645"},
+	{0xFC26, idnaStatusMapped, "\u0637\u062d"},
+	{0xFC27, idnaStatusMapped, "\u0637\u0645"},
+	{0xFC28, idnaStatusMapped, "\u0638\u0645"},
+	{0xFC29, idnaStatusMapped, "\u0639\u062c"},
+	{0xFC2A, idnaStatusMapped, "\u0639\u0645"},
+	{0xFC2B, idnaStatusMapped, "\u063a\u062c"},
+	{0xFC2C, idnaStatusMapped, "\u063a\u0645"},
+	{0xFC2D, idnaStatusMapped, "\u0641\u062c"},
+	{0xFC2E, idnaStatusMapped, "\u0641\u062d"},
+	{0xFC2F, idnaStatusMapped, "\u0641\u062e"},
+	{0xFC30, idnaStatusMapped, "\u0641\u0645"},
+	{0xFC31, idnaStatusMapped, "\u0641\u0649"},
+	{0xFC32, idnaStatusMapped, "\u0641\u064a"},
+	{0xFC33, idnaStatusMapped, "\u0642\u062d"},
+	{0xFC34, idnaStatusMapped, "\u0642\u0645"},
+	{0xFC35, idnaStatusMapped, "\u0642\u0649"},
+	{0xFC36, idnaStatusMapped, "\u0642\u064a"},
+	{0xFC37, idnaStatusMapped, "\u0643\u0627"},
+	{0xFC38, idnaStatusMapped, "\u0643\u062c"},
+	{0xFC39, idnaStatusMapped, "\u0643\u062d"},
+	{0xFC3A, idnaStatusMapped, "\u0643\u062e"},
+	{0xFC3B, idnaStatusMapped, "\u0643\u0644"},
+	{0xFC3C, idnaStatusMapped, "\u0643\u0645"},
+	{0xFC3D, idnaStatusMapped, "\u0643\u0649"},
+	{0xFC3E, idnaStatusMapped, "\u0643\u064a"},
+	{0xFC3F, idnaStatusMapped, "\u0644\u062c"},
+	{0xFC40, idnaStatusMapped, "\u0644\u062d"},
+	{0xFC41, idnaStatusMapped, "\u0644\u062e"},
+	{0xFC42, idnaStatusMapped, "\u0644\u0645"},
+	{0xFC43, idnaStatusMapped, "\u0644\u0649"},
+	{0xFC44, idnaStatusMapped, "\u0644\u064a"},
+	{0xFC45, idnaStatusMapped, "\u0645\u062c"},
+	{0xFC46, idnaStatusMapped, "\u0645\u062d"},
+	{0xFC47, idnaStatusMapped, "\u0645\u062e"},
+	{0xFC48, idnaStatusMapped, "\u0645\u0645"},
+	{0xFC49, idnaStatusMapped, "\u0645\u0649"},
+	{0xFC4A, idnaStatusMapped, "\u0645\u064a"},
+	{0xFC4B, idnaStatusMapped, "\u0646\u062c"},
+	{0xFC4C, idnaStatusMapped, "\u0646\u062d"},
+	{0xFC4D, idnaStatusMapped, "\u0646\u062e"},
+	{0xFC4E, idnaStatusMapped, "\u0646\u0645"},
+	{0xFC4F, idnaStatusMapped, "\u0646\u0649"},
+	{0xFC50, idnaStatusMapped, "\u0646\u064a"},
+	{0xFC51, idnaStatusMapped, "\u0647\u062c"},
+	{0xFC52, idnaStatusMapped, "\u0647\u0645"},
+	{0xFC53, idnaStatusMapped, "\u0647\u0649"},
+	{0xFC54, idnaStatusMapped, "\u0647\u064a"},
+	{0xFC55, idnaStatusMapped, "\u064a\u062c"},
+	{0xFC56, idnaStatusMapped, "\u064a\u062d"},
+	{0xFC57, idnaStatusMapped, "\u064a\u062e"},
+	{0xFC58, idnaStatusMapped, "\u064a\u0645"},
+	{0xFC59, idnaStatusMapped, "\u064a\u0649"},
+	{0xFC5A, idnaStatusMapped, "\u064a\u064a"},
+	{0xFC5B, idnaStatusMapped, "\u0630\u0670"},
+	{0xFC5C, idnaStatusMapped, "\u0631\u0670"},
+	{0xFC5D, idnaStatusMapped, "\u0649\u0670"},
+	{0xFC5E, idnaStatusMapped, " \u064c\u0651"},
+	{0xFC5F, idnaStatusMapped, " \u064d\u0651"},
+	{0xFC60, idnaStatusMapped, " \u064e\u0651"},
+	{0xFC61, idnaStatusMapped, " \u064f\u0651"},
+	{0xFC62, idnaStatusMapped, " \u0650\u0651"},
+	{0xFC63, idnaStatusMapped, " \u0651\u0670"},
+	{0xFC64, idnaStatusMapped, "\u0626\u0631"},
+	{0xFC65, idnaStatusMapped, "\u0626\u0632"},
+	{0xFC66, idnaStatusMapped, "\u0626\u0645"},
+	{0xFC67, idnaStatusMapped, "\u0626\u0646"},
+	{0xFC68, idnaStatusMapped, "\u0626\u0649"},
+	{0xFC69, idnaStatusMapped, "\u0626\u064a"},
+	{0xFC6A, idnaStatusMapped, "\u0628\u0631"},
+	{0xFC6B, idnaStatusMapped, "\u0628\u0632"},
+	{0xFC6C, idnaStatusMapped, "\u0628\u0645"},
+	{0xFC6D, idnaStatusMapped, "\u0628\u0646"},
+	{0xFC6E, idnaStatusMapped, "\u0628\u0649"},
+	{0xFC6F, idnaStatusMapped, "\u0628\u064a"},
+	{0xFC70, idnaStatusMapped, "\u062a\u0631"},
+	{0xFC71, idnaStatusMapped, "\u062a\u0632"},
+	{0xFC72, idnaStatusMapped, "\u062a\u0645"},
+	{0xFC73, idnaStatusMapped, "\u062a\u0646"},
+	{0xFC74, idnaStatusMapped, "\u062a\u0649"},
+	{0xFC75, idnaStatusMapped, "\u062a\u064a"},
+	{0xFC76, idnaStatusMapped, "\u062b\u0631"},
+	{0xFC77, idnaStatusMapped, "\u062b\u0632"},
+	{0xFC78, idnaStatusMapped, "\u062b\u0645"},
+	{0xFC79, idnaStatusMapped, "\u062b\u0646"},
+	{0xFC7A, idnaStatusMapped, "\u062b\u0649"},
+	{0xFC7B, idnaStatusMapped, "\u062b\u064a"},
+	{0xFC7C, idnaStatusMapped, "\u0641\u0649"},
+	{0xFC7D, idnaStatusMapped, "\u0641\u064a"},
+	{0xFC7E, idnaStatusMapped, "\u0642\u0649"},
+	{0xFC7F, idnaStatusMapped, "\u0642\u064a"},
+	{0xFC80, idnaStatusMapped, "\u0643\u0627"},
+	{0xFC81, idnaStatusMapped, "\u0643\u0644"},
+	{0xFC82, idnaStatusMapped, "\u0643\u0645"},
+	{0xFC83, idnaStatusMapped, "\u0643\u0649"},
+	{0xFC84, idnaStatusMapped, "\u0643\u064a"},
+	{0xFC85, idnaStatusMapped, "\u0644\u0645"},
+	{0xFC86, idnaStatusMapped, "\u0644\u0649"},
+	{0xFC87, idnaStatusMapped, "\u0644\u064a"},
+	{0xFC88, idnaStatusMapped, "\u0645\u0627"},
+	{0xFC89, idnaStatusMapped, "\u0645\u0645"},
+	{0xFC8A, idnaStatusMapped, "\u0646\u0631"},
+	{0xFC8B, idnaStatusMapped, "\u0646\u0632"},
+	{0xFC8C, idnaStatusMapped, "\u0646\u0645"},
+	{0xFC8D, idnaStatusMapped, "\u0646\u0646"},
+	{0xFC8E, idnaStatusMapped, "\u0646\u0649"},
+	{0xFC8F, idnaStatusMapped, "\u0646\u064a"},
+	{0xFC90, idnaStatusMapped, "\u0649\u0670"},
+	{0xFC91, idnaStatusMapped, "\u064a\u0631"},
+	{0xFC92, idnaStatusMapped, "\u064a\u0632"},
+	{0xFC93, idnaStatusMapped, "\u064a\u0645"},
+	{0xFC94, idnaStatusMapped, "\u064a\u0646"},
+	{0xFC95, idnaStatusMapped, "\u064a\u0649"},
+	{0xFC96, idnaStatusMapped, "\u064a\u064a"},
+	{0xFC97, idnaStatusMapped, "\u0626\u062c"},
+	{0xFC98, idnaStatusMapped, "\u0626\u062d"},
+	{0xFC99, idnaStatusMapped, "\u0626\u062e"},
+	{0xFC9A, idnaStatusMapped, "\u0626\u0645"},
+	{0xFC9B, idnaStatusMapped, "\u0626\u0647"},
+	{0xFC9C, idnaStatusMapped, "\u0628\u062c"},
+	{0xFC9D, idnaStatusMapped, "\u0628\u062d"},
+	{0xFC9E, idnaStatusMapped, "\u0628\u062e"},
+	{0xFC9F, idnaStatusMapped, "\u0628\u0645"},
+	{0xFCA0, idnaStatusMapped, "\u0628\u0647"},
+	{0xFCA1, idnaStatusMapped, "\u062a\u062c"},
+	{0xFCA2, idnaStatusMapped, "\u062a\u062d"},
+	{0xFCA3, idnaStatusMapped, "\u062a\u062e"},
+	{0xFCA4, idnaStatusMapped, "\u062a\u0645"},
+	{0xFCA5, idnaStatusMapped, "\u062a\u0647"},
+	{0xFCA6, idnaStatusMapped, "\u062b\u0645"},
+	{0xFCA7, idnaStatusMapped, "\u062c\u062d"},
+	{0xFCA8, idnaStatusMapped, "\u062c\u0645"},
+	{0xFCA9, idnaStatusMapped, "\u062d\u062c"},
+	{0xFCAA, idnaStatusMapped, "\u062d\u0645"},
+	{0xFCAB, idnaStatusMapped, "\u062e\u062c"},
+	{0xFCAC, idnaStatusMapped, "\u062e\u0645"},
+	{0xFCAD, idnaStatusMapped, "\u0633\u062c"},
+	{0xFCAE, idnaStatusMapped, "\u0633\u062d"},
+	{0xFCAF, idnaStatusMapped, "\u0633\u062e"},
+	{0xFCB0, idnaStatusMapped, "\u0633\u0645"},
+	{0xFCB1, idnaStatusMapped, "\u0635\u062d"},
+	{0xFCB2, idnaStatusMapped, "\u0635\u062e"},
+	{0xFCB3, idnaStatusMapped, "\u0635\u0645"},
+	{0xFCB4, idnaStatusMapped, "\u0636\u062c"},
+	{0xFCB5, idnaStatusMapped, "\u0636\u062d"},
+	{0xFCB6, idnaStatusMapped, "\u0636\u062e"},
+	{0xFCB7, idnaStatusMapped, "\u0636\u0645"},
+	{0xFCB8, idnaStatusMapped, "\u0637\u062d"},
+	{0xFCB9, idnaStatusMapped, "\u0638\u0645"},
+	{0xFCBA, idnaStatusMapped, "\u0639\u062c"},
+	{0xFCBB, idnaStatusMapped, "\u0639\u0645"},
+	{0xFCBC, idnaStatusMapped, "\u063a\u062c"},
+	{0xFCBD, idnaStatusMapped, "\u063a\u0645"},
+	{0xFCBE, idnaStatusMapped, "\u0641\u062c"},
+	{0xFCBF, idnaStatusMapped, "\u0641\u062d"},
+	{0xFCC0, idnaStatusMapped, "\u0641\u062e"},
+	{0xFCC1, idnaStatusMapped, "\u0641\u0645"},
+	{0xFCC2, idnaStatusMapped, "\u0642\u062d"},
+	{0xFCC3, idnaStatusMapped, "\u0642\u0645"},
+	{0xFCC4, idnaStatusMapped, "\u0643\u062c"},
+	{0xFCC5, idnaStatusMapped, "\u0643\u062d"},
+	{0xFCC6, idnaStatusMapped, "\u0643\u062e"},
+	{0xFCC7, idnaStatusMapped, "\u0643\u0644"},
+	{0xFCC8, idnaStatusMapped, "\u0643\u0645"},
+	{0xFCC9, idnaStatusMapped, "\u0644\u062c"},
+	{0xFCCA, idnaStatusMapped, "\u0644\u062d"},
+	{0xFCCB, idnaStatusMapped, "\u0644\u062e"},
+	{0xFCCC, idnaStatusMapped, "\u0644\u0645"},
+	{0xFCCD, idnaStatusMapped, "\u0644\u0647"},
+	{0xFCCE, idnaStatusMapped, "\u0645\u062c"},
+	{0xFCCF, idnaStatusMapped, "\u0645\u062d"},
+	{0xFCD0, idnaStatusMapped, "\u0645\u062e"},
+	{0xFCD1, idnaStatusMapped, "\u0645\u0645"},
+	{0xFCD2, idnaStatusMapped, "\u0646\u062c"},
+	{0xFCD3, idnaStatusMapped, "\u0646\u062d"},
+	{0xFCD4, idnaStatusMapped, "\u0646\u062e"},
+	{0xFCD5, idnaStatusMapped, "\u0646\u0645"},
+	{0xFCD6, idnaStatusMapped, "\u0646\u0647"},
+	{0xFCD7, idnaStatusMapped, "\u0647\u062c"},
+	{0xFCD8, idnaStatusMapped, "\u0647\u0645"},
+	{0xFCD9, idnaStatusMapped, "\u0647\u0670"},
+	{0xFCDA, idnaStatusMapped, "\u064a\u062c"},
+	{0xFCDB, idnaStatusMapped, "\u064a\u062d"},
+	{0xFCDC, idnaStatusMapped, "\u064a\u062e"},
+	{0xFCDD, idnaStatusMapped, "\u064a\u0645"},
+	{0xFCDE, idnaStatusMapped, "\u064a\u0647"},
+	{0xFCDF, idnaStatusMapped, "\u0626\u0645"},
+	{0xFCE0, idnaStatusMapped, "\u0626\u0647"},
+	{0xFCE1, idnaStatusMapped, "\u0628\u0645"},
+	{0xFCE2, idnaStatusMapped, "\u0628\u0647"},
+	{0xFCE3, idnaStatusMapped, "\u062a\u0645"},
+	{0xFCE4, idnaStatusMapped, "\u062a\u0647"},
+	{0xFCE5, idnaStatusMapped, "\u062b\u0645"},
+	{0xFCE6, idnaStatusMapped, "\u062b\u0647"},
+	{0xFCE7, idnaStatusMapped, "\u0633\u0645"},
+	{0xFCE8, idnaStatusMapped, "\u0633\u0647"},
+	{0xFCE9, idnaStatusMapped, "\u0634\u0645"},
+	{0xFCEA, idnaStatusMapped, "\u0634\u0647"},
+	{0xFCEB, idnaStatusMapped, "\u0643\u0644"},
+	{0xFCEC, idnaStatusMapped, "\u0643\u0645"},
+	{0xFCED, idnaStatusMapped, "\u0644\u0645"},
+	{0xFCEE, idnaStatusMapped, "\u0646\u0645"},
+	{0xFCEF, idnaStatusMapped, "\u0646\u0647"},
+	{0xFCF0, idnaStatusMapped, "\u064a\u0645"},
+	{0xFCF1, idnaStatusMapped, "\u064a\u0647"},
+	{0xFCF2, idnaStatusMapped, "\u0640\u064e\u0651"},
+	{0xFCF3, idnaStatusMapped, "\u0640\u064f\u0651"},
+	{0xFCF4, idnaStatusMapped, "\u0640\u0650\u0651"},
+	{0xFCF5, idnaStatusMapped, "\u0637\u0649"},
+	{0xFCF6, idnaStatusMapped, "\u0637\u064a"},
+	{0xFCF7, idnaStatusMapped, "\u0639\u0649"},
+	{0xFCF8, idnaStatusMapped, "\u0639\u064a"},
+	{0xFCF9, idnaStatusMapped, "\u063a\u0649"},
+	{0xFCFA, idnaStatusMapped, "\u063a\u064a"},
+	{0xFCFB, idnaStatusMapped, "\u0633\u0649"},
+	{0xFCFC, idnaStatusMapped, "\u0633\u064a"},
+	{0xFCFD, idnaStatusMapped, "\u0634\u0649"},
+	{0xFCFE, idnaStatusMapped, "\u0634\u064a"},
+	{0xFCFF, idnaStatusMapped, "\u062d\u0649"},
+	{0xFD00, idnaStatusMapped, "\u062d\u064a"},
+	{0xFD01, idnaStatusMapped, "\u062c\u0649"},
+	{0xFD02, idnaStatusMapped, "\u062c\u064a"},
+	{0xFD03, idnaStatusMapped, "\u062e\u0649"},
+	{0xFD04, idnaStatusMapped, "\u062e\u064a"},
+	{0xFD05, idnaStatusMapped, "\u0635\u0649"},
+	{0xFD06, idnaStatusMapped, "\u0635\u064a"},
+	{0xFD07, idnaStatusMapped, "\u0636\u0649"},
+	{0xFD08, idnaStatusMapped, "\u0636\u064a"},
+	{0xFD09, idnaStatusMapped, "\u0634\u062c"},
+	{0xFD0A, idnaStatusMapped, "\u0634\u062d"},
+	{0xFD0B, idnaStatusMapped, "\u0634\u062e"},
+	{0xFD0C, idnaStatusMapped, "\u0634\u0645"},
+	{0xFD0D, idnaStatusMapped, "\u0634\u0631"},
+	{0xFD0E, idnaStatusMapped, "\u0633\u0631"},
+	{0xFD0F, idnaStatusMapped, "\u0635\u0631"},
+	{0xFD10, idnaStatusMapped, "\u0636\u0631"},
+	{0xFD11, idnaStatusMapped, "\u0637\u0649"},
+	{0xFD12, idnaStatusMapped, "\u0637\u064a"},
+	{0xFD13, idnaStatusMapped, "\u0639\u0649"},
+	{0xFD14, idnaStatusMapped, "\u0639\u064a"},
+	{0xFD15, idnaStatusMapped, "\u063a\u0649"},
+	{0xFD16, idnaStatusMapped, "\u063a\u064a"},
+	{0xFD17, idnaStatusMapped, "\u0633\u0649"},
+	{0xFD18, idnaStatusMapped, "\u0633\u064a"},
+	{0xFD19, idnaStatusMapped, "\u0634\u0649"},
+	{0xFD1A, idnaStatusMapped, "\u0634\u064a"},
+	{0xFD1B, idnaStatusMapped, "\u062d\u0649"},
+	{0xFD1C, idnaStatusMapped, "\u062d\u064a"},
+	{0xFD1D, idnaStatusMapped, "\u062c\u0649"},
+	{0xFD1E, idnaStatusMapped, "\u062c\u064a"},
+	{0xFD1F, idnaStatusMapped, "\u062e\u0649"},
+	{0xFD20, idnaStatusMapped, "\u062e\u064a"},
+	{0xFD21, idnaStatusMapped, "\u0635\u0649"},
+	{0xFD22, idnaStatusMapped, "\u0635\u064a"},
+	{0xFD23, idnaStatusMapped, "\u0636\u0649"},
+	{0xFD24, idnaStatusMapped, "\u0636\u064a"},
+	{0xFD25, idnaStatusMapped, "\u0634\u062c"},
+	{0xFD26, idnaStatusMapped, "\u0634\u062d"},
+	{0xFD27, idnaStatusMapped, "\u0634\u062e"},
+	{0xFD28, idnaStatusMapped, "\u0634\u0645"},
+	{0xFD29, idnaStatusMapped, "\u0634\u0631"},
+	{0xFD2A, idnaStatusMapped, "\u0633\u0631"},
+	{0xFD2B, idnaStatusMapped, "\u0635\u0631"},
+	{0xFD2C, idnaStatusMapped, "\u0636\u0631"},
+	{0xFD2D, idnaStatusMapped, "\u0634\u062c"},
+	{0xFD2E, idnaStatusMapped, "\u0634\u062d"},
+	{0xFD2F, idnaStatusMapped, "\u0634\u062e"},
+	{0xFD30, idnaStatusMapped, "\u0634\u0645"},
+	{0xFD31, idnaStatusMapped, "\u0633\u0647"},
+	{0xFD32, idnaStatusMapped, "\u0634\u0647"},
+	{0xFD33, idnaStatusMapped, "\u0637\u0645"},
+	{0xFD34, idnaStatusMapped, "\u0633\u062c"},
+	{0xFD35, idnaStatusMapped, "\u0633\u062d"},
+	{0xFD36, idnaStatusMapped, "\u0633\u062e"},
+	{0xFD37, idnaStatusMapped, "\u0634\u062c"},
+	{0xFD38, idnaStatusMapped, "\u0634\u062d"},
+	{0xFD39, idnaStatusMapped, "\u0634\u062e"},
+	{0xFD3A, idnaStatusMapped, "\u0637\u0645"},
+	{0xFD3B, idnaStatusMapped, "\u0638\u0645"},
+	{0xFD3C, idnaStatusMapped, "\u0627\u064b"},
+	{0xFD3E, idnaStatusValid, ""},
+	{0xFD50, idnaStatusMapped, "\u062a\u062c\u0645"},
+	{0xFD51, idnaStatusMapped, "\u062a\u062d\u062c"},
+	{0xFD53, idnaStatusMapped, "\u062a\u062d\u0645"},
+	{0xFD54, idnaStatusMapped, "\u062a\u062e\u0645"},
+	{0xFD55, idnaStatusMapped, "\u062a\u0645\u062c"},
+	{0xFD56, idnaStatusMapped, "\u062a\u0645\u062d"},
+	{0xFD57, idnaStatusMapped, "\u062a\u0645\u062e"},
+	{0xFD58, idnaStatusMapped, "\u062c\u0645\u062d"},
+	{0xFD5A, idnaStatusMapped, "\u062d\u0645\u064a"},
+	{0xFD5B, idnaStatusMapped, "\u062d\u0645\u0649"},
+	{0xFD5C, idnaStatusMapped, "\u0633\u062d\u062c"},
+	{0xFD5D, idnaStatusMapped, "\u0633\u062c\u062d"},
+	{0xFD5E, idnaStatusMapped, "\u0633\u062c\u0649"},
+	{0xFD5F, idnaStatusMapped, "\u0633\u0645\u062d"},
+	{0xFD61, idnaStatusMapped, "\u0633\u0645\u062c"},
+	{0xFD62, idnaStatusMapped, "\u0633\u0645\u0645"},
+	{0xFD64, idnaStatusMapped, "\u0635\u062d\u062d"},
+	{0xFD66, idnaStatusMapped, "\u0635\u0645\u0645"},
+	{0xFD67, idnaStatusMapped, "\u0634\u062d\u0645"},
+	{0xFD69, idnaStatusMapped, "\u0634\u062c\u064a"},
+	{0xFD6A, idnaStatusMapped, "\u0634\u0645\u062e"},
+	{0xFD6C, idnaStatusMapped, "\u0634\u0645\u0645"},
+	{0xFD6E, idnaStatusMapped, "\u0636\u062d\u0649"},
+	{0xFD6F, idnaStatusMapped, "\u0636\u062e\u0645"},
+	{0xFD71, idnaStatusMapped, "\u0637\u0645\u062d"},
+	{0xFD73, idnaStatusMapped, "\u0637\u0645\u0645"},
+	{0xFD74, idnaStatusMapped, "\u0637\u0645\u064a"},
+	{0xFD75, idnaStatusMapped, "\u0639\u062c\u0645"},
+	{0xFD76, idnaStatusMapped, "\u0639\u0645\u0645"},
+	{0xFD78, idnaStatusMapped, "\u0639\u0645\u0649"},
+	{0xFD79, idnaStatusMapped, "\u063a\u0645\u0645"},
+	{0xFD7A, idnaStatusMapped, "\u063a\u0645\u064a"},
+	{0xFD7B, idnaStatusMapped, "\u063a\u0645\u0649"},
+	{0xFD7C, idnaStatusMapped, "\u0641\u062e\u0645"},
+	{0xFD7E, idnaStatusMapped, "\u0642\u0645\u062d"},
+	{0xFD7F, idnaStatusMapped, "\u0642\u0645\u0645"},
+	{0xFD80, idnaStatusMapped, "\u0644\u062d\u0645"},
+	{0xFD81, idnaStatusMapped, "\u0644\u062d\u064a"},
+	{0xFD82, idnaStatusMapped, "\u0644\u062d\u0649"},
+	{0xFD83, idnaStatusMapped, "\u0644\u062c\u062c"},
+	{0xFD85, idnaStatusMapped, "\u0644\u062e\u0645"},
+	{0xFD87, idnaStatusMapped, "\u0644\u0645\u062d"},
+	{0xFD89, idnaStatusMapped, "\u0645\u062d\u062c"},
+	{0xFD8A, idnaStatusMapped, "\u0645\u062d\u0645"},
+	{0xFD8B, idnaStatusMapped, "\u0645\u062d\u064a"},
+	{0xFD8C, idnaStatusMapped, "\u0645\u062c\u062d"},
+	{0xFD8D, idnaStatusMapped, "\u0645\u062c\u0645"},
+	{0xFD8E, idnaStatusMapped, "\u0645\u062e\u062c"},
+	{0xFD8F, idnaStatusMapped, "\u0645\u062e\u0645"},
+	{0xFD90, idnaStatusValid, ""},
+	{0xFD92, idnaStatusMapped, "\u0645\u062c\u062e"},
+	{0xFD93, idnaStatusMapped, "\u0647\u0645\u062c"},
+	{0xFD94, idnaStatusMapped, "\u0647\u0645\u0645"},
+	{0xFD95, idnaStatusMapped, "\u0646\u062d\u0645"},
+	{0xFD96, idnaStatusMapped, "\u0646\u062d\u0649"},
+	{0xFD97, idnaStatusMapped, "\u0646\u062c\u0645"},
+	{0xFD99, idnaStatusMapped, "\u0646\u062c\u0649"},
+	{0xFD9A, idnaStatusMapped, "\u0646\u0645\u064a"},
+	{0xFD9B, idnaStatusMapped, "\u0646\u0645\u0649"},
+	{0xFD9C, idnaStatusMapped, "\u064a\u0645\u0645"},
+	{0xFD9E, idnaStatusMapped, "\u0628\u062e\u064a"},
+	{0xFD9F, idnaStatusMapped, "\u062a\u062c\u064a"},
+	{0xFDA0, idnaStatusMapped, "\u062a\u062c\u0649"},
+	{0xFDA1, idnaStatusMapped, "\u062a\u062e\u064a"},
+	{0xFDA2, idnaStatusMapped, "\u062a\u062e\u0649"},
+	{0xFDA3, idnaStatusMapped, "\u062a\u0645\u064a"},
+	{0xFDA4, idnaStatusMapped, "\u062a\u0645\u0649"},
+	{0xFDA5, idnaStatusMapped, "\u062c\u0645\u064a"},
+	{0xFDA6, idnaStatusMapped, "\u062c\u062d\u0649"},
+	{0xFDA7, idnaStatusMapped, "\u062c\u0645\u0649"},
+	{0xFDA8, idnaStatusMapped, "\u0633\u062e\u0649"},
+	{0xFDA9, idnaStatusMapped, "\u0635\u062d\u064a"},
+	{0xFDAA, idnaStatusMapped, "\u0634\u062d\u064a"},
+	{0xFDAB, idnaStatusMapped, "\u0636\u062d\u064a"},
+	{0xFDAC, idnaStatusMapped, "\u0644\u062c\u064a"},
+	{0xFDAD, idnaStatusMapped, "\u0644\u0645\u064a"},
+	{0xFDAE, idnaStatusMapped, "\u064a\u062d\u064a"},
+	{0xFDAF, idnaStatusMapped, "\u064a\u062c\u064a"},
+	{0xFDB0, idnaStatusMapped, "\u064a\u0645\u064a"},
+	{0xFDB1, idnaStatusMapped, "\u0645\u0645\u064a"},
+	{0xFDB2, idnaStatusMapped, "\u0642\u0645\u064a"},
+	{0xFDB3, idnaStatusMapped, "\u0646\u062d\u064a"},
+	{0xFDB4, idnaStatusMapped, "\u0642\u0645\u062d"},
+	{0xFDB5, idnaStatusMapped, "\u0644\u062d\u0645"},
+	{0xFDB6, idnaStatusMapped, "\u0639\u0645\u064a"},
+	{0xFDB7, idnaStatusMapped, "\u0643\u0645\u064a"},
+	{0xFDB8, idnaStatusMapped, "\u0646\u062c\u062d"},
+	{0xFDB9, idnaStatusMapped, "\u0645\u062e\u064a"},
+	{0xFDBA, idnaStatusMapped, "\u0644\u062c\u0645"},
+	{0xFDBB, idnaStatusMapped, "\u0643\u0645\u0645"},
+	{0xFDBC, idnaStatusMapped, "\u0644\u062c\u0645"},
+	{0xFDBD, idnaStatusMapped, "\u0646\u062c\u062d"},
+	{0xFDBE, idnaStatusMapped, "\u062c\u062d\u064a"},
+	{0xFDBF, idnaStatusMapped, "\u062d\u062c\u064a"},
+	{0xFDC0, idnaStatusMapped, "\u0645\u062c\u064a"},
+	{0xFDC1, idnaStatusMapped, "\u0641\u0645\u064a"},
+	{0xFDC2, idnaStatusMapped, "\u0628\u062d\u064a"},
+	{0xFDC3, idnaStatusMapped, "\u0643\u0645\u0645"},
+	{0xFDC4, idnaStatusMapped, "\u0639\u062c\u0645"},
+	{0xFDC5, idnaStatusMapped, "\u0635\u0645\u0645"},
+	{0xFDC6, idnaStatusMapped, "\u0633\u062e\u064a"},
+	{0xFDC7, idnaStatusMapped, "\u0646\u062c\u064a"},
+	{0xFDC8, idnaStatusValid, ""},
+	{0xFDD0, idnaStatusDisallowed, ""},
+	{0xFDF0, idnaStatusMapped, "\u0635\u0644\u06d2"},
+	{0xFDF1, idnaStatusMapped, "\u0642\u0644\u06d2"},
+	{0xFDF2, idnaStatusMapped, "\u0627\u0644\u0644\u0647"},
+	{0xFDF3, idnaStatusMapped, "\u0627\u0643\u0628\u0631"},
+	{0xFDF4, idnaStatusMapped, "\u0645\u062d\u0645\u062f"},
+	{0xFDF5, idnaStatusMapped, "\u0635\u0644\u0639\u0645"},
+	{0xFDF6, idnaStatusMapped, "\u0631\u0633\u0648\u0644"},
+	{0xFDF7, idnaStatusMapped, "\u0639\u0644\u064a\u0647"},
+	{0xFDF8, idnaStatusMapped, "\u0648\u0633\u0644\u0645"},
+	{0xFDF9, idnaStatusMapped, "\u0635\u0644\u0649"},
+	{0xFDFA, idnaStatusMapped, "\u0635\u0644\u0649 \u0627\u0644\u0644\u0647 \u0639\u0644\u064a\u0647 \u0648\u0633\u0644\u0645"},
+	{0xFDFB, idnaStatusMapped, "\u062c\u0644 \u062c\u0644\u0627\u0644\u0647"},
+	{0xFDFC, idnaStatusMapped, "\u0631\u06cc\u0627\u0644"},
+	{0xFDFD, idnaStatusValid, ""},
+	{0xFE00, idnaStatusIgnored, ""},
+	{0xFE10, idnaStatusMapped, ","},
+	{0xFE11, idnaStatusMapped, "\u3001"},
+	{0xFE12, idnaStatusDisallowed, ""},
+	{0xFE13, idnaStatusMapped, ":"},
+	{0xFE14, idnaStatusMapped, ";"},
+	{0xFE15, idnaStatusMapped, "!"},
+	{0xFE16, idnaStatusMapped, "?"},
+	{0xFE17, idnaStatusMapped, "\u3016"},
+	{0xFE18, idnaStatusMapped, "\u3017"},
+	{0xFE19, idnaStatusDisallowed, ""},
+	{0xFE20, idnaStatusValid, ""},
+	{0xFE30, idnaStatusDisallowed, ""},
+	{0xFE31, idnaStatusMapped, "\u2014"},
+	{0xFE32, idnaStatusMapped, "\u2013"},
+	{0xFE33, idnaStatusMapped, "_"},
+	{0xFE35, idnaStatusMapped, "("},
+	{0xFE36, idnaStatusMapped, ")"},
+	{0xFE37, idnaStatusMapped, "{"},
+	{0xFE38, idnaStatusMapped, "}"},
+	{0xFE39, idnaStatusMapped, "\u3014"},
+	{0xFE3A, idnaStatusMapped, "\u3015"},
+	{0xFE3B, idnaStatusMapped, "\u3010"},
+	{0xFE3C, idnaStatusMapped, "\u3011"},
+	{0xFE3D, idnaStatusMapped, "\u300a"},
+	{0xFE3E, idnaStatusMapped, "\u300b"},
+	{0xFE3F, idnaStatusMapped, "\u3008"},
+	{0xFE40, idnaStatusMapped, "\u3009"},
+	{0xFE41, idnaStatusMapped, "\u300c"},
+	{0xFE42, idnaStatusMapped, "\u300d"},
+	{0xFE43, idnaStatusMapped, "\u300e"},
+	{0xFE44, idnaStatusMapped, "\u300f"},
+	{0xFE45, idnaStatusValid, ""},
+	{0xFE47, idnaStatusMapped, "["},
+	{0xFE48, idnaStatusMapped, "]"},
+	{0xFE49, idnaStatusMapped, " \u0305"},
+	{0xFE4D, idnaStatusMapped, "_"},
+	{0xFE50, idnaStatusMapped, ","},
+	{0xFE51, idnaStatusMapped, "\u3001"},
+	{0xFE52, idnaStatusDisallowed, ""},
+	{0xFE54, idnaStatusMapped, ";"},
+	{0xFE55, idnaStatusMapped, ":"},
+	{0xFE56, idnaStatusMapped, "?"},
+	{0xFE57, idnaStatusMapped, "!"},
+	{0xFE58, idnaStatusMapped, "\u2014"},
+	{0xFE59, idnaStatusMapped, "("},
+	{0xFE5A, idnaStatusMapped, ")"},
+	{0xFE5B, idnaStatusMapped, "{"},
+	{0xFE5C, idnaStatusMapped, "}"},
+	{0xFE5D, idnaStatusMapped, "\u3014"},
+	{0xFE5E, idnaStatusMapped, "\u3015"},
+	{0xFE5F, idnaStatusMapped, "#"},
+	{0xFE60, idnaStatusMapped, "&"},
+	{0xFE61, idnaStatusMapped, "*"},
+	{0xFE62, idnaStatusMapped, "+"},
+	{0xFE63, idnaStatusMapped, "-"},
+	{0xFE64, idnaStatusMapped, "<"},
+	{0xFE65, idnaStatusMapped, ">"},
+	{0xFE66, idnaStatusMapped, "="},
+	{0xFE67, idnaStatusDisallowed, ""},
+	{0xFE68, idnaStatusMapped, "\\"},
+	{0xFE69, idnaStatusMapped, "$"},
+	{0xFE6A, idnaStatusMapped, "%"},
+	{0xFE6B, idnaStatusMapped, "@"},
+	{0xFE6C, idnaStatusDisallowed, ""},
+	{0xFE70, idnaStatusMapped, " \u064b"},
+	{0xFE71, idnaStatusMapped, "\u0640\u064b"},
+	{0xFE72, idnaStatusMapped, " \u064c"},
+	{0xFE73, idnaStatusValid, ""},
+	{0xFE74, idnaStatusMapped, " \u064d"},
+	{0xFE75, idnaStatusDisallowed, ""},
+	{0xFE76, idnaStatusMapped, " \u064e"},
+	{0xFE77, idnaStatusMapped, "\u0640\u064e"},
+	{0xFE78, idnaStatusMapped, " \u064f"},
+	{0xFE79, idnaStatusMapped, "\u0640\u064f"},
+	{0xFE7A, idnaStatusMapped, " \u0650"},
+	{0xFE7B, idnaStatusMapped, "\u0640\u0650"},
+	{0xFE7C, idnaStatusMapped, " \u0651"},
+	{0xFE7D, idnaStatusMapped, "\u0640\u0651"},
+	{0xFE7E, idnaStatusMapped, " \u0652"},
+	{0xFE7F, idnaStatusMapped, "\u0640\u0652"},
+	{0xFE80, idnaStatusMapped, "\u0621"},
+	{0xFE81, idnaStatusMapped, "\u0622"},
+	{0xFE83, idnaStatusMapped, "\u0623"},
+	{0xFE85, idnaStatusMapped, "\u0624"},
+	{0xFE87, idnaStatusMapped, "\u0625"},
+	{0xFE89, idnaStatusMapped, "\u0626"},
+	{0xFE8D, idnaStatusMapped, "\u0627"},
+	{0xFE8F, idnaStatusMapped, "\u0628"},
+	{0xFE93, idnaStatusMapped, "\u0629"},
+	{0xFE95, idnaStatusMapped, "\u062a"},
+	{0xFE99, idnaStatusMapped, "\u062b"},
+	{0xFE9D, idnaStatusMapped, "\u062c"},
+	{0xFEA1, idnaStatusMapped, "\u062d"},
+	{0xFEA5, idnaStatusMapped, "\u062e"},
+	{0xFEA9, idnaStatusMapped, "\u062f"},
+	{0xFEAB, idnaStatusMapped, "\u0630"},
+	{0xFEAD, idnaStatusMapped, "\u0631"},
+	{0xFEAF, idnaStatusMapped, "\u0632"},
+	{0xFEB1, idnaStatusMapped, "\u0633"},
+	{0xFEB5, idnaStatusMapped, "\u0634"},
+	{0xFEB9, idnaStatusMapped, "\u0635"},
+	{0xFEBD, idnaStatusMapped, "\u0636"},
+	{0xFEC1, idnaStatusMapped, "\u0637"},
+	{0xFEC5, idnaStatusMapped, "\u0638"},
+	{0xFEC9, idnaStatusMapped, "\u0639"},
+	{0xFECD, idnaStatusMapped, "\u063a"},
+	{0xFED1, idnaStatusMapped, "\u0641"},
+	{0xFED5, idnaStatusMapped, "\u0642"},
+	{0xFED9, idnaStatusMapped, "\u0643"},
+	{0xFEDD, idnaStatusMapped, "\u0644"},
+	{0xFEE1, idnaStatusMapped, "\u0645"},
+	{0xFEE5, idnaStatusMapped, "\u0646"},
+	{0xFEE9, idnaStatusMapped, "\u0647"},
+	{0xFEED, idnaStatusMapped, "\u0648"},
+	{0xFEEF, idnaStatusMapped, "\u0649"},
+	{0xFEF1, idnaStatusMapped, "\u064a"},
+	{0xFEF5, idnaStatusMapped, "\u0644\u0622"},
+	{0xFEF7, idnaStatusMapped, "\u0644\u0623"},
+	{0xFEF9, idnaStatusMapped, "\u0644\u0625"},
+	{0xFEFB, idnaStatusMapped, "\u0644\u0627"},
+	{0xFEFD, idnaStatusDisallowed, ""},
+	{0xFEFF, idnaStatusIgnored, ""},
+	{0xFF00, idnaStatusDisallowed, ""},
+	{0xFF01, idnaStatusMapped, "!"},
+	{0xFF02, idnaStatusMapped, "\""},
+	{0xFF03, idnaStatusMapped, "#"},
+	{0xFF04, idnaStatusMapped, "$"},
+	{0xFF05, idnaStatusMapped, "%"},
+	{0xFF06, idnaStatusMapped, "&"},
+	{0xFF07, idnaStatusMapped, "'"},
+	{0xFF08, idnaStatusMapped, "("},
+	{0xFF09, idnaStatusMapped, ")"},
+	{0xFF0A, idnaStatusMapped, "*"},
+	{0xFF0B, idnaStatusMapped, "+"},
+	{0xFF0C, idnaStatusMapped, ","},
+	{0xFF0D, idnaStatusMapped, "-"},
+	{0xFF0E, idnaStatusMapped, "."},
+	{0xFF0F, idnaStatusMapped, "/"},
+	{0xFF10, idnaStatusMapped, "0"},
+	{0xFF11, idnaStatusMapped, "1"},
+	{0xFF12, idnaStatusMapped, "2"},
+	{0xFF13, idnaStatusMapped, "3"},
+	{0xFF14, idnaStatusMapped, "4"},
+	{0xFF15, idnaStatusMapped, "5"},
+	{0xFF16, idnaStatusMapped, "6"},
+	{0xFF17, idnaStatusMapped, "7"},
+	{0xFF18, idnaStatusMapped, "8"},
+	{0xFF19, idnaStatusMapped, "9"},
+	{0xFF1A, idnaStatusMapped, ":"},
+	{0xFF1B, idnaStatusMapped, ";"},
+	{0xFF1C, idnaStatusMapped, "<"},
+	{0xFF1D, idnaStatusMapped, "="},
+	{0xFF1E, idnaStatusMapped, ">"},
+	{0xFF1F, idnaStatusMapped, "?"},
+	{0xFF20, idnaStatusMapped, "@"},
+	{0xFF21, idnaStatusMapped, "a"},
+	{0xFF22, idnaStatusMapped, "b"},
+	{0xFF23, idnaStatusMapped, "c"},
+	{0xFF24, idnaStatusMapped, "d"},
+	{0xFF25, idnaStatusMapped, "e"},
+	{0xFF26, idnaStatusMapped, "f"},
+	{0xFF27, idnaStatusMapped, "g"},
+	{0xFF28, idnaStatusMapped, "h"},
+	{0xFF29, idnaStatusMapped, "i"},
+	{0xFF2A, idnaStatusMapped, "j"},
+	{0xFF2B, idnaStatusMapped, "k"},
+	{0xFF2C, idnaStatusMapped, "l"},
+	{0xFF2D, idnaStatusMapped, "m"},
+	{0xFF2E, idnaStatusMapped, "n"},
+	{0xFF2F, idnaStatusMapped, "o"},
+	{0xFF30, idnaStatusMapped, "p"},
+	{0xFF31, idnaStatusMapped, "q"},
+	{0xFF32, idnaStatusMapped, "r"},
+	{0xFF33, idnaStatusMapped, "s"},
+	{0xFF34, idnaStatusMapped, "t"},
+	{0xFF35, idnaStatusMapped, "u"},
+	{0xFF36, idnaStatusMapped, "v"},
+	{0xFF37, idnaStatusMapped, "w"},
+	{0xFF38, idnaStatusMapped, "x"},
+	{0xFF39, idnaStatusMapped, "y"},
+	{0xFF3A, idnaStatusMapped, "z"},
+	{0xFF3B, idnaStatusMapped, "["},
+	{0xFF3C, idnaStatusMapped, "\\"},
+	{0xFF3D, idnaStatusMapped, "]"},
+	{0xFF3E, idnaStatusMapped, "^"},
+	{0xFF3F, idnaStatusMapped, "_"},
+	{0xFF40, idnaStatusMapped, "`"},
+	{0xFF41, idnaStatusMapped, "a"},
+	{0xFF42, idnaStatusMapped, "b"},
+	{0xFF43, idnaStatusMapped, "c"},
+	{0xFF44, idnaStatusMapped, "d"},
+	{0xFF45, idnaStatusMapped, "e"},
+	{0xFF46, idnaStatusMapped, "f"},
+	{0xFF47, idnaStatusMapped, "g"},
+	{0xFF48, idnaStatusMapped, "h"},
+	{0xFF49, idnaStatusMapped, "i"},
+	{0xFF4A, idnaStatusMapped, "j"},
+	{0xFF4B, idnaStatusMapped, "k"},
+	{0xFF4C, idnaStatusMapped, "l"},
+	{0xFF4D, idnaStatusMapped, "m"},
+	{0xFF4E, idnaStatusMapped, "n"},
+	{0xFF4F, idnaStatusMapped, "o"},
+	{0xFF50, idnaStatusMapped, "p"},
+	{0xFF51, idnaStatusMapped, "q"},
+	{0xFF52, idnaStatusMapped, "r"},
+	{0xFF53, idnaStatusMapped, "s"},
+	{0xFF54, idnaStatusMapped, "t"},
+	{0xFF55, idnaStatusMapped, "u"},
+	{0xFF56, idnaStatusMapped, "v"},
+	{0xFF57, idnaStatusMapped, "w"},
+	{0xFF58, idnaStatusMapped, "x"},
+	{0xFF59, idnaStatusMapped, "y"},
+	{0xFF5A, idnaStatusMapped, "z"},
+	{0xFF5B, idnaStatusMapped, "{"},
+	{0xFF5C, idnaStatusMapped, "|"},
+	{0xFF5D, idnaStatusMapped, "}"},
+	{0xFF5E, idnaStatusMapped, "~"},
+	{0xFF5F, idnaStatusMapped, "\u2985"},
+	{0xFF60, idnaStatusMapped, "\u2986"},
+	{0xFF61, idnaStatusMapped, "."},
+	{0xFF62, idnaStatusMapped, "\u300c"},
+	{0xFF63, idnaStatusMapped, "\u300d"},
+	{0xFF64, idnaStatusMapped, "\u3001"},
+	{0xFF65, idnaStatusMapped, "\u30fb"},
+	{0xFF66, idnaStatusMapped, "\u30f2"},
+	{0xFF67, idnaStatusMapped, "\u30a1"},
+	{0xFF68, idnaStatusMapped, "\u30a3"},
+	{0xFF69, idnaStatusMapped, "\u30a5"},
+	{0xFF6A, idnaStatusMapped, "\u30a7"},
+	{0xFF6B, idnaStatusMapped, "\u30a9"},
+	{0xFF6C, idnaStatusMapped, "\u30e3"},
+	{0xFF6D, idnaStatusMapped, "\u30e5"},
+	{0xFF6E, idnaStatusMapped, "\u30e7"},
+	{0xFF6F, idnaStatusMapped, "\u30c3"},
+	{0xFF70, idnaStatusMapped, "\u30fc"},
+	{0xFF71, idnaStatusMapped, "\u30a2"},
+	{0xFF72, idnaStatusMapped, "\u30a4"},
+	{0xFF73, idnaStatusMapped, "\u30a6"},
+	{0xFF74, idnaStatusMapped, "\u30a8"},
+	{0xFF75, idnaStatusMapped, "\u30aa"},
+	{0xFF76, idnaStatusMapped, "\u30ab"},
+	{0xFF77, idnaStatusMapped, "\u30ad"},
+	{0xFF78, idnaStatusMapped, "\u30af"},
+	{0xFF79, idnaStatusMapped, "\u30b1"},
+	{0xFF7A, idnaStatusMapped, "\u30b3"},
+	{0xFF7B, idnaStatusMapped, "\u30b5"},
+	{0xFF7C, idnaStatusMapped, "\u30b7"},
+	{0xFF7D, idnaStatusMapped, "\u30b9"},
+	{0xFF7E, idnaStatusMapped, "\u30bb"},
+	{0xFF7F, idnaStatusMapped, "\u30bd"},
+	{0xFF80, idnaStatusMapped, "\u30bf"},
+	{0xFF81, idnaStatusMapped, "\u30c1"},
+	{0xFF82, idnaStatusMapped, "\u30c4"},
+	{0xFF83, idnaStatusMapped, "\u30c6"},
+	{0xFF84, idnaStatusMapped, "\u30c8"},
+	{0xFF85, idnaStatusMapped, "\u30ca"},
+	{0xFF86, idnaStatusMapped, "\u30cb"},
+	{0xFF87, idnaStatusMapped, "\u30cc"},
+	{0xFF88, idnaStatusMapped, "\u30cd"},
+	{0xFF89, idnaStatusMapped, "\u30ce"},
+	{0xFF8A, idnaStatusMapped, "\u30cf"},
+	{0xFF8B, idnaStatusMapped, "\u30d2"},
+	{0xFF8C, idnaStatusMapped, "\u30d5"},
+	{0xFF8D, idnaStatusMapped, "\u30d8"},
+	{0xFF8E, idnaStatusMapped, "\u30db"},
+	{0xFF8F, idnaStatusMapped, "\u30de"},
+	{0xFF90, idnaStatusMapped, "\u30df"},
+	{0xFF91, idnaStatusMapped, "\u30e0"},
+	{0xFF92, idnaStatusMapped, "\u30e1"},
+	{0xFF93, idnaStatusMapped, "\u30e2"},
+	{0xFF94, idnaStatusMapped, "\u30e4"},
+	{0xFF95, idnaStatusMapped, "\u30e6"},
+	{0xFF96, idnaStatusMapped, "\u30e8"},
+	{0xFF97, idnaStatusMapped, "\u30e9"},
+	{0xFF98, idnaStatusMapped, "\u30ea"},
+	{0xFF99, idnaStatusMapped, "\u30eb"},
+	{0xFF9A, idnaStatusMapped, "\u30ec"},
+	{0xFF9B, idnaStatusMapped, "\u30ed"},
+	{0xFF9C, idnaStatusMapped, "\u30ef"},
+	{0xFF9D, idnaStatusMapped, "\u30f3"},
+	{0xFF9E, idnaStatusMapped, "\u3099"},
+	{0xFF9F, idnaStatusMapped, "\u309a"},
+	{0xFFA0, idnaStatusIgnored, ""},
+	{0xFFA1, idnaStatusMapped, "\u1100"},
+	{0xFFA2, idnaStatusMapped, "\u1101"},
+	{0xFFA3, idnaStatusMapped, "\u11aa"},
+	{0xFFA4, idnaStatusMapped, "\u1102"},
+	{0xFFA5, idnaStatusMapped, "\u11ac"},
+	{0xFFA6, idnaStatusMapped, "\u11ad"},
+	{0xFFA7, idnaStatusMapped, "\u1103"},
+	{0xFFA8, idnaStatusMapped, "\u1104"},
+	{0xFFA9, idnaStatusMapped, "\u1105"},
+	{0xFFAA, idnaStatusMapped, "\u11b0"},
+	{0xFFAB, idnaStatusMapped, "\u11b1"},
+	{0xFFAC, idnaStatusMapped, "\u11b2"},
+	{0xFFAD, idnaStatusMapped, "\u11b3"},
+	{0xFFAE, idnaStatusMapped, "\u11b4"},
+	{0xFFAF, idnaStatusMapped, "\u11b5"},
+	{0xFFB0, idnaStatusMapped, "\u111a"},
+	{0xFFB1, idnaStatusMapped, "\u1106"},
+	{0xFFB2, idnaStatusMapped, "\u1107"},
+	{0xFFB3, idnaStatusMapped, "\u1108"},
+	{0xFFB4, idnaStatusMapped, "\u1121"},
+	{0xFFB5, idnaStatusMapped, "\u1109"},
+	{0xFFB6, idnaStatusMapped, "\u110a"},
+	{0xFFB7, idnaStatusMapped, "\u110b"},
+	{0xFFB8, idnaStatusMapped, "\u110c"},
+	{0xFFB9, idnaStatusMapped, "\u110d"},
+	{0xFFBA, idnaStatusMapped, "\u110e"},
+	{0xFFBB, idnaStatusMapped, "\u110f"},
+	{0xFFBC, idnaStatusMapped, "\u1110"},
+	{0xFFBD, idnaStatusMapped, "\u1111"},
+	{0xFFBE, idnaStatusMapped, "\u1112"},
+	{0xFFBF, idnaStatusDisallowed, ""},
+	{0xFFC2, idnaStatusMapped, "\u1161"},
+	{0xFFC3, idnaStatusMapped, "\u1162"},
+	{0xFFC4, idnaStatusMapped, "\u1163"},
+	{0xFFC5, idnaStatusMapped, "\u1164"},
+	{0xFFC6, idnaStatusMapped, "\u1165"},
+	{0xFFC7, idnaStatusMapped, "\u1166"},
+	{0xFFC8, idnaStatusDisallowed, ""},
+	{0xFFCA, idnaStatusMapped, "\u1167"},
+	{0xFFCB, idnaStatusMapped, "\u1168"},
+	{0xFFCC, idnaStatusMapped, "\u1169"},
+	{0xFFCD, idnaStatusMapped, "\u116a"},
+	{0xFFCE, idnaStatusMapped, "\u116b"},
+	{0xFFCF, idnaStatusMapped, "\u116c"},
+	{0xFFD0, idnaStatusDisallowed, ""},
+	{0xFFD2, idnaStatusMapped, "\u116d"},
+	{0xFFD3, idnaStatusMapped, "\u116e"},
+	{0xFFD4, idnaStatusMapped, "\u116f"},
+	{0xFFD5, idnaStatusMapped, "\u1170"},
+	{0xFFD6, idnaStatusMapped, "\u1171"},
+	{0xFFD7, idnaStatusMapped, "\u1172"},
+	{0xFFD8, idnaStatusDisallowed, ""},
+	{0xFFDA, idnaStatusMapped, "\u1173"},
+	{0xFFDB, idnaStatusMapped, "\u1174"},
+	{0xFFDC, idnaStatusMapped, "\u1175"},
+	{0xFFDD, idnaStatusDisallowed, ""},
+	{0xFFE0, idnaStatusMapped, "\u00a2"},
+	{0xFFE1, idnaStatusMapped, "\u00a3"},
+	{0xFFE2, idnaStatusMapped, "\u00ac"},
+	{0xFFE3, idnaStatusMapped, " \u0304"},
+	{0xFFE4, idnaStatusMapped, "\u00a6"},
+	{0xFFE5, idnaStatusMapped, "\u00a5"},
+	{0xFFE6, idnaStatusMapped, "\u20a9"},
+	{0xFFE7, idnaStatusDisallowed, ""},
+	{0xFFE8, idnaStatusMapped, "\u2502"},
+	{0xFFE9, idnaStatusMapped, "\u2190"},
+	{0xFFEA, idnaStatusMapped, "\u2191"},
+	{0xFFEB, idnaStatusMapped, "\u2192"},
+	{0xFFEC, idnaStatusMapped, "\u2193"},
+	{0xFFED, idnaStatusMapped, "\u25a0"},
+	{0xFFEE, idnaStatusMapped, "\u25cb"},
+	{0xFFEF, idnaStatusDisallowed, ""},
+	{0x10000, idnaStatusValid, ""},
+	{0x1000C, idnaStatusDisallowed, ""},
+	{0x1000D, idnaStatusValid, ""},
+	{0x10027, idnaStatusDisallowed, ""},
+	{0x10028, idnaStatusValid, ""},
+	{0x1003B, idnaStatusDisallowed, ""},
+	{0x1003C, idnaStatusValid, ""},
+	{0x1003E, idnaStatusDisallowed, ""},
+	{0x1003F, idnaStatusValid, ""},
+	{0x1004E, idnaStatusDisallowed, ""},
+	{0x10050, idnaStatusValid, ""},
+	{0x1005E, idnaStatusDisallowed, ""},
+	{0x10080, idnaStatusValid, ""},
+	{0x100FB, idnaStatusDisallowed, ""},
+	{0x10100, idnaStatusValid, ""},
+	{0x10103, idnaStatusDisallowed, ""},
+	{0x10107, idnaStatusValid, ""},
+	{0x10134, idnaStatusDisallowed, ""},
+	{0x10137, idnaStatusValid, ""},
+	{0x1018F, idnaStatusDisallowed, ""},
+	{0x10190, idnaStatusValid, ""},
+	{0x1019D, idnaStatusDisallowed, ""},
+	{0x101A0, idnaStatusValid, ""},
+	{0x101A1, idnaStatusDisallowed, ""},
+	{0x101D0, idnaStatusValid, ""},
+	{0x101FE, idnaStatusDisallowed, ""},
+	{0x10280, idnaStatusValid, ""},
+	{0x1029D, idnaStatusDisallowed, ""},
+	{0x102A0, idnaStatusValid, ""},
+	{0x102D1, idnaStatusDisallowed, ""},
+	{0x102E0, idnaStatusValid, ""},
+	{0x102FC, idnaStatusDisallowed, ""},
+	{0x10300, idnaStatusValid, ""},
+	{0x10324, idnaStatusDisallowed, ""},
+	{0x1032D, idnaStatusValid, ""},
+	{0x1034B, idnaStatusDisallowed, ""},
+	{0x10350, idnaStatusValid, ""},
+	{0x1037B, idnaStatusDisallowed, ""},
+	{0x10380, idnaStatusValid, ""},
+	{0x1039E, idnaStatusDisallowed, ""},
+	{0x1039F, idnaStatusValid, ""},
+	{0x103C4, idnaStatusDisallowed, ""},
+	{0x103C8, idnaStatusValid, ""},
+	{0x103D6, idnaStatusDisallowed, ""},
+	{0x10400, idnaStatusMapped, "\U00010428"},
+	{0x10401, idnaStatusMapped, "\U00010429"},
+	{0x10402, idnaStatusMapped, "\U0001042a"},
+	{0x10403, idnaStatusMapped, "\U0001042b"},
+	{0x10404, idnaStatusMapped, "\U0001042c"},
+	{0x10405, idnaStatusMapped, "\U0001042d"},
+	{0x10406, idnaStatusMapped, "\U0001042e"},
+	{0x10407, idnaStatusMapped, "\U0001042f"},
+	{0x10408, idnaStatusMapped, "\U00010430"},
+	{0x10409, idnaStatusMapped, "\U00010431"},
+	{0x1040A, idnaStatusMapped, "\U00010432"},
+	{0x1040B, idnaStatusMapped, "\U00010433"},
+	{0x1040C, idnaStatusMapped, "\U00010434"},
+	{0x1040D, idnaStatusMapped, "\U00010435"},
+	{0x1040E, idnaStatusMapped, "\U00010436"},
+	{0x1040F, idnaStatusMapped, "\U00010437"},
+	{0x10410, idnaStatusMapped, "\U00010438"},
+	{0x10411, idnaStatusMapped, "\U00010439"},
+	{0x10412, idnaStatusMapped, "\U0001043a"},
+	{0x10413, idnaStatusMapped, "\U0001043b"},
+	{0x10414, idnaStatusMapped, "\U0001043c"},
+	{0x10415, idnaStatusMapped, "\U0001043d"},
+	{0x10416, idnaStatusMapped, "\U0001043e"},
+	{0x10417, idnaStatusMapped, "\U0001043f"},
+	{0x10418, idnaStatusMapped, "\U00010440"},
+	{0x10419, idnaStatusMapped, "\U00010441"},
+	{0x1041A, idnaStatusMapped, "\U00010442"},
+	{0x1041B, idnaStatusMapped, "\U00010443"},
+	{0x1041C, idnaStatusMapped, "\U00010444"},
+	{0x1041D, idnaStatusMapped, "\U00010445"},
+	{0x1041E, idnaStatusMapped, "\U00010446"},
+	{0x1041F, idnaStatusMapped, "\U00010447"},
+	{0x10420, idnaStatusMapped, "\U00010448"},
+	{0x10421, idnaStatusMapped, "\U00010449"},
+	{0x10422, idnaStatusMapped, "\U0001044a"},
+	{0x10423, idnaStatusMapped, "\U0001044b"},
+	{0x10424, idnaStatusMapped, "\U0001044c"},
+	{0x10425, idnaStatusMapped, "\U0001044d"},
+	{0x10426, idnaStatusMapped, "\U0001044e"},
+	{0x10427, idnaStatusMapped, "\U0001044f"},
+	{0x10428, idnaStatusValid, ""},
+	{0x1049E, idnaStatusDisallowed, ""},
+	{0x104A0, idnaStatusValid, ""},
+	{0x104AA, idnaStatusDisallowed, ""},
+	{0x104B0, idnaStatusMapped, "\U000104d8"},
+	{0x104B1, idnaStatusMapped, "\U000104d9"},
+	{0x104B2, idnaStatusMapped, "\U000104da"},
+	{0x104B3, idnaStatusMapped, "\U000104db"},
+	{0x104B4, idnaStatusMapped, "\U000104dc"},
+	{0x104B5, idnaStatusMapped, "\U000104dd"},
+	{0x104B6, idnaStatusMapped, "\U000104de"},
+	{0x104B7, idnaStatusMapped, "\U000104df"},
+	{0x104B8, idnaStatusMapped, "\U000104e0"},
+	{0x104B9, idnaStatusMapped, "\U000104e1"},
+	{0x104BA, idnaStatusMapped, "\U000104e2"},
+	{0x104BB, idnaStatusMapped, "\U000104e3"},
+	{0x104BC, idnaStatusMapped, "\U000104e4"},
+	{0x104BD, idnaStatusMapped, "\U000104e5"},
+	{0x104BE, idnaStatusMapped, "\U000104e6"},
+	{0x104BF, idnaStatusMapped, "\U000104e7"},
+	{0x104C0, idnaStatusMapped, "\U000104e8"},
+	{0x104C1, idnaStatusMapped, "\U000104e9"},
+	{0x104C2, idnaStatusMapped, "\U000104ea"},
+	{0x104C3, idnaStatusMapped, "\U000104eb"},
+	{0x104C4, idnaStatusMapped, "\U000104ec"},
+	{0x104C5, idnaStatusMapped, "\U000104ed"},
+	{0x104C6, idnaStatusMapped, "\U000104ee"},
+	{0x104C7, idnaStatusMapped, "\U000104ef"},
+	{0x104C8, idnaStatusMapped, "\U000104f0"},
+	{0x104C9, idnaStatusMapped, "\U000104f1"},
+	{0x104CA, idnaStatusMapped, "\U000104f2"},
+	{0x104CB, idnaStatusMapped, "\U000104f3"},
+	{0x104CC, idnaStatusMapped, "\U000104f4"},
+	{0x104CD, idnaStatusMapped, "\U000104f5"},
+	{0x104CE, idnaStatusMapped, "\U000104f6"},
+	{0x104CF, idnaStatusMapped, "\U000104f7"},
+	{0x104D0, idnaStatusMapped, "\U000104f8"},
+	{0x104D1, idnaStatusMapped, "\U000104f9"},
+	{0x104D2, idnaStatusMapped, "\U000104fa"},
+	{0x104D3, idnaStatusMapped, "\U000104fb"},
+	{0x104D4, idnaStatusDisallowed, ""},
+	{0x104D8, idnaStatusValid, ""},
+	{0x104FC, idnaStatusDisallowed, ""},
+	{0x10500, idnaStatusValid, ""},
+	{0x10528, idnaStatusDisallowed, ""},
+	{0x10530, idnaStatusValid, ""},
+	{0x10564, idnaStatusDisallowed, ""},
+	{0x1056F, idnaStatusValid, ""},
+	{0x10570, idnaStatusMapped, "\U00010597"},
+	{0x10571, idnaStatusMapped, "\U00010598"},
+	{0x10572, idnaStatusMapped, "\U00010599"},
+	{0x10573, idnaStatusMapped, "\U0001059a"},
+	{0x10574, idnaStatusMapped, "\U0001059b"},
+	{0x10575, idnaStatusMapped, "\U0001059c"},
+	{0x10576, idnaStatusMapped, "\U0001059d"},
+	{0x10577, idnaStatusMapped, "\U0001059e"},
+	{0x10578, idnaStatusMapped, "\U0001059f"},
+	{0x10579, idnaStatusMapped, "\U000105a0"},
+	{0x1057A, idnaStatusMapped, "\U000105a1"},
+	{0x1057B, idnaStatusDisallowed, ""},
+	{0x1057C, idnaStatusMapped, "\U000105a3"},
+	{0x1057D, idnaStatusMapped, "\U000105a4"},
+	{0x1057E, idnaStatusMapped, "\U000105a5"},
+	{0x1057F, idnaStatusMapped, "\U000105a6"},
+	{0x10580, idnaStatusMapped, "\U000105a7"},
+	{0x10581, idnaStatusMapped, "\U000105a8"},
+	{0x10582, idnaStatusMapped, "\U000105a9"},
+	{0x10583, idnaStatusMapped, "\U000105aa"},
+	{0x10584, idnaStatusMapped, "\U000105ab"},
+	{0x10585, idnaStatusMapped, "\U000105ac"},
+	{0x10586, idnaStatusMapped, "\U000105ad"},
+	{0x10587, idnaStatusMapped, "\U000105ae"},
+	{0x10588, idnaStatusMapped, "\U000105af"},
+	{0x10589, idnaStatusMapped, "\U000105b0"},
+	{0x1058A, idnaStatusMapped, "\U000105b1"},
+	{0x1058B, idnaStatusDisallowed, ""},
+	{0x1058C, idnaStatusMapped, "\U000105b3"},
+	{0x1058D, idnaStatusMapped, "\U000105b4"},
+	{0x1058E, idnaStatusMapped, "\U000105b5"},
+	{0x1058F, idnaStatusMapped, "\U000105b6"},
+	{0x10590, idnaStatusMapped, "\U000105b7"},
+	{0x10591, idnaStatusMapped, "\U000105b8"},
+	{0x10592, idnaStatusMapped, "\U000105b9"},
+	{0x10593, idnaStatusDisallowed, ""},
+	{0x10594, idnaStatusMapped, "\U000105bb"},
+	{0x10595, idnaStatusMapped, "\U000105bc"},
+	{0x10596, idnaStatusDisallowed, ""},
+	{0x10597, idnaStatusValid, ""},
+	{0x105A2, idnaStatusDisallowed, ""},
+	{0x105A3, idnaStatusValid, ""},
+	{0x105B2, idnaStatusDisallowed, ""},
+	{0x105B3, idnaStatusValid, ""},
+	{0x105BA, idnaStatusDisallowed, ""},
+	{0x105BB, idnaStatusValid, ""},
+	{0x105BD, idnaStatusDisallowed, ""},
+	{0x105C0, idnaStatusValid, ""},
+	{0x105F4, idnaStatusDisallowed, ""},
+	{0x10600, idnaStatusValid, ""},
+	{0x10737, idnaStatusDisallowed, ""},
+	{0x10740, idnaStatusValid, ""},
+	{0x10756, idnaStatusDisallowed, ""},
+	{0x10760, idnaStatusValid, ""},
+	{0x10768, idnaStatusDisallowed, ""},
+	{0x10780, idnaStatusValid, ""},
+	{0x10781, idnaStatusMapped, "\u02d0"},
+	{0x10782, idnaStatusMapped, "\u02d1"},
+	{0x10783, idnaStatusMapped, "\u00e6"},
+	{0x10784, idnaStatusMapped, "\u0299"},
+	{0x10785, idnaStatusMapped, "\u0253"},
+	{0x10786, idnaStatusDisallowed, ""},
+	{0x10787, idnaStatusMapped, "\u02a3"},
+	{0x10788, idnaStatusMapped, "\uab66"},
+	{0x10789, idnaStatusMapped, "\u02a5"},
+	{0x1078A, idnaStatusMapped, "\u02a4"},
+	{0x1078B, idnaStatusMapped, "\u0256"},
+	{0x1078C, idnaStatusMapped, "\u0257"},
+	{0x1078D, idnaStatusMapped, "\u1d91"},
+	{0x1078E, idnaStatusMapped, "\u0258"},
+	{0x1078F, idnaStatusMapped, "\u025e"},
+	{0x10790, idnaStatusMapped, "\u02a9"},
+	{0x10791, idnaStatusMapped, "\u0264"},
+	{0x10792, idnaStatusMapped, "\u0262"},
+	{0x10793, idnaStatusMapped, "\u0260"},
+	{0x10794, idnaStatusMapped, "\u029b"},
+	{0x10795, idnaStatusMapped, "\u0127"},
+	{0x10796, idnaStatusMapped, "\u029c"},
+	{0x10797, idnaStatusMapped, "\u0267"},
+	{0x10798, idnaStatusMapped, "\u0284"},
+	{0x10799, idnaStatusMapped, "\u02aa"},
+	{0x1079A, idnaStatusMapped, "\u02ab"},
+	{0x1079B, idnaStatusMapped, "\u026c"},
+	{0x1079C, idnaStatusMapped, "\U0001df04"},
+	{0x1079D, idnaStatusMapped, "\ua78e"},
+	{0x1079E, idnaStatusMapped, "\u026e"},
+	{0x1079F, idnaStatusMapped, "\U0001df05"},
+	{0x107A0, idnaStatusMapped, "\u028e"},
+	{0x107A1, idnaStatusMapped, "\U0001df06"},
+	{0x107A2, idnaStatusMapped, "\u00f8"},
+	{0x107A3, idnaStatusMapped, "\u0276"},
+	{0x107A4, idnaStatusMapped, "\u0277"},
+	{0x107A5, idnaStatusMapped, "q"},
+	{0x107A6, idnaStatusMapped, "\u027a"},
+	{0x107A7, idnaStatusMapped, "\U0001df08"},
+	{0x107A8, idnaStatusMapped, "\u027d"},
+	{0x107A9, idnaStatusMapped, "\u027e"},
+	{0x107AA, idnaStatusMapped, "\u0280"},
+	{0x107AB, idnaStatusMapped, "\u02a8"},
+	{0x107AC, idnaStatusMapped, "\u02a6"},
+	{0x107AD, idnaStatusMapped, "\uab67"},
+	{0x107AE, idnaStatusMapped, "\u02a7"},
+	{0x107AF, idnaStatusMapped, "\u0288"},
+	{0x107B0, idnaStatusMapped, "\u2c71"},
+	{0x107B1, idnaStatusDisallowed, ""},
+	{0x107B2, idnaStatusMapped, "\u028f"},
+	{0x107B3, idnaStatusMapped, "\u02a1"},
+	{0x107B4, idnaStatusMapped, "\u02a2"},
+	{0x107B5, idnaStatusMapped, "\u0298"},
+	{0x107B6, idnaStatusMapped, "\u01c0"},
+	{0x107B7, idnaStatusMapped, "\u01c1"},
+	{0x107B8, idnaStatusMapped, "\u01c2"},
+	{0x107B9, idnaStatusMapped, "\U0001df0a"},
+	{0x107BA, idnaStatusMapped, "\U0001df1e"},
+	{0x107BB, idnaStatusDisallowed, ""},
+	{0x10800, idnaStatusValid, ""},
+	{0x10806, idnaStatusDisallowed, ""},
+	{0x10808, idnaStatusValid, ""},
+	{0x10809, idnaStatusDisallowed, ""},
+	{0x1080A, idnaStatusValid, ""},
+	{0x10836, idnaStatusDisallowed, ""},
+	{0x10837, idnaStatusValid, ""},
+	{0x10839, idnaStatusDisallowed, ""},
+	{0x1083C, idnaStatusValid, ""},
+	{0x1083D, idnaStatusDisallowed, ""},
+	{0x1083F, idnaStatusValid, ""},
+	{0x10856, idnaStatusDisallowed, ""},
+	{0x10857, idnaStatusValid, ""},
+	{0x1089F, idnaStatusDisallowed, ""},
+	{0x108A7, idnaStatusValid, ""},
+	{0x108B0, idnaStatusDisallowed, ""},
+	{0x108E0, idnaStatusValid, ""},
+	{0x108F3, idnaStatusDisallowed, ""},
+	{0x108F4, idnaStatusValid, ""},
+	{0x108F6, idnaStatusDisallowed, ""},
+	{0x108FB, idnaStatusValid, ""},
+	{0x1091C, idnaStatusDisallowed, ""},
+	{0x1091F, idnaStatusValid, ""},
+	{0x1093A, idnaStatusDisallowed, ""},
+	{0x1093F, idnaStatusValid, ""},
+	{0x1095A, idnaStatusDisallowed, ""},
+	{0x10980, idnaStatusValid, ""},
+	{0x109B8, idnaStatusDisallowed, ""},
+	{0x109BC, idnaStatusValid, ""},
+	{0x109D0, idnaStatusDisallowed, ""},
+	{0x109D2, idnaStatusValid, ""},
+	{0x10A04, idnaStatusDisallowed, ""},
+	{0x10A05, idnaStatusValid, ""},
+	{0x10A07, idnaStatusDisallowed, ""},
+	{0x10A0C, idnaStatusValid, ""},
+	{0x10A14, idnaStatusDisallowed, ""},
+	{0x10A15, idnaStatusValid, ""},
+	{0x10A18, idnaStatusDisallowed, ""},
+	{0x10A19, idnaStatusValid, ""},
+	{0x10A36, idnaStatusDisallowed, ""},
+	{0x10A38, idnaStatusValid, ""},
+	{0x10A3B, idnaStatusDisallowed, ""},
+	{0x10A3F, idnaStatusValid, ""},
+	{0x10A49, idnaStatusDisallowed, ""},
+	{0x10A50, idnaStatusValid, ""},
+	{0x10A59, idnaStatusDisallowed, ""},
+	{0x10A60, idnaStatusValid, ""},
+	{0x10AA0, idnaStatusDisallowed, ""},
+	{0x10AC0, idnaStatusValid, ""},
+	{0x10AE7, idnaStatusDisallowed, ""},
+	{0x10AEB, idnaStatusValid, ""},
+	{0x10AF7, idnaStatusDisallowed, ""},
+	{0x10B00, idnaStatusValid, ""},
+	{0x10B36, idnaStatusDisallowed, ""},
+	{0x10B39, idnaStatusValid, ""},
+	{0x10B56, idnaStatusDisallowed, ""},
+	{0x10B58, idnaStatusValid, ""},
+	{0x10B73, idnaStatusDisallowed, ""},
+	{0x10B78, idnaStatusValid, ""},
+	{0x10B92, idnaStatusDisallowed, ""},
+	{0x10B99, idnaStatusValid, ""},
+	{0x10B9D, idnaStatusDisallowed, ""},
+	{0x10BA9, idnaStatusValid, ""},
+	{0x10BB0, idnaStatusDisallowed, ""},
+	{0x10C00, idnaStatusValid, ""},
+	{0x10C49, idnaStatusDisallowed, ""},
+	{0x10C80, idnaStatusMapped, "\U00010cc0"},
+	{0x10C81, idnaStatusMapped, "\U00010cc1"},
+	{0x10C82, idnaStatusMapped, "\U00010cc2"},
+	{0x10C83, idnaStatusMapped, "\U00010cc3"},
+	{0x10C84, idnaStatusMapped, "\U00010cc4"},
+	{0x10C85, idnaStatusMapped, "\U00010cc5"},
+	{0x10C86, idnaStatusMapped, "\U00010cc6"},
+	{0x10C87, idnaStatusMapped, "\U00010cc7"},
+	{0x10C88, idnaStatusMapped, "\U00010cc8"},
+	{0x10C89, idnaStatusMapped, "\U00010cc9"},
+	{0x10C8A, idnaStatusMapped, "\U00010cca"},
+	{0x10C8B, idnaStatusMapped, "\U00010ccb"},
+	{0x10C8C, idnaStatusMapped, "\U00010ccc"},
+	{0x10C8D, idnaStatusMapped, "\U00010ccd"},
+	{0x10C8E, idnaStatusMapped, "\U00010cce"},
+	{0x10C8F, idnaStatusMapped, "\U00010ccf"},
+	{0x10C90, idnaStatusMapped, "\U00010cd0"},
+	{0x10C91, idnaStatusMapped, "\U00010cd1"},
+	{0x10C92, idnaStatusMapped, "\U00010cd2"},
+	{0x10C93, idnaStatusMapped, "\U00010cd3"},
+	{0x10C94, idnaStatusMapped, "\U00010cd4"},
+	{0x10C95, idnaStatusMapped, "\U00010cd5"},
+	{0x10C96, idnaStatusMapped, "\U00010cd6"},
+	{0x10C97, idnaStatusMapped, "\U00010cd7"},
+	{0x10C98, idnaStatusMapped, "\U00010cd8"},
+	{0x10C99, idnaStatusMapped, "\U00010cd9"},
+	{0x10C9A, idnaStatusMapped, "\U00010cda"},
+	{0x10C9B, idnaStatusMapped, "\U00010cdb"},
+	{0x10C9C, idnaStatusMapped, "\U00010cdc"},
+	{0x10C9D, idnaStatusMapped, "\U00010cdd"},
+	{0x10C9E, idnaStatusMapped, "\U00010cde"},
+	{0x10C9F, idnaStatusMapped, "\U00010cdf"},
+	{0x10CA0, idnaStatusMapped, "\U00010ce0"},
+	{0x10CA1, idnaStatusMapped, "\U00010ce1"},
+	{0x10CA2, idnaStatusMapped, "\U00010ce2"},
+	{0x10CA3, idnaStatusMapped, "\U00010ce3"},
+	{0x10CA4, idnaStatusMapped, "\U00010ce4"},
+	{0x10CA5, idnaStatusMapped, "\U00010ce5"},
+	{0x10CA6, idnaStatusMapped, "\U00010ce6"},
+	{0x10CA7, idnaStatusMapped, "\U00010ce7"},
+	{0x10CA8, idnaStatusMapped, "\U00010ce8"},
+	{0x10CA9, idnaStatusMapped, "\U00010ce9"},
+	{0x10CAA, idnaStatusMapped, "\U00010cea"},
+	{0x10CAB, idnaStatusMapped, "\U00010ceb"},
+	{0x10CAC, idnaStatusMapped, "\U00010cec"},
+	{0x10CAD, idnaStatusMapped, "\U00010ced"},
+	{0x10CAE, idnaStatusMapped, "\U00010cee"},
+	{0x10CAF, idnaStatusMapped, "\U00010cef"},
+	{0x10CB0, idnaStatusMapped, "\U00010cf0"},
+	{0x10CB1, idnaStatusMapped, "\U00010cf1"},
+	{0x10CB2, idnaStatusMapped, "\U00010cf2"},
+	{0x10CB3, idnaStatusDisallowed, ""},
+	{0x10CC0, idnaStatusValid, ""},
+	{0x10CF3, idnaStatusDisallowed, ""},
+	{0x10CFA, idnaStatusValid, ""},
+	{0x10D28, idnaStatusDisallowed, ""},
+	{0x10D30, idnaStatusValid, ""},
+	{0x10D3A, idnaStatusDisallowed, ""},
+	{0x10D40, idnaStatusValid, ""},
+	{0x10D50, idnaStatusMapped, "\U00010d70"},
+	{0x10D51, idnaStatusMapped, "\U00010d71"},
+	{0x10D52, idnaStatusMapped, "\U00010d72"},
+	{0x10D53, idnaStatusMapped, "\U00010d73"},
+	{0x10D54, idnaStatusMapped, "\U00010d74"},
+	{0x10D55, idnaStatusMapped, "\U00010d75"},
+	{0x10D56, idnaStatusMapped, "\U00010d76"},
+	{0x10D57, idnaStatusMapped, "\U00010d77"},
+	{0x10D58, idnaStatusMapped, "\U00010d78"},
+	{0x10D59, idnaStatusMapped, "\U00010d79"},
+	{0x10D5A, idnaStatusMapped, "\U00010d7a"},
+	{0x10D5B, idnaStatusMapped, "\U00010d7b"},
+	{0x10D5C, idnaStatusMapped, "\U00010d7c"},
+	{0x10D5D, idnaStatusMapped, "\U00010d7d"},
+	{0x10D5E, idnaStatusMapped, "\U00010d7e"},
+	{0x10D5F, idnaStatusMapped, "\U00010d7f"},
+	{0x10D60, idnaStatusMapped, "\U00010d80"},
+	{0x10D61, idnaStatusMapped, "\U00010d81"},
+	{0x10D62, idnaStatusMapped, "\U00010d82"},
+	{0x10D63, idnaStatusMapped, "\U00010d83"},
+	{0x10D64, idnaStatusMapped, "\U00010d84"},
+	{0x10D65, idnaStatusMapped, "\U00010d85"},
+	{0x10D66, idnaStatusDisallowed, ""},
+	{0x10D69, idnaStatusValid, ""},
+	{0x10D86, idnaStatusDisallowed, ""},
+	{0x10D8E, idnaStatusValid, ""},
+	{0x10D90, idnaStatusDisallowed, ""},
+	{0x10E60, idnaStatusValid, ""},
+	{0x10E7F, idnaStatusDisallowed, ""},
+	{0x10E80, idnaStatusValid, ""},
+	{0x10EAA, idnaStatusDisallowed, ""},
+	{0x10EAB, idnaStatusValid, ""},
+	{0x10EAE, idnaStatusDisallowed, ""},
+	{0x10EB0, idnaStatusValid, ""},
+	{0x10EB2, idnaStatusDisallowed, ""},
+	{0x10EC2, idnaStatusValid, ""},
+	{0x10EC8, idnaStatusDisallowed, ""},
+	{0x10ED0, idnaStatusValid, ""},
+	{0x10ED9, idnaStatusDisallowed, ""},
+	{0x10EFA, idnaStatusValid, ""},
+	{0x10F28, idnaStatusDisallowed, ""},
+	{0x10F30, idnaStatusValid, ""},
+	{0x10F5A, idnaStatusDisallowed, ""},
+	{0x10F70, idnaStatusValid, ""},
+	{0x10F8A, idnaStatusDisallowed, ""},
+	{0x10FB0, idnaStatusValid, ""},
+	{0x10FCC, idnaStatusDisallowed, ""},
+	{0x10FE0, idnaStatusValid, ""},
+	{0x10FF7, idnaStatusDisallowed, ""},
+	{0x11000, idnaStatusValid, ""},
+	{0x1104E, idnaStatusDisallowed, ""},
+	{0x11052, idnaStatusValid, ""},
+	{0x11076, idnaStatusDisallowed, ""},
+	{0x1107F, idnaStatusValid, ""},
+	{0x110BD, idnaStatusDisallowed, ""},
+	{0x110BE, idnaStatusValid, ""},
+	{0x110C3, idnaStatusDisallowed, ""},
+	{0x110D0, idnaStatusValid, ""},
+	{0x110E9, idnaStatusDisallowed, ""},
+	{0x110F0, idnaStatusValid, ""},
+	{0x110FA, idnaStatusDisallowed, ""},
+	{0x11100, idnaStatusValid, ""},
+	{0x11135, idnaStatusDisallowed, ""},
+	{0x11136, idnaStatusValid, ""},
+	{0x11148, idnaStatusDisallowed, ""},
+	{0x11150, idnaStatusValid, ""},
+	{0x11177, idnaStatusDisallowed, ""},
+	{0x11180, idnaStatusValid, ""},
+	{0x111E0, idnaStatusDisallowed, ""},
+	{0x111E1, idnaStatusValid, ""},
+	{0x111F5, idnaStatusDisallowed, ""},
+	{0x11200, idnaStatusValid, ""},
+	{0x11212, idnaStatusDisallowed, ""},
+	{0x11213, idnaStatusValid, ""},
+	{0x11242, idnaStatusDisallowed, ""},
+	{0x11280, idnaStatusValid, ""},
+	{0x11287, idnaStatusDisallowed, ""},
+	{0x11288, idnaStatusValid, ""},
+	{0x11289, idnaStatusDisallowed, ""},
+	{0x1128A, idnaStatusValid, ""},
+	{0x1128E, idnaStatusDisallowed, ""},
+	{0x1128F, idnaStatusValid, ""},
+	{0x1129E, idnaStatusDisallowed, ""},
+	{0x1129F, idnaStatusValid, ""},
+	{0x112AA, idnaStatusDisallowed, ""},
+	{0x112B0, idnaStatusValid, ""},
+	{0x112EB, idnaStatusDisallowed, ""},
+	{0x112F0, idnaStatusValid, ""},
+	{0x112FA, idnaStatusDisallowed, ""},
+	{0x11300, idnaStatusValid, ""},
+	{0x11304, idnaStatusDisallowed, ""},
+	{0x11305, idnaStatusValid, ""},
+	{0x1130D, idnaStatusDisallowed, ""},
+	{0x1130F, idnaStatusValid, ""},
+	{0x11311, idnaStatusDisallowed, ""},
+	{0x11313, idnaStatusValid, ""},
+	{0x11329, idnaStatusDisallowed, ""},
+	{0x1132A, idnaStatusValid, ""},
+	{0x11331, idnaStatusDisallowed, ""},
+	{0x11332, idnaStatusValid, ""},
+	{0x11334, idnaStatusDisallowed, ""},
+	{0x11335, idnaStatusValid, ""},
+	{0x1133A, idnaStatusDisallowed, ""},
+	{0x1133B, idnaStatusValid, ""},
+	{0x11345, idnaStatusDisallowed, ""},
+	{0x11347, idnaStatusValid, ""},
+	{0x11349, idnaStatusDisallowed, ""},
+	{0x1134B, idnaStatusValid, ""},
+	{0x1134E, idnaStatusDisallowed, ""},
+	{0x11350, idnaStatusValid, ""},
+	{0x11351, idnaStatusDisallowed, ""},
+	{0x11357, idnaStatusValid, ""},
+	{0x11358, idnaStatusDisallowed, ""},
+	{0x1135D, idnaStatusValid, ""},
+	{0x11364, idnaStatusDisallowed, ""},
+	{0x11366, idnaStatusValid, ""},
+	{0x1136D, idnaStatusDisallowed, ""},
+	{0x11370, idnaStatusValid, ""},
+	{0x11375, idnaStatusDisallowed, ""},
+	{0x11380, idnaStatusValid, ""},
+	{0x1138A, idnaStatusDisallowed, ""},
+	{0x1138B, idnaStatusValid, ""},
+	{0x1138C, idnaStatusDisallowed, ""},
+	{0x1138E, idnaStatusValid, ""},
+	{0x1138F, idnaStatusDisallowed, ""},
+	{0x11390, idnaStatusValid, ""},
+	{0x113B6, idnaStatusDisallowed, ""},
+	{0x113B7, idnaStatusValid, ""},
+	{0x113C1, idnaStatusDisallowed, ""},
+	{0x113C2, idnaStatusValid, ""},
+	{0x113C3, idnaStatusDisallowed, ""},
+	{0x113C5, idnaStatusValid, ""},
+	{0x113C6, idnaStatusDisallowed, ""},
+	{0x113C7, idnaStatusValid, ""},
+	{0x113CB, idnaStatusDisallowed, ""},
+	{0x113CC, idnaStatusValid, ""},
+	{0x113D6, idnaStatusDisallowed, ""},
+	{0x113D7, idnaStatusValid, ""},
+	{0x113D9, idnaStatusDisallowed, ""},
+	{0x113E1, idnaStatusValid, ""},
+	{0x113E3, idnaStatusDisallowed, ""},
+	{0x11400, idnaStatusValid, ""},
+	{0x1145C, idnaStatusDisallowed, ""},
+	{0x1145D, idnaStatusValid, ""},
+	{0x11462, idnaStatusDisallowed, ""},
+	{0x11480, idnaStatusValid, ""},
+	{0x114C8, idnaStatusDisallowed, ""},
+	{0x114D0, idnaStatusValid, ""},
+	{0x114DA, idnaStatusDisallowed, ""},
+	{0x11580, idnaStatusValid, ""},
+	{0x115B6, idnaStatusDisallowed, ""},
+	{0x115B8, idnaStatusValid, ""},
+	{0x115DE, idnaStatusDisallowed, ""},
+	{0x11600, idnaStatusValid, ""},
+	{0x11645, idnaStatusDisallowed, ""},
+	{0x11650, idnaStatusValid, ""},
+	{0x1165A, idnaStatusDisallowed, ""},
+	{0x11660, idnaStatusValid, ""},
+	{0x1166D, idnaStatusDisallowed, ""},
+	{0x11680, idnaStatusValid, ""},
+	{0x116BA, idnaStatusDisallowed, ""},
+	{0x116C0, idnaStatusValid, ""},
+	{0x116CA, idnaStatusDisallowed, ""},
+	{0x116D0, idnaStatusValid, ""},
+	{0x116E4, idnaStatusDisallowed, ""},
+	{0x11700, idnaStatusValid, ""},
+	{0x1171B, idnaStatusDisallowed, ""},
+	{0x1171D, idnaStatusValid, ""},
+	{0x1172C, idnaStatusDisallowed, ""},
+	{0x11730, idnaStatusValid, ""},
+	{0x11747, idnaStatusDisallowed, ""},
+	{0x11800, idnaStatusValid, ""},
+	{0x1183C, idnaStatusDisallowed, ""},
+	{0x118A0, idnaStatusMapped, "\U000118c0"},
+	{0x118A1, idnaStatusMapped, "\U000118c1"},
+	{0x118A2, idnaStatusMapped, "\U000118c2"},
+	{0x118A3, idnaStatusMapped, "\U000118c3"},
+	{0x118A4, idnaStatusMapped, "\U000118c4"},
+	{0x118A5, idnaStatusMapped, "\U000118c5"},
+	{0x118A6, idnaStatusMapped, "\U000118c6"},
+	{0x118A7, idnaStatusMapped, "\U000118c7"},
+	{0x118A8, idnaStatusMapped, "\U000118c8"},
+	{0x118A9, idnaStatusMapped, "\U000118c9"},
+	{0x118AA, idnaStatusMapped, "\U000118ca"},
+	{0x118AB, idnaStatusMapped, "\U000118cb"},
+	{0x118AC, idnaStatusMapped, "\U000118cc"},
+	{0x118AD, idnaStatusMapped, "\U000118cd"},
+	{0x118AE, idnaStatusMapped, "\U000118ce"},
+	{0x118AF, idnaStatusMapped, "\U000118cf"},
+	{0x118B0, idnaStatusMapped, "\U000118d0"},
+	{0x118B1, idnaStatusMapped, "\U000118d1"},
+	{0x118B2, idnaStatusMapped, "\U000118d2"},
+	{0x118B3, idnaStatusMapped, "\U000118d3"},
+	{0x118B4, idnaStatusMapped, "\U000118d4"},
+	{0x118B5, idnaStatusMapped, "\U000118d5"},
+	{0x118B6, idnaStatusMapped, "\U000118d6"},
+	{0x118B7, idnaStatusMapped, "\U000118d7"},
+	{0x118B8, idnaStatusMapped, "\U000118d8"},
+	{0x118B9, idnaStatusMapped, "\U000118d9"},
+	{0x118BA, idnaStatusMapped, "\U000118da"},
+	{0x118BB, idnaStatusMapped, "\U000118db"},
+	{0x118BC, idnaStatusMapped, "\U000118dc"},
+	{0x118BD, idnaStatusMapped, "\U000118dd"},
+	{0x118BE, idnaStatusMapped, "\U000118de"},
+	{0x118BF, idnaStatusMapped, "\U000118df"},
+	{0x118C0, idnaStatusValid, ""},
+	{0x118F3, idnaStatusDisallowed, ""},
+	{0x118FF, idnaStatusValid, ""},
+	{0x11907, idnaStatusDisallowed, ""},
+	{0x11909, idnaStatusValid, ""},
+	{0x1190A, idnaStatusDisallowed, ""},
+	{0x1190C, idnaStatusValid, ""},
+	{0x11914, idnaStatusDisallowed, ""},
+	{0x11915, idnaStatusValid, ""},
+	{0x11917, idnaStatusDisallowed, ""},
+	{0x11918, idnaStatusValid, ""},
+	{0x11936, idnaStatusDisallowed, ""},
+	{0x11937, idnaStatusValid, ""},
+	{0x11939, idnaStatusDisallowed, ""},
+	{0x1193B, idnaStatusValid, ""},
+	{0x11947, idnaStatusDisallowed, ""},
+	{0x11950, idnaStatusValid, ""},
+	{0x1195A, idnaStatusDisallowed, ""},
+	{0x119A0, idnaStatusValid, ""},
+	{0x119A8, idnaStatusDisallowed, ""},
+	{0x119AA, idnaStatusValid, ""},
+	{0x119D8, idnaStatusDisallowed, ""},
+	{0x119DA, idnaStatusValid, ""},
+	{0x119E5, idnaStatusDisallowed, ""},
+	{0x11A00, idnaStatusValid, ""},
+	{0x11A48, idnaStatusDisallowed, ""},
+	{0x11A50, idnaStatusValid, ""},
+	{0x11AA3, idnaStatusDisallowed, ""},
+	{0x11AB0, idnaStatusValid, ""},
+	{0x11AF9, idnaStatusDisallowed, ""},
+	{0x11B00, idnaStatusValid, ""},
+	{0x11B0A, idnaStatusDisallowed, ""},
+	{0x11B60, idnaStatusValid, ""},
+	{0x11B68, idnaStatusDisallowed, ""},
+	{0x11BC0, idnaStatusValid, ""},
+	{0x11BE2, idnaStatusDisallowed, ""},
+	{0x11BF0, idnaStatusValid, ""},
+	{0x11BFA, idnaStatusDisallowed, ""},
+	{0x11C00, idnaStatusValid, ""},
+	{0x11C09, idnaStatusDisallowed, ""},
+	{0x11C0A, idnaStatusValid, ""},
+	{0x11C37, idnaStatusDisallowed, ""},
+	{0x11C38, idnaStatusValid, ""},
+	{0x11C46, idnaStatusDisallowed, ""},
+	{0x11C50, idnaStatusValid, ""},
+	{0x11C6D, idnaStatusDisallowed, ""},
+	{0x11C70, idnaStatusValid, ""},
+	{0x11C90, idnaStatusDisallowed, ""},
+	{0x11C92, idnaStatusValid, ""},
+	{0x11CA8, idnaStatusDisallowed, ""},
+	{0x11CA9, idnaStatusValid, ""},
+	{0x11CB7, idnaStatusDisallowed, ""},
+	{0x11D00, idnaStatusValid, ""},
+	{0x11D07, idnaStatusDisallowed, ""},
+	{0x11D08, idnaStatusValid, ""},
+	{0x11D0A, idnaStatusDisallowed, ""},
+	{0x11D0B, idnaStatusValid, ""},
+	{0x11D37, idnaStatusDisallowed, ""},
+	{0x11D3A, idnaStatusValid, ""},
+	{0x11D3B, idnaStatusDisallowed, ""},
+	{0x11D3C, idnaStatusValid, ""},
+	{0x11D3E, idnaStatusDisallowed, ""},
+	{0x11D3F, idnaStatusValid, ""},
+	{0x11D48, idnaStatusDisallowed, ""},
+	{0x11D50, idnaStatusValid, ""},
+	{0x11D5A, idnaStatusDisallowed, ""},
+	{0x11D60, idnaStatusValid, ""},
+	{0x11D66, idnaStatusDisallowed, ""},
+	{0x11D67, idnaStatusValid, ""},
+	{0x11D69, idnaStatusDisallowed, ""},
+	{0x11D6A, idnaStatusValid, ""},
+	{0x11D8F, idnaStatusDisallowed, ""},
+	{0x11D90, idnaStatusValid, ""},
+	{0x11D92, idnaStatusDisallowed, ""},
+	{0x11D93, idnaStatusValid, ""},
+	{0x11D99, idnaStatusDisallowed, ""},
+	{0x11DA0, idnaStatusValid, ""},
+	{0x11DAA, idnaStatusDisallowed, ""},
+	{0x11DB0, idnaStatusValid, ""},
+	{0x11DDC, idnaStatusDisallowed, ""},
+	{0x11DE0, idnaStatusValid, ""},
+	{0x11DEA, idnaStatusDisallowed, ""},
+	{0x11EE0, idnaStatusValid, ""},
+	{0x11EF9, idnaStatusDisallowed, ""},
+	{0x11F00, idnaStatusValid, ""},
+	{0x11F11, idnaStatusDisallowed, ""},
+	{0x11F12, idnaStatusValid, ""},
+	{0x11F3B, idnaStatusDisallowed, ""},
+	{0x11F3E, idnaStatusValid, ""},
+	{0x11F5B, idnaStatusDisallowed, ""},
+	{0x11FB0, idnaStatusValid, ""},
+	{0x11FB1, idnaStatusDisallowed, ""},
+	{0x11FC0, idnaStatusValid, ""},
+	{0x11FF2, idnaStatusDisallowed, ""},
+	{0x11FFF, idnaStatusValid, ""},
+	{0x1239A, idnaStatusDisallowed, ""},
+	{0x12400, idnaStatusValid, ""},
+	{0x1246F, idnaStatusDisallowed, ""},
+	{0x12470, idnaStatusValid, ""},
+	{0x12475, idnaStatusDisallowed, ""},
+	{0x12480, idnaStatusValid, ""},
+	{0x12544, idnaStatusDisallowed, ""},
+	{0x12F90, idnaStatusValid, ""},
+	{0x12FF3, idnaStatusDisallowed, ""},
+	{0x13000, idnaStatusValid, ""},
+	{0x13430, idnaStatusDisallowed, ""},
+	{0x13440, idnaStatusValid, ""},
+	{0x13456, idnaStatusDisallowed, ""},
+	{0x13460, idnaStatusValid, ""},
+	{0x143FB, idnaStatusDisallowed, ""},
+	{0x14400, idnaStatusValid, ""},
+	{0x14647, idnaStatusDisallowed, ""},
+	{0x16100, idnaStatusValid, ""},
+	{0x1613A, idnaStatusDisallowed, ""},
+	{0x16800, idnaStatusValid, ""},
+	{0x16A39, idnaStatusDisallowed, ""},
+	{0x16A40, idnaStatusValid, ""},
+	{0x16A5F, idnaStatusDisallowed, ""},
+	{0x16A60, idnaStatusValid, ""},
+	{0x16A6A, idnaStatusDisallowed, ""},
+	{0x16A6E, idnaStatusValid, ""},
+	{0x16ABF, idnaStatusDisallowed, ""},
+	{0x16AC0, idnaStatusValid, ""},
+	{0x16ACA, idnaStatusDisallowed, ""},
+	{0x16AD0, idnaStatusValid, ""},
+	{0x16AEE, idnaStatusDisallowed, ""},
+	{0x16AF0, idnaStatusValid, ""},
+	{0x16AF6, idnaStatusDisallowed, ""},
+	{0x16B00, idnaStatusValid, ""},
+	{0x16B46, idnaStatusDisallowed, ""},
+	{0x16B50, idnaStatusValid, ""},
+	{0x16B5A, idnaStatusDisallowed, ""},
+	{0x16B5B, idnaStatusValid, ""},
+	{0x16B62, idnaStatusDisallowed, ""},
+	{0x16B63, idnaStatusValid, ""},
+	{0x16B78, idnaStatusDisallowed, ""},
+	{0x16B7D, idnaStatusValid, ""},
+	{0x16B90, idnaStatusDisallowed, ""},
+	{0x16D40, idnaStatusValid, ""},
+	{0x16D7A, idnaStatusDisallowed, ""},
+	{0x16E40, idnaStatusMapped, "\U00016e60"},
+	{0x16E41, idnaStatusMapped, "\U00016e61"},
+	{0x16E42, idnaStatusMapped, "\U00016e62"},
+	{0x16E43, idnaStatusMapped, "\U00016e63"},
+	{0x16E44, idnaStatusMapped, "\U00016e64"},
+	{0x16E45, idnaStatusMapped, "\U00016e65"},
+	{0x16E46, idnaStatusMapped, "\U00016e66"},
+	{0x16E47, idnaStatusMapped, "\U00016e67"},
+	{0x16E48, idnaStatusMapped, "\U00016e68"},
+	{0x16E49, idnaStatusMapped, "\U00016e69"},
+	{0x16E4A, idnaStatusMapped, "\U00016e6a"},
+	{0x16E4B, idnaStatusMapped, "\U00016e6b"},
+	{0x16E4C, idnaStatusMapped, "\U00016e6c"},
+	{0x16E4D, idnaStatusMapped, "\U00016e6d"},
+	{0x16E4E, idnaStatusMapped, "\U00016e6e"},
+	{0x16E4F, idnaStatusMapped, "\U00016e6f"},
+	{0x16E50, idnaStatusMapped, "\U00016e70"},
+	{0x16E51, idnaStatusMapped, "\U00016e71"},
+	{0x16E52, idnaStatusMapped, "\U00016e72"},
+	{0x16E53, idnaStatusMapped, "\U00016e73"},
+	{0x16E54, idnaStatusMapped, "\U00016e74"},
+	{0x16E55, idnaStatusMapped, "\U00016e75"},
+	{0x16E56, idnaStatusMapped, "\U00016e76"},
+	{0x16E57, idnaStatusMapped, "\U00016e77"},
+	{0x16E58, idnaStatusMapped, "\U00016e78"},
+	{0x16E59, idnaStatusMapped, "\U00016e79"},
+	{0x16E5A, idnaStatusMapped, "\U00016e7a"},
+	{0x16E5B, idnaStatusMapped, "\U00016e7b"},
+	{0x16E5C, idnaStatusMapped, "\U00016e7c"},
+	{0x16E5D, idnaStatusMapped, "\U00016e7d"},
+	{0x16E5E, idnaStatusMapped, "\U00016e7e"},
+	{0x16E5F, idnaStatusMapped, "\U00016e7f"},
+	{0x16E60, idnaStatusValid, ""},
+	{0x16E9B, idnaStatusDisallowed, ""},
+	{0x16EA0, idnaStatusMapped, "\U00016ebb"},
+	{0x16EA1, idnaStatusMapped, "\U00016ebc"},
+	{0x16EA2, idnaStatusMapped, "\U00016ebd"},
+	{0x16EA3, idnaStatusMapped, "\U00016ebe"},
+	{0x16EA4, idnaStatusMapped, "\U00016ebf"},
+	{0x16EA5, idnaStatusMapped, "\U00016ec0"},
+	{0x16EA6, idnaStatusMapped, "\U00016ec1"},
+	{0x16EA7, idnaStatusMapped, "\U00016ec2"},
+	{0x16EA8, idnaStatusMapped, "\U00016ec3"},
+	{0x16EA9, idnaStatusMapped, "\U00016ec4"},
+	{0x16EAA, idnaStatusMapped, "\U00016ec5"},
+	{0x16EAB, idnaStatusMapped, "\U00016ec6"},
+	{0x16EAC, idnaStatusMapped, "\U00016ec7"},
+	{0x16EAD, idnaStatusMapped, "\U00016ec8"},
+	{0x16EAE, idnaStatusMapped, "\U00016ec9"},
+	{0x16EAF, idnaStatusMapped, "\U00016eca"},
+	{0x16EB0, idnaStatusMapped, "\U00016ecb"},
+	{0x16EB1, idnaStatusMapped, "\U00016ecc"},
+	{0x16EB2, idnaStatusMapped, "\U00016ecd"},
+	{0x16EB3, idnaStatusMapped, "\U00016ece"},
+	{0x16EB4, idnaStatusMapped, "\U00016ecf"},
+	{0x16EB5, idnaStatusMapped, "\U00016ed0"},
+	{0x16EB6, idnaStatusMapped, "\U00016ed1"},
+	{0x16EB7, idnaStatusMapped, "\U00016ed2"},
+	{0x16EB8, idnaStatusMapped, "\U00016ed3"},
+	{0x16EB9, idnaStatusDisallowed, ""},
+	{0x16EBB, idnaStatusValid, ""},
+	{0x16ED4, idnaStatusDisallowed, ""},
+	{0x16F00, idnaStatusValid, ""},
+	{0x16F4B, idnaStatusDisallowed, ""},
+	{0x16F4F, idnaStatusValid, ""},
+	{0x16F88, idnaStatusDisallowed, ""},
+	{0x16F8F, idnaStatusValid, ""},
+	{0x16FA0, idnaStatusDisallowed, ""},
+	{0x16FE0, idnaStatusValid, ""},
+	{0x16FE5, idnaStatusDisallowed, ""},
+	{0x16FF0, idnaStatusValid, ""},
+	{0x16FF7, idnaStatusDisallowed, ""},
+	{0x17000, idnaStatusValid, ""},
+	{0x18CD6, idnaStatusDisallowed, ""},
+	{0x18CFF, idnaStatusValid, ""},
+	{0x18D1F, idnaStatusDisallowed, ""},
+	{0x18D80, idnaStatusValid, ""},
+	{0x18DF3, idnaStatusDisallowed, ""},
+	{0x1AFF0, idnaStatusValid, ""},
+	{0x1AFF4, idnaStatusDisallowed, ""},
+	{0x1AFF5, idnaStatusValid, ""},
+	{0x1AFFC, idnaStatusDisallowed, ""},
+	{0x1AFFD, idnaStatusValid, ""},
+	{0x1AFFF, idnaStatusDisallowed, ""},
+	{0x1B000, idnaStatusValid, ""},
+	{0x1B123, idnaStatusDisallowed, ""},
+	{0x1B132, idnaStatusValid, ""},
+	{0x1B133, idnaStatusDisallowed, ""},
+	{0x1B150, idnaStatusValid, ""},
+	{0x1B153, idnaStatusDisallowed, ""},
+	{0x1B155, idnaStatusValid, ""},
+	{0x1B156, idnaStatusDisallowed, ""},
+	{0x1B164, idnaStatusValid, ""},
+	{0x1B168, idnaStatusDisallowed, ""},
+	{0x1B170, idnaStatusValid, ""},
+	{0x1B2FC, idnaStatusDisallowed, ""},
+	{0x1BC00, idnaStatusValid, ""},
+	{0x1BC6B, idnaStatusDisallowed, ""},
+	{0x1BC70, idnaStatusValid, ""},
+	{0x1BC7D, idnaStatusDisallowed, ""},
+	{0x1BC80, idnaStatusValid, ""},
+	{0x1BC89, idnaStatusDisallowed, ""},
+	{0x1BC90, idnaStatusValid, ""},
+	{0x1BC9A, idnaStatusDisallowed, ""},
+	{0x1BC9C, idnaStatusValid, ""},
+	{0x1BCA0, idnaStatusIgnored, ""},
+	{0x1BCA4, idnaStatusDisallowed, ""},
+	{0x1CC00, idnaStatusValid, ""},
+	{0x1CCD6, idnaStatusMapped, "a"},
+	{0x1CCD7, idnaStatusMapped, "b"},
+	{0x1CCD8, idnaStatusMapped, "c"},
+	{0x1CCD9, idnaStatusMapped, "d"},
+	{0x1CCDA, idnaStatusMapped, "e"},
+	{0x1CCDB, idnaStatusMapped, "f"},
+	{0x1CCDC, idnaStatusMapped, "g"},
+	{0x1CCDD, idnaStatusMapped, "h"},
+	{0x1CCDE, idnaStatusMapped, "i"},
+	{0x1CCDF, idnaStatusMapped, "j"},
+	{0x1CCE0, idnaStatusMapped, "k"},
+	{0x1CCE1, idnaStatusMapped, "l"},
+	{0x1CCE2, idnaStatusMapped, "m"},
+	{0x1CCE3, idnaStatusMapped, "n"},
+	{0x1CCE4, idnaStatusMapped, "o"},
+	{0x1CCE5, idnaStatusMapped, "p"},
+	{0x1CCE6, idnaStatusMapped, "q"},
+	{0x1CCE7, idnaStatusMapped, "r"},
+	{0x1CCE8, idnaStatusMapped, "s"},
+	{0x1CCE9, idnaStatusMapped, "t"},
+	{0x1CCEA, idnaStatusMapped, "u"},
+	{0x1CCEB, idnaStatusMapped, "v"},
+	{0x1CCEC, idnaStatusMapped, "w"},
+	{0x1CCED, idnaStatusMapped, "x"},
+	{0x1CCEE, idnaStatusMapped, "y"},
+	{0x1CCEF, idnaStatusMapped, "z"},
+	{0x1CCF0, idnaStatusMapped, "0"},
+	{0x1CCF1, idnaStatusMapped, "1"},
+	{0x1CCF2, idnaStatusMapped, "2"},
+	{0x1CCF3, idnaStatusMapped, "3"},
+	{0x1CCF4, idnaStatusMapped, "4"},
+	{0x1CCF5, idnaStatusMapped, "5"},
+	{0x1CCF6, idnaStatusMapped, "6"},
+	{0x1CCF7, idnaStatusMapped, "7"},
+	{0x1CCF8, idnaStatusMapped, "8"},
+	{0x1CCF9, idnaStatusMapped, "9"},
+	{0x1CCFA, idnaStatusValid, ""},
+	{0x1CCFD, idnaStatusDisallowed, ""},
+	{0x1CD00, idnaStatusValid, ""},
+	{0x1CEB4, idnaStatusDisallowed, ""},
+	{0x1CEBA, idnaStatusValid, ""},
+	{0x1CED1, idnaStatusDisallowed, ""},
+	{0x1CEE0, idnaStatusValid, ""},
+	{0x1CEF1, idnaStatusDisallowed, ""},
+	{0x1CF00, idnaStatusValid, ""},
+	{0x1CF2E, idnaStatusDisallowed, ""},
+	{0x1CF30, idnaStatusValid, ""},
+	{0x1CF47, idnaStatusDisallowed, ""},
+	{0x1CF50, idnaStatusValid, ""},
+	{0x1CFC4, idnaStatusDisallowed, ""},
+	{0x1D000, idnaStatusValid, ""},
+	{0x1D0F6, idnaStatusDisallowed, ""},
+	{0x1D100, idnaStatusValid, ""},
+	{0x1D127, idnaStatusDisallowed, ""},
+	{0x1D129, idnaStatusValid, ""},
+	{0x1D15E, idnaStatusMapped, "\U0001d157\U0001d165"},
+	{0x1D15F, idnaStatusMapped, "\U0001d158\U0001d165"},
+	{0x1D160, idnaStatusMapped, "\U0001d158\U0001d165\U0001d16e"},
+	{0x1D161, idnaStatusMapped, "\U0001d158\U0001d165\U0001d16f"},
+	{0x1D162, idnaStatusMapped, "\U0001d158\U0001d165\U0001d170"},
+	{0x1D163, idnaStatusMapped, "\U0001d158\U0001d165\U0001d171"},
+	{0x1D164, idnaStatusMapped, "\U0001d158\U0001d165\U0001d172"},
+	{0x1D165, idnaStatusValid, ""},
+	{0x1D173, idnaStatusIgnored, ""},
+	{0x1D17B, idnaStatusValid, ""},
+	{0x1D1BB, idnaStatusMapped, "\U0001d1b9\U0001d165"},
+	{0x1D1BC, idnaStatusMapped, "\U0001d1ba\U0001d165"},
+	{0x1D1BD, idnaStatusMapped, "\U0001d1b9\U0001d165\U0001d16e"},
+	{0x1D1BE, idnaStatusMapped, "\U0001d1ba\U0001d165\U0001d16e"},
+	{0x1D1BF, idnaStatusMapped, "\U0001d1b9\U0001d165\U0001d16f"},
+	{0x1D1C0, idnaStatusMapped, "\U0001d1ba\U0001d165\U0001d16f"},
+	{0x1D1C1, idnaStatusValid, ""},
+	{0x1D1EB, idnaStatusDisallowed, ""},
+	{0x1D200, idnaStatusValid, ""},
+	{0x1D246, idnaStatusDisallowed, ""},
+	{0x1D2C0, idnaStatusValid, ""},
+	{0x1D2D4, idnaStatusDisallowed, ""},
+	{0x1D2E0, idnaStatusValid, ""},
+	{0x1D2F4, idnaStatusDisallowed, ""},
+	{0x1D300, idnaStatusValid, ""},
+	{0x1D357, idnaStatusDisallowed, ""},
+	{0x1D360, idnaStatusValid, ""},
+	{0x1D379, idnaStatusDisallowed, ""},
+	{0x1D400, idnaStatusMapped, "a"},
+	{0x1D401, idnaStatusMapped, "b"},
+	{0x1D402, idnaStatusMapped, "c"},
+	{0x1D403, idnaStatusMapped, "d"},
+	{0x1D404, idnaStatusMapped, "e"},
+	{0x1D405, idnaStatusMapped, "f"},
+	{0x1D406, idnaStatusMapped, "g"},
+	{0x1D407, idnaStatusMapped, "h"},
+	{0x1D408, idnaStatusMapped, "i"},
+	{0x1D409, idnaStatusMapped, "j"},
+	{0x1D40A, idnaStatusMapped, "k"},
+	{0x1D40B, idnaStatusMapped, "l"},
+	{0x1D40C, idnaStatusMapped, "m"},
+	{0x1D40D, idnaStatusMapped, "n"},
+	{0x1D40E, idnaStatusMapped, "o"},
+	{0x1D40F, idnaStatusMapped, "p"},
+	{0x1D410, idnaStatusMapped, "q"},
+	{0x1D411, idnaStatusMapped, "r"},
+	{0x1D412, idnaStatusMapped, "s"},
+	{0x1D413, idnaStatusMapped, "t"},
+	{0x1D414, idnaStatusMapped, "u"},
+	{0x1D415, idnaStatusMapped, "v"},
+	{0x1D416, idnaStatusMapped, "w"},
+	{0x1D417, idnaStatusMapped, "x"},
+	{0x1D418, idnaStatusMapped, "y"},
+	{0x1D419, idnaStatusMapped, "z"},
+	{0x1D41A, idnaStatusMapped, "a"},
+	{0x1D41B, idnaStatusMapped, "b"},
+	{0x1D41C, idnaStatusMapped, "c"},
+	{0x1D41D, idnaStatusMapped, "d"},
+	{0x1D41E, idnaStatusMapped, "e"},
+	{0x1D41F, idnaStatusMapped, "f"},
+	{0x1D420, idnaStatusMapped, "g"},
+	{0x1D421, idnaStatusMapped, "h"},
+	{0x1D422, idnaStatusMapped, "i"},
+	{0x1D423, idnaStatusMapped, "j"},
+	{0x1D424, idnaStatusMapped, "k"},
+	{0x1D425, idnaStatusMapped, "l"},
+	{0x1D426, idnaStatusMapped, "m"},
+	{0x1D427, idnaStatusMapped, "n"},
+	{0x1D428, idnaStatusMapped, "o"},
+	{0x1D429, idnaStatusMapped, "p"},
+	{0x1D42A, idnaStatusMapped, "q"},
+	{0x1D42B, idnaStatusMapped, "r"},
+	{0x1D42C, idnaStatusMapped, "s"},
+	{0x1D42D, idnaStatusMapped, "t"},
+	{0x1D42E, idnaStatusMapped, "u"},
+	{0x1D42F, idnaStatusMapped, "v"},
+	{0x1D430, idnaStatusMapped, "w"},
+	{0x1D431, idnaStatusMapped, "x"},
+	{0x1D432, idnaStatusMapped, "y"},
+	{0x1D433, idnaStatusMapped, "z"},
+	{0x1D434, idnaStatusMapped, "a"},
+	{0x1D435, idnaStatusMapped, "b"},
+	{0x1D436, idnaStatusMapped, "c"},
+	{0x1D437, idnaStatusMapped, "d"},
+	{0x1D438, idnaStatusMapped, "e"},
+	{0x1D439, idnaStatusMapped, "f"},
+	{0x1D43A, idnaStatusMapped, "g"},
+	{0x1D43B, idnaStatusMapped, "h"},
+	{0x1D43C, idnaStatusMapped, "i"},
+	{0x1D43D, idnaStatusMapped, "j"},
+	{0x1D43E, idnaStatusMapped, "k"},
+	{0x1D43F, idnaStatusMapped, "l"},
+	{0x1D440, idnaStatusMapped, "m"},
+	{0x1D441, idnaStatusMapped, "n"},
+	{0x1D442, idnaStatusMapped, "o"},
+	{0x1D443, idnaStatusMapped, "p"},
+	{0x1D444, idnaStatusMapped, "q"},
+	{0x1D445, idnaStatusMapped, "r"},
+	{0x1D446, idnaStatusMapped, "s"},
+	{0x1D447, idnaStatusMapped, "t"},
+	{0x1D448, idnaStatusMapped, "u"},
+	{0x1D449, idnaStatusMapped, "v"},
+	{0x1D44A, idnaStatusMapped, "w"},
+	{0x1D44B, idnaStatusMapped, "x"},
+	{0x1D44C, idnaStatusMapped, "y"},
+	{0x1D44D, idnaStatusMapped, "z"},
+	{0x1D44E, idnaStatusMapped, "a"},
+	{0x1D44F, idnaStatusMapped, "b"},
+	{0x1D450, idnaStatusMapped, "c"},
+	{0x1D451, idnaStatusMapped, "d"},
+	{0x1D452, idnaStatusMapped, "e"},
+	{0x1D453, idnaStatusMapped, "f"},
+	{0x1D454, idnaStatusMapped, "g"},
+	{0x1D455, idnaStatusDisallowed, ""},
+	{0x1D456, idnaStatusMapped, "i"},
+	{0x1D457, idnaStatusMapped, "j"},
+	{0x1D458, idnaStatusMapped, "k"},
+	{0x1D459, idnaStatusMapped, "l"},
+	{0x1D45A, idnaStatusMapped, "m"},
+	{0x1D45B, idnaStatusMapped, "n"},
+	{0x1D45C, idnaStatusMapped, "o"},
+	{0x1D45D, idnaStatusMapped, "p"},
+	{0x1D45E, idnaStatusMapped, "q"},
+	{0x1D45F, idnaStatusMapped, "r"},
+	{0x1D460, idnaStatusMapped, "s"},
+	{0x1D461, idnaStatusMapped, "t"},
+	{0x1D462, idnaStatusMapped, "u"},
+	{0x1D463, idnaStatusMapped, "v"},
+	{0x1D464, idnaStatusMapped, "w"},
+	{0x1D465, idnaStatusMapped, "x"},
+	{0x1D466, idnaStatusMapped, "y"},
+	{0x1D467, idnaStatusMapped, "z"},
+	{0x1D468, idnaStatusMapped, "a"},
+	{0x1D469, idnaStatusMapped, "b"},
+	{0x1D46A, idnaStatusMapped, "c"},
+	{0x1D46B, idnaStatusMapped, "d"},
+	{0x1D46C, idnaStatusMapped, "e"},
+	{0x1D46D, idnaStatusMapped, "f"},
+	{0x1D46E, idnaStatusMapped, "g"},
+	{0x1D46F, idnaStatusMapped, "h"},
+	{0x1D470, idnaStatusMapped, "i"},
+	{0x1D471, idnaStatusMapped, "j"},
+	{0x1D472, idnaStatusMapped, "k"},
+	{0x1D473, idnaStatusMapped, "l"},
+	{0x1D474, idnaStatusMapped, "m"},
+	{0x1D475, idnaStatusMapped, "n"},
+	{0x1D476, idnaStatusMapped, "o"},
+	{0x1D477, idnaStatusMapped, "p"},
+	{0x1D478, idnaStatusMapped, "q"},
+	{0x1D479, idnaStatusMapped, "r"},
+	{0x1D47A, idnaStatusMapped, "s"},
+	{0x1D47B, idnaStatusMapped, "t"},
+	{0x1D47C, idnaStatusMapped, "u"},
+	{0x1D47D, idnaStatusMapped, "v"},
+	{0x1D47E, idnaStatusMapped, "w"},
+	{0x1D47F, idnaStatusMapped, "x"},
+	{0x1D480, idnaStatusMapped, "y"},
+	{0x1D481, idnaStatusMapped, "z"},
+	{0x1D482, idnaStatusMapped, "a"},
+	{0x1D483, idnaStatusMapped, "b"},
+	{0x1D484, idnaStatusMapped, "c"},
+	{0x1D485, idnaStatusMapped, "d"},
+	{0x1D486, idnaStatusMapped, "e"},
+	{0x1D487, idnaStatusMapped, "f"},
+	{0x1D488, idnaStatusMapped, "g"},
+	{0x1D489, idnaStatusMapped, "h"},
+	{0x1D48A, idnaStatusMapped, "i"},
+	{0x1D48B, idnaStatusMapped, "j"},
+	{0x1D48C, idnaStatusMapped, "k"},
+	{0x1D48D, idnaStatusMapped, "l"},
+	{0x1D48E, idnaStatusMapped, "m"},
+	{0x1D48F, idnaStatusMapped, "n"},
+	{0x1D490, idnaStatusMapped, "o"},
+	{0x1D491, idnaStatusMapped, "p"},
+	{0x1D492, idnaStatusMapped, "q"},
+	{0x1D493, idnaStatusMapped, "r"},
+	{0x1D494, idnaStatusMapped, "s"},
+	{0x1D495, idnaStatusMapped, "t"},
+	{0x1D496, idnaStatusMapped, "u"},
+	{0x1D497, idnaStatusMapped, "v"},
+	{0x1D498, idnaStatusMapped, "w"},
+	{0x1D499, idnaStatusMapped, "x"},
+	{0x1D49A, idnaStatusMapped, "y"},
+	{0x1D49B, idnaStatusMapped, "z"},
+	{0x1D49C, idnaStatusMapped, "a"},
+	{0x1D49D, idnaStatusDisallowed, ""},
+	{0x1D49E, idnaStatusMapped, "c"},
+	{0x1D49F, idnaStatusMapped, "d"},
+	{0x1D4A0, idnaStatusDisallowed, ""},
+	{0x1D4A2, idnaStatusMapped, "g"},
+	{0x1D4A3, idnaStatusDisallowed, ""},
+	{0x1D4A5, idnaStatusMapped, "j"},
+	{0x1D4A6, idnaStatusMapped, "k"},
+	{0x1D4A7, idnaStatusDisallowed, ""},
+	{0x1D4A9, idnaStatusMapped, "n"},
+	{0x1D4AA, idnaStatusMapped, "o"},
+	{0x1D4AB, idnaStatusMapped, "p"},
+	{0x1D4AC, idnaStatusMapped, "q"},
+	{0x1D4AD, idnaStatusDisallowed, ""},
+	{0x1D4AE, idnaStatusMapped, "s"},
+	{0x1D4AF, idnaStatusMapped, "t"},
+	{0x1D4B0, idnaStatusMapped, "u"},
+	{0x1D4B1, idnaStatusMapped, "v"},
+	{0x1D4B2, idnaStatusMapped, "w"},
+	{0x1D4B3, idnaStatusMapped, "x"},
+	{0x1D4B4, idnaStatusMapped, "y"},
+	{0x1D4B5, idnaStatusMapped, "z"},
+	{0x1D4B6, idnaStatusMapped, "a"},
+	{0x1D4B7, idnaStatusMapped, "b"},
+	{0x1D4B8, idnaStatusMapped, "c"},
+	{0x1D4B9, idnaStatusMapped, "d"},
+	{0x1D4BA, idnaStatusDisallowed, ""},
+	{0x1D4BB, idnaStatusMapped, "f"},
+	{0x1D4BC, idnaStatusDisallowed, ""},
+	{0x1D4BD, idnaStatusMapped, "h"},
+	{0x1D4BE, idnaStatusMapped, "i"},
+	{0x1D4BF, idnaStatusMapped, "j"},
+	{0x1D4C0, idnaStatusMapped, "k"},
+	{0x1D4C1, idnaStatusMapped, "l"},
+	{0x1D4C2, idnaStatusMapped, "m"},
+	{0x1D4C3, idnaStatusMapped, "n"},
+	{0x1D4C4, idnaStatusDisallowed, ""},
+	{0x1D4C5, idnaStatusMapped, "p"},
+	{0x1D4C6, idnaStatusMapped, "q"},
+	{0x1D4C7, idnaStatusMapped, "r"},
+	{0x1D4C8, idnaStatusMapped, "s"},
+	{0x1D4C9, idnaStatusMapped, "t"},
+	{0x1D4CA, idnaStatusMapped, "u"},
+	{0x1D4CB, idnaStatusMapped, "v"},
+	{0x1D4CC, idnaStatusMapped, "w"},
+	{0x1D4CD, idnaStatusMapped, "x"},
+	{0x1D4CE, idnaStatusMapped, "y"},
+	{0x1D4CF, idnaStatusMapped, "z"},
+	{0x1D4D0, idnaStatusMapped, "a"},
+	{0x1D4D1, idnaStatusMapped, "b"},
+	{0x1D4D2, idnaStatusMapped, "c"},
+	{0x1D4D3, idnaStatusMapped, "d"},
+	{0x1D4D4, idnaStatusMapped, "e"},
+	{0x1D4D5, idnaStatusMapped, "f"},
+	{0x1D4D6, idnaStatusMapped, "g"},
+	{0x1D4D7, idnaStatusMapped, "h"},
+	{0x1D4D8, idnaStatusMapped, "i"},
+	{0x1D4D9, idnaStatusMapped, "j"},
+	{0x1D4DA, idnaStatusMapped, "k"},
+	{0x1D4DB, idnaStatusMapped, "l"},
+	{0x1D4DC, idnaStatusMapped, "m"},
+	{0x1D4DD, idnaStatusMapped, "n"},
+	{0x1D4DE, idnaStatusMapped, "o"},
+	{0x1D4DF, idnaStatusMapped, "p"},
+	{0x1D4E0, idnaStatusMapped, "q"},
+	{0x1D4E1, idnaStatusMapped, "r"},
+	{0x1D4E2, idnaStatusMapped, "s"},
+	{0x1D4E3, idnaStatusMapped, "t"},
+	{0x1D4E4, idnaStatusMapped, "u"},
+	{0x1D4E5, idnaStatusMapped, "v"},
+	{0x1D4E6, idnaStatusMapped, "w"},
+	{0x1D4E7, idnaStatusMapped, "x"},
+	{0x1D4E8, idnaStatusMapped, "y"},
+	{0x1D4E9, idnaStatusMapped, "z"},
+	{0x1D4EA, idnaStatusMapped, "a"},
+	{0x1D4EB, idnaStatusMapped, "b"},
+	{0x1D4EC, idnaStatusMapped, "c"},
+	{0x1D4ED, idnaStatusMapped, "d"},
+	{0x1D4EE, idnaStatusMapped, "e"},
+	{0x1D4EF, idnaStatusMapped, "f"},
+	{0x1D4F0, idnaStatusMapped, "g"},
+	{0x1D4F1, idnaStatusMapped, "h"},
+	{0x1D4F2, idnaStatusMapped, "i"},
+	{0x1D4F3, idnaStatusMapped, "j"},
+	{0x1D4F4, idnaStatusMapped, "k"},
+	{0x1D4F5, idnaStatusMapped, "l"},
+	{0x1D4F6, idnaStatusMapped, "m"},
+	{0x1D4F7, idnaStatusMapped, "n"},
+	{0x1D4F8, idnaStatusMapped, "o"},
+	{0x1D4F9, idnaStatusMapped, "p"},
+	{0x1D4FA, idnaStatusMapped, "q"},
+	{0x1D4FB, idnaStatusMapped, "r"},
+	{0x1D4FC, idnaStatusMapped, "s"},
+	{0x1D4FD, idnaStatusMapped, "t"},
+	{0x1D4FE, idnaStatusMapped, "u"},
+	{0x1D4FF, idnaStatusMapped, "v"},
+	{0x1D500, idnaStatusMapped, "w"},
+	{0x1D501, idnaStatusMapped, "x"},
+	{0x1D502, idnaStatusMapped, "y"},
+	{0x1D503, idnaStatusMapped, "z"},
+	{0x1D504, idnaStatusMapped, "a"},
+	{0x1D505, idnaStatusMapped, "b"},
+	{0x1D506, idnaStatusDisallowed, ""},
+	{0x1D507, idnaStatusMapped, "d"},
+	{0x1D508, idnaStatusMapped, "e"},
+	{0x1D509, idnaStatusMapped, "f"},
+	{0x1D50A, idnaStatusMapped, "g"},
+	{0x1D50B, idnaStatusDisallowed, ""},
+	{0x1D50D, idnaStatusMapped, "j"},
+	{0x1D50E, idnaStatusMapped, "k"},
+	{0x1D50F, idnaStatusMapped, "l"},
+	{0x1D510, idnaStatusMapped, "m"},
+	{0x1D511, idnaStatusMapped, "n"},
+	{0x1D512, idnaStatusMapped, "o"},
+	{0x1D513, idnaStatusMapped, "p"},
+	{0x1D514, idnaStatusMapped, "q"},
+	{0x1D515, idnaStatusDisallowed, ""},
+	{0x1D516, idnaStatusMapped, "s"},
+	{0x1D517, idnaStatusMapped, "t"},
+	{0x1D518, idnaStatusMapped, "u"},
+	{0x1D519, idnaStatusMapped, "v"},
+	{0x1D51A, idnaStatusMapped, "w"},
+	{0x1D51B, idnaStatusMapped, "x"},
+	{0x1D51C, idnaStatusMapped, "y"},
+	{0x1D51D, idnaStatusDisallowed, ""},
+	{0x1D51E, idnaStatusMapped, "a"},
+	{0x1D51F, idnaStatusMapped, "b"},
+	{0x1D520, idnaStatusMapped, "c"},
+	{0x1D521, idnaStatusMapped, "d"},
+	{0x1D522, idnaStatusMapped, "e"},
+	{0x1D523, idnaStatusMapped, "f"},
+	{0x1D524, idnaStatusMapped, "g"},
+	{0x1D525, idnaStatusMapped, "h"},
+	{0x1D526, idnaStatusMapped, "i"},
+	{0x1D527, idnaStatusMapped, "j"},
+	{0x1D528, idnaStatusMapped, "k"},
+	{0x1D529, idnaStatusMapped, "l"},
+	{0x1D52A, idnaStatusMapped, "m"},
+	{0x1D52B, idnaStatusMapped, "n"},
+	{0x1D52C, idnaStatusMapped, "o"},
+	{0x1D52D, idnaStatusMapped, "p"},
+	{0x1D52E, idnaStatusMapped, "q"},
+	{0x1D52F, idnaStatusMapped, "r"},
+	{0x1D530, idnaStatusMapped, "s"},
+	{0x1D531, idnaStatusMapped, "t"},
+	{0x1D532, idnaStatusMapped, "u"},
+	{0x1D533, idnaStatusMapped, "v"},
+	{0x1D534, idnaStatusMapped, "w"},
+	{0x1D535, idnaStatusMapped, "x"},
+	{0x1D536, idnaStatusMapped, "y"},
+	{0x1D537, idnaStatusMapped, "z"},
+	{0x1D538, idnaStatusMapped, "a"},
+	{0x1D539, idnaStatusMapped, "b"},
+	{0x1D53A, idnaStatusDisallowed, ""},
+	{0x1D53B, idnaStatusMapped, "d"},
+	{0x1D53C, idnaStatusMapped, "e"},
+	{0x1D53D, idnaStatusMapped, "f"},
+	{0x1D53E, idnaStatusMapped, "g"},
+	{0x1D53F, idnaStatusDisallowed, ""},
+	{0x1D540, idnaStatusMapped, "i"},
+	{0x1D541, idnaStatusMapped, "j"},
+	{0x1D542, idnaStatusMapped, "k"},
+	{0x1D543, idnaStatusMapped, "l"},
+	{0x1D544, idnaStatusMapped, "m"},
+	{0x1D545, idnaStatusDisallowed, ""},
+	{0x1D546, idnaStatusMapped, "o"},
+	{0x1D547, idnaStatusDisallowed, ""},
+	{0x1D54A, idnaStatusMapped, "s"},
+	{0x1D54B, idnaStatusMapped, "t"},
+	{0x1D54C, idnaStatusMapped, "u"},
+	{0x1D54D, idnaStatusMapped, "v"},
+	{0x1D54E, idnaStatusMapped, "w"},
+	{0x1D54F, idnaStatusMapped, "x"},
+	{0x1D550, idnaStatusMapped, "y"},
+	{0x1D551, idnaStatusDisallowed, ""},
+	{0x1D552, idnaStatusMapped, "a"},
+	{0x1D553, idnaStatusMapped, "b"},
+	{0x1D554, idnaStatusMapped, "c"},
+	{0x1D555, idnaStatusMapped, "d"},
+	{0x1D556, idnaStatusMapped, "e"},
+	{0x1D557, idnaStatusMapped, "f"},
+	{0x1D558, idnaStatusMapped, "g"},
+	{0x1D559, idnaStatusMapped, "h"},
+	{0x1D55A, idnaStatusMapped, "i"},
+	{0x1D55B, idnaStatusMapped, "j"},
+	{0x1D55C, idnaStatusMapped, "k"},
+	{0x1D55D, idnaStatusMapped, "l"},
+	{0x1D55E, idnaStatusMapped, "m"},
+	{0x1D55F, idnaStatusMapped, "n"},
+	{0x1D560, idnaStatusMapped, "o"},
+	{0x1D561, idnaStatusMapped, "p"},
+	{0x1D562, idnaStatusMapped, "q"},
+	{0x1D563, idnaStatusMapped, "r"},
+	{0x1D564, idnaStatusMapped, "s"},
+	{0x1D565, idnaStatusMapped, "t"},
+	{0x1D566, idnaStatusMapped, "u"},
+	{0x1D567, idnaStatusMapped, "v"},
+	{0x1D568, idnaStatusMapped, "w"},
+	{0x1D569, idnaStatusMapped, "x"},
+	{0x1D56A, idnaStatusMapped, "y"},
+	{0x1D56B, idnaStatusMapped, "z"},
+	{0x1D56C, idnaStatusMapped, "a"},
+	{0x1D56D, idnaStatusMapped, "b"},
+	{0x1D56E, idnaStatusMapped, "c"},
+	{0x1D56F, idnaStatusMapped, "d"},
+	{0x1D570, idnaStatusMapped, "e"},
+	{0x1D571, idnaStatusMapped, "f"},
+	{0x1D572, idnaStatusMapped, "g"},
+	{0x1D573, idnaStatusMapped, "h"},
+	{0x1D574, idnaStatusMapped, "i"},
+	{0x1D575, idnaStatusMapped, "j"},
+	{0x1D576, idnaStatusMapped, "k"},
+	{0x1D577, idnaStatusMapped, "l"},
+	{0x1D578, idnaStatusMapped, "m"},
+	{0x1D579, idnaStatusMapped, "n"},
+	{0x1D57A, idnaStatusMapped, "o"},
+	{0x1D57B, idnaStatusMapped, "p"},
+	{0x1D57C, idnaStatusMapped, "q"},
+	{0x1D57D, idnaStatusMapped, "r"},
+	{0x1D57E, idnaStatusMapped, "s"},
+	{0x1D57F, idnaStatusMapped, "t"},
+	{0x1D580, idnaStatusMapped, "u"},
+	{0x1D581, idnaStatusMapped, "v"},
+	{0x1D582, idnaStatusMapped, "w"},
+	{0x1D583, idnaStatusMapped, "x"},
+	{0x1D584, idnaStatusMapped, "y"},
+	{0x1D585, idnaStatusMapped, "z"},
+	{0x1D586, idnaStatusMapped, "a"},
+	{0x1D587, idnaStatusMapped, "b"},
+	{0x1D588, idnaStatusMapped, "c"},
+	{0x1D589, idnaStatusMapped, "d"},
+	{0x1D58A, idnaStatusMapped, "e"},
+	{0x1D58B, idnaStatusMapped, "f"},
+	{0x1D58C, idnaStatusMapped, "g"},
+	{0x1D58D, idnaStatusMapped, "h"},
+	{0x1D58E, idnaStatusMapped, "i"},
+	{0x1D58F, idnaStatusMapped, "j"},
+	{0x1D590, idnaStatusMapped, "k"},
+	{0x1D591, idnaStatusMapped, "l"},
+	{0x1D592, idnaStatusMapped, "m"},
+	{0x1D593, idnaStatusMapped, "n"},
+	{0x1D594, idnaStatusMapped, "o"},
+	{0x1D595, idnaStatusMapped, "p"},
+	{0x1D596, idnaStatusMapped, "q"},
+	{0x1D597, idnaStatusMapped, "r"},
+	{0x1D598, idnaStatusMapped, "s"},
+	{0x1D599, idnaStatusMapped, "t"},
+	{0x1D59A, idnaStatusMapped, "u"},
+	{0x1D59B, idnaStatusMapped, "v"},
+	{0x1D59C, idnaStatusMapped, "w"},
+	{0x1D59D, idnaStatusMapped, "x"},
+	{0x1D59E, idnaStatusMapped, "y"},
+	{0x1D59F, idnaStatusMapped, "z"},
+	{0x1D5A0, idnaStatusMapped, "a"},
+	{0x1D5A1, idnaStatusMapped, "b"},
+	{0x1D5A2, idnaStatusMapped, "c"},
+	{0x1D5A3, idnaStatusMapped, "d"},
+	{0x1D5A4, idnaStatusMapped, "e"},
+	{0x1D5A5, idnaStatusMapped, "f"},
+	{0x1D5A6, idnaStatusMapped, "g"},
+	{0x1D5A7, idnaStatusMapped, "h"},
+	{0x1D5A8, idnaStatusMapped, "i"},
+	{0x1D5A9, idnaStatusMapped, "j"},
+	{0x1D5AA, idnaStatusMapped, "k"},
+	{0x1D5AB, idnaStatusMapped, "l"},
+	{0x1D5AC, idnaStatusMapped, "m"},
+	{0x1D5AD, idnaStatusMapped, "n"},
+	{0x1D5AE, idnaStatusMapped, "o"},
+	{0x1D5AF, idnaStatusMapped, "p"},
+	{0x1D5B0, idnaStatusMapped, "q"},
+	{0x1D5B1, idnaStatusMapped, "r"},
+	{0x1D5B2, idnaStatusMapped, "s"},
+	{0x1D5B3, idnaStatusMapped, "t"},
+	{0x1D5B4, idnaStatusMapped, "u"},
+	{0x1D5B5, idnaStatusMapped, "v"},
+	{0x1D5B6, idnaStatusMapped, "w"},
+	{0x1D5B7, idnaStatusMapped, "x"},
+	{0x1D5B8, idnaStatusMapped, "y"},
+	{0x1D5B9, idnaStatusMapped, "z"},
+	{0x1D5BA, idnaStatusMapped, "a"},
+	{0x1D5BB, idnaStatusMapped, "b"},
+	{0x1D5BC, idnaStatusMapped, "c"},
+	{0x1D5BD, idnaStatusMapped, "d"},
+	{0x1D5BE, idnaStatusMapped, "e"},
+	{0x1D5BF, idnaStatusMapped, "f"},
+	{0x1D5C0, idnaStatusMapped, "g"},
+	{0x1D5C1, idnaStatusMapped, "h"},
+	{0x1D5C2, idnaStatusMapped, "i"},
+	{0x1D5C3, idnaStatusMapped, "j"},
+	{0x1D5C4, idnaStatusMapped, "k"},
+	{0x1D5C5, idnaStatusMapped, "l"},
+	{0x1D5C6, idnaStatusMapped, "m"},
+	{0x1D5C7, idnaStatusMapped, "n"},
+	{0x1D5C8, idnaStatusMapped, "o"},
+	{0x1D5C9, idnaStatusMapped, "p"},
+	{0x1D5CA, idnaStatusMapped, "q"},
+	{0x1D5CB, idnaStatusMapped, "r"},
+	{0x1D5CC, idnaStatusMapped, "s"},
+	{0x1D5CD, idnaStatusMapped, "t"},
+	{0x1D5CE, idnaStatusMapped, "u"},
+	{0x1D5CF, idnaStatusMapped, "v"},
+	{0x1D5D0, idnaStatusMapped, "w"},
+	{0x1D5D1, idnaStatusMapped, "x"},
+	{0x1D5D2, idnaStatusMapped, "y"},
+	{0x1D5D3, idnaStatusMapped, "z"},
+	{0x1D5D4, idnaStatusMapped, "a"},
+	{0x1D5D5, idnaStatusMapped, "b"},
+	{0x1D5D6, idnaStatusMapped, "c"},
+	{0x1D5D7, idnaStatusMapped, "d"},
+	{0x1D5D8, idnaStatusMapped, "e"},
+	{0x1D5D9, idnaStatusMapped, "f"},
+	{0x1D5DA, idnaStatusMapped, "g"},
+	{0x1D5DB, idnaStatusMapped, "h"},
+	{0x1D5DC, idnaStatusMapped, "i"},
+	{0x1D5DD, idnaStatusMapped, "j"},
+	{0x1D5DE, idnaStatusMapped, "k"},
+	{0x1D5DF, idnaStatusMapped, "l"},
+	{0x1D5E0, idnaStatusMapped, "m"},
+	{0x1D5E1, idnaStatusMapped, "n"},
+	{0x1D5E2, idnaStatusMapped, "o"},
+	{0x1D5E3, idnaStatusMapped, "p"},
+	{0x1D5E4, idnaStatusMapped, "q"},
+	{0x1D5E5, idnaStatusMapped, "r"},
+	{0x1D5E6, idnaStatusMapped, "s"},
+	{0x1D5E7, idnaStatusMapped, "t"},
+	{0x1D5E8, idnaStatusMapped, "u"},
+	{0x1D5E9, idnaStatusMapped, "v"},
+	{0x1D5EA, idnaStatusMapped, "w"},
+	{0x1D5EB, idnaStatusMapped, "x"},
+	{0x1D5EC, idnaStatusMapped, "y"},
+	{0x1D5ED, idnaStatusMapped, "z"},
+	{0x1D5EE, idnaStatusMapped, "a"},
+	{0x1D5EF, idnaStatusMapped, "b"},
+	{0x1D5F0, idnaStatusMapped, "c"},
+	{0x1D5F1, idnaStatusMapped, "d"},
+	{0x1D5F2, idnaStatusMapped, "e"},
+	{0x1D5F3, idnaStatusMapped, "f"},
+	{0x1D5F4, idnaStatusMapped, "g"},
+	{0x1D5F5, idnaStatusMapped, "h"},
+	{0x1D5F6, idnaStatusMapped, "i"},
+	{0x1D5F7, idnaStatusMapped, "j"},
+	{0x1D5F8, idnaStatusMapped, "k"},
+	{0x1D5F9, idnaStatusMapped, "l"},
+	{0x1D5FA, idnaStatusMapped, "m"},
+	{0x1D5FB, idnaStatusMapped, "n"},
+	{0x1D5FC, idnaStatusMapped, "o"},
+	{0x1D5FD, idnaStatusMapped, "p"},
+	{0x1D5FE, idnaStatusMapped, "q"},
+	{0x1D5FF, idnaStatusMapped, "r"},
+	{0x1D600, idnaStatusMapped, "s"},
+	{0x1D601, idnaStatusMapped, "t"},
+	{0x1D602, idnaStatusMapped, "u"},
+	{0x1D603, idnaStatusMapped, "v"},
+	{0x1D604, idnaStatusMapped, "w"},
+	{0x1D605, idnaStatusMapped, "x"},
+	{0x1D606, idnaStatusMapped, "y"},
+	{0x1D607, idnaStatusMapped, "z"},
+	{0x1D608, idnaStatusMapped, "a"},
+	{0x1D609, idnaStatusMapped, "b"},
+	{0x1D60A, idnaStatusMapped, "c"},
+	{0x1D60B, idnaStatusMapped, "d"},
+	{0x1D60C, idnaStatusMapped, "e"},
+	{0x1D60D, idnaStatusMapped, "f"},
+	{0x1D60E, idnaStatusMapped, "g"},
+	{0x1D60F, idnaStatusMapped, "h"},
+	{0x1D610, idnaStatusMapped, "i"},
+	{0x1D611, idnaStatusMapped, "j"},
+	{0x1D612, idnaStatusMapped, "k"},
+	{0x1D613, idnaStatusMapped, "l"},
+	{0x1D614, idnaStatusMapped, "m"},
+	{0x1D615, idnaStatusMapped, "n"},
+	{0x1D616, idnaStatusMapped, "o"},
+	{0x1D617, idnaStatusMapped, "p"},
+	{0x1D618, idnaStatusMapped, "q"},
+	{0x1D619, idnaStatusMapped, "r"},
+	{0x1D61A, idnaStatusMapped, "s"},
+	{0x1D61B, idnaStatusMapped, "t"},
+	{0x1D61C, idnaStatusMapped, "u"},
+	{0x1D61D, idnaStatusMapped, "v"},
+	{0x1D61E, idnaStatusMapped, "w"},
+	{0x1D61F, idnaStatusMapped, "x"},
+	{0x1D620, idnaStatusMapped, "y"},
+	{0x1D621, idnaStatusMapped, "z"},
+	{0x1D622, idnaStatusMapped, "a"},
+	{0x1D623, idnaStatusMapped, "b"},
+	{0x1D624, idnaStatusMapped, "c"},
+	{0x1D625, idnaStatusMapped, "d"},
+	{0x1D626, idnaStatusMapped, "e"},
+	{0x1D627, idnaStatusMapped, "f"},
+	{0x1D628, idnaStatusMapped, "g"},
+	{0x1D629, idnaStatusMapped, "h"},
+	{0x1D62A, idnaStatusMapped, "i"},
+	{0x1D62B, idnaStatusMapped, "j"},
+	{0x1D62C, idnaStatusMapped, "k"},
+	{0x1D62D, idnaStatusMapped, "l"},
+	{0x1D62E, idnaStatusMapped, "m"},
+	{0x1D62F, idnaStatusMapped, "n"},
+	{0x1D630, idnaStatusMapped, "o"},
+	{0x1D631, idnaStatusMapped, "p"},
+	{0x1D632, idnaStatusMapped, "q"},
+	{0x1D633, idnaStatusMapped, "r"},
+	{0x1D634, idnaStatusMapped, "s"},
+	{0x1D635, idnaStatusMapped, "t"},
+	{0x1D636, idnaStatusMapped, "u"},
+	{0x1D637, idnaStatusMapped, "v"},
+	{0x1D638, idnaStatusMapped, "w"},
+	{0x1D639, idnaStatusMapped, "x"},
+	{0x1D63A, idnaStatusMapped, "y"},
+	{0x1D63B, idnaStatusMapped, "z"},
+	{0x1D63C, idnaStatusMapped, "a"},
+	{0x1D63D, idnaStatusMapped, "b"},
+	{0x1D63E, idnaStatusMapped, "c"},
+	{0x1D63F, idnaStatusMapped, "d"},
+	{0x1D640, idnaStatusMapped, "e"},
+	{0x1D641, idnaStatusMapped, "f"},
+	{0x1D642, idnaStatusMapped, "g"},
+	{0x1D643, idnaStatusMapped, "h"},
+	{0x1D644, idnaStatusMapped, "i"},
+	{0x1D645, idnaStatusMapped, "j"},
+	{0x1D646, idnaStatusMapped, "k"},
+	{0x1D647, idnaStatusMapped, "l"},
+	{0x1D648, idnaStatusMapped, "m"},
+	{0x1D649, idnaStatusMapped, "n"},
+	{0x1D64A, idnaStatusMapped, "o"},
+	{0x1D64B, idnaStatusMapped, "p"},
+	{0x1D64C, idnaStatusMapped, "q"},
+	{0x1D64D, idnaStatusMapped, "r"},
+	{0x1D64E, idnaStatusMapped, "s"},
+	{0x1D64F, idnaStatusMapped, "t"},
+	{0x1D650, idnaStatusMapped, "u"},
+	{0x1D651, idnaStatusMapped, "v"},
+	{0x1D652, idnaStatusMapped, "w"},
+	{0x1D653, idnaStatusMapped, "x"},
+	{0x1D654, idnaStatusMapped, "y"},
+	{0x1D655, idnaStatusMapped, "z"},
+	{0x1D656, idnaStatusMapped, "a"},
+	{0x1D657, idnaStatusMapped, "b"},
+	{0x1D658, idnaStatusMapped, "c"},
+	{0x1D659, idnaStatusMapped, "d"},
+	{0x1D65A, idnaStatusMapped, "e"},
+	{0x1D65B, idnaStatusMapped, "f"},
+	{0x1D65C, idnaStatusMapped, "g"},
+	{0x1D65D, idnaStatusMapped, "h"},
+	{0x1D65E, idnaStatusMapped, "i"},
+	{0x1D65F, idnaStatusMapped, "j"},
+	{0x1D660, idnaStatusMapped, "k"},
+	{0x1D661, idnaStatusMapped, "l"},
+	{0x1D662, idnaStatusMapped, "m"},
+	{0x1D663, idnaStatusMapped, "n"},
+	{0x1D664, idnaStatusMapped, "o"},
+	{0x1D665, idnaStatusMapped, "p"},
+	{0x1D666, idnaStatusMapped, "q"},
+	{0x1D667, idnaStatusMapped, "r"},
+	{0x1D668, idnaStatusMapped, "s"},
+	{0x1D669, idnaStatusMapped, "t"},
+	{0x1D66A, idnaStatusMapped, "u"},
+	{0x1D66B, idnaStatusMapped, "v"},
+	{0x1D66C, idnaStatusMapped, "w"},
+	{0x1D66D, idnaStatusMapped, "x"},
+	{0x1D66E, idnaStatusMapped, "y"},
+	{0x1D66F, idnaStatusMapped, "z"},
+	{0x1D670, idnaStatusMapped, "a"},
+	{0x1D671, idnaStatusMapped, "b"},
+	{0x1D672, idnaStatusMapped, "c"},
+	{0x1D673, idnaStatusMapped, "d"},
+	{0x1D674, idnaStatusMapped, "e"},
+	{0x1D675, idnaStatusMapped, "f"},
+	{0x1D676, idnaStatusMapped, "g"},
+	{0x1D677, idnaStatusMapped, "h"},
+	{0x1D678, idnaStatusMapped, "i"},
+	{0x1D679, idnaStatusMapped, "j"},
+	{0x1D67A, idnaStatusMapped, "k"},
+	{0x1D67B, idnaStatusMapped, "l"},
+	{0x1D67C, idnaStatusMapped, "m"},
+	{0x1D67D, idnaStatusMapped, "n"},
+	{0x1D67E, idnaStatusMapped, "o"},
+	{0x1D67F, idnaStatusMapped, "p"},
+	{0x1D680, idnaStatusMapped, "q"},
+	{0x1D681, idnaStatusMapped, "r"},
+	{0x1D682, idnaStatusMapped, "s"},
+	{0x1D683, idnaStatusMapped, "t"},
+	{0x1D684, idnaStatusMapped, "u"},
+	{0x1D685, idnaStatusMapped, "v"},
+	{0x1D686, idnaStatusMapped, "w"},
+	{0x1D687, idnaStatusMapped, "x"},
+	{0x1D688, idnaStatusMapped, "y"},
+	{0x1D689, idnaStatusMapped, "z"},
+	{0x1D68A, idnaStatusMapped, "a"},
+	{0x1D68B, idnaStatusMapped, "b"},
+	{0x1D68C, idnaStatusMapped, "c"},
+	{0x1D68D, idnaStatusMapped, "d"},
+	{0x1D68E, idnaStatusMapped, "e"},
+	{0x1D68F, idnaStatusMapped, "f"},
+	{0x1D690, idnaStatusMapped, "g"},
+	{0x1D691, idnaStatusMapped, "h"},
+	{0x1D692, idnaStatusMapped, "i"},
+	{0x1D693, idnaStatusMapped, "j"},
+	{0x1D694, idnaStatusMapped, "k"},
+	{0x1D695, idnaStatusMapped, "l"},
+	{0x1D696, idnaStatusMapped, "m"},
+	{0x1D697, idnaStatusMapped, "n"},
+	{0x1D698, idnaStatusMapped, "o"},
+	{0x1D699, idnaStatusMapped, "p"},
+	{0x1D69A, idnaStatusMapped, "q"},
+	{0x1D69B, idnaStatusMapped, "r"},
+	{0x1D69C, idnaStatusMapped, "s"},
+	{0x1D69D, idnaStatusMapped, "t"},
+	{0x1D69E, idnaStatusMapped, "u"},
+	{0x1D69F, idnaStatusMapped, "v"},
+	{0x1D6A0, idnaStatusMapped, "w"},
+	{0x1D6A1, idnaStatusMapped, "x"},
+	{0x1D6A2, idnaStatusMapped, "y"},
+	{0x1D6A3, idnaStatusMapped, "z"},
+	{0x1D6A4, idnaStatusMapped, "\u0131"},
+	{0x1D6A5, idnaStatusMapped, "\u0237"},
+	{0x1D6A6, idnaStatusDisallowed, ""},
+	{0x1D6A8, idnaStatusMapped, "\u03b1"},
+	{0x1D6A9, idnaStatusMapped, "\u03b2"},
+	{0x1D6AA, idnaStatusMapped, "\u03b3"},
+	{0x1D6AB, idnaStatusMapped, "\u03b4"},
+	{0x1D6AC, idnaStatusMapped, "\u03b5"},
+	{0x1D6AD, idnaStatusMapped, "\u03b6"},
+	{0x1D6AE, idnaStatusMapped, "\u03b7"},
+	{0x1D6AF, idnaStatusMapped, "\u03b8"},
+	{0x1D6B0, idnaStatusMapped, "\u03b9"},
+	{0x1D6B1, idnaStatusMapped, "\u03ba"},
+	{0x1D6B2, idnaStatusMapped, "\u03bb"},
+	{0x1D6B3, idnaStatusMapped, "\u03bc"},
+	{0x1D6B4, idnaStatusMapped, "\u03bd"},
+	{0x1D6B5, idnaStatusMapped, "\u03be"},
+	{0x1D6B6, idnaStatusMapped, "\u03bf"},
+	{0x1D6B7, idnaStatusMapped, "\u03c0"},
+	{0x1D6B8, idnaStatusMapped, "\u03c1"},
+	{0x1D6B9, idnaStatusMapped, "\u03b8"},
+	{0x1D6BA, idnaStatusMapped, "\u03c3"},
+	{0x1D6BB, idnaStatusMapped, "\u03c4"},
+	{0x1D6BC, idnaStatusMapped, "\u03c5"},
+	{0x1D6BD, idnaStatusMapped, "\u03c6"},
+	{0x1D6BE, idnaStatusMapped, "\u03c7"},
+	{0x1D6BF, idnaStatusMapped, "\u03c8"},
+	{0x1D6C0, idnaStatusMapped, "\u03c9"},
+	{0x1D6C1, idnaStatusMapped, "\u2207"},
+	{0x1D6C2, idnaStatusMapped, "\u03b1"},
+	{0x1D6C3, idnaStatusMapped, "\u03b2"},
+	{0x1D6C4, idnaStatusMapped, "\u03b3"},
+	{0x1D6C5, idnaStatusMapped, "\u03b4"},
+	{0x1D6C6, idnaStatusMapped, "\u03b5"},
+	{0x1D6C7, idnaStatusMapped, "\u03b6"},
+	{0x1D6C8, idnaStatusMapped, "\u03b7"},
+	{0x1D6C9, idnaStatusMapped, "\u03b8"},
+	{0x1D6CA, idnaStatusMapped, "\u03b9"},
+	{0x1D6CB, idnaStatusMapped, "\u03ba"},
+	{0x1D6CC, idnaStatusMapped, "\u03bb"},
+	{0x1D6CD, idnaStatusMapped, "\u03bc"},
+	{0x1D6CE, idnaStatusMapped, "\u03bd"},
+	{0x1D6CF, idnaStatusMapped, "\u03be"},
+	{0x1D6D0, idnaStatusMapped, "\u03bf"},
+	{0x1D6D1, idnaStatusMapped, "\u03c0"},
+	{0x1D6D2, idnaStatusMapped, "\u03c1"},
+	{0x1D6D3, idnaStatusMapped, "\u03c3"},
+	{0x1D6D5, idnaStatusMapped, "\u03c4"},
+	{0x1D6D6, idnaStatusMapped, "\u03c5"},
+	{0x1D6D7, idnaStatusMapped, "\u03c6"},
+	{0x1D6D8, idnaStatusMapped, "\u03c7"},
+	{0x1D6D9, idnaStatusMapped, "\u03c8"},
+	{0x1D6DA, idnaStatusMapped, "\u03c9"},
+	{0x1D6DB, idnaStatusMapped, "\u2202"},
+	{0x1D6DC, idnaStatusMapped, "\u03b5"},
+	{0x1D6DD, idnaStatusMapped, "\u03b8"},
+	{0x1D6DE, idnaStatusMapped, "\u03ba"},
+	{0x1D6DF, idnaStatusMapped, "\u03c6"},
+	{0x1D6E0, idnaStatusMapped, "\u03c1"},
+	{0x1D6E1, idnaStatusMapped, "\u03c0"},
+	{0x1D6E2, idnaStatusMapped, "\u03b1"},
+	{0x1D6E3, idnaStatusMapped, "\u03b2"},
+	{0x1D6E4, idnaStatusMapped, "\u03b3"},
+	{0x1D6E5, idnaStatusMapped, "\u03b4"},
+	{0x1D6E6, idnaStatusMapped, "\u03b5"},
+	{0x1D6E7, idnaStatusMapped, "\u03b6"},
+	{0x1D6E8, idnaStatusMapped, "\u03b7"},
+	{0x1D6E9, idnaStatusMapped, "\u03b8"},
+	{0x1D6EA, idnaStatusMapped, "\u03b9"},
+	{0x1D6EB, idnaStatusMapped, "\u03ba"},
+	{0x1D6EC, idnaStatusMapped, "\u03bb"},
+	{0x1D6ED, idnaStatusMapped, "\u03bc"},
+	{0x1D6EE, idnaStatusMapped, "\u03bd"},
+	{0x1D6EF, idnaStatusMapped, "\u03be"},
+	{0x1D6F0, idnaStatusMapped, "\u03bf"},
+	{0x1D6F1, idnaStatusMapped, "\u03c0"},
+	{0x1D6F2, idnaStatusMapped, "\u03c1"},
+	{0x1D6F3, idnaStatusMapped, "\u03b8"},
+	{0x1D6F4, idnaStatusMapped, "\u03c3"},
+	{0x1D6F5, idnaStatusMapped, "\u03c4"},
+	{0x1D6F6, idnaStatusMapped, "\u03c5"},
+	{0x1D6F7, idnaStatusMapped, "\u03c6"},
+	{0x1D6F8, idnaStatusMapped, "\u03c7"},
+	{0x1D6F9, idnaStatusMapped, "\u03c8"},
+	{0x1D6FA, idnaStatusMapped, "\u03c9"},
+	{0x1D6FB, idnaStatusMapped, "\u2207"},
+	{0x1D6FC, idnaStatusMapped, "\u03b1"},
+	{0x1D6FD, idnaStatusMapped, "\u03b2"},
+	{0x1D6FE, idnaStatusMapped, "\u03b3"},
+	{0x1D6FF, idnaStatusMapped, "\u03b4"},
+	{0x1D700, idnaStatusMapped, "\u03b5"},
+	{0x1D701, idnaStatusMapped, "\u03b6"},
+	{0x1D702, idnaStatusMapped, "\u03b7"},
+	{0x1D703, idnaStatusMapped, "\u03b8"},
+	{0x1D704, idnaStatusMapped, "\u03b9"},
+	{0x1D705, idnaStatusMapped, "\u03ba"},
+	{0x1D706, idnaStatusMapped, "\u03bb"},
+	{0x1D707, idnaStatusMapped, "\u03bc"},
+	{0x1D708, idnaStatusMapped, "\u03bd"},
+	{0x1D709, idnaStatusMapped, "\u03be"},
+	{0x1D70A, idnaStatusMapped, "\u03bf"},
+	{0x1D70B, idnaStatusMapped, "\u03c0"},
+	{0x1D70C, idnaStatusMapped, "\u03c1"},
+	{0x1D70D, idnaStatusMapped, "\u03c3"},
+	{0x1D70F, idnaStatusMapped, "\u03c4"},
+	{0x1D710, idnaStatusMapped, "\u03c5"},
+	{0x1D711, idnaStatusMapped, "\u03c6"},
+	{0x1D712, idnaStatusMapped, "\u03c7"},
+	{0x1D713, idnaStatusMapped, "\u03c8"},
+	{0x1D714, idnaStatusMapped, "\u03c9"},
+	{0x1D715, idnaStatusMapped, "\u2202"},
+	{0x1D716, idnaStatusMapped, "\u03b5"},
+	{0x1D717, idnaStatusMapped, "\u03b8"},
+	{0x1D718, idnaStatusMapped, "\u03ba"},
+	{0x1D719, idnaStatusMapped, "\u03c6"},
+	{0x1D71A, idnaStatusMapped, "\u03c1"},
+	{0x1D71B, idnaStatusMapped, "\u03c0"},
+	{0x1D71C, idnaStatusMapped, "\u03b1"},
+	{0x1D71D, idnaStatusMapped, "\u03b2"},
+	{0x1D71E, idnaStatusMapped, "\u03b3"},
+	{0x1D71F, idnaStatusMapped, "\u03b4"},
+	{0x1D720, idnaStatusMapped, "\u03b5"},
+	{0x1D721, idnaStatusMapped, "\u03b6"},
+	{0x1D722, idnaStatusMapped, "\u03b7"},
+	{0x1D723, idnaStatusMapped, "\u03b8"},
+	{0x1D724, idnaStatusMapped, "\u03b9"},
+	{0x1D725, idnaStatusMapped, "\u03ba"},
+	{0x1D726, idnaStatusMapped, "\u03bb"},
+	{0x1D727, idnaStatusMapped, "\u03bc"},
+	{0x1D728, idnaStatusMapped, "\u03bd"},
+	{0x1D729, idnaStatusMapped, "\u03be"},
+	{0x1D72A, idnaStatusMapped, "\u03bf"},
+	{0x1D72B, idnaStatusMapped, "\u03c0"},
+	{0x1D72C, idnaStatusMapped, "\u03c1"},
+	{0x1D72D, idnaStatusMapped, "\u03b8"},
+	{0x1D72E, idnaStatusMapped, "\u03c3"},
+	{0x1D72F, idnaStatusMapped, "\u03c4"},
+	{0x1D730, idnaStatusMapped, "\u03c5"},
+	{0x1D731, idnaStatusMapped, "\u03c6"},
+	{0x1D732, idnaStatusMapped, "\u03c7"},
+	{0x1D733, idnaStatusMapped, "\u03c8"},
+	{0x1D734, idnaStatusMapped, "\u03c9"},
+	{0x1D735, idnaStatusMapped, "\u2207"},
+	{0x1D736, idnaStatusMapped, "\u03b1"},
+	{0x1D737, idnaStatusMapped, "\u03b2"},
+	{0x1D738, idnaStatusMapped, "\u03b3"},
+	{0x1D739, idnaStatusMapped, "\u03b4"},
+	{0x1D73A, idnaStatusMapped, "\u03b5"},
+	{0x1D73B, idnaStatusMapped, "\u03b6"},
+	{0x1D73C, idnaStatusMapped, "\u03b7"},
+	{0x1D73D, idnaStatusMapped, "\u03b8"},
+	{0x1D73E, idnaStatusMapped, "\u03b9"},
+	{0x1D73F, idnaStatusMapped, "\u03ba"},
+	{0x1D740, idnaStatusMapped, "\u03bb"},
+	{0x1D741, idnaStatusMapped, "\u03bc"},
+	{0x1D742, idnaStatusMapped, "\u03bd"},
+	{0x1D743, idnaStatusMapped, "\u03be"},
+	{0x1D744, idnaStatusMapped, "\u03bf"},
+	{0x1D745, idnaStatusMapped, "\u03c0"},
+	{0x1D746, idnaStatusMapped, "\u03c1"},
+	{0x1D747, idnaStatusMapped, "\u03c3"},
+	{0x1D749, idnaStatusMapped, "\u03c4"},
+	{0x1D74A, idnaStatusMapped, "\u03c5"},
+	{0x1D74B, idnaStatusMapped, "\u03c6"},
+	{0x1D74C, idnaStatusMapped, "\u03c7"},
+	{0x1D74D, idnaStatusMapped, "\u03c8"},
+	{0x1D74E, idnaStatusMapped, "\u03c9"},
+	{0x1D74F, idnaStatusMapped, "\u2202"},
+	{0x1D750, idnaStatusMapped, "\u03b5"},
+	{0x1D751, idnaStatusMapped, "\u03b8"},
+	{0x1D752, idnaStatusMapped, "\u03ba"},
+	{0x1D753, idnaStatusMapped, "\u03c6"},
+	{0x1D754, idnaStatusMapped, "\u03c1"},
+	{0x1D755, idnaStatusMapped, "\u03c0"},
+	{0x1D756, idnaStatusMapped, "\u03b1"},
+	{0x1D757, idnaStatusMapped, "\u03b2"},
+	{0x1D758, idnaStatusMapped, "\u03b3"},
+	{0x1D759, idnaStatusMapped, "\u03b4"},
+	{0x1D75A, idnaStatusMapped, "\u03b5"},
+	{0x1D75B, idnaStatusMapped, "\u03b6"},
+	{0x1D75C, idnaStatusMapped, "\u03b7"},
+	{0x1D75D, idnaStatusMapped, "\u03b8"},
+	{0x1D75E, idnaStatusMapped, "\u03b9"},
+	{0x1D75F, idnaStatusMapped, "\u03ba"},
+	{0x1D760, idnaStatusMapped, "\u03bb"},
+	{0x1D761, idnaStatusMapped, "\u03bc"},
+	{0x1D762, idnaStatusMapped, "\u03bd"},
+	{0x1D763, idnaStatusMapped, "\u03be"},
+	{0x1D764, idnaStatusMapped, "\u03bf"},
+	{0x1D765, idnaStatusMapped, "\u03c0"},
+	{0x1D766, idnaStatusMapped, "\u03c1"},
+	{0x1D767, idnaStatusMapped, "\u03b8"},
+	{0x1D768, idnaStatusMapped, "\u03c3"},
+	{0x1D769, idnaStatusMapped, "\u03c4"},
+	{0x1D76A, idnaStatusMapped, "\u03c5"},
+	{0x1D76B, idnaStatusMapped, "\u03c6"},
+	{0x1D76C, idnaStatusMapped, "\u03c7"},
+	{0x1D76D, idnaStatusMapped, "\u03c8"},
+	{0x1D76E, idnaStatusMapped, "\u03c9"},
+	{0x1D76F, idnaStatusMapped, "\u2207"},
+	{0x1D770, idnaStatusMapped, "\u03b1"},
+	{0x1D771, idnaStatusMapped, "\u03b2"},
+	{0x1D772, idnaStatusMapped, "\u03b3"},
+	{0x1D773, idnaStatusMapped, "\u03b4"},
+	{0x1D774, idnaStatusMapped, "\u03b5"},
+	{0x1D775, idnaStatusMapped, "\u03b6"},
+	{0x1D776, idnaStatusMapped, "\u03b7"},
+	{0x1D777, idnaStatusMapped, "\u03b8"},
+	{0x1D778, idnaStatusMapped, "\u03b9"},
+	{0x1D779, idnaStatusMapped, "\u03ba"},
+	{0x1D77A, idnaStatusMapped, "\u03bb"},
+	{0x1D77B, idnaStatusMapped, "\u03bc"},
+	{0x1D77C, idnaStatusMapped, "\u03bd"},
+	{0x1D77D, idnaStatusMapped, "\u03be"},
+	{0x1D77E, idnaStatusMapped, "\u03bf"},
+	{0x1D77F, idnaStatusMapped, "\u03c0"},
+	{0x1D780, idnaStatusMapped, "\u03c1"},
+	{0x1D781, idnaStatusMapped, "\u03c3"},
+	{0x1D783, idnaStatusMapped, "\u03c4"},
+	{0x1D784, idnaStatusMapped, "\u03c5"},
+	{0x1D785, idnaStatusMapped, "\u03c6"},
+	{0x1D786, idnaStatusMapped, "\u03c7"},
+	{0x1D787, idnaStatusMapped, "\u03c8"},
+	{0x1D788, idnaStatusMapped, "\u03c9"},
+	{0x1D789, idnaStatusMapped, "\u2202"},
+	{0x1D78A, idnaStatusMapped, "\u03b5"},
+	{0x1D78B, idnaStatusMapped, "\u03b8"},
+	{0x1D78C, idnaStatusMapped, "\u03ba"},
+	{0x1D78D, idnaStatusMapped, "\u03c6"},
+	{0x1D78E, idnaStatusMapped, "\u03c1"},
+	{0x1D78F, idnaStatusMapped, "\u03c0"},
+	{0x1D790, idnaStatusMapped, "\u03b1"},
+	{0x1D791, idnaStatusMapped, "\u03b2"},
+	{0x1D792, idnaStatusMapped, "\u03b3"},
+	{0x1D793, idnaStatusMapped, "\u03b4"},
+	{0x1D794, idnaStatusMapped, "\u03b5"},
+	{0x1D795, idnaStatusMapped, "\u03b6"},
+	{0x1D796, idnaStatusMapped, "\u03b7"},
+	{0x1D797, idnaStatusMapped, "\u03b8"},
+	{0x1D798, idnaStatusMapped, "\u03b9"},
+	{0x1D799, idnaStatusMapped, "\u03ba"},
+	{0x1D79A, idnaStatusMapped, "\u03bb"},
+	{0x1D79B, idnaStatusMapped, "\u03bc"},
+	{0x1D79C, idnaStatusMapped, "\u03bd"},
+	{0x1D79D, idnaStatusMapped, "\u03be"},
+	{0x1D79E, idnaStatusMapped, "\u03bf"},
+	{0x1D79F, idnaStatusMapped, "\u03c0"},
+	{0x1D7A0, idnaStatusMapped, "\u03c1"},
+	{0x1D7A1, idnaStatusMapped, "\u03b8"},
+	{0x1D7A2, idnaStatusMapped, "\u03c3"},
+	{0x1D7A3, idnaStatusMapped, "\u03c4"},
+	{0x1D7A4, idnaStatusMapped, "\u03c5"},
+	{0x1D7A5, idnaStatusMapped, "\u03c6"},
+	{0x1D7A6, idnaStatusMapped, "\u03c7"},
+	{0x1D7A7, idnaStatusMapped, "\u03c8"},
+	{0x1D7A8, idnaStatusMapped, "\u03c9"},
+	{0x1D7A9, idnaStatusMapped, "\u2207"},
+	{0x1D7AA, idnaStatusMapped, "\u03b1"},
+	{0x1D7AB, idnaStatusMapped, "\u03b2"},
+	{0x1D7AC, idnaStatusMapped, "\u03b3"},
+	{0x1D7AD, idnaStatusMapped, "\u03b4"},
+	{0x1D7AE, idnaStatusMapped, "\u03b5"},
+	{0x1D7AF, idnaStatusMapped, "\u03b6"},
+	{0x1D7B0, idnaStatusMapped, "\u03b7"},
+	{0x1D7B1, idnaStatusMapped, "\u03b8"},
+	{0x1D7B2, idnaStatusMapped, "\u03b9"},
+	{0x1D7B3, idnaStatusMapped, "\u03ba"},
+	{0x1D7B4, idnaStatusMapped, "\u03bb"},
+	{0x1D7B5, idnaStatusMapped, "\u03bc"},
+	{0x1D7B6, idnaStatusMapped, "\u03bd"},
+	{0x1D7B7, idnaStatusMapped, "\u03be"},
+	{0x1D7B8, idnaStatusMapped, "\u03bf"},
+	{0x1D7B9, idnaStatusMapped, "\u03c0"},
+	{0x1D7BA, idnaStatusMapped, "\u03c1"},
+	{0x1D7BB, idnaStatusMapped, "\u03c3"},
+	{0x1D7BD, idnaStatusMapped, "\u03c4"},
+	{0x1D7BE, idnaStatusMapped, "\u03c5"},
+	{0x1D7BF, idnaStatusMapped, "\u03c6"},
+	{0x1D7C0, idnaStatusMapped, "\u03c7"},
+	{0x1D7C1, idnaStatusMapped, "\u03c8"},
+	{0x1D7C2, idnaStatusMapped, "\u03c9"},
+	{0x1D7C3, idnaStatusMapped, "\u2202"},
+	{0x1D7C4, idnaStatusMapped, "\u03b5"},
+	{0x1D7C5, idnaStatusMapped, "\u03b8"},
+	{0x1D7C6, idnaStatusMapped, "\u03ba"},
+	{0x1D7C7, idnaStatusMapped, "\u03c6"},
+	{0x1D7C8, idnaStatusMapped, "\u03c1"},
+	{0x1D7C9, idnaStatusMapped, "\u03c0"},
+	{0x1D7CA, idnaStatusMapped, "\u03dd"},
+	{0x1D7CC, idnaStatusDisallowed, ""},
+	{0x1D7CE, idnaStatusMapped, "0"},
+	{0x1D7CF, idnaStatusMapped, "1"},
+	{0x1D7D0, idnaStatusMapped, "2"},
+	{0x1D7D1, idnaStatusMapped, "3"},
+	{0x1D7D2, idnaStatusMapped, "4"},
+	{0x1D7D3, idnaStatusMapped, "5"},
+	{0x1D7D4, idnaStatusMapped, "6"},
+	{0x1D7D5, idnaStatusMapped, "7"},
+	{0x1D7D6, idnaStatusMapped, "8"},
+	{0x1D7D7, idnaStatusMapped, "9"},
+	{0x1D7D8, idnaStatusMapped, "0"},
+	{0x1D7D9, idnaStatusMapped, "1"},
+	{0x1D7DA, idnaStatusMapped, "2"},
+	{0x1D7DB, idnaStatusMapped, "3"},
+	{0x1D7DC, idnaStatusMapped, "4"},
+	{0x1D7DD, idnaStatusMapped, "5"},
+	{0x1D7DE, idnaStatusMapped, "6"},
+	{0x1D7DF, idnaStatusMapped, "7"},
+	{0x1D7E0, idnaStatusMapped, "8"},
+	{0x1D7E1, idnaStatusMapped, "9"},
+	{0x1D7E2, idnaStatusMapped, "0"},
+	{0x1D7E3, idnaStatusMapped, "1"},
+	{0x1D7E4, idnaStatusMapped, "2"},
+	{0x1D7E5, idnaStatusMapped, "3"},
+	{0x1D7E6, idnaStatusMapped, "4"},
+	{0x1D7E7, idnaStatusMapped, "5"},
+	{0x1D7E8, idnaStatusMapped, "6"},
+	{0x1D7E9, idnaStatusMapped, "7"},
+	{0x1D7EA, idnaStatusMapped, "8"},
+	{0x1D7EB, idnaStatusMapped, "9"},
+	{0x1D7EC, idnaStatusMapped, "0"},
+	{0x1D7ED, idnaStatusMapped, "1"},
+	{0x1D7EE, idnaStatusMapped, "2"},
+	{0x1D7EF, idnaStatusMapped, "3"},
+	{0x1D7F0, idnaStatusMapped, "4"},
+	{0x1D7F1, idnaStatusMapped, "5"},
+	{0x1D7F2, idnaStatusMapped, "6"},
+	{0x1D7F3, idnaStatusMapped, "7"},
+	{0x1D7F4, idnaStatusMapped, "8"},
+	{0x1D7F5, idnaStatusMapped, "9"},
+	{0x1D7F6, idnaStatusMapped, "0"},
+	{0x1D7F7, idnaStatusMapped, "1"},
+	{0x1D7F8, idnaStatusMapped, "2"},
+	{0x1D7F9, idnaStatusMapped, "3"},
+	{0x1D7FA, idnaStatusMapped, "4"},
+	{0x1D7FB, idnaStatusMapped, "5"},
+	{0x1D7FC, idnaStatusMapped, "6"},
+	{0x1D7FD, idnaStatusMapped, "7"},
+	{0x1D7FE, idnaStatusMapped, "8"},
+	{0x1D7FF, idnaStatusMapped, "9"},
+	{0x1D800, idnaStatusValid, ""},
+	{0x1DA8C, idnaStatusDisallowed, ""},
+	{0x1DA9B, idnaStatusValid, ""},
+	{0x1DAA0, idnaStatusDisallowed, ""},
+	{0x1DAA1, idnaStatusValid, ""},
+	{0x1DAB0, idnaStatusDisallowed, ""},
+	{0x1DF00, idnaStatusValid, ""},
+	{0x1DF1F, idnaStatusDisallowed, ""},
+	{0x1DF25, idnaStatusValid, ""},
+	{0x1DF2B, idnaStatusDisallowed, ""},
+	{0x1E000, idnaStatusValid, ""},
+	{0x1E007, idnaStatusDisallowed, ""},
+	{0x1E008, idnaStatusValid, ""},
+	{0x1E019, idnaStatusDisallowed, ""},
+	{0x1E01B, idnaStatusValid, ""},
+	{0x1E022, idnaStatusDisallowed, ""},
+	{0x1E023, idnaStatusValid, ""},
+	{0x1E025, idnaStatusDisallowed, ""},
+	{0x1E026, idnaStatusValid, ""},
+	{0x1E02B, idnaStatusDisallowed, ""},
+	{0x1E030, idnaStatusMapped, "\u0430"},
+	{0x1E031, idnaStatusMapped, "\u0431"},
+	{0x1E032, idnaStatusMapped, "\u0432"},
+	{0x1E033, idnaStatusMapped, "\u0433"},
+	{0x1E034, idnaStatusMapped, "\u0434"},
+	{0x1E035, idnaStatusMapped, "\u0435"},
+	{0x1E036, idnaStatusMapped, "\u0436"},
+	{0x1E037, idnaStatusMapped, "\u0437"},
+	{0x1E038, idnaStatusMapped, "\u0438"},
+	{0x1E039, idnaStatusMapped, "\u043a"},
+	{0x1E03A, idnaStatusMapped, "\u043b"},
+	{0x1E03B, idnaStatusMapped, "\u043c"},
+	{0x1E03C, idnaStatusMapped, "\u043e"},
+	{0x1E03D, idnaStatusMapped, "\u043f"},
+	{0x1E03E, idnaStatusMapped, "\u0440"},
+	{0x1E03F, idnaStatusMapped, "\u0441"},
+	{0x1E040, idnaStatusMapped, "\u0442"},
+	{0x1E041, idnaStatusMapped, "\u0443"},
+	{0x1E042, idnaStatusMapped, "\u0444"},
+	{0x1E043, idnaStatusMapped, "\u0445"},
+	{0x1E044, idnaStatusMapped, "\u0446"},
+	{0x1E045, idnaStatusMapped, "\u0447"},
+	{0x1E046, idnaStatusMapped, "\u0448"},
+	{0x1E047, idnaStatusMapped, "\u044b"},
+	{0x1E048, idnaStatusMapped, "\u044d"},
+	{0x1E049, idnaStatusMapped, "\u044e"},
+	{0x1E04A, idnaStatusMapped, "\ua689"},
+	{0x1E04B, idnaStatusMapped, "\u04d9"},
+	{0x1E04C, idnaStatusMapped, "\u0456"},
+	{0x1E04D, idnaStatusMapped, "\u0458"},
+	{0x1E04E, idnaStatusMapped, "\u04e9"},
+	{0x1E04F, idnaStatusMapped, "\u04af"},
+	{0x1E050, idnaStatusMapped, "\u04cf"},
+	{0x1E051, idnaStatusMapped, "\u0430"},
+	{0x1E052, idnaStatusMapped, "\u0431"},
+	{0x1E053, idnaStatusMapped, "\u0432"},
+	{0x1E054, idnaStatusMapped, "\u0433"},
+	{0x1E055, idnaStatusMapped, "\u0434"},
+	{0x1E056, idnaStatusMapped, "\u0435"},
+	{0x1E057, idnaStatusMapped, "\u0436"},
+	{0x1E058, idnaStatusMapped, "\u0437"},
+	{0x1E059, idnaStatusMapped, "\u0438"},
+	{0x1E05A, idnaStatusMapped, "\u043a"},
+	{0x1E05B, idnaStatusMapped, "\u043b"},
+	{0x1E05C, idnaStatusMapped, "\u043e"},
+	{0x1E05D, idnaStatusMapped, "\u043f"},
+	{0x1E05E, idnaStatusMapped, "\u0441"},
+	{0x1E05F, idnaStatusMapped, "\u0443"},
+	{0x1E060, idnaStatusMapped, "\u0444"},
+	{0x1E061, idnaStatusMapped, "\u0445"},
+	{0x1E062, idnaStatusMapped, "\u0446"},
+	{0x1E063, idnaStatusMapped, "\u0447"},
+	{0x1E064, idnaStatusMapped, "\u0448"},
+	{0x1E065, idnaStatusMapped, "\u044a"},
+	{0x1E066, idnaStatusMapped, "\u044b"},
+	{0x1E067, idnaStatusMapped, "\u0491"},
+	{0x1E068, idnaStatusMapped, "\u0456"},
+	{0x1E069, idnaStatusMapped, "\u0455"},
+	{0x1E06A, idnaStatusMapped, "\u045f"},
+	{0x1E06B, idnaStatusMapped, "\u04ab"},
+	{0x1E06C, idnaStatusMapped, "\ua651"},
+	{0x1E06D, idnaStatusMapped, "\u04b1"},
+	{0x1E06E, idnaStatusDisallowed, ""},
+	{0x1E08F, idnaStatusValid, ""},
+	{0x1E090, idnaStatusDisallowed, ""},
+	{0x1E100, idnaStatusValid, ""},
+	{0x1E12D, idnaStatusDisallowed, ""},
+	{0x1E130, idnaStatusValid, ""},
+	{0x1E13E, idnaStatusDisallowed, ""},
+	{0x1E140, idnaStatusValid, ""},
+	{0x1E14A, idnaStatusDisallowed, ""},
+	{0x1E14E, idnaStatusValid, ""},
+	{0x1E150, idnaStatusDisallowed, ""},
+	{0x1E290, idnaStatusValid, ""},
+	{0x1E2AF, idnaStatusDisallowed, ""},
+	{0x1E2C0, idnaStatusValid, ""},
+	{0x1E2FA, idnaStatusDisallowed, ""},
+	{0x1E2FF, idnaStatusValid, ""},
+	{0x1E300, idnaStatusDisallowed, ""},
+	{0x1E4D0, idnaStatusValid, ""},
+	{0x1E4FA, idnaStatusDisallowed, ""},
+	{0x1E5D0, idnaStatusValid, ""},
+	{0x1E5FB, idnaStatusDisallowed, ""},
+	{0x1E5FF, idnaStatusValid, ""},
+	{0x1E600, idnaStatusDisallowed, ""},
+	{0x1E6C0, idnaStatusValid, ""},
+	{0x1E6DF, idnaStatusDisallowed, ""},
+	{0x1E6E0, idnaStatusValid, ""},
+	{0x1E6F6, idnaStatusDisallowed, ""},
+	{0x1E6FE, idnaStatusValid, ""},
+	{0x1E700, idnaStatusDisallowed, ""},
+	{0x1E7E0, idnaStatusValid, ""},
+	{0x1E7E7, idnaStatusDisallowed, ""},
+	{0x1E7E8, idnaStatusValid, ""},
+	{0x1E7EC, idnaStatusDisallowed, ""},
+	{0x1E7ED, idnaStatusValid, ""},
+	{0x1E7EF, idnaStatusDisallowed, ""},
+	{0x1E7F0, idnaStatusValid, ""},
+	{0x1E7FF, idnaStatusDisallowed, ""},
+	{0x1E800, idnaStatusValid, ""},
+	{0x1E8C5, idnaStatusDisallowed, ""},
+	{0x1E8C7, idnaStatusValid, ""},
+	{0x1E8D7, idnaStatusDisallowed, ""},
+	{0x1E900, idnaStatusMapped, "\U0001e922"},
+	{0x1E901, idnaStatusMapped, "\U0001e923"},
+	{0x1E902, idnaStatusMapped, "\U0001e924"},
+	{0x1E903, idnaStatusMapped, "\U0001e925"},
+	{0x1E904, idnaStatusMapped, "\U0001e926"},
+	{0x1E905, idnaStatusMapped, "\U0001e927"},
+	{0x1E906, idnaStatusMapped, "\U0001e928"},
+	{0x1E907, idnaStatusMapped, "\U0001e929"},
+	{0x1E908, idnaStatusMapped, "\U0001e92a"},
+	{0x1E909, idnaStatusMapped, "\U0001e92b"},
+	{0x1E90A, idnaStatusMapped, "\U0001e92c"},
+	{0x1E90B, idnaStatusMapped, "\U0001e92d"},
+	{0x1E90C, idnaStatusMapped, "\U0001e92e"},
+	{0x1E90D, idnaStatusMapped, "\U0001e92f"},
+	{0x1E90E, idnaStatusMapped, "\U0001e930"},
+	{0x1E90F, idnaStatusMapped, "\U0001e931"},
+	{0x1E910, idnaStatusMapped, "\U0001e932"},
+	{0x1E911, idnaStatusMapped, "\U0001e933"},
+	{0x1E912, idnaStatusMapped, "\U0001e934"},
+	{0x1E913, idnaStatusMapped, "\U0001e935"},
+	{0x1E914, idnaStatusMapped, "\U0001e936"},
+	{0x1E915, idnaStatusMapped, "\U0001e937"},
+	{0x1E916, idnaStatusMapped, "\U0001e938"},
+	{0x1E917, idnaStatusMapped, "\U0001e939"},
+	{0x1E918, idnaStatusMapped, "\U0001e93a"},
+	{0x1E919, idnaStatusMapped, "\U0001e93b"},
+	{0x1E91A, idnaStatusMapped, "\U0001e93c"},
+	{0x1E91B, idnaStatusMapped, "\U0001e93d"},
+	{0x1E91C, idnaStatusMapped, "\U0001e93e"},
+	{0x1E91D, idnaStatusMapped, "\U0001e93f"},
+	{0x1E91E, idnaStatusMapped, "\U0001e940"},
+	{0x1E91F, idnaStatusMapped, "\U0001e941"},
+	{0x1E920, idnaStatusMapped, "\U0001e942"},
+	{0x1E921, idnaStatusMapped, "\U0001e943"},
+	{0x1E922, idnaStatusValid, ""},
+	{0x1E94C, idnaStatusDisallowed, ""},
+	{0x1E950, idnaStatusValid, ""},
+	{0x1E95A, idnaStatusDisallowed, ""},
+	{0x1E95E, idnaStatusValid, ""},
+	{0x1E960, idnaStatusDisallowed, ""},
+	{0x1EC71, idnaStatusValid, ""},
+	{0x1ECB5, idnaStatusDisallowed, ""},
+	{0x1ED01, idnaStatusValid, ""},
+	{0x1ED3E, idnaStatusDisallowed, ""},
+	{0x1EE00, idnaStatusMapped, "\u0627"},
+	{0x1EE01, idnaStatusMapped, "\u0628"},
+	{0x1EE02, idnaStatusMapped, "\u062c"},
+	{0x1EE03, idnaStatusMapped, "\u062f"},
+	{0x1EE04, idnaStatusDisallowed, ""},
+	{0x1EE05, idnaStatusMapped, "\u0648"},
+	{0x1EE06, idnaStatusMapped, "\u0632"},
+	{0x1EE07, idnaStatusMapped, "\u062d"},
+	{0x1EE08, idnaStatusMapped, "\u0637"},
+	{0x1EE09, idnaStatusMapped, "\u064a"},
+	{0x1EE0A, idnaStatusMapped, "\u0643"},
+	{0x1EE0B, idnaStatusMapped, "\u0644"},
+	{0x1EE0C, idnaStatusMapped, "\u0645"},
+	{0x1EE0D, idnaStatusMapped, "\u0646"},
+	{0x1EE0E, idnaStatusMapped, "\u0633"},
+	{0x1EE0F, idnaStatusMapped, "\u0639"},
+	{0x1EE10, idnaStatusMapped, "\u0641"},
+	{0x1EE11, idnaStatusMapped, "\u0635"},
+	{0x1EE12, idnaStatusMapped, "\u0642"},
+	{0x1EE13, idnaStatusMapped, "\u0631"},
+	{0x1EE14, idnaStatusMapped, "\u0634"},
+	{0x1EE15, idnaStatusMapped, "\u062a"},
+	{0x1EE16, idnaStatusMapped, "\u062b"},
+	{0x1EE17, idnaStatusMapped, "\u062e"},
+	{0x1EE18, idnaStatusMapped, "\u0630"},
+	{0x1EE19, idnaStatusMapped, "\u0636"},
+	{0x1EE1A, idnaStatusMapped, "\u0638"},
+	{0x1EE1B, idnaStatusMapped, "\u063a"},
+	{0x1EE1C, idnaStatusMapped, "\u066e"},
+	{0x1EE1D, idnaStatusMapped, "\u06ba"},
+	{0x1EE1E, idnaStatusMapped, "\u06a1"},
+	{0x1EE1F, idnaStatusMapped, "\u066f"},
+	{0x1EE20, idnaStatusDisallowed, ""},
+	{0x1EE21, idnaStatusMapped, "\u0628"},
+	{0x1EE22, idnaStatusMapped, "\u062c"},
+	{0x1EE23, idnaStatusDisallowed, ""},
+	{0x1EE24, idnaStatusMapped, "\u0647"},
+	{0x1EE25, idnaStatusDisallowed, ""},
+	{0x1EE27, idnaStatusMapped, "\u062d"},
+	{0x1EE28, idnaStatusDisallowed, ""},
+	{0x1EE29, idnaStatusMapped, "\u064a"},
+	{0x1EE2A, idnaStatusMapped, "\u0643"},
+	{0x1EE2B, idnaStatusMapped, "\u0644"},
+	{0x1EE2C, idnaStatusMapped, "\u0645"},
+	{0x1EE2D, idnaStatusMapped, "\u0646"},
+	{0x1EE2E, idnaStatusMapped, "\u0633"},
+	{0x1EE2F, idnaStatusMapped, "\u0639"},
+	{0x1EE30, idnaStatusMapped, "\u0641"},
+	{0x1EE31, idnaStatusMapped, "\u0635"},
+	{0x1EE32, idnaStatusMapped, "\u0642"},
+	{0x1EE33, idnaStatusDisallowed, ""},
+	{0x1EE34, idnaStatusMapped, "\u0634"},
+	{0x1EE35, idnaStatusMapped, "\u062a"},
+	{0x1EE36, idnaStatusMapped, "\u062b"},
+	{0x1EE37, idnaStatusMapped, "\u062e"},
+	{0x1EE38, idnaStatusDisallowed, ""},
+	{0x1EE39, idnaStatusMapped, "\u0636"},
+	{0x1EE3A, idnaStatusDisallowed, ""},
+	{0x1EE3B, idnaStatusMapped, "\u063a"},
+	{0x1EE3C, idnaStatusDisallowed, ""},
+	{0x1EE42, idnaStatusMapped, "\u062c"},
+	{0x1EE43, idnaStatusDisallowed, ""},
+	{0x1EE47, idnaStatusMapped, "\u062d"},
+	{0x1EE48, idnaStatusDisallowed, ""},
+	{0x1EE49, idnaStatusMapped, "\u064a"},
+	{0x1EE4A, idnaStatusDisallowed, ""},
+	{0x1EE4B, idnaStatusMapped, "\u0644"},
+	{0x1EE4C, idnaStatusDisallowed, ""},
+	{0x1EE4D, idnaStatusMapped, "\u0646"},
+	{0x1EE4E, idnaStatusMapped, "\u0633"},
+	{0x1EE4F, idnaStatusMapped, "\u0639"},
+	{0x1EE50, idnaStatusDisallowed, ""},
+	{0x1EE51, idnaStatusMapped, "\u0635"},
+	{0x1EE52, idnaStatusMapped, "\u0642"},
+	{0x1EE53, idnaStatusDisallowed, ""},
+	{0x1EE54, idnaStatusMapped, "\u0634"},
+	{0x1EE55, idnaStatusDisallowed, ""},
+	{0x1EE57, idnaStatusMapped, "\u062e"},
+	{0x1EE58, idnaStatusDisallowed, ""},
+	{0x1EE59, idnaStatusMapped, "\u0636"},
+	{0x1EE5A, idnaStatusDisallowed, ""},
+	{0x1EE5B, idnaStatusMapped, "\u063a"},
+	{0x1EE5C, idnaStatusDisallowed, ""},
+	{0x1EE5D, idnaStatusMapped, "\u06ba"},
+	{0x1EE5E, idnaStatusDisallowed, ""},
+	{0x1EE5F, idnaStatusMapped, "\u066f"},
+	{0x1EE60, idnaStatusDisallowed, ""},
+	{0x1EE61, idnaStatusMapped, "\u0628"},
+	{0x1EE62, idnaStatusMapped, "\u062c"},
+	{0x1EE63, idnaStatusDisallowed, ""},
+	{0x1EE64, idnaStatusMapped, "\u0647"},
+	{0x1EE65, idnaStatusDisallowed, ""},
+	{0x1EE67, idnaStatusMapped, "\u062d"},
+	{0x1EE68, idnaStatusMapped, "\u0637"},
+	{0x1EE69, idnaStatusMapped, "\u064a"},
+	{0x1EE6A, idnaStatusMapped, "\u0643"},
+	{0x1EE6B, idnaStatusDisallowed, ""},
+	{0x1EE6C, idnaStatusMapped, "\u0645"},
+	{0x1EE6D, idnaStatusMapped, "\u0646"},
+	{0x1EE6E, idnaStatusMapped, "\u0633"},
+	{0x1EE6F, idnaStatusMapped, "\u0639"},
+	{0x1EE70, idnaStatusMapped, "\u0641"},
+	{0x1EE71, idnaStatusMapped, "\u0635"},
+	{0x1EE72, idnaStatusMapped, "\u0642"},
+	{0x1EE73, idnaStatusDisallowed, ""},
+	{0x1EE74, idnaStatusMapped, "\u0634"},
+	{0x1EE75, idnaStatusMapped, "\u062a"},
+	{0x1EE76, idnaStatusMapped, "\u062b"},
+	{0x1EE77, idnaStatusMapped, "\u062e"},
+	{0x1EE78, idnaStatusDisallowed, ""},
+	{0x1EE79, idnaStatusMapped, "\u0636"},
+	{0x1EE7A, idnaStatusMapped, "\u0638"},
+	{0x1EE7B, idnaStatusMapped, "\u063a"},
+	{0x1EE7C, idnaStatusMapped, "\u066e"},
+	{0x1EE7D, idnaStatusDisallowed, ""},
+	{0x1EE7E, idnaStatusMapped, "\u06a1"},
+	{0x1EE7F, idnaStatusDisallowed, ""},
+	{0x1EE80, idnaStatusMapped, "\u0627"},
+	{0x1EE81, idnaStatusMapped, "\u0628"},
+	{0x1EE82, idnaStatusMapped, "\u062c"},
+	{0x1EE83, idnaStatusMapped, "\u062f"},
+	{0x1EE84, idnaStatusMapped, "\u0647"},
+	{0x1EE85, idnaStatusMapped, "\u0648"},
+	{0x1EE86, idnaStatusMapped, "\u0632"},
+	{0x1EE87, idnaStatusMapped, "\u062d"},
+	{0x1EE88, idnaStatusMapped, "\u0637"},
+	{0x1EE89, idnaStatusMapped, "\u064a"},
+	{0x1EE8A, idnaStatusDisallowed, ""},
+	{0x1EE8B, idnaStatusMapped, "\u0644"},
+	{0x1EE8C, idnaStatusMapped, "\u0645"},
+	{0x1EE8D, idnaStatusMapped, "\u0646"},
+	{0x1EE8E, idnaStatusMapped, "\u0633"},
+	{0x1EE8F, idnaStatusMapped, "\u0639"},
+	{0x1EE90, idnaStatusMapped, "\u0641"},
+	{0x1EE91, idnaStatusMapped, "\u0635"},
+	{0x1EE92, idnaStatusMapped, "\u0642"},
+	{0x1EE93, idnaStatusMapped, "\u0631"},
+	{0x1EE94, idnaStatusMapped, "\u0634"},
+	{0x1EE95, idnaStatusMapped, "\u062a"},
+	{0x1EE96, idnaStatusMapped, "\u062b"},
+	{0x1EE97, idnaStatusMapped, "\u062e"},
+	{0x1EE98, idnaStatusMapped, "\u0630"},
+	{0x1EE99, idnaStatusMapped, "\u0636"},
+	{0x1EE9A, idnaStatusMapped, "\u0638"},
+	{0x1EE9B, idnaStatusMapped, "\u063a"},
+	{0x1EE9C, idnaStatusDisallowed, ""},
+	{0x1EEA1, idnaStatusMapped, "\u0628"},
+	{0x1EEA2, idnaStatusMapped, "\u062c"},
+	{0x1EEA3, idnaStatusMapped, "\u062f"},
+	{0x1EEA4, idnaStatusDisallowed, ""},
+	{0x1EEA5, idnaStatusMapped, "\u0648"},
+	{0x1EEA6, idnaStatusMapped, "\u0632"},
+	{0x1EEA7, idnaStatusMapped, "\u062d"},
+	{0x1EEA8, idnaStatusMapped, "\u0637"},
+	{0x1EEA9, idnaStatusMapped, "\u064a"},
+	{0x1EEAA, idnaStatusDisallowed, ""},
+	{0x1EEAB, idnaStatusMapped, "\u0644"},
+	{0x1EEAC, idnaStatusMapped, "\u0645"},
+	{0x1EEAD, idnaStatusMapped, "\u0646"},
+	{0x1EEAE, idnaStatusMapped, "\u0633"},
+	{0x1EEAF, idnaStatusMapped, "\u0639"},
+	{0x1EEB0, idnaStatusMapped, "\u0641"},
+	{0x1EEB1, idnaStatusMapped, "\u0635"},
+	{0x1EEB2, idnaStatusMapped, "\u0642"},
+	{0x1EEB3, idnaStatusMapped, "\u0631"},
+	{0x1EEB4, idnaStatusMapped, "\u0634"},
+	{0x1EEB5, idnaStatusMapped, "\u062a"},
+	{0x1EEB6, idnaStatusMapped, "\u062b"},
+	{0x1EEB7, idnaStatusMapped, "\u062e"},
+	{0x1EEB8, idnaStatusMapped, "\u0630"},
+	{0x1EEB9, idnaStatusMapped, "\u0636"},
+	{0x1EEBA, idnaStatusMapped, "\u0638"},
+	{0x1EEBB, idnaStatusMapped, "\u063a"},
+	{0x1EEBC, idnaStatusDisallowed, ""},
+	{0x1EEF0, idnaStatusValid, ""},
+	{0x1EEF2, idnaStatusDisallowed, ""},
+	{0x1F000, idnaStatusValid, ""},
+	{0x1F02C, idnaStatusDisallowed, ""},
+	{0x1F030, idnaStatusValid, ""},
+	{0x1F094, idnaStatusDisallowed, ""},
+	{0x1F0A0, idnaStatusValid, ""},
+	{0x1F0AF, idnaStatusDisallowed, ""},
+	{0x1F0B1, idnaStatusValid, ""},
+	{0x1F0C0, idnaStatusDisallowed, ""},
+	{0x1F0C1, idnaStatusValid, ""},
+	{0x1F0D0, idnaStatusDisallowed, ""},
+	{0x1F0D1, idnaStatusValid, ""},
+	{0x1F0F6, idnaStatusDisallowed, ""},
+	{0x1F101, idnaStatusMapped, "0,"},
+	{0x1F102, idnaStatusMapped, "1,"},
+	{0x1F103, idnaStatusMapped, "2,"},
+	{0x1F104, idnaStatusMapped, "3,"},
+	{0x1F105, idnaStatusMapped, "4,"},
+	{0x1F106, idnaStatusMapped, "5,"},
+	{0x1F107, idnaStatusMapped, "6,"},
+	{0x1F108, idnaStatusMapped, "7,"},
+	{0x1F109, idnaStatusMapped, "8,"},
+	{0x1F10A, idnaStatusMapped, "9,"},
+	{0x1F10B, idnaStatusValid, ""},
+	{0x1F110, idnaStatusMapped, "(a)"},
+	{0x1F111, idnaStatusMapped, "(b)"},
+	{0x1F112, idnaStatusMapped, "(c)"},
+	{0x1F113, idnaStatusMapped, "(d)"},
+	{0x1F114, idnaStatusMapped, "(e)"},
+	{0x1F115, idnaStatusMapped, "(f)"},
+	{0x1F116, idnaStatusMapped, "(g)"},
+	{0x1F117, idnaStatusMapped, "(h)"},
+	{0x1F118, idnaStatusMapped, "(i)"},
+	{0x1F119, idnaStatusMapped, "(j)"},
+	{0x1F11A, idnaStatusMapped, "(k)"},
+	{0x1F11B, idnaStatusMapped, "(l)"},
+	{0x1F11C, idnaStatusMapped, "(m)"},
+	{0x1F11D, idnaStatusMapped, "(n)"},
+	{0x1F11E, idnaStatusMapped, "(o)"},
+	{0x1F11F, idnaStatusMapped, "(p)"},
+	{0x1F120, idnaStatusMapped, "(q)"},
+	{0x1F121, idnaStatusMapped, "(r)"},
+	{0x1F122, idnaStatusMapped, "(s)"},
+	{0x1F123, idnaStatusMapped, "(t)"},
+	{0x1F124, idnaStatusMapped, "(u)"},
+	{0x1F125, idnaStatusMapped, "(v)"},
+	{0x1F126, idnaStatusMapped, "(w)"},
+	{0x1F127, idnaStatusMapped, "(x)"},
+	{0x1F128, idnaStatusMapped, "(y)"},
+	{0x1F129, idnaStatusMapped, "(z)"},
+	{0x1F12A, idnaStatusMapped, "\u3014s\u3015"},
+	{0x1F12B, idnaStatusMapped, "c"},
+	{0x1F12C, idnaStatusMapped, "r"},
+	{0x1F12D, idnaStatusMapped, "cd"},
+	{0x1F12E, idnaStatusMapped, "wz"},
+	{0x1F12F, idnaStatusValid, ""},
+	{0x1F130, idnaStatusMapped, "a"},
+	{0x1F131, idnaStatusMapped, "b"},
+	{0x1F132, idnaStatusMapped, "c"},
+	{0x1F133, idnaStatusMapped, "d"},
+	{0x1F134, idnaStatusMapped, "e"},
+	{0x1F135, idnaStatusMapped, "f"},
+	{0x1F136, idnaStatusMapped, "g"},
+	{0x1F137, idnaStatusMapped, "h"},
+	{0x1F138, idnaStatusMapped, "i"},
+	{0x1F139, idnaStatusMapped, "j"},
+	{0x1F13A, idnaStatusMapped, "k"},
+	{0x1F13B, idnaStatusMapped, "l"},
+	{0x1F13C, idnaStatusMapped, "m"},
+	{0x1F13D, idnaStatusMapped, "n"},
+	{0x1F13E, idnaStatusMapped, "o"},
+	{0x1F13F, idnaStatusMapped, "p"},
+	{0x1F140, idnaStatusMapped, "q"},
+	{0x1F141, idnaStatusMapped, "r"},
+	{0x1F142, idnaStatusMapped, "s"},
+	{0x1F143, idnaStatusMapped, "t"},
+	{0x1F144, idnaStatusMapped, "u"},
+	{0x1F145, idnaStatusMapped, "v"},
+	{0x1F146, idnaStatusMapped, "w"},
+	{0x1F147, idnaStatusMapped, "x"},
+	{0x1F148, idnaStatusMapped, "y"},
+	{0x1F149, idnaStatusMapped, "z"},
+	{0x1F14A, idnaStatusMapped, "hv"},
+	{0x1F14B, idnaStatusMapped, "mv"},
+	{0x1F14C, idnaStatusMapped, "sd"},
+	{0x1F14D, idnaStatusMapped, "ss"},
+	{0x1F14E, idnaStatusMapped, "ppv"},
+	{0x1F14F, idnaStatusMapped, "wc"},
+	{0x1F150, idnaStatusValid, ""},
+	{0x1F16A, idnaStatusMapped, "mc"},
+	{0x1F16B, idnaStatusMapped, "md"},
+	{0x1F16C, idnaStatusMapped, "mr"},
+	{0x1F16D, idnaStatusValid, ""},
+	{0x1F190, idnaStatusMapped, "dj"},
+	{0x1F191, idnaStatusValid, ""},
+	{0x1F1AE, idnaStatusDisallowed, ""},
+	{0x1F1E6, idnaStatusValid, ""},
+	{0x1F200, idnaStatusMapped, "\u307b\u304b"},
+	{0x1F201, idnaStatusMapped, "\u30b3\u30b3"},
+	{0x1F202, idnaStatusMapped, "\u30b5"},
+	{0x1F203, idnaStatusDisallowed, ""},
+	{0x1F210, idnaStatusMapped, "\u624b"},
+	{0x1F211, idnaStatusMapped, "\u5b57"},
+	{0x1F212, idnaStatusMapped, "\u53cc"},
+	{0x1F213, idnaStatusMapped, "\u30c7"},
+	{0x1F214, idnaStatusMapped, "\u4e8c"},
+	{0x1F215, idnaStatusMapped, "\u591a"},
+	{0x1F216, idnaStatusMapped, "\u89e3"},
+	{0x1F217, idnaStatusMapped, "\u5929"},
+	{0x1F218, idnaStatusMapped, "\u4ea4"},
+	{0x1F219, idnaStatusMapped, "\u6620"},
+	{0x1F21A, idnaStatusMapped, "\u7121"},
+	{0x1F21B, idnaStatusMapped, "\u6599"},
+	{0x1F21C, idnaStatusMapped, "\u524d"},
+	{0x1F21D, idnaStatusMapped, "\u5f8c"},
+	{0x1F21E, idnaStatusMapped, "\u518d"},
+	{0x1F21F, idnaStatusMapped, "\u65b0"},
+	{0x1F220, idnaStatusMapped, "\u521d"},
+	{0x1F221, idnaStatusMapped, "\u7d42"},
+	{0x1F222, idnaStatusMapped, "\u751f"},
+	{0x1F223, idnaStatusMapped, "\u8ca9"},
+	{0x1F224, idnaStatusMapped, "\u58f0"},
+	{0x1F225, idnaStatusMapped, "\u5439"},
+	{0x1F226, idnaStatusMapped, "\u6f14"},
+	{0x1F227, idnaStatusMapped, "\u6295"},
+	{0x1F228, idnaStatusMapped, "\u6355"},
+	{0x1F229, idnaStatusMapped, "\u4e00"},
+	{0x1F22A, idnaStatusMapped, "\u4e09"},
+	{0x1F22B, idnaStatusMapped, "\u904a"},
+	{0x1F22C, idnaStatusMapped, "\u5de6"},
+	{0x1F22D, idnaStatusMapped, "\u4e2d"},
+	{0x1F22E, idnaStatusMapped, "\u53f3"},
+	{0x1F22F, idnaStatusMapped, "\u6307"},
+	{0x1F230, idnaStatusMapped, "\u8d70"},
+	{0x1F231, idnaStatusMapped, "\u6253"},
+	{0x1F232, idnaStatusMapped, "\u7981"},
+	{0x1F233, idnaStatusMapped, "\u7a7a"},
+	{0x1F234, idnaStatusMapped, "\u5408"},
+	{0x1F235, idnaStatusMapped, "\u6e80"},
+	{0x1F236, idnaStatusMapped, "\u6709"},
+	{0x1F237, idnaStatusMapped, "\u6708"},
+	{0x1F238, idnaStatusMapped, "\u7533"},
+	{0x1F239, idnaStatusMapped, "\u5272"},
+	{0x1F23A, idnaStatusMapped, "\u55b6"},
+	{0x1F23B, idnaStatusMapped, "\u914d"},
+	{0x1F23C, idnaStatusDisallowed, ""},
+	{0x1F240, idnaStatusMapped, "\u3014\u672c\u3015"},
+	{0x1F241, idnaStatusMapped, "\u3014\u4e09\u3015"},
+	{0x1F242, idnaStatusMapped, "\u3014\u4e8c\u3015"},
+	{0x1F243, idnaStatusMapped, "\u3014\u5b89\u3015"},
+	{0x1F244, idnaStatusMapped, "\u3014\u70b9\u3015"},
+	{0x1F245, idnaStatusMapped, "\u3014\u6253\u3015"},
+	{0x1F246, idnaStatusMapped, "\u3014\u76d7\u3015"},
+	{0x1F247, idnaStatusMapped, "\u3014\u52dd\u3015"},
+	{0x1F248, idnaStatusMapped, "\u3014\u6557\u3015"},
+	{0x1F249, idnaStatusDisallowed, ""},
+	{0x1F250, idnaStatusMapped, "\u5f97"},
+	{0x1F251, idnaStatusMapped, "\u53ef"},
+	{0x1F252, idnaStatusDisallowed, ""},
+	{0x1F260, idnaStatusValid, ""},
+	{0x1F266, idnaStatusDisallowed, ""},
+	{0x1F300, idnaStatusValid, ""},
+	{0x1F6D9, idnaStatusDisallowed, ""},
+	{0x1F6DC, idnaStatusValid, ""},
+	{0x1F6ED, idnaStatusDisallowed, ""},
+	{0x1F6F0, idnaStatusValid, ""},
+	{0x1F6FD, idnaStatusDisallowed, ""},
+	{0x1F700, idnaStatusValid, ""},
+	{0x1F7DA, idnaStatusDisallowed, ""},
+	{0x1F7E0, idnaStatusValid, ""},
+	{0x1F7EC, idnaStatusDisallowed, ""},
+	{0x1F7F0, idnaStatusValid, ""},
+	{0x1F7F1, idnaStatusDisallowed, ""},
+	{0x1F800, idnaStatusValid, ""},
+	{0x1F80C, idnaStatusDisallowed, ""},
+	{0x1F810, idnaStatusValid, ""},
+	{0x1F848, idnaStatusDisallowed, ""},
+	{0x1F850, idnaStatusValid, ""},
+	{0x1F85A, idnaStatusDisallowed, ""},
+	{0x1F860, idnaStatusValid, ""},
+	{0x1F888, idnaStatusDisallowed, ""},
+	{0x1F890, idnaStatusValid, ""},
+	{0x1F8AE, idnaStatusDisallowed, ""},
+	{0x1F8B0, idnaStatusValid, ""},
+	{0x1F8BC, idnaStatusDisallowed, ""},
+	{0x1F8C0, idnaStatusValid, ""},
+	{0x1F8C2, idnaStatusDisallowed, ""},
+	{0x1F8D0, idnaStatusValid, ""},
+	{0x1F8D9, idnaStatusDisallowed, ""},
+	{0x1F900, idnaStatusValid, ""},
+	{0x1FA58, idnaStatusDisallowed, ""},
+	{0x1FA60, idnaStatusValid, ""},
+	{0x1FA6E, idnaStatusDisallowed, ""},
+	{0x1FA70, idnaStatusValid, ""},
+	{0x1FA7D, idnaStatusDisallowed, ""},
+	{0x1FA80, idnaStatusValid, ""},
+	{0x1FA8B, idnaStatusDisallowed, ""},
+	{0x1FA8E, idnaStatusValid, ""},
+	{0x1FAC7, idnaStatusDisallowed, ""},
+	{0x1FAC8, idnaStatusValid, ""},
+	{0x1FAC9, idnaStatusDisallowed, ""},
+	{0x1FACD, idnaStatusValid, ""},
+	{0x1FADD, idnaStatusDisallowed, ""},
+	{0x1FADF, idnaStatusValid, ""},
+	{0x1FAEB, idnaStatusDisallowed, ""},
+	{0x1FAEF, idnaStatusValid, ""},
+	{0x1FAF9, idnaStatusDisallowed, ""},
+	{0x1FB00, idnaStatusValid, ""},
+	{0x1FB93, idnaStatusDisallowed, ""},
+	{0x1FB94, idnaStatusValid, ""},
+	{0x1FBF0, idnaStatusMapped, "0"},
+	{0x1FBF1, idnaStatusMapped, "1"},
+	{0x1FBF2, idnaStatusMapped, "2"},
+	{0x1FBF3, idnaStatusMapped, "3"},
+	{0x1FBF4, idnaStatusMapped, "4"},
+	{0x1FBF5, idnaStatusMapped, "5"},
+	{0x1FBF6, idnaStatusMapped, "6"},
+	{0x1FBF7, idnaStatusMapped, "7"},
+	{0x1FBF8, idnaStatusMapped, "8"},
+	{0x1FBF9, idnaStatusMapped, "9"},
+	{0x1FBFA, idnaStatusValid, ""},
+	{0x1FBFB, idnaStatusDisallowed, ""},
+	{0x20000, idnaStatusValid, ""},
+	{0x2A6E0, idnaStatusDisallowed, ""},
+	{0x2A700, idnaStatusValid, ""},
+	{0x2B81E, idnaStatusDisallowed, ""},
+	{0x2B820, idnaStatusValid, ""},
+	{0x2CEAE, idnaStatusDisallowed, ""},
+	{0x2CEB0, idnaStatusValid, ""},
+	{0x2EBE1, idnaStatusDisallowed, ""},
+	{0x2EBF0, idnaStatusValid, ""},
+	{0x2EE5E, idnaStatusDisallowed, ""},
+	{0x2F800, idnaStatusMapped, "\u4e3d"},
+	{0x2F801, idnaStatusMapped, "\u4e38"},
+	{0x2F802, idnaStatusMapped, "\u4e41"},
+	{0x2F803, idnaStatusMapped, "\U00020122"},
+	{0x2F804, idnaStatusMapped, "\u4f60"},
+	{0x2F805, idnaStatusMapped, "\u4fae"},
+	{0x2F806, idnaStatusMapped, "\u4fbb"},
+	{0x2F807, idnaStatusMapped, "\u5002"},
+	{0x2F808, idnaStatusMapped, "\u507a"},
+	{0x2F809, idnaStatusMapped, "\u5099"},
+	{0x2F80A, idnaStatusMapped, "\u50e7"},
+	{0x2F80B, idnaStatusMapped, "\u50cf"},
+	{0x2F80C, idnaStatusMapped, "\u349e"},
+	{0x2F80D, idnaStatusMapped, "\U0002063a"},
+	{0x2F80E, idnaStatusMapped, "\u514d"},
+	{0x2F80F, idnaStatusMapped, "\u5154"},
+	{0x2F810, idnaStatusMapped, "\u5164"},
+	{0x2F811, idnaStatusMapped, "\u5177"},
+	{0x2F812, idnaStatusMapped, "\U0002051c"},
+	{0x2F813, idnaStatusMapped, "\u34b9"},
+	{0x2F814, idnaStatusMapped, "\u5167"},
+	{0x2F815, idnaStatusMapped, "\u518d"},
+	{0x2F816, idnaStatusMapped, "\U0002054b"},
+	{0x2F817, idnaStatusMapped, "\u5197"},
+	{0x2F818, idnaStatusMapped, "\u51a4"},
+	{0x2F819, idnaStatusMapped, "\u4ecc"},
+	{0x2F81A, idnaStatusMapped, "\u51ac"},
+	{0x2F81B, idnaStatusMapped, "\u51b5"},
+	{0x2F81C, idnaStatusMapped, "\U000291df"},
+	{0x2F81D, idnaStatusMapped, "\u51f5"},
+	{0x2F81E, idnaStatusMapped, "\u5203"},
+	{0x2F81F, idnaStatusMapped, "\u34df"},
+	{0x2F820, idnaStatusMapped, "\u523b"},
+	{0x2F821, idnaStatusMapped, "\u5246"},
+	{0x2F822, idnaStatusMapped, "\u5272"},
+	{0x2F823, idnaStatusMapped, "\u5277"},
+	{0x2F824, idnaStatusMapped, "\u3515"},
+	{0x2F825, idnaStatusMapped, "\u52c7"},
+	{0x2F826, idnaStatusMapped, "\u52c9"},
+	{0x2F827, idnaStatusMapped, "\u52e4"},
+	{0x2F828, idnaStatusMapped, "\u52fa"},
+	{0x2F829, idnaStatusMapped, "\u5305"},
+	{0x2F82A, idnaStatusMapped, "\u5306"},
+	{0x2F82B, idnaStatusMapped, "\u5317"},
+	{0x2F82C, idnaStatusMapped, "\u5349"},
+	{0x2F82D, idnaStatusMapped, "\u5351"},
+	{0x2F82E, idnaStatusMapped, "\u535a"},
+	{0x2F82F, idnaStatusMapped, "\u5373"},
+	{0x2F830, idnaStatusMapped, "\u537d"},
+	{0x2F831, idnaStatusMapped, "\u537f"},
+	{0x2F834, idnaStatusMapped, "\U00020a2c"},
+	{0x2F835, idnaStatusMapped, "\u7070"},
+	{0x2F836, idnaStatusMapped, "\u53ca"},
+	{0x2F837, idnaStatusMapped, "\u53df"},
+	{0x2F838, idnaStatusMapped, "\U00020b63"},
+	{0x2F839, idnaStatusMapped, "\u53eb"},
+	{0x2F83A, idnaStatusMapped, "\u53f1"},
+	{0x2F83B, idnaStatusMapped, "\u5406"},
+	{0x2F83C, idnaStatusMapped, "\u549e"},
+	{0x2F83D, idnaStatusMapped, "\u5438"},
+	{0x2F83E, idnaStatusMapped, "\u5448"},
+	{0x2F83F, idnaStatusMapped, "\u5468"},
+	{0x2F840, idnaStatusMapped, "\u54a2"},
+	{0x2F841, idnaStatusMapped, "\u54f6"},
+	{0x2F842, idnaStatusMapped, "\u5510"},
+	{0x2F843, idnaStatusMapped, "\u5553"},
+	{0x2F844, idnaStatusMapped, "\u5563"},
+	{0x2F845, idnaStatusMapped, "\u5584"},
+	{0x2F847, idnaStatusMapped, "\u5599"},
+	{0x2F848, idnaStatusMapped, "\u55ab"},
+	{0x2F849, idnaStatusMapped, "\u55b3"},
+	{0x2F84A, idnaStatusMapped, "\u55c2"},
+	{0x2F84B, idnaStatusMapped, "\u5716"},
+	{0x2F84C, idnaStatusMapped, "\u5606"},
+	{0x2F84D, idnaStatusMapped, "\u5717"},
+	{0x2F84E, idnaStatusMapped, "\u5651"},
+	{0x2F84F, idnaStatusMapped, "\u5674"},
+	{0x2F850, idnaStatusMapped, "\u5207"},
+	{0x2F851, idnaStatusMapped, "\u58ee"},
+	{0x2F852, idnaStatusMapped, "\u57ce"},
+	{0x2F853, idnaStatusMapped, "\u57f4"},
+	{0x2F854, idnaStatusMapped, "\u580d"},
+	{0x2F855, idnaStatusMapped, "\u578b"},
+	{0x2F856, idnaStatusMapped, "\u5832"},
+	{0x2F857, idnaStatusMapped, "\u5831"},
+	{0x2F858, idnaStatusMapped, "\u58ac"},
+	{0x2F859, idnaStatusMapped, "\U000214e4"},
+	{0x2F85A, idnaStatusMapped, "\u58f2"},
+	{0x2F85B, idnaStatusMapped, "\u58f7"},
+	{0x2F85C, idnaStatusMapped, "\u5906"},
+	{0x2F85D, idnaStatusMapped, "\u591a"},
+	{0x2F85E, idnaStatusMapped, "\u5922"},
+	{0x2F85F, idnaStatusMapped, "\u5962"},
+	{0x2F860, idnaStatusMapped, "\U000216a8"},
+	{0x2F861, idnaStatusMapped, "\U000216ea"},
+	{0x2F862, idnaStatusMapped, "\u59ec"},
+	{0x2F863, idnaStatusMapped, "\u5a1b"},
+	{0x2F864, idnaStatusMapped, "\u5a27"},
+	{0x2F865, idnaStatusMapped, "\u59d8"},
+	{0x2F866, idnaStatusMapped, "\u5a66"},
+	{0x2F867, idnaStatusMapped, "\u36ee"},
+	{0x2F868, idnaStatusMapped, "\u36fc"},
+	{0x2F869, idnaStatusMapped, "\u5b08"},
+	{0x2F86A, idnaStatusMapped, "\u5b3e"},
+	{0x2F86C, idnaStatusMapped, "\U000219c8"},
+	{0x2F86D, idnaStatusMapped, "\u5bc3"},
+	{0x2F86E, idnaStatusMapped, "\u5bd8"},
+	{0x2F86F, idnaStatusMapped, "\u5be7"},
+	{0x2F870, idnaStatusMapped, "\u5bf3"},
+	{0x2F871, idnaStatusMapped, "\U00021b18"},
+	{0x2F872, idnaStatusMapped, "\u5bff"},
+	{0x2F873, idnaStatusMapped, "\u5c06"},
+	{0x2F874, idnaStatusMapped, "\u5f53"},
+	{0x2F875, idnaStatusMapped, "\u5c22"},
+	{0x2F876, idnaStatusMapped, "\u3781"},
+	{0x2F877, idnaStatusMapped, "\u5c60"},
+	{0x2F878, idnaStatusMapped, "\u5c6e"},
+	{0x2F879, idnaStatusMapped, "\u5cc0"},
+	{0x2F87A, idnaStatusMapped, "\u5c8d"},
+	{0x2F87B, idnaStatusMapped, "\U00021de4"},
+	{0x2F87C, idnaStatusMapped, "\u5d43"},
+	{0x2F87D, idnaStatusMapped, "\U00021de6"},
+	{0x2F87E, idnaStatusMapped, "\u5d6e"},
+	{0x2F87F, idnaStatusMapped, "\u5d6b"},
+	{0x2F880, idnaStatusMapped, "\u5d7c"},
+	{0x2F881, idnaStatusMapped, "\u5de1"},
+	{0x2F882, idnaStatusMapped, "\u5de2"},
+	{0x2F883, idnaStatusMapped, "\u382f"},
+	{0x2F884, idnaStatusMapped, "\u5dfd"},
+	{0x2F885, idnaStatusMapped, "\u5e28"},
+	{0x2F886, idnaStatusMapped, "\u5e3d"},
+	{0x2F887, idnaStatusMapped, "\u5e69"},
+	{0x2F888, idnaStatusMapped, "\u3862"},
+	{0x2F889, idnaStatusMapped, "\U00022183"},
+	{0x2F88A, idnaStatusMapped, "\u387c"},
+	{0x2F88B, idnaStatusMapped, "\u5eb0"},
+	{0x2F88C, idnaStatusMapped, "\u5eb3"},
+	{0x2F88D, idnaStatusMapped, "\u5eb6"},
+	{0x2F88E, idnaStatusMapped, "\u5eca"},
+	{0x2F88F, idnaStatusMapped, "\U0002a392"},
+	{0x2F890, idnaStatusMapped, "\u5efe"},
+	{0x2F891, idnaStatusMapped, "\U00022331"},
+	{0x2F893, idnaStatusMapped, "\u8201"},
+	{0x2F894, idnaStatusMapped, "\u5f22"},
+	{0x2F896, idnaStatusMapped, "\u38c7"},
+	{0x2F897, idnaStatusMapped, "\U000232b8"},
+	{0x2F898, idnaStatusMapped, "\U000261da"},
+	{0x2F899, idnaStatusMapped, "\u5f62"},
+	{0x2F89A, idnaStatusMapped, "\u5f6b"},
+	{0x2F89B, idnaStatusMapped, "\u38e3"},
+	{0x2F89C, idnaStatusMapped, "\u5f9a"},
+	{0x2F89D, idnaStatusMapped, "\u5fcd"},
+	{0x2F89E, idnaStatusMapped, "\u5fd7"},
+	{0x2F89F, idnaStatusMapped, "\u5ff9"},
+	{0x2F8A0, idnaStatusMapped, "\u6081"},
+	{0x2F8A1, idnaStatusMapped, "\u393a"},
+	{0x2F8A2, idnaStatusMapped, "\u391c"},
+	{0x2F8A3, idnaStatusMapped, "\u6094"},
+	{0x2F8A4, idnaStatusMapped, "\U000226d4"},
+	{0x2F8A5, idnaStatusMapped, "\u60c7"},
+	{0x2F8A6, idnaStatusMapped, "\u6148"},
+	{0x2F8A7, idnaStatusMapped, "\u614c"},
+	{0x2F8A8, idnaStatusMapped, "\u614e"},
+	{0x2F8A9, idnaStatusMapped, "\u614c"},
+	{0x2F8AA, idnaStatusMapped, "\u617a"},
+	{0x2F8AB, idnaStatusMapped, "\u618e"},
+	{0x2F8AC, idnaStatusMapped, "\u61b2"},
+	{0x2F8AD, idnaStatusMapped, "\u61a4"},
+	{0x2F8AE, idnaStatusMapped, "\u61af"},
+	{0x2F8AF, idnaStatusMapped, "\u61de"},
+	{0x2F8B0, idnaStatusMapped, "\u61f2"},
+	{0x2F8B1, idnaStatusMapped, "\u61f6"},
+	{0x2F8B2, idnaStatusMapped, "\u6210"},
+	{0x2F8B3, idnaStatusMapped, "\u621b"},
+	{0x2F8B4, idnaStatusMapped, "\u625d"},
+	{0x2F8B5, idnaStatusMapped, "\u62b1"},
+	{0x2F8B6, idnaStatusMapped, "\u62d4"},
+	{0x2F8B7, idnaStatusMapped, "\u6350"},
+	{0x2F8B8, idnaStatusMapped, "\U00022b0c"},
+	{0x2F8B9, idnaStatusMapped, "\u633d"},
+	{0x2F8BA, idnaStatusMapped, "\u62fc"},
+	{0x2F8BB, idnaStatusMapped, "\u6368"},
+	{0x2F8BC, idnaStatusMapped, "\u6383"},
+	{0x2F8BD, idnaStatusMapped, "\u63e4"},
+	{0x2F8BE, idnaStatusMapped, "\U00022bf1"},
+	{0x2F8BF, idnaStatusMapped, "\u6422"},
+	{0x2F8C0, idnaStatusMapped, "\u63c5"},
+	{0x2F8C1, idnaStatusMapped, "\u63a9"},
+	{0x2F8C2, idnaStatusMapped, "\u3a2e"},
+	{0x2F8C3, idnaStatusMapped, "\u6469"},
+	{0x2F8C4, idnaStatusMapped, "\u647e"},
+	{0x2F8C5, idnaStatusMapped, "\u649d"},
+	{0x2F8C6, idnaStatusMapped, "\u6477"},
+	{0x2F8C7, idnaStatusMapped, "\u3a6c"},
+	{0x2F8C8, idnaStatusMapped, "\u654f"},
+	{0x2F8C9, idnaStatusMapped, "\u656c"},
+	{0x2F8CA, idnaStatusMapped, "\U0002300a"},
+	{0x2F8CB, idnaStatusMapped, "\u65e3"},
+	{0x2F8CC, idnaStatusMapped, "\u66f8"},
+	{0x2F8CD, idnaStatusMapped, "\u6649"},
+	{0x2F8CE, idnaStatusMapped, "\u3b19"},
+	{0x2F8CF, idnaStatusMapped, "\u6691"},
+	{0x2F8D0, idnaStatusMapped, "\u3b08"},
+	{0x2F8D1, idnaStatusMapped, "\u3ae4"},
+	{0x2F8D2, idnaStatusMapped, "\u5192"},
+	{0x2F8D3, idnaStatusMapped, "\u5195"},
+	{0x2F8D4, idnaStatusMapped, "\u6700"},
+	{0x2F8D5, idnaStatusMapped, "\u669c"},
+	{0x2F8D6, idnaStatusMapped, "\u80ad"},
+	{0x2F8D7, idnaStatusMapped, "\u43d9"},
+	{0x2F8D8, idnaStatusMapped, "\u6717"},
+	{0x2F8D9, idnaStatusMapped, "\u671b"},
+	{0x2F8DA, idnaStatusMapped, "\u6721"},
+	{0x2F8DB, idnaStatusMapped, "\u675e"},
+	{0x2F8DC, idnaStatusMapped, "\u6753"},
+	{0x2F8DD, idnaStatusMapped, "\U000233c3"},
+	{0x2F8DE, idnaStatusMapped, "\u3b49"},
+	{0x2F8DF, idnaStatusMapped, "\u67fa"},
+	{0x2F8E0, idnaStatusMapped, "\u6785"},
+	{0x2F8E1, idnaStatusMapped, "\u6852"},
+	{0x2F8E2, idnaStatusMapped, "\u6885"},
+	{0x2F8E3, idnaStatusMapped, "\U0002346d"},
+	{0x2F8E4, idnaStatusMapped, "\u688e"},
+	{0x2F8E5, idnaStatusMapped, "\u681f"},
+	{0x2F8E6, idnaStatusMapped, "\u6914"},
+	{0x2F8E7, idnaStatusMapped, "\u3b9d"},
+	{0x2F8E8, idnaStatusMapped, "\u6942"},
+	{0x2F8E9, idnaStatusMapped, "\u69a3"},
+	{0x2F8EA, idnaStatusMapped, "\u69ea"},
+	{0x2F8EB, idnaStatusMapped, "\u6aa8"},
+	{0x2F8EC, idnaStatusMapped, "\U000236a3"},
+	{0x2F8ED, idnaStatusMapped, "\u6adb"},
+	{0x2F8EE, idnaStatusMapped, "\u3c18"},
+	{0x2F8EF, idnaStatusMapped, "\u6b21"},
+	{0x2F8F0, idnaStatusMapped, "\U000238a7"},
+	{0x2F8F1, idnaStatusMapped, "\u6b54"},
+	{0x2F8F2, idnaStatusMapped, "\u3c4e"},
+	{0x2F8F3, idnaStatusMapped, "\u6b72"},
+	{0x2F8F4, idnaStatusMapped, "\u6b9f"},
+	{0x2F8F5, idnaStatusMapped, "\u6bba"},
+	{0x2F8F6, idnaStatusMapped, "\u6bbb"},
+	{0x2F8F7, idnaStatusMapped, "\U00023a8d"},
+	{0x2F8F8, idnaStatusMapped, "\U00021d0b"},
+	{0x2F8F9, idnaStatusMapped, "\U00023afa"},
+	{0x2F8FA, idnaStatusMapped, "\u6c4e"},
+	{0x2F8FB, idnaStatusMapped, "\U00023cbc"},
+	{0x2F8FC, idnaStatusMapped, "\u6cbf"},
+	{0x2F8FD, idnaStatusMapped, "\u6ccd"},
+	{0x2F8FE, idnaStatusMapped, "\u6c67"},
+	{0x2F8FF, idnaStatusMapped, "\u6d16"},
+	{0x2F900, idnaStatusMapped, "\u6d3e"},
+	{0x2F901, idnaStatusMapped, "\u6d77"},
+	{0x2F902, idnaStatusMapped, "\u6d41"},
+	{0x2F903, idnaStatusMapped, "\u6d69"},
+	{0x2F904, idnaStatusMapped, "\u6d78"},
+	{0x2F905, idnaStatusMapped, "\u6d85"},
+	{0x2F906, idnaStatusMapped, "\U00023d1e"},
+	{0x2F907, idnaStatusMapped, "\u6d34"},
+	{0x2F908, idnaStatusMapped, "\u6e2f"},
+	{0x2F909, idnaStatusMapped, "\u6e6e"},
+	{0x2F90A, idnaStatusMapped, "\u3d33"},
+	{0x2F90B, idnaStatusMapped, "\u6ecb"},
+	{0x2F90C, idnaStatusMapped, "\u6ec7"},
+	{0x2F90D, idnaStatusMapped, "\U00023ed1"},
+	{0x2F90E, idnaStatusMapped, "\u6df9"},
+	{0x2F90F, idnaStatusMapped, "\u6f6e"},
+	{0x2F910, idnaStatusMapped, "\U00023f5e"},
+	{0x2F911, idnaStatusMapped, "\U00023f8e"},
+	{0x2F912, idnaStatusMapped, "\u6fc6"},
+	{0x2F913, idnaStatusMapped, "\u7039"},
+	{0x2F914, idnaStatusMapped, "\u701e"},
+	{0x2F915, idnaStatusMapped, "\u701b"},
+	{0x2F916, idnaStatusMapped, "\u3d96"},
+	{0x2F917, idnaStatusMapped, "\u704a"},
+	{0x2F918, idnaStatusMapped, "\u707d"},
+	{0x2F919, idnaStatusMapped, "\u7077"},
+	{0x2F91A, idnaStatusMapped, "\u70ad"},
+	{0x2F91B, idnaStatusMapped, "\U00020525"},
+	{0x2F91C, idnaStatusMapped, "\u7145"},
+	{0x2F91D, idnaStatusMapped, "\U00024263"},
+	{0x2F91E, idnaStatusMapped, "\u719c"},
+	{0x2F91F, idnaStatusMapped, "\U000243ab"},
+	{0x2F920, idnaStatusMapped, "\u7228"},
+	{0x2F921, idnaStatusMapped, "\u7235"},
+	{0x2F922, idnaStatusMapped, "\u7250"},
+	{0x2F923, idnaStatusMapped, "\U00024608"},
+	{0x2F924, idnaStatusMapped, "\u7280"},
+	{0x2F925, idnaStatusMapped, "\u7295"},
+	{0x2F926, idnaStatusMapped, "\U00024735"},
+	{0x2F927, idnaStatusMapped, "\U00024814"},
+	{0x2F928, idnaStatusMapped, "\u737a"},
+	{0x2F929, idnaStatusMapped, "\u738b"},
+	{0x2F92A, idnaStatusMapped, "\u3eac"},
+	{0x2F92B, idnaStatusMapped, "\u73a5"},
+	{0x2F92C, idnaStatusMapped, "\u3eb8"},
+	{0x2F92E, idnaStatusMapped, "\u7447"},
+	{0x2F92F, idnaStatusMapped, "\u745c"},
+	{0x2F930, idnaStatusMapped, "\u7471"},
+	{0x2F931, idnaStatusMapped, "\u7485"},
+	{0x2F932, idnaStatusMapped, "\u74ca"},
+	{0x2F933, idnaStatusMapped, "\u3f1b"},
+	{0x2F934, idnaStatusMapped, "\u7524"},
+	{0x2F935, idnaStatusMapped, "\U00024c36"},
+	{0x2F936, idnaStatusMapped, "\u753e"},
+	{0x2F937, idnaStatusMapped, "\U00024c92"},
+	{0x2F938, idnaStatusMapped, "\u7570"},
+	{0x2F939, idnaStatusMapped, "\U0002219f"},
+	{0x2F93A, idnaStatusMapped, "\u7610"},
+	{0x2F93B, idnaStatusMapped, "\U00024fa1"},
+	{0x2F93C, idnaStatusMapped, "\U00024fb8"},
+	{0x2F93D, idnaStatusMapped, "\U00025044"},
+	{0x2F93E, idnaStatusMapped, "\u3ffc"},
+	{0x2F93F, idnaStatusMapped, "\u4008"},
+	{0x2F940, idnaStatusMapped, "\u76f4"},
+	{0x2F941, idnaStatusMapped, "\U000250f3"},
+	{0x2F942, idnaStatusMapped, "\U000250f2"},
+	{0x2F943, idnaStatusMapped, "\U00025119"},
+	{0x2F944, idnaStatusMapped, "\U00025133"},
+	{0x2F945, idnaStatusMapped, "\u771e"},
+	{0x2F946, idnaStatusMapped, "\u771f"},
+	{0x2F948, idnaStatusMapped, "\u774a"},
+	{0x2F949, idnaStatusMapped, "\u4039"},
+	{0x2F94A, idnaStatusMapped, "\u778b"},
+	{0x2F94B, idnaStatusMapped, "\u4046"},
+	{0x2F94C, idnaStatusMapped, "\u4096"},
+	{0x2F94D, idnaStatusMapped, "\U0002541d"},
+	{0x2F94E, idnaStatusMapped, "\u784e"},
+	{0x2F94F, idnaStatusMapped, "\u788c"},
+	{0x2F950, idnaStatusMapped, "\u78cc"},
+	{0x2F951, idnaStatusMapped, "\u40e3"},
+	{0x2F952, idnaStatusMapped, "\U00025626"},
+	{0x2F953, idnaStatusMapped, "\u7956"},
+	{0x2F954, idnaStatusMapped, "\U0002569a"},
+	{0x2F955, idnaStatusMapped, "\U000256c5"},
+	{0x2F956, idnaStatusMapped, "\u798f"},
+	{0x2F957, idnaStatusMapped, "\u79eb"},
+	{0x2F958, idnaStatusMapped, "\u412f"},
+	{0x2F959, idnaStatusMapped, "\u7a40"},
+	{0x2F95A, idnaStatusMapped, "\u7a4a"},
+	{0x2F95B, idnaStatusMapped, "\u7a4f"},
+	{0x2F95C, idnaStatusMapped, "\U0002597c"},
+	{0x2F95D, idnaStatusMapped, "\U00025aa7"},
+	{0x2F95F, idnaStatusMapped, "\u7aee"},
+	{0x2F960, idnaStatusMapped, "\u4202"},
+	{0x2F961, idnaStatusMapped, "\U00025bab"},
+	{0x2F962, idnaStatusMapped, "\u7bc6"},
+	{0x2F963, idnaStatusMapped, "\u7bc9"},
+	{0x2F964, idnaStatusMapped, "\u4227"},
+	{0x2F965, idnaStatusMapped, "\U00025c80"},
+	{0x2F966, idnaStatusMapped, "\u7cd2"},
+	{0x2F967, idnaStatusMapped, "\u42a0"},
+	{0x2F968, idnaStatusMapped, "\u7ce8"},
+	{0x2F969, idnaStatusMapped, "\u7ce3"},
+	{0x2F96A, idnaStatusMapped, "\u7d00"},
+	{0x2F96B, idnaStatusMapped, "\U00025f86"},
+	{0x2F96C, idnaStatusMapped, "\u7d63"},
+	{0x2F96D, idnaStatusMapped, "\u4301"},
+	{0x2F96E, idnaStatusMapped, "\u7dc7"},
+	{0x2F96F, idnaStatusMapped, "\u7e02"},
+	{0x2F970, idnaStatusMapped, "\u7e45"},
+	{0x2F971, idnaStatusMapped, "\u4334"},
+	{0x2F972, idnaStatusMapped, "\U00026228"},
+	{0x2F973, idnaStatusMapped, "\U00026247"},
+	{0x2F974, idnaStatusMapped, "\u4359"},
+	{0x2F975, idnaStatusMapped, "\U000262d9"},
+	{0x2F976, idnaStatusMapped, "\u7f7a"},
+	{0x2F977, idnaStatusMapped, "\U0002633e"},
+	{0x2F978, idnaStatusMapped, "\u7f95"},
+	{0x2F979, idnaStatusMapped, "\u7ffa"},
+	{0x2F97A, idnaStatusMapped, "\u8005"},
+	{0x2F97B, idnaStatusMapped, "\U000264da"},
+	{0x2F97C, idnaStatusMapped, "\U00026523"},
+	{0x2F97D, idnaStatusMapped, "\u8060"},
+	{0x2F97E, idnaStatusMapped, "\U000265a8"},
+	{0x2F97F, idnaStatusMapped, "\u8070"},
+	{0x2F980, idnaStatusMapped, "\U0002335f"},
+	{0x2F981, idnaStatusMapped, "\u43d5"},
+	{0x2F982, idnaStatusMapped, "\u80b2"},
+	{0x2F983, idnaStatusMapped, "\u8103"},
+	{0x2F984, idnaStatusMapped, "\u440b"},
+	{0x2F985, idnaStatusMapped, "\u813e"},
+	{0x2F986, idnaStatusMapped, "\u5ab5"},
+	{0x2F987, idnaStatusMapped, "\U000267a7"},
+	{0x2F988, idnaStatusMapped, "\U000267b5"},
+	{0x2F989, idnaStatusMapped, "\U00023393"},
+	{0x2F98A, idnaStatusMapped, "\U0002339c"},
+	{0x2F98B, idnaStatusMapped, "\u8201"},
+	{0x2F98C, idnaStatusMapped, "\u8204"},
+	{0x2F98D, idnaStatusMapped, "\u8f9e"},
+	{0x2F98E, idnaStatusMapped, "\u446b"},
+	{0x2F98F, idnaStatusMapped, "\u8291"},
+	{0x2F990, idnaStatusMapped, "\u828b"},
+	{0x2F991, idnaStatusMapped, "\u829d"},
+	{0x2F992, idnaStatusMapped, "\u52b3"},
+	{0x2F993, idnaStatusMapped, "\u82b1"},
+	{0x2F994, idnaStatusMapped, "\u82b3"},
+	{0x2F995, idnaStatusMapped, "\u82bd"},
+	{0x2F996, idnaStatusMapped, "\u82e6"},
+	{0x2F997, idnaStatusMapped, "\U00026b3c"},
+	{0x2F998, idnaStatusMapped, "\u82e5"},
+	{0x2F999, idnaStatusMapped, "\u831d"},
+	{0x2F99A, idnaStatusMapped, "\u8363"},
+	{0x2F99B, idnaStatusMapped, "\u83ad"},
+	{0x2F99C, idnaStatusMapped, "\u8323"},
+	{0x2F99D, idnaStatusMapped, "\u83bd"},
+	{0x2F99E, idnaStatusMapped, "\u83e7"},
+	{0x2F99F, idnaStatusMapped, "\u8457"},
+	{0x2F9A0, idnaStatusMapped, "\u8353"},
+	{0x2F9A1, idnaStatusMapped, "\u83ca"},
+	{0x2F9A2, idnaStatusMapped, "\u83cc"},
+	{0x2F9A3, idnaStatusMapped, "\u83dc"},
+	{0x2F9A4, idnaStatusMapped, "\U00026c36"},
+	{0x2F9A5, idnaStatusMapped, "\U00026d6b"},
+	{0x2F9A6, idnaStatusMapped, "\U00026cd5"},
+	{0x2F9A7, idnaStatusMapped, "\u452b"},
+	{0x2F9A8, idnaStatusMapped, "\u84f1"},
+	{0x2F9A9, idnaStatusMapped, "\u84f3"},
+	{0x2F9AA, idnaStatusMapped, "\u8516"},
+	{0x2F9AB, idnaStatusMapped, "\U000273ca"},
+	{0x2F9AC, idnaStatusMapped, "\u8564"},
+	{0x2F9AD, idnaStatusMapped, "\U00026f2c"},
+	{0x2F9AE, idnaStatusMapped, "\u455d"},
+	{0x2F9AF, idnaStatusMapped, "\u4561"},
+	{0x2F9B0, idnaStatusMapped, "\U00026fb1"},
+	{0x2F9B1, idnaStatusMapped, "\U000270d2"},
+	{0x2F9B2, idnaStatusMapped, "\u456b"},
+	{0x2F9B3, idnaStatusMapped, "\u8650"},
+	{0x2F9B4, idnaStatusMapped, "\u865c"},
+	{0x2F9B5, idnaStatusMapped, "\u8667"},
+	{0x2F9B6, idnaStatusMapped, "\u8669"},
+	{0x2F9B7, idnaStatusMapped, "\u86a9"},
+	{0x2F9B8, idnaStatusMapped, "\u8688"},
+	{0x2F9B9, idnaStatusMapped, "\u870e"},
+	{0x2F9BA, idnaStatusMapped, "\u86e2"},
+	{0x2F9BB, idnaStatusMapped, "\u8779"},
+	{0x2F9BC, idnaStatusMapped, "\u8728"},
+	{0x2F9BD, idnaStatusMapped, "\u876b"},
+	{0x2F9BE, idnaStatusMapped, "\u8786"},
+	{0x2F9BF, idnaStatusMapped, "\u45d7"},
+	{0x2F9C0, idnaStatusMapped, "\u87e1"},
+	{0x2F9C1, idnaStatusMapped, "\u8801"},
+	{0x2F9C2, idnaStatusMapped, "\u45f9"},
+	{0x2F9C3, idnaStatusMapped, "\u8860"},
+	{0x2F9C4, idnaStatusMapped, "\u8863"},
+	{0x2F9C5, idnaStatusMapped, "\U00027667"},
+	{0x2F9C6, idnaStatusMapped, "\u88d7"},
+	{0x2F9C7, idnaStatusMapped, "\u88de"},
+	{0x2F9C8, idnaStatusMapped, "\u4635"},
+	{0x2F9C9, idnaStatusMapped, "\u88fa"},
+	{0x2F9CA, idnaStatusMapped, "\u34bb"},
+	{0x2F9CB, idnaStatusMapped, "\U000278ae"},
+	{0x2F9CC, idnaStatusMapped, "\U00027966"},
+	{0x2F9CD, idnaStatusMapped, "\u46be"},
+	{0x2F9CE, idnaStatusMapped, "\u46c7"},
+	{0x2F9CF, idnaStatusMapped, "\u8aa0"},
+	{0x2F9D0, idnaStatusMapped, "\u8aed"},
+	{0x2F9D1, idnaStatusMapped, "\u8b8a"},
+	{0x2F9D2, idnaStatusMapped, "\u8c55"},
+	{0x2F9D3, idnaStatusMapped, "\U00027ca8"},
+	{0x2F9D4, idnaStatusMapped, "\u8cab"},
+	{0x2F9D5, idnaStatusMapped, "\u8cc1"},
+	{0x2F9D6, idnaStatusMapped, "\u8d1b"},
+	{0x2F9D7, idnaStatusMapped, "\u8d77"},
+	{0x2F9D8, idnaStatusMapped, "\U00027f2f"},
+	{0x2F9D9, idnaStatusMapped, "\U00020804"},
+	{0x2F9DA, idnaStatusMapped, "\u8dcb"},
+	{0x2F9DB, idnaStatusMapped, "\u8dbc"},
+	{0x2F9DC, idnaStatusMapped, "\u8df0"},
+	{0x2F9DD, idnaStatusMapped, "\U000208de"},
+	{0x2F9DE, idnaStatusMapped, "\u8ed4"},
+	{0x2F9DF, idnaStatusMapped, "\u8f38"},
+	{0x2F9E0, idnaStatusMapped, "\U000285d2"},
+	{0x2F9E1, idnaStatusMapped, "\U000285ed"},
+	{0x2F9E2, idnaStatusMapped, "\u9094"},
+	{0x2F9E3, idnaStatusMapped, "\u90f1"},
+	{0x2F9E4, idnaStatusMapped, "\u9111"},
+	{0x2F9E5, idnaStatusMapped, "\U0002872e"},
+	{0x2F9E6, idnaStatusMapped, "\u911b"},
+	{0x2F9E7, idnaStatusMapped, "\u9238"},
+	{0x2F9E8, idnaStatusMapped, "\u92d7"},
+	{0x2F9E9, idnaStatusMapped, "\u92d8"},
+	{0x2F9EA, idnaStatusMapped, "\u927c"},
+	{0x2F9EB, idnaStatusMapped, "\u93f9"},
+	{0x2F9EC, idnaStatusMapped, "\u9415"},
+	{0x2F9ED, idnaStatusMapped, "\U00028bfa"},
+	{0x2F9EE, idnaStatusMapped, "\u958b"},
+	{0x2F9EF, idnaStatusMapped, "\u4995"},
+	{0x2F9F0, idnaStatusMapped, "\u95b7"},
+	{0x2F9F1, idnaStatusMapped, "\U00028d77"},
+	{0x2F9F2, idnaStatusMapped, "\u49e6"},
+	{0x2F9F3, idnaStatusMapped, "\u96c3"},
+	{0x2F9F4, idnaStatusMapped, "\u5db2"},
+	{0x2F9F5, idnaStatusMapped, "\u9723"},
+	{0x2F9F6, idnaStatusMapped, "\U00029145"},
+	{0x2F9F7, idnaStatusMapped, "\U0002921a"},
+	{0x2F9F8, idnaStatusMapped, "\u4a6e"},
+	{0x2F9F9, idnaStatusMapped, "\u4a76"},
+	{0x2F9FA, idnaStatusMapped, "\u97e0"},
+	{0x2F9FB, idnaStatusMapped, "\U0002940a"},
+	{0x2F9FC, idnaStatusMapped, "\u4ab2"},
+	{0x2F9FD, idnaStatusMapped, "\U00029496"},
+	{0x2F9FE, idnaStatusMapped, "\u980b"},
+	{0x2FA00, idnaStatusMapped, "\u9829"},
+	{0x2FA01, idnaStatusMapped, "\U000295b6"},
+	{0x2FA02, idnaStatusMapped, "\u98e2"},
+	{0x2FA03, idnaStatusMapped, "\u4b33"},
+	{0x2FA04, idnaStatusMapped, "\u9929"},
+	{0x2FA05, idnaStatusMapped, "\u99a7"},
+	{0x2FA06, idnaStatusMapped, "\u99c2"},
+	{0x2FA07, idnaStatusMapped, "\u99fe"},
+	{0x2FA08, idnaStatusMapped, "\u4bce"},
+	{0x2FA09, idnaStatusMapped, "\U00029b30"},
+	{0x2FA0A, idnaStatusMapped, "\u9b12"},
+	{0x2FA0B, idnaStatusMapped, "\u9c40"},
+	{0x2FA0C, idnaStatusMapped, "\u9cfd"},
+	{0x2FA0D, idnaStatusMapped, "\u4cce"},
+	{0x2FA0E, idnaStatusMapped, "\u4ced"},
+	{0x2FA0F, idnaStatusMapped, "\u9d67"},
+	{0x2FA10, idnaStatusMapped, "\U0002a0ce"},
+	{0x2FA11, idnaStatusMapped, "\u4cf8"},
+	{0x2FA12, idnaStatusMapped, "\U0002a105"},
+	{0x2FA13, idnaStatusMapped, "\U0002a20e"},
+	{0x2FA14, idnaStatusMapped, "\U0002a291"},
+	{0x2FA15, idnaStatusMapped, "\u9ebb"},
+	{0x2FA16, idnaStatusMapped, "\u4d56"},
+	{0x2FA17, idnaStatusMapped, "\u9ef9"},
+	{0x2FA18, idnaStatusMapped, "\u9efe"},
+	{0x2FA19, idnaStatusMapped, "\u9f05"},
+	{0x2FA1A, idnaStatusMapped, "\u9f0f"},
+	{0x2FA1B, idnaStatusMapped, "\u9f16"},
+	{0x2FA1C, idnaStatusMapped, "\u9f3b"},
+	{0x2FA1D, idnaStatusMapped, "\U0002a600"},
+	{0x2FA1E, idnaStatusDisallowed, ""},
+	{0x30000, idnaStatusValid, ""},
+	{0x3134B, idnaStatusDisallowed, ""},
+	{0x31350, idnaStatusValid, ""},
+	{0x3347A, idnaStatusDisallowed, ""},
+	{0xE0100, idnaStatusIgnored, ""},
+	{0xE01F0, idnaStatusDisallowed, ""},
+}
+
+// canonicalCombiningClasses lists the code points with a non-zero
+// Canonical_Combining_Class
+var canonicalCombiningClasses = []combiningClassRange{
+	{0x0300, 0x0314, 230},
+	{0x0315, 0x0315, 232},
+	{0x0316, 0x0319, 220},
+	{0x031A, 0x031A, 232},
+	{0x031B, 0x031B, 216},
+	{0x031C, 0x0320, 220},
+	{0x0321, 0x0322, 202},
+	{0x0323, 0x0326, 220},
+	{0x0327, 0x0328, 202},
+	{0x0329, 0x0333, 220},
+	{0x0334, 0x0338, 1},
+	{0x0339, 0x033C, 220},
+	{0x033D, 0x0344, 230},
+	{0x0345, 0x0345, 240},
+	{0x0346, 0x0346, 230},
+	{0x0347, 0x0349, 220},
+	{0x034A, 0x034C, 230},
+	{0x034D, 0x034E, 220},
+	{0x0350, 0x0352, 230},
+	{0x0353, 0x0356, 220},
+	{0x0357, 0x0357, 230},
+	{0x0358, 0x0358, 232},
+	{0x0359, 0x035A, 220},
+	{0x035B, 0x035B, 230},
+	{0x035C, 0x035C, 233},
+	{0x035D, 0x035E, 234},
+	{0x035F, 0x035F, 233},
+	{0x0360, 0x0361, 234},
+	{0x0362, 0x0362, 233},
+	{0x0363, 0x036F, 230},
+	{0x0483, 0x0487, 230},
+	{0x0591, 0x0591, 220},
+	{0x0592, 0x0595, 230},
+	{0x0596, 0x0596, 220},
+	{0x0597, 0x0599, 230},
+	{0x059A, 0x059A, 222},
+	{0x059B, 0x059B, 220},
+	{0x059C, 0x05A1, 230},
+	{0x05A2, 0x05A7, 220},
+	{0x05A8, 0x05A9, 230},
+	{0x05AA, 0x05AA, 220},
+	{0x05AB, 0x05AC, 230},
+	{0x05AD, 0x05AD, 222},
+	{0x05AE, 0x05AE, 228},
+	{0x05AF, 0x05AF, 230},
+	{0x05B0, 0x05B0, 10},
+	{0x05B1, 0x05B1, 11},
+	{0x05B2, 0x05B2, 12},
+	{0x05B3, 0x05B3, 13},
+	{0x05B4, 0x05B4, 14},
+	{0x05B5, 0x05B5, 15},
+	{0x05B6, 0x05B6, 16},
+	{0x05B7, 0x05B7, 17},
+	{0x05B8, 0x05B8, 18},
+	{0x05B9, 0x05BA, 19},
+	{0x05BB, 0x05BB, 20},
+	{0x05BC, 0x05BC, 21},
+	{0x05BD, 0x05BD, 22},
+	{0x05BF, 0x05BF, 23},
+	{0x05C1, 0x05C1, 24},
+	{0x05C2, 0x05C2, 25},
+	{0x05C4, 0x05C4, 230},
+	{0x05C5, 0x05C5, 220},
+	{0x05C7, 0x05C7, 18},
+	{0x0610, 0x0617, 230},
+	{0x0618, 0x0618, 30},
+	{0x0619, 0x0619, 31},
+	{0x061A, 0x061A, 32},
+	{0x064B, 0x064B, 27},
+	{0x064C, 0x064C, 28},
+	{0x064D, 0x064D, 29},
+	{0x064E, 0x064E, 30},
+	{0x064F, 0x064F, 31},
+	{0x0650, 0x0650, 32},
+	{0x0651, 0x0651, 33},
+	{0x0652, 0x0652, 34},
+	{0x0653, 0x0654, 230},
+	{0x0655, 0x0656, 220},
+	{0x0657, 0x065B, 230},
+	{0x065C, 0x065C, 220},
+	{0x065D, 0x065E, 230},
+	{0x065F, 0x065F, 220},
+	{0x0670, 0x0670, 35},
+	{0x06D6, 0x06DC, 230},
+	{0x06DF, 0x06E2, 230},
+	{0x06E3, 0x06E3, 220},
+	{0x06E4, 0x06E4, 230},
+	{0x06E7, 0x06E8, 230},
+	{0x06EA, 0x06EA, 220},
+	{0x06EB, 0x06EC, 230},
+	{0x06ED, 0x06ED, 220},
+	{0x0711, 0x0711, 36},
+	{0x0730, 0x0730, 230},
+	{0x0731, 0x0731, 220},
+	{0x0732, 0x0733, 230},
+	{0x0734, 0x0734, 220},
+	{0x0735, 0x0736, 230},
+	{0x0737, 0x0739, 220},
+	{0x073A, 0x073A, 230},
+	{0x073B, 0x073C, 220},
+	{0x073D, 0x073D, 230},
+	{0x073E, 0x073E, 220},
+	{0x073F, 0x0741, 230},
+	{0x0742, 0x0742, 220},
+	{0x0743, 0x0743, 230},
+	{0x0744, 0x0744, 220},
+	{0x0745, 0x0745, 230},
+	{0x0746, 0x0746, 220},
+	{0x0747, 0x0747, 230},
+	{0x0748, 0x0748, 220},
+	{0x0749, 0x074A, 230},
+	{0x07EB, 0x07F1, 230},
+	{0x07F2, 0x07F2, 220},
+	{0x07F3, 0x07F3, 230},
+	{0x07FD, 0x07FD, 220},
+	{0x0816, 0x0819, 230},
+	{0x081B, 0x0823, 230},
+	{0x0825, 0x0827, 230},
+	{0x0829, 0x082D, 230},
+	{0x0859, 0x085B, 220},
+	{0x0897, 0x0898, 230},
+	{0x0899, 0x089B, 220},
+	{0x089C, 0x089F, 230},
+	{0x08CA, 0x08CE, 230},
+	{0x08CF, 0x08D3, 220},
+	{0x08D4, 0x08E1, 230},
+	{0x08E3, 0x08E3, 220},
+	{0x08E4, 0x08E5, 230},
+	{0x08E6, 0x08E6, 220},
+	{0x08E7, 0x08E8, 230},
+	{0x08E9, 0x08E9, 220},
+	{0x08EA, 0x08EC, 230},
+	{0x08ED, 0x08EF, 220},
+	{0x08F0, 0x08F0, 27},
+	{0x08F1, 0x08F1, 28},
+	{0x08F2, 0x08F2, 29},
+	{0x08F3, 0x08F5, 230},
+	{0x08F6, 0x08F6, 220},
+	{0x08F7, 0x08F8, 230},
+	{0x08F9, 0x08FA, 220},
+	{0x08FB, 0x08FF, 230},
+	{0x093C, 0x093C, 7},
+	{0x094D, 0x094D, 9},
+	{0x0951, 0x0951, 230},
+	{0x0952, 0x0952, 220},
+	{0x0953, 0x0954, 230},
+	{0x09BC, 0x09BC, 7},
+	{0x09CD, 0x09CD, 9},
+	{0x09FE, 0x09FE, 230},
+	{0x0A3C, 0x0A3C, 7},
+	{0x0A4D, 0x0A4D, 9},
+	{0x0ABC, 0x0ABC, 7},
+	{0x0ACD, 0x0ACD, 9},
+	{0x0B3C, 0x0B3C, 7},
+	{0x0B4D, 0x0B4D, 9},
+	{0x0BCD, 0x0BCD, 9},
+	{0x0C3C, 0x0C3C, 7},
+	{0x0C4D, 0x0C4D, 9},
+	{0x0C55, 0x0C55, 84},
+	{0x0C56, 0x0C56, 91},
+	{0x0CBC, 0x0CBC, 7},
+	{0x0CCD, 0x0CCD, 9},
+	{0x0D3B, 0x0D3C, 9},
+	{0x0D4D, 0x0D4D, 9},
+	{0x0DCA, 0x0DCA, 9},
+	{0x0E38, 0x0E39, 103},
+	{0x0E3A, 0x0E3A, 9},
+	{0x0E48, 0x0E4B, 107},
+	{0x0EB8, 0x0EB9, 118},
+	{0x0EBA, 0x0EBA, 9},
+	{0x0EC8, 0x0ECB, 122},
+	{0x0F18, 0x0F19, 220},
+	{0x0F35, 0x0F35, 220},
+	{0x0F37, 0x0F37, 220},
+	{0x0F39, 0x0F39, 216},
+	{0x0F71, 0x0F71, 129},
+	{0x0F72, 0x0F72, 130},
+	{0x0F74, 0x0F74, 132},
+	{0x0F7A, 0x0F7D, 130},
+	{0x0F80, 0x0F80, 130},
+	{0x0F82, 0x0F83, 230},
+	{0x0F84, 0x0F84, 9},
+	{0x0F86, 0x0F87, 230},
+	{0x0FC6, 0x0FC6, 220},
+	{0x1037, 0x1037, 7},
+	{0x1039, 0x103A, 9},
+	{0x108D, 0x108D, 220},
+	{0x135D, 0x135F, 230},
+	{0x1714, 0x1715, 9},
+	{0x1734, 0x1734, 9},
+	{0x17D2, 0x17D2, 9},
+	{0x17DD, 0x17DD, 230},
+	{0x18A9, 0x18A9, 228},
+	{0x1939, 0x1939, 222},
+	{0x193A, 0x193A, 230},
+	{0x193B, 0x193B, 220},
+	{0x1A17, 0x1A17, 230},
+	{0x1A18, 0x1A18, 220},
+	{0x1A60, 0x1A60, 9},
+	{0x1A75, 0x1A7C, 230},
+	{0x1A7F, 0x1A7F, 220},
+	{0x1AB0, 0x1AB4, 230},
+	{0x1AB5, 0x1ABA, 220},
+	{0x1ABB, 0x1ABC, 230},
+	{0x1ABD, 0x1ABD, 220},
+	{0x1ABF, 0x1AC0, 220},
+	{0x1AC1, 0x1AC2, 230},
+	{0x1AC3, 0x1AC4, 220},
+	{0x1AC5, 0x1AC9, 230},
+	{0x1ACA, 0x1ACA, 220},
+	{0x1ACB, 0x1ADC, 230},
+	{0x1ADD, 0x1ADD, 220},
+	{0x1AE0, 0x1AE5, 230},
+	{0x1AE6, 0x1AE6, 220},
+	{0x1AE7, 0x1AEA, 230},
+	{0x1AEB, 0x1AEB, 234},
+	{0x1B34, 0x1B34, 7},
+	{0x1B44, 0x1B44, 9},
+	{0x1B6B, 0x1B6B, 230},
+	{0x1B6C, 0x1B6C, 220},
+	{0x1B6D, 0x1B73, 230},
+	{0x1BAA, 0x1BAB, 9},
+	{0x1BE6, 0x1BE6, 7},
+	{0x1BF2, 0x1BF3, 9},
+	{0x1C37, 0x1C37, 7},
+	{0x1CD0, 0x1CD2, 230},
+	{0x1CD4, 0x1CD4, 1},
+	{0x1CD5, 0x1CD9, 220},
+	{0x1CDA, 0x1CDB, 230},
+	{0x1CDC, 0x1CDF, 220},
+	{0x1CE0, 0x1CE0, 230},
+	{0x1CE2, 0x1CE8, 1},
+	{0x1CED, 0x1CED, 220},
+	{0x1CF4, 0x1CF4, 230},
+	{0x1CF8, 0x1CF9, 230},
+	{0x1DC0, 0x1DC1, 230},
+	{0x1DC2, 0x1DC2, 220},
+	{0x1DC3, 0x1DC9, 230},
+	{0x1DCA, 0x1DCA, 220},
+	{0x1DCB, 0x1DCC, 230},
+	{0x1DCD, 0x1DCD, 234},
+	{0x1DCE, 0x1DCE, 214},
+	{0x1DCF, 0x1DCF, 220},
+	{0x1DD0, 0x1DD0, 202},
+	{0x1DD1, 0x1DF5, 230},
+	{0x1DF6, 0x1DF6, 232},
+	{0x1DF7, 0x1DF8, 228},
+	{0x1DF9, 0x1DF9, 220},
+	{0x1DFA, 0x1DFA, 218},
+	{0x1DFB, 0x1DFB, 230},
+	{0x1DFC, 0x1DFC, 233},
+	{0x1DFD, 0x1DFD, 220},
+	{0x1DFE, 0x1DFE, 230},
+	{0x1DFF, 0x1DFF, 220},
+	{0x20D0, 0x20D1, 230},
+	{0x20D2, 0x20D3, 1},
+	{0x20D4, 0x20D7, 230},
+	{0x20D8, 0x20DA, 1},
+	{0x20DB, 0x20DC, 230},
+	{0x20E1, 0x20E1, 230},
+	{0x20E5, 0x20E6, 1},
+	{0x20E7, 0x20E7, 230},
+	{0x20E8, 0x20E8, 220},
+	{0x20E9, 0x20E9, 230},
+	{0x20EA, 0x20EB, 1},
+	{0x20EC, 0x20EF, 220},
+	{0x20F0, 0x20F0, 230},
+	{0x2CEF, 0x2CF1, 230},
+	{0x2D7F, 0x2D7F, 9},
+	{0x2DE0, 0x2DFF, 230},
+	{0x302A, 0x302A, 218},
+	{0x302B, 0x302B, 228},
+	{0x302C, 0x302C, 232},
+	{0x302D, 0x302D, 222},
+	{0x302E, 0x302F, 224},
+	{0x3099, 0x309A, 8},
+	{0xA66F, 0xA66F, 230},
+	{0xA674, 0xA67D, 230},
+	{0xA69E, 0xA69F, 230},
+	{0xA6F0, 0xA6F1, 230},
+	{0xA806, 0xA806, 9},
+	{0xA82C, 0xA82C, 9},
+	{0xA8C4, 0xA8C4, 9},
+	{0xA8E0, 0xA8F1, 230},
+	{0xA92B, 0xA92D, 220},
+	{0xA953, 0xA953, 9},
+	{0xA9B3, 0xA9B3, 7},
+	{0xA9C0, 0xA9C0, 9},
+	{0xAAB0, 0xAAB0, 230},
+	{0xAAB2, 0xAAB3, 230},
+	{0xAAB4, 0xAAB4, 220},
+	{0xAAB7, 0xAAB8, 230},
+	{0xAABE, 0xAABF, 230},
+	{0xAAC1, 0xAAC1, 230},
+	{0xAAF6, 0xAAF6, 9},
+	{0xABED, 0xABED, 9},
+	{0xFB1E, 0xFB1E, 26},
+	{0xFE20, 0xFE26, 230},
+	{0xFE27, 0xFE2D, 220},
+	{0xFE2E, 0xFE2F, 230},
+	{0x101FD, 0x101FD, 220},
+	{0x102E0, 0x102E0, 220},
+	{0x10376, 0x1037A, 230},
+	{0x10A0D, 0x10A0D, 220},
+	{0x10A0F, 0x10A0F, 230},
+	{0x10A38, 0x10A38, 230},
+	{0x10A39, 0x10A39, 1},
+	{0x10A3A, 0x10A3A, 220},
+	{0x10A3F, 0x10A3F, 9},
+	{0x10AE5, 0x10AE5, 230},
+	{0x10AE6, 0x10AE6, 220},
+	{0x10D24, 0x10D27, 230},
+	{0x10D69, 0x10D6D, 230},
+	{0x10EAB, 0x10EAC, 230},
+	{0x10EFA, 0x10EFB, 220},
+	{0x10EFD, 0x10EFF, 220},
+	{0x10F46, 0x10F47, 220},
+	{0x10F48, 0x10F4A, 230},
+	{0x10F4B, 0x10F4B, 220},
+	{0x10F4C, 0x10F4C, 230},
+	{0x10F4D, 0x10F50, 220},
+	{0x10F82, 0x10F82, 230},
+	{0x10F83, 0x10F83, 220},
+	{0x10F84, 0x10F84, 230},
+	{0x10F85, 0x10F85, 220},
+	{0x11046, 0x11046, 9},
+	{0x11070, 0x11070, 9},
+	{0x1107F, 0x1107F, 9},
+	{0x110B9, 0x110B9, 9},
+	{0x110BA, 0x110BA, 7},
+	{0x11100, 0x11102, 230},
+	{0x11133, 0x11134, 9},
+	{0x11173, 0x11173, 7},
+	{0x111C0, 0x111C0, 9},
+	{0x111CA, 0x111CA, 7},
+	{0x11235, 0x11235, 9},
+	{0x11236, 0x11236, 7},
+	{0x112E9, 0x112E9, 7},
+	{0x112EA, 0x112EA, 9},
+	{0x1133B, 0x1133C, 7},
+	{0x1134D, 0x1134D, 9},
+	{0x11366, 0x1136C, 230},
+	{0x11370, 0x11374, 230},
+	{0x113CE, 0x113D0, 9},
+	{0x11442, 0x11442, 9},
+	{0x11446, 0x11446, 7},
+	{0x1145E, 0x1145E, 230},
+	{0x114C2, 0x114C2, 9},
+	{0x114C3, 0x114C3, 7},
+	{0x115BF, 0x115BF, 9},
+	{0x115C0, 0x115C0, 7},
+	{0x1163F, 0x1163F, 9},
+	{0x116B6, 0x116B6, 9},
+	{0x116B7, 0x116B7, 7},
+	{0x1172B, 0x1172B, 9},
+	{0x11839, 0x11839, 9},
+	{0x1183A, 0x1183A, 7},
+	{0x1193D, 0x1193E, 9},
+	{0x11943, 0x11943, 7},
+	{0x119E0, 0x119E0, 9},
+	{0x11A34, 0x11A34, 9},
+	{0x11A47, 0x11A47, 9},
+	{0x11A99, 0x11A99, 9},
+	{0x11C3F, 0x11C3F, 9},
+	{0x11D42, 0x11D42, 7},
+	{0x11D44, 0x11D45, 9},
+	{0x11D97, 0x11D97, 9},
+	{0x11F41, 0x11F42, 9},
+	{0x1612F, 0x1612F, 9},
+	{0x16AF0, 0x16AF4, 1},
+	{0x16B30, 0x16B36, 230},
+	{0x16FF0, 0x16FF1, 6},
+	{0x1BC9E, 0x1BC9E, 1},
+	{0x1D165, 0x1D166, 216},
+	{0x1D167, 0x1D169, 1},
+	{0x1D16D, 0x1D16D, 226},
+	{0x1D16E, 0x1D172, 216},
+	{0x1D17B, 0x1D182, 220},
+	{0x1D185, 0x1D189, 230},
+	{0x1D18A, 0x1D18B, 220},
+	{0x1D1AA, 0x1D1AD, 230},
+	{0x1D242, 0x1D244, 230},
+	{0x1E000, 0x1E006, 230},
+	{0x1E008, 0x1E018, 230},
+	{0x1E01B, 0x1E021, 230},
+	{0x1E023, 0x1E024, 230},
+	{0x1E026, 0x1E02A, 230},
+	{0x1E08F, 0x1E08F, 230},
+	{0x1E130, 0x1E136, 230},
+	{0x1E2AE, 0x1E2AE, 230},
+	{0x1E2EC, 0x1E2EF, 230},
+	{0x1E4EC, 0x1E4ED, 232},
+	{0x1E4EE, 0x1E4EE, 220},
+	{0x1E4EF, 0x1E4EF, 230},
+	{0x1E5EE, 0x1E5EE, 230},
+	{0x1E5EF, 0x1E5EF, 220},
+	{0x1E6E3, 0x1E6E3, 230},
+	{0x1E6E6, 0x1E6E6, 230},
+	{0x1E6EE, 0x1E6EF, 230},
+	{0x1E6F5, 0x1E6F5, 230},
+	{0x1E8D0, 0x1E8D6, 220},
+	{0x1E944, 0x1E949, 230},
+	{0x1E94A, 0x1E94A, 7},
+}
+
+// canonicalDecompositions holds the full canonical decomposition of every
+// code point that has one, except Hangul syllables (see decompose)
+var canonicalDecompositions = map[rune]string{
+	0x00C0:  "A\u0300",
+	0x00C1:  "A\u0301",
+	0x00C2:  "A\u0302",
+	0x00C3:  "A\u0303",
+	0x00C4:  "A\u0308",
+	0x00C5:  "A\u030a",
+	0x00C7:  "C\u0327",
+	0x00C8:  "E\u0300",
+	0x00C9:  "E\u0301",
+	0x00CA:  "E\u0302",
+	0x00CB:  "E\u0308",
+	0x00CC:  "I\u0300",
+	0x00CD:  "I\u0301",
+	0x00CE:  "I\u0302",
+	0x00CF:  "I\u0308",
+	0x00D1:  "N\u0303",
+	0x00D2:  "O\u0300",
+	0x00D3:  "O\u0301",
+	0x00D4:  "O\u0302",
+	0x00D5:  "O\u0303",
+	0x00D6:  "O\u0308",
+	0x00D9:  "U\u0300",
+	0x00DA:  "U\u0301",
+	0x00DB:  "U\u0302",
+	0x00DC:  "U\u0308",
+	0x00DD:  "Y\u0301",
+	0x00E0:  "a\u0300",
+	0x00E1:  "a\u0301",
+	0x00E2:  "a\u0302",
+	0x00E3:  "a\u0303",
+	0x00E4:  "a\u0308",
+	0x00E5:  "a\u030a",
+	0x00E7:  "c\u0327",
+	0x00E8:  "e\u0300",
+	0x00E9:  "e\u0301",
+	0x00EA:  "e\u0302",
+	0x00EB:  "e\u0308",
+	0x00EC:  "i\u0300",
+	0x00ED:  "i\u0301",
+	0x00EE:  "i\u0302",
+	0x00EF:  "i\u0308",
+	0x00F1:  "n\u0303",
+	0x00F2:  "o\u0300",
+	0x00F3:  "o\u0301",
+	0x00F4:  "o\u0302",
+	0x00F5:  "o\u0303",
+	0x00F6:  "o\u0308",
+	0x00F9:  "u\u0300",
+	0x00FA:  "u\u0301",
+	0x00FB:  "u\u0302",
+	0x00FC:  "u\u0308",
+	0x00FD:  "y\u0301",
+	0x00FF:  "y\u0308",
+	0x0100:  "A\u0304",
+	0x0101:  "a\u0304",
+	0x0102:  "A\u0306",
+	0x0103:  "a\u0306",
+	0x0104:  "A\u0328",
+	0x0105:  "a\u0328",
+	0x0106:  "C\u0301",
+	0x0107:  "c\u0301",
+	0x0108:  "C\u0302",
+	0x0109:  "c\u0302",
+	0x010A:  "C\u0307",
+	0x010B:  "c\u0307",
+	0x010C:  "C\u030c",
+	0x010D:  "c\u030c",
+	0x010E:  "D\u030c",
+	0x010F:  "d\u030c",
+	0x0112:  "E\u0304",
+	0x0113:  "e\u0304",
+	0x0114:  "E\u0306",
+	0x0115:  "e\u0306",
+	0x0116:  "E\u0307",
+	0x0117:  "e\u0307",
+	0x0118:  "E\u0328",
+	0x0119:  "e\u0328",
+	0x011A:  "E\u030c",
+	0x011B:  "e\u030c",
+	0x011C:  "G\u0302",
+	0x011D:  "g\u0302",
+	0x011E:  "G\u0306",
+	0x011F:  "g\u0306",
+	0x0120:  "G\u0307",
+	0x0121:  "g\u0307",
+	0x0122:  "G\u0327",
+	0x0123:  "g\u0327",
+	0x0124:  "H\u0302",
+	0x0125:  "h\u0302",
+	0x0128:  "I\u0303",
+	0x0129:  "i\u0303",
+	0x012A:  "I\u0304",
+	0x012B:  "i\u0304",
+	0x012C:  "I\u0306",
+	0x012D:  "i\u0306",
+	0x012E:  "I\u0328",
+	0x012F:  "i\u0328",
+	0x0130:  "I\u0307",
+	0x0134:  "J\u0302",
+	0x0135:  "j\u0302",
+	0x0136:  "K\u0327",
+	0x0137:  "k\u0327",
+	0x0139:  "L\u0301",
+	0x013A:  "l\u0301",
+	0x013B:  "L\u0327",
+	0x013C:  "l\u0327",
+	0x013D:  "L\u030c",
+	0x013E:  "l\u030c",
+	0x0143:  "N\u0301",
+	0x0144:  "n\u0301",
+	0x0145:  "N\u0327",
+	0x0146:  "n\u0327",
+	0x0147:  "N\u030c",
+	0x0148:  "n\u030c",
+	0x014C:  "O\u0304",
+	0x014D:  "o\u0304",
+	0x014E:  "O\u0306",
+	0x014F:  "o\u0306",
+	0x0150:  "O\u030b",
+	0x0151:  "o\u030b",
+	0x0154:  "R\u0301",
+	0x0155:  "r\u0301",
+	0x0156:  "R\u0327",
+	0x0157:  "r\u0327",
+	0x0158:  "R\u030c",
+	0x0159:  "r\u030c",
+	0x015A:  "S\u0301",
+	0x015B:  "s\u0301",
+	0x015C:  "S\u0302",
+	0x015D:  "s\u0302",
+	0x015E:  "S\u0327",
+	0x015F:  "s\u0327",
+	0x0160:  "S\u030c",
+	0x0161:  "s\u030c",
+	0x0162:  "T\u0327",
+	0x0163:  "t\u0327",
+	0x0164:  "T\u030c",
+	0x0165:  "t\u030c",
+	0x0168:  "U\u0303",
+	0x0169:  "u\u0303",
+	0x016A:  "U\u0304",
+	0x016B:  "u\u0304",
+	0x016C:  "U\u0306",
+	0x016D:  "u\u0306",
+	0x016E:  "U\u030a",
+	0x016F:  "u\u030a",
+	0x0170:  "U\u030b",
+	0x0171:  "u\u030b",
+	0x0172:  "U\u0328",
+	0x0173:  "u\u0328",
+	0x0174:  "W\u0302",
+	0x0175:  "w\u0302",
+	0x0176:  "Y\u0302",
+	0x0177:  "y\u0302",
+	0x0178:  "Y\u0308",
+	0x0179:  "Z\u0301",
+	0x017A:  "z\u0301",
+	0x017B:  "Z\u0307",
+	0x017C:  "z\u0307",
+	0x017D:  "Z\u030c",
+	0x017E:  "z\u030c",
+	0x01A0:  "O\u031b",
+	0x01A1:  "o\u031b",
+	0x01AF:  "U\u031b",
+	0x01B0:  "u\u031b",
+	0x01CD:  "A\u030c",
+	0x01CE:  "a\u030c",
+	0x01CF:  "I\u030c",
+	0x01D0:  "i\u030c",
+	0x01D1:  "O\u030c",
+	0x01D2:  "o\u030c",
+	0x01D3:  "U\u030c",
+	0x01D4:  "u\u030c",
+	0x01D5:  "U\u0308\u0304",
+	0x01D6:  "u\u0308\u0304",
+	0x01D7:  "U\u0308\u0301",
+	0x01D8:  "u\u0308\u0301",
+	0x01D9:  "U\u0308\u030c",
+	0x01DA:  "u\u0308\u030c",
+	0x01DB:  "U\u0308\u0300",
+	0x01DC:  "u\u0308\u0300",
+	0x01DE:  "A\u0308\u0304",
+	0x01DF:  "a\u0308\u0304",
+	0x01E0:  "A\u0307\u0304",
+	0x01E1:  "a\u0307\u0304",
+	0x01E2:  "\u00c6\u0304",
+	0x01E3:  "\u00e6\u0304",
+	0x01E6:  "G\u030c",
+	0x01E7:  "g\u030c",
+	0x01E8:  "K\u030c",
+	0x01E9:  "k\u030c",
+	0x01EA:  "O\u0328",
+	0x01EB:  "o\u0328",
+	0x01EC:  "O\u0328\u0304",
+	0x01ED:  "o\u0328\u0304",
+	0x01EE:  "\u01b7\u030c",
+	0x01EF:  "\u0292\u030c",
+	0x01F0:  "j\u030c",
+	0x01F4:  "G\u0301",
+	0x01F5:  "g\u0301",
+	0x01F8:  "N\u0300",
+	0x01F9:  "n\u0300",
+	0x01FA:  "A\u030a\u0301",
+	0x01FB:  "a\u030a\u0301",
+	0x01FC:  "\u00c6\u0301",
+	0x01FD:  "\u00e6\u0301",
+	0x01FE:  "\u00d8\u0301",
+	0x01FF:  "\u00f8\u0301",
+	0x0200:  "A\u030f",
+	0x0201:  "a\u030f",
+	0x0202:  "A\u0311",
+	0x0203:  "a\u0311",
+	0x0204:  "E\u030f",
+	0x0205:  "e\u030f",
+	0x0206:  "E\u0311",
+	0x0207:  "e\u0311",
+	0x0208:  "I\u030f",
+	0x0209:  "i\u030f",
+	0x020A:  "I\u0311",
+	0x020B:  "i\u0311",
+	0x020C:  "O\u030f",
+	0x020D:  "o\u030f",
+	0x020E:  "O\u0311",
+	0x020F:  "o\u0311",
+	0x0210:  "R\u030f",
+	0x0211:  "r\u030f",
+	0x0212:  "R\u0311",
+	0x0213:  "r\u0311",
+	0x0214:  "U\u030f",
+	0x0215:  "u\u030f",
+	0x0216:  "U\u0311",
+	0x0217:  "u\u0311",
+	0x0218:  "S\u0326",
+	0x0219:  "s\u0326",
+	0x021A:  "T\u0326",
+	0x021B:  "t\u0326",
+	0x021E:  "H\u030c",
+	0x021F:  "h\u030c",
+	0x0226:  "A\u0307",
+	0x0227:  "a\u0307",
+	0x0228:  "E\u0327",
+	0x0229:  "e\u0327",
+	0x022A:  "O\u0308\u0304",
+	0x022B:  "o\u0308\u0304",
+	0x022C:  "O\u0303\u0304",
+	0x022D:  "o\u0303\u0304",
+	0x022E:  "O\u0307",
+	0x022F:  "o\u0307",
+	0x0230:  "O\u0307\u0304",
+	0x0231:  "o\u0307\u0304",
+	0x0232:  "Y\u0304",
+	0x0233:  "y\u0304",
+	0x0340:  "\u0300",
+	0x0341:  "\u0301",
+	0x0343:  "\u0313",
+	0x0344:  "\u0308\u0301",
+	0x0374:  "\u02b9",
+	0x037E:  ";",
+	0x0385:  "\u00a8\u0301",
+	0x0386:  "\u0391\u0301",
+	0x0387:  "\u00b7",
+	0x0388:  "\u0395\u0301",
+	0x0389:  "\u0397\u0301",
+	0x038A:  "\u0399\u0301",
+	0x038C:  "\u039f\u0301",
+	0x038E:  "\u03a5\u0301",
+	0x038F:  "\u03a9\u0301",
+	0x0390:  "\u03b9\u0308\u0301",
+	0x03AA:  "\u0399\u0308",
+	0x03AB:  "\u03a5\u0308",
+	0x03AC:  "\u03b1\u0301",
+	0x03AD:  "\u03b5\u0301",
+	0x03AE:  "\u03b7\u0301",
+	0x03AF:  "\u03b9\u0301",
+	0x03B0:  "\u03c5\u0308\u0301",
+	0x03CA:  "\u03b9\u0308",
+	0x03CB:  "\u03c5\u0308",
+	0x03CC:  "\u03bf\u0301",
+	0x03CD:  "\u03c5\u0301",
+	0x03CE:  "\u03c9\u0301",
+	0x03D3:  "\u03d2\u0301",
+	0x03D4:  "\u03d2\u0308",
+	0x0400:  "\u0415\u0300",
+	0x0401:  "\u0415\u0308",
+	0x0403:  "\u0413\u0301",
+	0x0407:  "\u0406\u0308",
+	0x040C:  "\u041a\u0301",
+	0x040D:  "\u0418\u0300",
+	0x040E:  "\u0423\u0306",
+	0x0419:  "\u0418\u0306",
+	0x0439:  "\u0438\u0306",
+	0x0450:  "\u0435\u0300",
+	0x0451:  "\u0435\u0308",
+	0x0453:  "\u0433\u0301",
+	0x0457:  "\u0456\u0308",
+	0x045C:  "\u043a\u0301",
+	0x045D:  "\u0438\u0300",
+	0x045E:  "\u0443\u0306",
+	0x0476:  "\u0474\u030f",
+	0x0477:  "\u0475\u030f",
+	0x04C1:  "\u0416\u0306",
+	0x04C2:  "\u0436\u0306",
+	0x04D0:  "\u0410\u0306",
+	0x04D1:  "\u0430\u0306",
+	0x04D2:  "\u0410\u0308",
+	0x04D3:  "\u0430\u0308",
+	0x04D6:  "\u0415\u0306",
+	0x04D7:  "\u0435\u0306",
+	0x04DA:  "\u04d8\u0308",
+	0x04DB:  "\u04d9\u0308",
+	0x04DC:  "\u0416\u0308",
+	0x04DD:  "\u0436\u0308",
+	0x04DE:  "\u0417\u0308",
+	0x04DF:  "\u0437\u0308",
+	0x04E2:  "\u0418\u0304",
+	0x04E3:  "\u0438\u0304",
+	0x04E4:  "\u0418\u0308",
+	0x04E5:  "\u0438\u0308",
+	0x04E6:  "\u041e\u0308",
+	0x04E7:  "\u043e\u0308",
+	0x04EA:  "\u04e8\u0308",
+	0x04EB:  "\u04e9\u0308",
+	0x04EC:  "\u042d\u0308",
+	0x04ED:  "\u044d\u0308",
+	0x04EE:  "\u0423\u0304",
+	0x04EF:  "\u0443\u0304",
+	0x04F0:  "\u0423\u0308",
+	0x04F1:  "\u0443\u0308",
+	0x04F2:  "\u0423\u030b",
+	0x04F3:  "\u0443\u030b",
+	0x04F4:  "\u0427\u0308",
+	0x04F5:  "\u0447\u0308",
+	0x04F8:  "\u042b\u0308",
+	0x04F9:  "\u044b\u0308",
+	0x0622:  "\u0627\u0653",
+	0x0623:  "\u0627\u0654",
+	0x0624:  "\u0648\u0654",
+	0x0625:  "\u0627\u0655",
+	0x0626:  "\u064a\u0654",
+	0x06C0:  "\u06d5\u0654",
+	0x06C2:  "\u06c1\u0654",
+	0x06D3:  "\u06d2\u0654",
+	0x0929:  "\u0928\u093c",
+	0x0931:  "\u0930\u093c",
+	0x0934:  "\u0933\u093c",
+	0x0958:  "\u0915\u093c",
+	0x0959:  "\u0916\u093c",
+	0x095A:  "\u0917\u093c",
+	0x095B:  "\u091c\u093c",
+	0x095C:  "\u0921\u093c",
+	0x095D:  "\u0922\u093c",
+	0x095E:  "\u092b\u093c",
+	0x095F:  "\u092f\u093c",
+	0x09CB:  "\u09c7\u09be",
+	0x09CC:  "\u09c7\u09d7",
+	0x09DC:  "\u09a1\u09bc",
+	0x09DD:  "\u09a2\u09bc",
+	0x09DF:  "\u09af\u09bc",
+	0x0A33:  "\u0a32\u0a3c",
+	0x0A36:  "\u0a38\u0a3c",
+	0x0A59:  "\u0a16\u0a3c",
+	0x0A5A:  "\u0a17\u0a3c",
+	0x0A5B:  "\u0a1c\u0a3c",
+	0x0A5E:  "\u0a2b\u0a3c",
+	0x0B48:  "\u0b47\u0b56",
+	0x0B4B:  "\u0b47\u0b3e",
+	0x0B4C:  "\u0b47\u0b57",
+	0x0B5C:  "\u0b21\u0b3c",
+	0x0B5D:  "\u0b22\u0b3c",
+	0x0B94:  "\u0b92\u0bd7",
+	0x0BCA:  "\u0bc6\u0bbe",
+	0x0BCB:  "\u0bc7\u0bbe",
+	0x0BCC:  "\u0bc6\u0bd7",
+	0x0C48:  "\u0c46\u0c56",
+	0x0CC0:  "\u0cbf\u0cd5",
+	0x0CC7:  "\u0cc6\u0cd5",
+	0x0CC8:  "\u0cc6\u0cd6",
+	0x0CCA:  "\u0cc6\u0cc2",
+	0x0CCB:  "\u0cc6\u0cc2\u0cd5",
+	0x0D4A:  "\u0d46\u0d3e",
+	0x0D4B:  "\u0d47\u0d3e",
+	0x0D4C:  "\u0d46\u0d57",
+	0x0DDA:  "\u0dd9\u0dca",
+	0x0DDC:  "\u0dd9\u0dcf",
+	0x0DDD:  "\u0dd9\u0dcf\u0dca",
+	0x0DDE:  "\u0dd9\u0ddf",
+	0x0F43:  "\u0f42\u0fb7",
+	0x0F4D:  "\u0f4c\u0fb7",
+	0x0F52:  "\u0f51\u0fb7",
+	0x0F57:  "\u0f56\u0fb7",
+	0x0F5C:  "\u0f5b\u0fb7",
+	0x0F69:  "\u0f40\u0fb5",
+	0x0F73:  "\u0f71\u0f72",
+	0x0F75:  "\u0f71\u0f74",
+	0x0F76:  "\u0fb2\u0f80",
+	0x0F78:  "\u0fb3\u0f80",
+	0x0F81:  "\u0f71\u0f80",
+	0x0F93:  "\u0f92\u0fb7",
+	0x0F9D:  "\u0f9c\u0fb7",
+	0x0FA2:  "\u0fa1\u0fb7",
+	0x0FA7:  "\u0fa6\u0fb7",
+	0x0FAC:  "\u0fab\u0fb7",
+	0x0FB9:  "\u0f90\u0fb5",
+	0x1026:  "\u1025\u102e",
+	0x1B06:  "\u1b05\u1b35",
+	0x1B08:  "\u1b07\u1b35",
+	0x1B0A:  "\u1b09\u1b35",
+	0x1B0C:  "\u1b0b\u1b35",
+	0x1B0E:  "\u1b0d\u1b35",
+	0x1B12:  "\u1b11\u1b35",
+	0x1B3B:  "\u1b3a\u1b35",
+	0x1B3D:  "\u1b3c\u1b35",
+	0x1B40:  "\u1b3e\u1b35",
+	0x1B41:  "\u1b3f\u1b35",
+	0x1B43:  "\u1b42\u1b35",
+	0x1E00:  "A\u0325",
+	0x1E01:  "a\u0325",
+	0x1E02:  "B\u0307",
+	0x1E03:  "b\u0307",
+	0x1E04:  "B\u0323",
+	0x1E05:  "b\u0323",
+	0x1E06:  "B\u0331",
+	0x1E07:  "b\u0331",
+	0x1E08:  "C\u0327\u0301",
+	0x1E09:  "c\u0327\u0301",
+	0x1E0A:  "D\u0307",
+	0x1E0B:  "d\u0307",
+	0x1E0C:  "D\u0323",
+	0x1E0D:  "d\u0323",
+	0x1E0E:  "D\u0331",
+	0x1E0F:  "d\u0331",
+	0x1E10:  "D\u0327",
+	0x1E11:  "d\u0327",
+	0x1E12:  "D\u032d",
+	0x1E13:  "d\u032d",
+	0x1E14:  "E\u0304\u0300",
+	0x1E15:  "e\u0304\u0300",
+	0x1E16:  "E\u0304\u0301",
+	0x1E17:  "e\u0304\u0301",
+	0x1E18:  "E\u032d",
+	0x1E19:  "e\u032d",
+	0x1E1A:  "E\u0330",
+	0x1E1B:  "e\u0330",
+	0x1E1C:  "E\u0327\u0306",
+	0x1E1D:  "e\u0327\u0306",
+	0x1E1E:  "F\u0307",
+	0x1E1F:  "f\u0307",
+	0x1E20:  "G\u0304",
+	0x1E21:  "g\u0304",
+	0x1E22:  "H\u0307",
+	0x1E23:  "h\u0307",
+	0x1E24:  "H\u0323",
+	0x1E25:  "h\u0323",
+	0x1E26:  "H\u0308",
+	0x1E27:  "h\u0308",
+	0x1E28:  "H\u0327",
+	0x1E29:  "h\u0327",
+	0x1E2A:  "H\u032e",
+	0x1E2B:  "h\u032e",
+	0x1E2C:  "I\u0330",
+	0x1E2D:  "i\u0330",
+	0x1E2E:  "I\u0308\u0301",
+	0x1E2F:  "i\u0308\u0301",
+	0x1E30:  "K\u0301",
+	0x1E31:  "k\u0301",
+	0x1E32:  "K\u0323",
+	0x1E33:  "k\u0323",
+	0x1E34:  "K\u0331",
+	0x1E35:  "k\u0331",
+	0x1E36:  "L\u0323",
+	0x1E37:  "l\u0323",
+	0x1E38:  "L\u0323\u0304",
+	0x1E39:  "l\u0323\u0304",
+	0x1E3A:  "L\u0331",
+	0x1E3B:  "l\u0331",
+	0x1E3C:  "L\u032d",
+	0x1E3D:  "l\u032d",
+	0x1E3E:  "M\u0301",
+	0x1E3F:  "m\u0301",
+	0x1E40:  "M\u0307",
+	0x1E41:  "m\u0307",
+	0x1E42:  "M\u0323",
+	0x1E43:  "m\u0323",
+	0x1E44:  "N\u0307",
+	0x1E45:  "n\u0307",
+	0x1E46:  "N\u0323",
+	0x1E47:  "n\u0323",
+	0x1E48:  "N\u0331",
+	0x1E49:  "n\u0331",
+	0x1E4A:  "N\u032d",
+	0x1E4B:  "n\u032d",
+	0x1E4C:  "O\u0303\u0301",
+	0x1E4D:  "o\u0303\u0301",
+	0x1E4E:  "O\u0303\u0308",
+	0x1E4F:  "o\u0303\u0308",
+	0x1E50:  "O\u0304\u0300",
+	0x1E51:  "o\u0304\u0300",
+	0x1E52:  "O\u0304\u0301",
+	0x1E53:  "o\u0304\u0301",
+	0x1E54:  "P\u0301",
+	0x1E55:  "p\u0301",
+	0x1E56:  "P\u0307",
+	0x1E57:  "p\u0307",
+	0x1E58:  "R\u0307",
+	0x1E59:  "r\u0307",
+	0x1E5A:  "R\u0323",
+	0x1E5B:  "r\u0323",
+	0x1E5C:  "R\u0323\u0304",
+	0x1E5D:  "r\u0323\u0304",
+	0x1E5E:  "R\u0331",
+	0x1E5F:  "r\u0331",
+	0x1E60:  "S\u0307",
+	0x1E61:  "s\u0307",
+	0x1E62:  "S\u0323",
+	0x1E63:  "s\u0323",
+	0x1E64:  "S\u0301\u0307",
+	0x1E65:  "s\u0301\u0307",
+	0x1E66:  "S\u030c\u0307",
+	0x1E67:  "s\u030c\u0307",
+	0x1E68:  "S\u0323\u0307",
+	0x1E69:  "s\u0323\u0307",
+	0x1E6A:  "T\u0307",
+	0x1E6B:  "t\u0307",
+	0x1E6C:  "T\u0323",
+	0x1E6D:  "t\u0323",
+	0x1E6E:  "T\u0331",
+	0x1E6F:  "t\u0331",
+	0x1E70:  "T\u032d",
+	0x1E71:  "t\u032d",
+	0x1E72:  "U\u0324",
+	0x1E73:  "u\u0324",
+	0x1E74:  "U\u0330",
+	0x1E75:  "u\u0330",
+	0x1E76:  "U\u032d",
+	0x1E77:  "u\u032d",
+	0x1E78:  "U\u0303\u0301",
+	0x1E79:  "u\u0303\u0301",
+	0x1E7A:  "U\u0304\u0308",
+	0x1E7B:  "u\u0304\u0308",
+	0x1E7C:  "V\u0303",
+	0x1E7D:  "v\u0303",
+	0x1E7E:  "V\u0323",
+	0x1E7F:  "v\u0323",
+	0x1E80:  "W\u0300",
+	0x1E81:  "w\u0300",
+	0x1E82:  "W\u0301",
+	0x1E83:  "w\u0301",
+	0x1E84:  "W\u0308",
+	0x1E85:  "w\u0308",
+	0x1E86:  "W\u0307",
+	0x1E87:  "w\u0307",
+	0x1E88:  "W\u0323",
+	0x1E89:  "w\u0323",
+	0x1E8A:  "X\u0307",
+	0x1E8B:  "x\u0307",
+	0x1E8C:  "X\u0308",
+	0x1E8D:  "x\u0308",
+	0x1E8E:  "Y\u0307",
+	0x1E8F:  "y\u0307",
+	0x1E90:  "Z\u0302",
+	0x1E91:  "z\u0302",
+	0x1E92:  "Z\u0323",
+	0x1E93:  "z\u0323",
+	0x1E94:  "Z\u0331",
+	0x1E95:  "z\u0331",
+	0x1E96:  "h\u0331",
+	0x1E97:  "t\u0308",
+	0x1E98:  "w\u030a",
+	0x1E99:  "y\u030a",
+	0x1E9B:  "\u017f\u0307",
+	0x1EA0:  "A\u0323",
+	0x1EA1:  "a\u0323",
+	0x1EA2:  "A\u0309",
+	0x1EA3:  "a\u0309",
+	0x1EA4:  "A\u0302\u0301",
+	0x1EA5:  "a\u0302\u0301",
+	0x1EA6:  "A\u0302\u0300",
+	0x1EA7:  "a\u0302\u0300",
+	0x1EA8:  "A\u0302\u0309",
+	0x1EA9:  "a\u0302\u0309",
+	0x1EAA:  "A\u0302\u0303",
+	0x1EAB:  "a\u0302\u0303",
+	0x1EAC:  "A\u0323\u0302",
+	0x1EAD:  "a\u0323\u0302",
+	0x1EAE:  "A\u0306\u0301",
+	0x1EAF:  "a\u0306\u0301",
+	0x1EB0:  "A\u0306\u0300",
+	0x1EB1:  "a\u0306\u0300",
+	0x1EB2:  "A\u0306\u0309",
+	0x1EB3:  "a\u0306\u0309",
+	0x1EB4:  "A\u0306\u0303",
+	0x1EB5:  "a\u0306\u0303",
+	0x1EB6:  "A\u0323\u0306",
+	0x1EB7:  "a\u0323\u0306",
+	0x1EB8:  "E\u0323",
+	0x1EB9:  "e\u0323",
+	0x1EBA:  "E\u0309",
+	0x1EBB:  "e\u0309",
+	0x1EBC:  "E\u0303",
+	0x1EBD:  "e\u0303",
+	0x1EBE:  "E\u0302\u0301",
+	0x1EBF:  "e\u0302\u0301",
+	0x1EC0:  "E\u0302\u0300",
+	0x1EC1:  "e\u0302\u0300",
+	0x1EC2:  "E\u0302\u0309",
+	0x1EC3:  "e\u0302\u0309",
+	0x1EC4:  "E\u0302\u0303",
+	0x1EC5:  "e\u0302\u0303",
+	0x1EC6:  "E\u0323\u0302",
+	0x1EC7:  "e\u0323\u0302",
+	0x1EC8:  "I\u0309",
+	0x1EC9:  "i\u0309",
+	0x1ECA:  "I\u0323",
+	0x1ECB:  "i\u0323",
+	0x1ECC:  "O\u0323",
+	0x1ECD:  "o\u0323",
+	0x1ECE:  "O\u0309",
+	0x1ECF:  "o\u0309",
+	0x1ED0:  "O\u0302\u0301",
+	0x1ED1:  "o\u0302\u0301",
+	0x1ED2:  "O\u0302\u0300",
+	0x1ED3:  "o\u0302\u0300",
+	0x1ED4:  "O\u0302\u0309",
+	0x1ED5:  "o\u0302\u0309",
+	0x1ED6:  "O\u0302\u0303",
+	0x1ED7:  "o\u0302\u0303",
+	0x1ED8:  "O\u0323\u0302",
+	0x1ED9:  "o\u0323\u0302",
+	0x1EDA:  "O\u031b\u0301",
+	0x1EDB:  "o\u031b\u0301",
+	0x1EDC:  "O\u031b\u0300",
+	0x1EDD:  "o\u031b\u0300",
+	0x1EDE:  "O\u031b\u0309",
+	0x1EDF:  "o\u031b\u0309",
+	0x1EE0:  "O\u031b\u0303",
+	0x1EE1:  "o\u031b\u0303",
+	0x1EE2:  "O\u031b\u0323",
+	0x1EE3:  "o\u031b\u0323",
+	0x1EE4:  "U\u0323",
+	0x1EE5:  "u\u0323",
+	0x1EE6:  "U\u0309",
+	0x1EE7:  "u\u0309",
+	0x1EE8:  "U\u031b\u0301",
+	0x1EE9:  "u\u031b\u0301",
+	0x1EEA:  "U\u031b\u0300",
+	0x1EEB:  "u\u031b\u0300",
+	0x1EEC:  "U\u031b\u0309",
+	0x1EED:  "u\u031b\u0309",
+	0x1EEE:  "U\u031b\u0303",
+	0x1EEF:  "u\u031b\u0303",
+	0x1EF0:  "U\u031b\u0323",
+	0x1EF1:  "u\u031b\u0323",
+	0x1EF2:  "Y\u0300",
+	0x1EF3:  "y\u0300",
+	0x1EF4:  "Y\u0323",
+	0x1EF5:  "y\u0323",
+	0x1EF6:  "Y\u0309",
+	0x1EF7:  "y\u0309",
+	0x1EF8:  "Y\u0303",
+	0x1EF9:  "y\u0303",
+	0x1F00:  "\u03b1\u0313",
+	0x1F01:  "\u03b1\u0314",
+	0x1F02:  "\u03b1\u0313\u0300",
+	0x1F03:  "\u03b1\u0314\u0300",
+	0x1F04:  "\u03b1\u0313\u0301",
+	0x1F05:  "\u03b1\u0314\u0301",
+	0x1F06:  "\u03b1\u0313\u0342",
+	0x1F07:  "\u03b1\u0314\u0342",
+	0x1F08:  "\u0391\u0313",
+	0x1F09:  "\u0391\u0314",
+	0x1F0A:  "\u0391\u0313\u0300",
+	0x1F0B:  "\u0391\u0314\u0300",
+	0x1F0C:  "\u0391\u0313\u0301",
+	0x1F0D:  "\u0391\u0314\u0301",
+	0x1F0E:  "\u0391\u0313\u0342",
+	0x1F0F:  "\u0391\u0314\u0342",
+	0x1F10:  "\u03b5\u0313",
+	0x1F11:  "\u03b5\u0314",
+	0x1F12:  "\u03b5\u0313\u0300",
+	0x1F13:  "\u03b5\u0314\u0300",
+	0x1F14:  "\u03b5\u0313\u0301",
+	0x1F15:  "\u03b5\u0314\u0301",
+	0x1F18:  "\u0395\u0313",
+	0x1F19:  "\u0395\u0314",
+	0x1F1A:  "\u0395\u0313\u0300",
+	0x1F1B:  "\u0395\u0314\u0300",
+	0x1F1C:  "\u0395\u0313\u0301",
+	0x1F1D:  "\u0395\u0314\u0301",
+	0x1F20:  "\u03b7\u0313",
+	0x1F21:  "\u03b7\u0314",
+	0x1F22:  "\u03b7\u0313\u0300",
+	0x1F23:  "\u03b7\u0314\u0300",
+	0x1F24:  "\u03b7\u0313\u0301",
+	0x1F25:  "\u03b7\u0314\u0301",
+	0x1F26:  "\u03b7\u0313\u0342",
+	0x1F27:  "\u03b7\u0314\u0342",
+	0x1F28:  "\u0397\u0313",
+	0x1F29:  "\u0397\u0314",
+	0x1F2A:  "\u0397\u0313\u0300",
+	0x1F2B:  "\u0397\u0314\u0300",
+	0x1F2C:  "\u0397\u0313\u0301",
+	0x1F2D:  "\u0397\u0314\u0301",
+	0x1F2E:  "\u0397\u0313\u0342",
+	0x1F2F:  "\u0397\u0314\u0342",
+	0x1F30:  "\u03b9\u0313",
+	0x1F31:  "\u03b9\u0314",
+	0x1F32:  "\u03b9\u0313\u0300",
+	0x1F33:  "\u03b9\u0314\u0300",
+	0x1F34:  "\u03b9\u0313\u0301",
+	0x1F35:  "\u03b9\u0314\u0301",
+	0x1F36:  "\u03b9\u0313\u0342",
+	0x1F37:  "\u03b9\u0314\u0342",
+	0x1F38:  "\u0399\u0313",
+	0x1F39:  "\u0399\u0314",
+	0x1F3A:  "\u0399\u0313\u0300",
+	0x1F3B:  "\u0399\u0314\u0300",
+	0x1F3C:  "\u0399\u0313\u0301",
+	0x1F3D:  "\u0399\u0314\u0301",
+	0x1F3E:  "\u0399\u0313\u0342",
+	0x1F3F:  "\u0399\u0314\u0342",
+	0x1F40:  "\u03bf\u0313",
+	0x1F41:  "\u03bf\u0314",
+	0x1F42:  "\u03bf\u0313\u0300",
+	0x1F43:  "\u03bf\u0314\u0300",
+	0x1F44:  "\u03bf\u0313\u0301",
+	0x1F45:  "\u03bf\u0314\u0301",
+	0x1F48:  "\u039f\u0313",
+	0x1F49:  "\u039f\u0314",
+	0x1F4A:  "\u039f\u0313\u0300",
+	0x1F4B:  "\u039f\u0314\u0300",
+	0x1F4C:  "\u039f\u0313\u0301",
+	0x1F4D:  "\u039f\u0314\u0301",
+	0x1F50:  "\u03c5\u0313",
+	0x1F51:  "\u03c5\u0314",
+	0x1F52:  "\u03c5\u0313\u0300",
+	0x1F53:  "\u03c5\u0314\u0300",
+	0x1F54:  "\u03c5\u0313\u0301",
+	0x1F55:  "\u03c5\u0314\u0301",
+	0x1F56:  "\u03c5\u0313\u0342",
+	0x1F57:  "\u03c5\u0314\u0342",
+	0x1F59:  "\u03a5\u0314",
+	0x1F5B:  "\u03a5\u0314\u0300",
+	0x1F5D:  "\u03a5\u0314\u0301",
+	0x1F5F:  "\u03a5\u0314\u0342",
+	0x1F60:  "\u03c9\u0313",
+	0x1F61:  "\u03c9\u0314",
+	0x1F62:  "\u03c9\u0313\u0300",
+	0x1F63:  "\u03c9\u0314\u0300",
+	0x1F64:  "\u03c9\u0313\u0301",
+	0x1F65:  "\u03c9\u0314\u0301",
+	0x1F66:  "\u03c9\u0313\u0342",
+	0x1F67:  "\u03c9\u0314\u0342",
+	0x1F68:  "\u03a9\u0313",
+	0x1F69:  "\u03a9\u0314",
+	0x1F6A:  "\u03a9\u0313\u0300",
+	0x1F6B:  "\u03a9\u0314\u0300",
+	0x1F6C:  "\u03a9\u0313\u0301",
+	0x1F6D:  "\u03a9\u0314\u0301",
+	0x1F6E:  "\u03a9\u0313\u0342",
+	0x1F6F:  "\u03a9\u0314\u0342",
+	0x1F70:  "\u03b1\u0300",
+	0x1F71:  "\u03b1\u0301",
+	0x1F72:  "\u03b5\u0300",
+	0x1F73:  "\u03b5\u0301",
+	0x1F74:  "\u03b7\u0300",
+	0x1F75:  "\u03b7\u0301",
+	0x1F76:  "\u03b9\u0300",
+	0x1F77:  "\u03b9\u0301",
+	0x1F78:  "\u03bf\u0300",
+	0x1F79:  "\u03bf\u0301",
+	0x1F7A:  "\u03c5\u0300",
+	0x1F7B:  "\u03c5\u0301",
+	0x1F7C:  "\u03c9\u0300",
+	0x1F7D:  "\u03c9\u0301",
+	0x1F80:  "\u03b1\u0313\u0345",
+	0x1F81:  "\u03b1\u0314\u0345",
+	0x1F82:  "\u03b1\u0313\u0300\u0345",
+	0x1F83:  "\u03b1\u0314\u0300\u0345",
+	0x1F84:  "\u03b1\u0313\u0301\u0345",
+	0x1F85:  "\u03b1\u0314\u0301\u0345",
+	0x1F86:  "\u03b1\u0313\u0342\u0345",
+	0x1F87:  "\u03b1\u0314\u0342\u0345",
+	0x1F88:  "\u0391\u0313\u0345",
+	0x1F89:  "\u0391\u0314\u0345",
+	0x1F8A:  "\u0391\u0313\u0300\u0345",
+	0x1F8B:  "\u0391\u0314\u0300\u0345",
+	0x1F8C:  "\u0391\u0313\u0301\u0345",
+	0x1F8D:  "\u0391\u0314\u0301\u0345",
+	0x1F8E:  "\u0391\u0313\u0342\u0345",
+	0x1F8F:  "\u0391\u0314\u0342\u0345",
+	0x1F90:  "\u03b7\u0313\u0345",
+	0x1F91:  "\u03b7\u0314\u0345",
+	0x1F92:  "\u03b7\u0313\u0300\u0345",
+	0x1F93:  "\u03b7\u0314\u0300\u0345",
+	0x1F94:  "\u03b7\u0313\u0301\u0345",
+	0x1F95:  "\u03b7\u0314\u0301\u0345",
+	0x1F96:  "\u03b7\u0313\u0342\u0345",
+	0x1F97:  "\u03b7\u0314\u0342\u0345",
+	0x1F98:  "\u0397\u0313\u0345",
+	0x1F99:  "\u0397\u0314\u0345",
+	0x1F9A:  "\u0397\u0313\u0300\u0345",
+	0x1F9B:  "\u0397\u0314\u0300\u0345",
+	0x1F9C:  "\u0397\u0313\u0301\u0345",
+	0x1F9D:  "\u0397\u0314\u0301\u0345",
+	0x1F9E:  "\u0397\u0313\u0342\u0345",
+	0x1F9F:  "\u0397\u0314\u0342\u0345",
+	0x1FA0:  "\u03c9\u0313\u0345",
+	0x1FA1:  "\u03c9\u0314\u0345",
+	0x1FA2:  "\u03c9\u0313\u0300\u0345",
+	0x1FA3:  "\u03c9\u0314\u0300\u0345",
+	0x1FA4:  "\u03c9\u0313\u0301\u0345",
+	0x1FA5:  "\u03c9\u0314\u0301\u0345",
+	0x1FA6:  "\u03c9\u0313\u0342\u0345",
+	0x1FA7:  "\u03c9\u0314\u0342\u0345",
+	0x1FA8:  "\u03a9\u0313\u0345",
+	0x1FA9:  "\u03a9\u0314\u0345",
+	0x1FAA:  "\u03a9\u0313\u0300\u0345",
+	0x1FAB:  "\u03a9\u0314\u0300\u0345",
+	0x1FAC:  "\u03a9\u0313\u0301\u0345",
+	0x1FAD:  "\u03a9\u0314\u0301\u0345",
+	0x1FAE:  "\u03a9\u0313\u0342\u0345",
+	0x1FAF:  "\u03a9\u0314\u0342\u0345",
+	0x1FB0:  "\u03b1\u0306",
+	0x1FB1:  "\u03b1\u0304",
+	0x1FB2:  "\u03b1\u0300\u0345",
+	0x1FB3:  "\u03b1\u0345",
+	0x1FB4:  "\u03b1\u0301\u0345",
+	0x1FB6:  "\u03b1\u0342",
+	0x1FB7:  "\u03b1\u0342\u0345",
+	0x1FB8:  "\u0391\u0306",
+	0x1FB9:  "\u0391\u0304",
+	0x1FBA:  "\u0391\u0300",
+	0x1FBB:  "\u0391\u0301",
+	0x1FBC:  "\u0391\u0345",
+	0x1FBE:  "\u03b9",
+	0x1FC1:  "\u00a8\u0342",
+	0x1FC2:  "\u03b7\u0300\u0345",
+	0x1FC3:  "\u03b7\u0345",
+	0x1FC4:  "\u03b7\u0301\u0345",
+	0x1FC6:  "\u03b7\u0342",
+	0x1FC7:  "\u03b7\u0342\u0345",
+	0x1FC8:  "\u0395\u0300",
+	0x1FC9:  "\u0395\u0301",
+	0x1FCA:  "\u0397\u0300",
+	0x1FCB:  "\u0397\u0301",
+	0x1FCC:  "\u0397\u0345",
+	0x1FCD:  "\u1fbf\u0300",
+	0x1FCE:  "\u1fbf\u0301",
+	0x1FCF:  "\u1fbf\u0342",
+	0x1FD0:  "\u03b9\u0306",
+	0x1FD1:  "\u03b9\u0304",
+	0x1FD2:  "\u03b9\u0308\u0300",
+	0x1FD3:  "\u03b9\u0308\u0301",
+	0x1FD6:  "\u03b9\u0342",
+	0x1FD7:  "\u03b9\u0308\u0342",
+	0x1FD8:  "\u0399\u0306",
+	0x1FD9:  "\u0399\u0304",
+	0x1FDA:  "\u0399\u0300",
+	0x1FDB:  "\u0399\u0301",
+	0x1FDD:  "\u1ffe\u0300",
+	0x1FDE:  "\u1ffe\u0301",
+	0x1FDF:  "\u1ffe\u0342",
+	0x1FE0:  "\u03c5\u0306",
+	0x1FE1:  "\u03c5\u0304",
+	0x1FE2:  "\u03c5\u0308\u0300",
+	0x1FE3:  "\u03c5\u0308\u0301",
+	0x1FE4:  "\u03c1\u0313",
+	0x1FE5:  "\u03c1\u0314",
+	0x1FE6:  "\u03c5\u0342",
+	0x1FE7:  "\u03c5\u0308\u0342",
+	0x1FE8:  "\u03a5\u0306",
+	0x1FE9:  "\u03a5\u0304",
+	0x1FEA:  "\u03a5\u0300",
+	0x1FEB:  "\u03a5\u0301",
+	0x1FEC:  "\u03a1\u0314",
+	0x1FED:  "\u00a8\u0300",
+	0x1FEE:  "\u00a8\u0301",
+	0x1FEF:  "`",
+	0x1FF2:  "\u03c9\u0300\u0345",
+	0x1FF3:  "\u03c9\u0345",
+	0x1FF4:  "\u03c9\u0301\u0345",
+	0x1FF6:  "\u03c9\u0342",
+	0x1FF7:  "\u03c9\u0342\u0345",
+	0x1FF8:  "\u039f\u0300",
+	0x1FF9:  "\u039f\u0301",
+	0x1FFA:  "\u03a9\u0300",
+	0x1FFB:  "\u03a9\u0301",
+	0x1FFC:  "\u03a9\u0345",
+	0x1FFD:  "\u00b4",
+	0x2000:  "\u2002",
+	0x2001:  "\u2003",
+	0x2126:  "\u03a9",
+	0x212A:  "K",
+	0x212B:  "A\u030a",
+	0x219A:  "\u2190\u0338",
+	0x219B:  "\u2192\u0338",
+	0x21AE:  "\u2194\u0338",
+	0x21CD:  "\u21d0\u0338",
+	0x21CE:  "\u21d4\u0338",
+	0x21CF:  "\u21d2\u0338",
+	0x2204:  "\u2203\u0338",
+	0x2209:  "\u2208\u0338",
+	0x220C:  "\u220b\u0338",
+	0x2224:  "\u2223\u0338",
+	0x2226:  "\u2225\u0338",
+	0x2241:  "\u223c\u0338",
+	0x2244:  "\u2243\u0338",
+	0x2247:  "\u2245\u0338",
+	0x2249:  "\u2248\u0338",
+	0x2260:  "=\u0338",
+	0x2262:  "\u2261\u0338",
+	0x226D:  "\u224d\u0338",
+	0x226E:  "<\u0338",
+	0x226F:  ">\u0338",
+	0x2270:  "\u2264\u0338",
+	0x2271:  "\u2265\u0338",
+	0x2274:  "\u2272\u0338",
+	0x2275:  "\u2273\u0338",
+	0x2278:  "\u2276\u0338",
+	0x2279:  "\u2277\u0338",
+	0x2280:  "\u227a\u0338",
+	0x2281:  "\u227b\u0338",
+	0x2284:  "\u2282\u0338",
+	0x2285:  "\u2283\u0338",
+	0x2288:  "\u2286\u0338",
+	0x2289:  "\u2287\u0338",
+	0x22AC:  "\u22a2\u0338",
+	0x22AD:  "\u22a8\u0338",
+	0x22AE:  "\u22a9\u0338",
+	0x22AF:  "\u22ab\u0338",
+	0x22E0:  "\u227c\u0338",
+	0x22E1:  "\u227d\u0338",
+	0x22E2:  "\u2291\u0338",
+	0x22E3:  "\u2292\u0338",
+	0x22EA:  "\u22b2\u0338",
+	0x22EB:  "\u22b3\u0338",
+	0x22EC:  "\u22b4\u0338",
+	0x22ED:  "\u22b5\u0338",
+	0x2329:  "\u3008",
+	0x232A:  "\u3009",
+	0x2ADC:  "\u2add\u0338",
+	0x304C:  "\u304b\u3099",
+	0x304E:  "\u304d\u3099",
+	0x3050:  "\u304f\u3099",
+	0x3052:  "\u3051\u3099",
+	0x3054:  "\u3053\u3099",
+	0x3056:  "\u3055\u3099",
+	0x3058:  "\u3057\u3099",
+	0x305A:  "\u3059\u3099",
+	0x305C:  "\u305b\u3099",
+	0x305E:  "\u305d\u3099",
+	0x3060:  "\u305f\u3099",
+	0x3062:  "\u3061\u3099",
+	0x3065:  "\u3064\u3099",
+	0x3067:  "\u3066\u3099",
+	0x3069:  "\u3068\u3099",
+	0x3070:  "\u306f\u3099",
+	0x3071:  "\u306f\u309a",
+	0x3073:  "\u3072\u3099",
+	0x3074:  "\u3072\u309a",
+	0x3076:  "\u3075\u3099",
+	0x3077:  "\u3075\u309a",
+	0x3079:  "\u3078\u3099",
+	0x307A:  "\u3078\u309a",
+	0x307C:  "\u307b\u3099",
+	0x307D:  "\u307b\u309a",
+	0x3094:  "\u3046\u3099",
+	0x309E:  "\u309d\u3099",
+	0x30AC:  "\u30ab\u3099",
+	0x30AE:  "\u30ad\u3099",
+	0x30B0:  "\u30af\u3099",
+	0x30B2:  "\u30b1\u3099",
+	0x30B4:  "\u30b3\u3099",
+	0x30B6:  "\u30b5\u3099",
+	0x30B8:  "\u30b7\u3099",
+	0x30BA:  "\u30b9\u3099",
+	0x30BC:  "\u30bb\u3099",
+	0x30BE:  "\u30bd\u3099",
+	0x30C0:  "\u30bf\u3099",
+	0x30C2:  "\u30c1\u3099",
+	0x30C5:  "\u30c4\u3099",
+	0x30C7:  "\u30c6\u3099",
+	0x30C9:  "\u30c8\u3099",
+	0x30D0:  "\u30cf\u3099",
+	0x30D1:  "\u30cf\u309a",
+	0x30D3:  "\u30d2\u3099",
+	0x30D4:  "\u30d2\u309a",
+	0x30D6:  "\u30d5\u3099",
+	0x30D7:  "\u30d5\u309a",
+	0x30D9:  "\u30d8\u3099",
+	0x30DA:  "\u30d8\u309a",
+	0x30DC:  "\u30db\u3099",
+	0x30DD:  "\u30db\u309a",
+	0x30F4:  "\u30a6\u3099",
+	0x30F7:  "\u30ef\u3099",
+	0x30F8:  "\u30f0\u3099",
+	0x30F9:  "\u30f1\u3099",
+	0x30FA:  "\u30f2\u3099",
+	0x30FE:  "\u30fd\u3099",
+	0xF900:  "\u8c48",
+	0xF901:  "\u66f4",
+	0xF902:  "\u8eca",
+	0xF903:  "\u8cc8",
+	0xF904:  "\u6ed1",
+	0xF905:  "\u4e32",
+	0xF906:  "\u53e5",
+	0xF907:  "\u9f9c",
+	0xF908:  "\u9f9c",
+	0xF909:  "\u5951",
+	0xF90A:  "\u91d1",
+	0xF90B:  "\u5587",
+	0xF90C:  "\u5948",
+	0xF90D:  "\u61f6",
+	0xF90E:  "\u7669",
+	0xF90F:  "\u7f85",
+	0xF910:  "\u863f",
+	0xF911:  "\u87ba",
+	0xF912:  "\u88f8",
+	0xF913:  "\u908f",
+	0xF914:  "\u6a02",
+	0xF915:  "\u6d1b",
+	0xF916:  "\u70d9",
+	0xF917:  "\u73de",
+	0xF918:  "\u843d",
+	0xF919:  "\u916a",
+	0xF91A:  "\u99f1",
+	0xF91B:  "\u4e82",
+	0xF91C:  "\u5375",
+	0xF91D:  "\u6b04",
+	0xF91E:  "\u721b",
+	0xF91F:  "\u862d",
+	0xF920:  "\u9e1e",
+	0xF921:  "\u5d50",
+	0xF922:  "\u6feb",
+	0xF923:  "\u85cd",
+	0xF924:  "\u8964",
+	0xF925:  "\u62c9",
+	0xF926:  "\u81d8",
+	0xF927:  "\u881f",
+	0xF928:  "\u5eca",
+	0xF929:  "\u6717",
+	0xF92A:  "\u6d6a",
+	0xF92B:  "\u72fc",
+	0xF92C:  "\u90ce",
+	0xF92D:  "\u4f86",
+	0xF92E:  "\u51b7",
+	0xF92F:  "\u52de",
+	0xF930:  "\u64c4",
+	0xF931:  "\u6ad3",
+	0xF932:  "\u7210",
+	0xF933:  "\u76e7",
+	0xF934:  "\u8001",
+	0xF935:  "\u8606",
+	0xF936:  "\u865c",
+	0xF937:  "\u8def",
+	0xF938:  "\u9732",
+	0xF939:  "\u9b6f",
+	0xF93A:  "\u9dfa",
+	0xF93B:  "\u788c",
+	0xF93C:  "\u797f",
+	0xF93D:  "\u7da0",
+	0xF93E:  "\u83c9",
+	0xF93F:  "\u9304",
+	0xF940:  "\u9e7f",
+	0xF941:  "\u8ad6",
+	0xF942:  "\u58df",
+	0xF943:  "\u5f04",
+	0xF944:  "\u7c60",
+	0xF945:  "\u807e",
+	0xF946:  "\u7262",
+	0xF947:  "\u78ca",
+	0xF948:  "\u8cc2",
+	0xF949:  "\u96f7",
+	0xF94A:  "\u58d8",
+	0xF94B:  "\u5c62",
+	0xF94C:  "\u6a13",
+	0xF94D:  "\u6dda",
+	0xF94E:  "\u6f0f",
+	0xF94F:  "\u7d2f",
+	0xF950:  "\u7e37",
+	0xF951:  "\u964b",
+	0xF952:  "\u52d2",
+	0xF953:  "\u808b",
+	0xF954:  "\u51dc",
+	0xF955:  "\u51cc",
+	0xF956:  "\u7a1c",
+	0xF957:  "\u7dbe",
+	0xF958:  "\u83f1",
+	0xF959:  "\u9675",
+	0xF95A:  "\u8b80",
+	0xF95B:  "\u62cf",
+	0xF95C:  "\u6a02",
+	0xF95D:  "\u8afe",
+	0xF95E:  "\u4e39",
+	0xF95F:  "\u5be7",
+	0xF960:  "\u6012",
+	0xF961:  "\u7387",
+	0xF962:  "\u7570",
+	0xF963:  "\u5317",
+	0xF964:  "\u78fb",
+	0xF965:  "\u4fbf",
+	0xF966:  "\u5fa9",
+	0xF967:  "\u4e0d",
+	0xF968:  "\u6ccc",
+	0xF969:  "\u6578",
+	0xF96A:  "\u7d22",
+	0xF96B:  "\u53c3",
+	0xF96C:  "\u585e",
+	0xF96D:  "\u7701",
+	0xF96E:  "\u8449",
+	0xF96F:  "\u8aaa",
+	0xF970:  "\u6bba",
+	0xF971:  "\u8fb0",
+	0xF972:  "\u6c88",
+	0xF973:  "\u62fe",
+	0xF974:  "\u82e5",
+	0xF975:  "\u63a0",
+	0xF976:  "\u7565",
+	0xF977:  "\u4eae",
+	0xF978:  "\u5169",
+	0xF979:  "\u51c9",
+	0xF97A:  "\u6881",
+	0xF97B:  "\u7ce7",
+	0xF97C:  "\u826f",
+	0xF97D:  "\u8ad2",
+	0xF97E:  "\u91cf",
+	0xF97F:  "\u52f5",
+	0xF980:  "\u5442",
+	0xF981:  "\u5973",
+	0xF982:  "\u5eec",
+	0xF983:  "\u65c5",
+	0xF984:  "\u6ffe",
+	0xF985:  "\u792a",
+	0xF986:  "\u95ad",
+	0xF987:  "\u9a6a",
+	0xF988:  "\u9e97",
+	0xF989:  "\u9ece",
+	0xF98A:  "\u529b",
+	0xF98B:  "\u66c6",
+	0xF98C:  "\u6b77",
+	0xF98D:  "\u8f62",
+	0xF98E:  "\u5e74",
+	0xF98F:  "\u6190",
+	0xF990:  "\u6200",
+	0xF991:  "\u649a",
+	0xF992:  "\u6f23",
+	0xF993:  "\u7149",
+	0xF994:  "\u7489",
+	0xF995:  "\u79ca",
+	0xF996:  "\u7df4",
+	0xF997:  "\u806f",
+	0xF998:  "\u8f26",
+	0xF999:  "\u84ee",
+	0xF99A:  "\u9023",
+	0xF99B:  "\u934a",
+	0xF99C:  "\u5217",
+	0xF99D:  "\u52a3",
+	0xF99E:  "\u54bd",
+	0xF99F:  "\u70c8",
+	0xF9A0:  "\u88c2",
+	0xF9A1:  "\u8aaa",
+	0xF9A2:  "\u5ec9",
+	0xF9A3:  "\u5ff5",
+	0xF9A4:  "\u637b",
+	0xF9A5:  "\u6bae",
+	0xF9A6:  "\u7c3e",
+	0xF9A7:  "\u7375",
+	0xF9A8:  "\u4ee4",
+	0xF9A9:  "\u56f9",
+	0xF9AA:  "\u5be7",
+	0xF9AB:  "\u5dba",
+	0xF9AC:  "\u601c",
+	0xF9AD:  "\u73b2",
+	0xF9AE:  "\u7469",
+	0xF9AF:  "\u7f9a",
+	0xF9B0:  "\u8046",
+	0xF9B1:  "\u9234",
+	0xF9B2:  "\u96f6",
+	0xF9B3:  "\u9748",
+	0xF9B4:  "\u9818",
+	0xF9B5:  "\u4f8b",
+	0xF9B6:  "\u79ae",
+	0xF9B7:  "\u91b4",
+	0xF9B8:  "\u96b8",
+	0xF9B9:  "\u60e1",
+	0xF9BA:  "\u4e86",
+	0xF9BB:  "\u50da",
+	0xF9BC:  "\u5bee",
+	0xF9BD:  "\u5c3f",
+	0xF9BE:  "\u6599",
+	0xF9BF:  "\u6a02",
+	0xF9C0:  "\u71ce",
+	0xF9C1:  "\u7642",
+	0xF9C2:  "\u84fc",
+	0xF9C3:  "\u907c",
+	0xF9C4:  "\u9f8d",
+	0xF9C5:  "\u6688",
+	0xF9C6:  "\u962e",
+	0xF9C7:  "\u5289",
+	0xF9C8:  "\u677b",
+	0xF9C9:  "\u67f3",
+	0xF9CA:  "\u6d41",
+	0xF9CB:  "\u6e9c",
+	0xF9CC:  "\u7409",
+	0xF9CD:  "\u7559",
+	0xF9CE:  "\u786b",
+	0xF9CF:  "\u7d10",
+	0xF9D0:  "\u985e",
+	0xF9D1:  "\u516d",
+	0xF9D2:  "\u622e",
+	0xF9D3:  "\u9678",
+	0xF9D4:  "\u502b",
+	0xF9D5:  "\u5d19",
+	0xF9D6:  "\u6dea",
+	0xF9D7:  "\u8f2a",
+	0xF9D8:  "\u5f8b",
+	0xF9D9:  "\u6144",
+	0xF9DA:  "\u6817",
+	0xF9DB:  "\u7387",
+	0xF9DC:  "\u9686",
+	0xF9DD:  "\u5229",
+	0xF9DE:  "\u540f",
+	0xF9DF:  "\u5c65",
+	0xF9E0:  "\u6613",
+	0xF9E1:  "\u674e",
+	0xF9E2:  "\u68a8",
+	0xF9E3:  "\u6ce5",
+	0xF9E4:  "\u7406",
+	0xF9E5:  "\u75e2",
+	0xF9E6:  "\u7f79",
+	0xF9E7:  "\u88cf",
+	0xF9E8:  "\u88e1",
+	0xF9E9:  "\u91cc",
+	0xF9EA:  "\u96e2",
+	0xF9EB:  "\u533f",
+	0xF9EC:  "\u6eba",
+	0xF9ED:  "\u541d",
+	0xF9EE:  "\u71d0",
+	0xF9EF:  "\u7498",
+	0xF9F0:  "\u85fa",
+	0xF9F1:  "\u96a3",
+	0xF9F2:  "\u9c57",
+	0xF9F3:  "\u9e9f",
+	0xF9F4:  "\u6797",
+	0xF9F5:  "\u6dcb",
+	0xF9F6:  "\u81e8",
+	0xF9F7:  "\u7acb",
+	0xF9F8:  "\u7b20",
+	0xF9F9:  "\u7c92",
+	0xF9FA:  "\u72c0",
+	0xF9FB:  "\u7099",
+	0xF9FC:  "\u8b58",
+	0xF9FD:  "\u4ec0",
+	0xF9FE:  "\u8336",
+	0xF9FF:  "\u523a",
+	0xFA00:  "\u5207",
+	0xFA01:  "\u5ea6",
+	0xFA02:  "\u62d3",
+	0xFA03:  "\u7cd6",
+	0xFA04:  "\u5b85",
+	0xFA05:  "\u6d1e",
+	0xFA06:  "\u66b4",
+	0xFA07:  "\u8f3b",
+	0xFA08:  "\u884c",
+	0xFA09:  "\u964d",
+	0xFA0A:  "\u898b",
+	0xFA0B:  "\u5ed3",
+	0xFA0C:  "\u5140",
+	0xFA0D:  "\u55c0",
+	0xFA10:  "\u585a",
+	0xFA12:  "\u6674",
+	0xFA15:  "\u51de",
+	0xFA16:  "\u732a",
+	0xFA17:  "\u76ca",
+	0xFA18:  "\u793c",
+	0xFA19:  "\u795e",
+	0xFA1A:  "\u7965",
+	0xFA1B:  "\u798f",
+	0xFA1C:  "\u9756",
+	0xFA1D:  "\u7cbe",
+	0xFA1E:  "\u7fbd",
+	0xFA20:  "\u8612",
+	0xFA22:  "\u8af8",
+	0xFA25:  "\u9038",
+	0xFA26:  "\u90fd",
+	0xFA2A:  "\u98ef",
+	0xFA2B:  "\u98fc",
+	0xFA2C:  "\u9928",
+	0xFA2D:  "\u9db4",
+	0xFA2E:  "\u90de",
+	0xFA2F:  "\u96b7",
+	0xFA30:  "\u4fae",
+	0xFA31:  "\u50e7",
+	0xFA32:  "\u514d",
+	0xFA33:  "\u52c9",
+	0xFA34:  "\u52e4",
+	0xFA35:  "\u5351",
+	0xFA36:  "\u559d",
+	0xFA37:  "\u5606",
+	0xFA38:  "\u5668",
+	0xFA39:  "\u5840",
+	0xFA3A:  "\u58a8",
+	0xFA3B:  "\u5c64",
+	0xFA3C:  "\u5c6e",
+	0xFA3D:  "\u6094",
+	0xFA3E:  "\u6168",
+	0xFA3F:  "\u618e",
+	0xFA40:  "\u61f2",
+	0xFA41:  "\u654f",
+	0xFA42:  "\u65e2",
+	0xFA43:  "\u6691",
+	0xFA44:  "\u6885",
+	0xFA45:  "\u6d77",
+	0xFA46:  "\u6e1a",
+	0xFA47:  "\u6f22",
+	0xFA48:  "\u716e",
+	0xFA49:  "\u722b",
+	0xFA4A:  "\u7422",
+	0xFA4B:  "\u7891",
+	0xFA4C:  "\u793e",
+	0xFA4D:  "\u7949",
+	0xFA4E:  "\u7948",
+	0xFA4F:  "\u7950",
+	0xFA50:  "\u7956",
+	0xFA51:  "\u795d",
+	0xFA52:  "\u798d",
+	0xFA53:  "\u798e",
+	0xFA54:  "\u7a40",
+	0xFA55:  "\u7a81",
+	0xFA56:  "\u7bc0",
+	0xFA57:  "\u7df4",
+	0xFA58:  "\u7e09",
+	0xFA59:  "\u7e41",
+	0xFA5A:  "\u7f72",
+	0xFA5B:  "\u8005",
+	0xFA5C:  "\u81ed",
+	0xFA5D:  "\u8279",
+	0xFA5E:  "\u8279",
+	0xFA5F:  "\u8457",
+	0xFA60:  "\u8910",
+	0xFA61:  "\u8996",
+	0xFA62:  "\u8b01",
+	0xFA63:  "\u8b39",
+	0xFA64:  "\u8cd3",
+	0xFA65:  "\u8d08",
+	0xFA66:  "\u8fb6",
+	0xFA67:  "\u9038",
+	0xFA68:  "\u96e3",
+	0xFA69:  "\u97ff",
+	0xFA6A:  "\u983b",
+	0xFA6B:  "\u6075",
+	0xFA6C:  "\U000242ee",
+	0xFA6D:  "\u8218",
+	0xFA70:  "\u4e26",
+	0xFA71:  "\u51b5",
+	0xFA72:  "\u5168",
+	0xFA73:  "\u4f80",
+	0xFA74:  "\u5145",
+	0xFA75:  "\u5180",
+	0xFA76:  "\u52c7",
+	0xFA77:  "\u52fa",
+	0xFA78:  "\u559d",
+	0xFA79:  "\u5555",
+	0xFA7A:  "\u5599",
+	0xFA7B:  "\u55e2",
+	0xFA7C:  "\u585a",
+	0xFA7D:  "\u58b3",
+	0xFA7E:  "\u5944",
+	0xFA7F:  "\u5954",
+	0xFA80:  "\u5a62",
+	0xFA81:  "\u5b28",
+	0xFA82:  "\u5ed2",
+	0xFA83:  "\u5ed9",
+	0xFA84:  "\u5f69",
+	0xFA85:  "\u5fad",
+	0xFA86:  "\u60d8",
+	0xFA87:  "\u614e",
+	0xFA88:  "\u6108",
+	0xFA89:  "\u618e",
+	0xFA8A:  "\u6160",
+	0xFA8B:  "\u61f2",
+	0xFA8C:  "\u6234",
+	0xFA8D:  "\u63c4",
+	0xFA8E:  "\u641c",
+	0xFA8F:  "\u6452",
+	0xFA90:  "\u6556",
+	0xFA91:  "\u6674",
+	0xFA92:  "\u6717",
+	0xFA93:  "\u671b",
+	0xFA94:  "\u6756",
+	0xFA95:  "\u6b79",
+	0xFA96:  "\u6bba",
+	0xFA97:  "\u6d41",
+	0xFA98:  "\u6edb",
+	0xFA99:  "\u6ecb",
+	0xFA9A:  "\u6f22",
+	0xFA9B:  "\u701e",
+	0xFA9C:  "\u716e",
+	0xFA9D:  "\u77a7",
+	0xFA9E:  "\u7235",
+	0xFA9F:  "\u72af",
+	0xFAA0:  "\u732a",
+	0xFAA1:  "\u7471",
+	0xFAA2:  "\u7506",
+	0xFAA3:  "\u753b",
+	0xFAA4:  "\u761d",
+	0xFAA5:  "\u761f",
+	0xFAA6:  "\u76ca",
+	0xFAA7:  "\u76db",
+	0xFAA8:  "\u76f4",
+	0xFAA9:  "\u774a",
+	0xFAAA:  "\u7740",
+	0xFAAB:  "\u78cc",
+	0xFAAC:  "\u7ab1",
+	0xFAAD:  "\u7bc0",
+	0xFAAE:  "\u7c7b",
+	0xFAAF:  "\u7d5b",
+	0xFAB0:  "\u7df4",
+	0xFAB1:  "\u7f3e",
+	0xFAB2:  "\u8005",
+	0xFAB3:  "\u8352",
+	0xFAB4:  "\u83ef",
+	0xFAB5:  "\u8779",
+	0xFAB6:  "\u8941",
+	0xFAB7:  "\u8986",
+	0xFAB8:  "\u8996",
+	0xFAB9:  "\u8abf",
+	0xFABA:  "\u8af8",
+	0xFABB:  "\u8acb",
+	0xFABC:  "\u8b01",
+	0xFABD:  "\u8afe",
+	0xFABE:  "\u8aed",
+	0xFABF:  "\u8b39",
+	0xFAC0:  "\u8b8a",
+	0xFAC1:  "\u8d08",
+	0xFAC2:  "\u8f38",
+	0xFAC3:  "\u9072",
+	0xFAC4:  "\u9199",
+	0xFAC5:  "\u9276",
+	0xFAC6:  "\u967c",
+	0xFAC7:  "\u96e3",
+	0xFAC8:  "\u9756",
+	0xFAC9:  "\u97db",
+	0xFACA:  "\u97ff",
+	0xFACB:  "\u980b",
+	0xFACC:  "\u983b",
+	0xFACD:  "\u9b12",
+	0xFACE:  "\u9f9c",
+	0xFACF:  "\U0002284a",
+	0xFAD0:  "\U00022844",
+	0xFAD1:  "\U000233d5",
+	0xFAD2:  "\u3b9d",
+	0xFAD3:  "\u4018",
+	0xFAD4:  "\u4039",
+	0xFAD5:  "\U00025249",
+	0xFAD6:  "\U00025cd0",
+	0xFAD7:  "\U00027ed3",
+	0xFAD8:  "\u9f43",
+	0xFAD9:  "\u9f8e",
+	0xFB1D:  "\u05d9\u05b4",
+	0xFB1F:  "\u05f2\u05b7",
+	0xFB2A:  "\u05e9\u05c1",
+	0xFB2B:  "\u05e9\u05c2",
+	0xFB2C:  "\u05e9\u05bc\u05c1",
+	0xFB2D:  "\u05e9\u05bc\u05c2",
+	0xFB2E:  "\u05d0\u05b7",
+	0xFB2F:  "\u05d0\u05b8",
+	0xFB30:  "\u05d0\u05bc",
+	0xFB31:  "\u05d1\u05bc",
+	0xFB32:  "\u05d2\u05bc",
+	0xFB33:  "\u05d3\u05bc",
+	0xFB34:  "\u05d4\u05bc",
+	0xFB35:  "\u05d5\u05bc",
+	0xFB36:  "\u05d6\u05bc",
+	0xFB38:  "\u05d8\u05bc",
+	0xFB39:  "\u05d9\u05bc",
+	0xFB3A:  "\u05da\u05bc",
+	0xFB3B:  "\u05db\u05bc",
+	0xFB3C:  "\u05dc\u05bc",
+	0xFB3E:  "\u05de\u05bc",
+	0xFB40:  "\u05e0\u05bc",
+	0xFB41:  "\u05e1\u05bc",
+	0xFB43:  "\u05e3\u05bc",
+	0xFB44:  "\u05e4\u05bc",
+	0xFB46:  "\u05e6\u05bc",
+	0xFB47:  "\u05e7\u05bc",
+	0xFB48:  "\u05e8\u05bc",
+	0xFB49:  "\u05e9\u05bc",
+	0xFB4A:  "\u05ea\u05bc",
+	0xFB4B:  "\u05d5\u05b9",
+	0xFB4C:  "\u05d1\u05bf",
+	0xFB4D:  "\u05db\u05bf",
+	0xFB4E:  "\u05e4\u05bf",
+	0x105C9: "\U000105d2\u0307",
+	0x105E4: "\U000105da\u0307",
+	0x1109A: "\U00011099\U000110ba",
+	0x1109C: "\U0001109b\U000110ba",
+	0x110AB: "\U000110a5\U000110ba",
+	0x1112E: "\U00011131\U00011127",
+	0x1112F: "\U00011132\U00011127",
+	0x1134B: "\U00011347\U0001133e",
+	0x1134C: "\U00011347\U00011357",
+	0x11383: "\U00011382\U000113c9",
+	0x11385: "\U00011384\U000113bb",
+	0x1138E: "\U0001138b\U000113c2",
+	0x11391: "\U00011390\U000113c9",
+	0x113C5: "\U000113c2\U000113c2",
+	0x113C7: "\U000113c2\U000113b8",
+	0x113C8: "\U000113c2\U000113c9",
+	0x114BB: "\U000114b9\U000114ba",
+	0x114BC: "\U000114b9\U000114b0",
+	0x114BE: "\U000114b9\U000114bd",
+	0x115BA: "\U000115b8\U000115af",
+	0x115BB: "\U000115b9\U000115af",
+	0x11938: "\U00011935\U00011930",
+	0x16121: "\U0001611e\U0001611e",
+	0x16122: "\U0001611e\U00016129",
+	0x16123: "\U0001611e\U0001611f",
+	0x16124: "\U00016129\U0001611f",
+	0x16125: "\U0001611e\U00016120",
+	0x16126: "\U0001611e\U0001611e\U0001611f",
+	0x16127: "\U0001611e\U00016129\U0001611f",
+	0x16128: "\U0001611e\U0001611e\U00016120",
+	0x16D68: "\U00016d67\U00016d67",
+	0x16D69: "\U00016d63\U00016d67",
+	0x16D6A: "\U00016d63\U00016d67\U00016d67",
+	0x1D15E: "\U0001d157\U0001d165",
+	0x1D15F: "\U0001d158\U0001d165",
+	0x1D160: "\U0001d158\U0001d165\U0001d16e",
+	0x1D161: "\U0001d158\U0001d165\U0001d16f",
+	0x1D162: "\U0001d158\U0001d165\U0001d170",
+	0x1D163: "\U0001d158\U0001d165\U0001d171",
+	0x1D164: "\U0001d158\U0001d165\U0001d172",
+	0x1D1BB: "\U0001d1b9\U0001d165",
+	0x1D1BC: "\U0001d1ba\U0001d165",
+	0x1D1BD: "\U0001d1b9\U0001d165\U0001d16e",
+	0x1D1BE: "\U0001d1ba\U0001d165\U0001d16e",
+	0x1D1BF: "\U0001d1b9\U0001d165\U0001d16f",
+	0x1D1C0: "\U0001d1ba\U0001d165\U0001d16f",
+	0x2F800: "\u4e3d",
+	0x2F801: "\u4e38",
+	0x2F802: "\u4e41",
+	0x2F803: "\U00020122",
+	0x2F804: "\u4f60",
+	0x2F805: "\u4fae",
+	0x2F806: "\u4fbb",
+	0x2F807: "\u5002",
+	0x2F808: "\u507a",
+	0x2F809: "\u5099",
+	0x2F80A: "\u50e7",
+	0x2F80B: "\u50cf",
+	0x2F80C: "\u349e",
+	0x2F80D: "\U0002063a",
+	0x2F80E: "\u514d",
+	0x2F80F: "\u5154",
+	0x2F810: "\u5164",
+	0x2F811: "\u5177",
+	0x2F812: "\U0002051c",
+	0x2F813: "\u34b9",
+	0x2F814: "\u5167",
+	0x2F815: "\u518d",
+	0x2F816: "\U0002054b",
+	0x2F817: "\u5197",
+	0x2F818: "\u51a4",
+	0x2F819: "\u4ecc",
+	0x2F81A: "\u51ac",
+	0x2F81B: "\u51b5",
+	0x2F81C: "\U000291df",
+	0x2F81D: "\u51f5",
+	0x2F81E: "\u5203",
+	0x2F81F: "\u34df",
+	0x2F820: "\u523b",
+	0x2F821: "\u5246",
+	0x2F822: "\u5272",
+	0x2F823: "\u5277",
+	0x2F824: "\u3515",
+	0x2F825: "\u52c7",
+	0x2F826: "\u52c9",
+	0x2F827: "\u52e4",
+	0x2F828: "\u52fa",
+	0x2F829: "\u5305",
+	0x2F82A: "\u5306",
+	0x2F82B: "\u5317",
+	0x2F82C: "\u5349",
+	0x2F82D: "\u5351",
+	0x2F82E: "\u535a",
+	0x2F82F: "\u5373",
+	0x2F830: "\u537d",
+	0x2F831: "\u537f",
+	0x2F832: "\u537f",
+	0x2F833: "\u537f",
+	0x2F834: "\U00020a2c",
+	0x2F835: "\u7070",
+	0x2F836: "\u53ca",
+	0x2F837: "\u53df",
+	0x2F838: "\U00020b63",
+	0x2F839: "\u53eb",
+	0x2F83A: "\u53f1",
+	0x2F83B: "\u5406",
+	0x2F83C: "\u549e",
+	0x2F83D: "\u5438",
+	0x2F83E: "\u5448",
+	0x2F83F: "\u5468",
+	0x2F840: "\u54a2",
+	0x2F841: "\u54f6",
+	0x2F842: "\u5510",
+	0x2F843: "\u5553",
+	0x2F844: "\u5563",
+	0x2F845: "\u5584",
+	0x2F846: "\u5584",
+	0x2F847: "\u5599",
+	0x2F848: "\u55ab",
+	0x2F849: "\u55b3",
+	0x2F84A: "\u55c2",
+	0x2F84B: "\u5716",
+	0x2F84C: "\u5606",
+	0x2F84D: "\u5717",
+	0x2F84E: "\u5651",
+	0x2F84F: "\u5674",
+	0x2F850: "\u5207",
+	0x2F851: "\u58ee",
+	0x2F852: "\u57ce",
+	0x2F853: "\u57f4",
+	0x2F854: "\u580d",
+	0x2F855: "\u578b",
+	0x2F856: "\u5832",
+	0x2F857: "\u5831",
+	0x2F858: "\u58ac",
+	0x2F859: "\U000214e4",
+	0x2F85A: "\u58f2",
+	0x2F85B: "\u58f7",
+	0x2F85C: "\u5906",
+	0x2F85D: "\u591a",
+	0x2F85E: "\u5922",
+	0x2F85F: "\u5962",
+	0x2F860: "\U000216a8",
+	0x2F861: "\U000216ea",
+	0x2F862: "\u59ec",
+	0x2F863: "\u5a1b",
+	0x2F864: "\u5a27",
+	0x2F865: "\u59d8",
+	0x2F866: "\u5a66",
+	0x2F867: "\u36ee",
+	0x2F868: "\u36fc",
+	0x2F869: "\u5b08",
+	0x2F86A: "\u5b3e",
+	0x2F86B: "\u5b3e",
+	0x2F86C: "\U000219c8",
+	0x2F86D: "\u5bc3",
+	0x2F86E: "\u5bd8",
+	0x2F86F: "\u5be7",
+	0x2F870: "\u5bf3",
+	0x2F871: "\U00021b18",
+	0x2F872: "\u5bff",
+	0x2F873: "\u5c06",
+	0x2F874: "\u5f53",
+	0x2F875: "\u5c22",
+	0x2F876: "\u3781",
+	0x2F877: "\u5c60",
+	0x2F878: "\u5c6e",
+	0x2F879: "\u5cc0",
+	0x2F87A: "\u5c8d",
+	0x2F87B: "\U00021de4",
+	0x2F87C: "\u5d43",
+	0x2F87D: "\U00021de6",
+	0x2F87E: "\u5d6e",
+	0x2F87F: "\u5d6b",
+	0x2F880: "\u5d7c",
+	0x2F881: "\u5de1",
+	0x2F882: "\u5de2",
+	0x2F883: "\u382f",
+	0x2F884: "\u5dfd",
+	0x2F885: "\u5e28",
+	0x2F886: "\u5e3d",
+	0x2F887: "\u5e69",
+	0x2F888: "\u3862",
+	0x2F889: "\U00022183",
+	0x2F88A: "\u387c",
+	0x2F88B: "\u5eb0",
+	0x2F88C: "\u5eb3",
+	0x2F88D: "\u5eb6",
+	0x2F88E: "\u5eca",
+	0x2F88F: "\U0002a392",
+	0x2F890: "\u5efe",
+	0x2F891: "\U00022331",
+	0x2F892: "\U00022331",
+	0x2F893: "\u8201",
+	0x2F894: "\u5f22",
+	0x2F895: "\u5f22",
+	0x2F896: "\u38c7",
+	0x2F897: "\U000232b8",
+	0x2F898: "\U000261da",
+	0x2F899: "\u5f62",
+	0x2F89A: "\u5f6b",
+	0x2F89B: "\u38e3",
+	0x2F89C: "\u5f9a",
+	0x2F89D: "\u5fcd",
+	0x2F89E: "\u5fd7",
+	0x2F89F: "\u5ff9",
+	0x2F8A0: "\u6081",
+	0x2F8A1: "\u393a",
+	0x2F8A2: "\u391c",
+	0x2F8A3: "\u6094",
+	0x2F8A4: "\U000226d4",
+	0x2F8A5: "\u60c7",
+	0x2F8A6: "\u6148",
+	0x2F8A7: "\u614c",
+	0x2F8A8: "\u614e",
+	0x2F8A9: "\u614c",
+	0x2F8AA: "\u617a",
+	0x2F8AB: "\u618e",
+	0x2F8AC: "\u61b2",
+	0x2F8AD: "\u61a4",
+	0x2F8AE: "\u61af",
+	0x2F8AF: "\u61de",
+	0x2F8B0: "\u61f2",
+	0x2F8B1: "\u61f6",
+	0x2F8B2: "\u6210",
+	0x2F8B3: "\u621b",
+	0x2F8B4: "\u625d",
+	0x2F8B5: "\u62b1",
+	0x2F8B6: "\u62d4",
+	0x2F8B7: "\u6350",
+	0x2F8B8: "\U00022b0c",
+	0x2F8B9: "\u633d",
+	0x2F8BA: "\u62fc",
+	0x2F8BB: "\u6368",
+	0x2F8BC: "\u6383",
+	0x2F8BD: "\u63e4",
+	0x2F8BE: "\U00022bf1",
+	0x2F8BF: "\u6422",
+	0x2F8C0: "\u63c5",
+	0x2F8C1: "\u63a9",
+	0x2F8C2: "\u3a2e",
+	0x2F8C3: "\u6469",
+	0x2F8C4: "\u647e",
+	0x2F8C5: "\u649d",
+	0x2F8C6: "\u6477",
+	0x2F8C7: "\u3a6c",
+	0x2F8C8: "\u654f",
+	0x2F8C9: "\u656c",
+	0x2F8CA: "\U0002300a",
+	0x2F8CB: "\u65e3",
+	0x2F8CC: "\u66f8",
+	0x2F8CD: "\u6649",
+	0x2F8CE: "\u3b19",
+	0x2F8CF: "\u6691",
+	0x2F8D0: "\u3b08",
+	0x2F8D1: "\u3ae4",
+	0x2F8D2: "\u5192",
+	0x2F8D3: "\u5195",
+	0x2F8D4: "\u6700",
+	0x2F8D5: "\u669c",
+	0x2F8D6: "\u80ad",
+	0x2F8D7: "\u43d9",
+	0x2F8D8: "\u6717",
+	0x2F8D9: "\u671b",
+	0x2F8DA: "\u6721",
+	0x2F8DB: "\u675e",
+	0x2F8DC: "\u6753",
+	0x2F8DD: "\U000233c3",
+	0x2F8DE: "\u3b49",
+	0x2F8DF: "\u67fa",
+	0x2F8E0: "\u6785",
+	0x2F8E1: "\u6852",
+	0x2F8E2: "\u6885",
+	0x2F8E3: "\U0002346d",
+	0x2F8E4: "\u688e",
+	0x2F8E5: "\u681f",
+	0x2F8E6: "\u6914",
+	0x2F8E7: "\u3b9d",
+	0x2F8E8: "\u6942",
+	0x2F8E9: "\u69a3",
+	0x2F8EA: "\u69ea",
+	0x2F8EB: "\u6aa8",
+	0x2F8EC: "\U000236a3",
+	0x2F8ED: "\u6adb",
+	0x2F8EE: "\u3c18",
+	0x2F8EF: "\u6b21",
+	0x2F8F0: "\U000238a7",
+	0x2F8F1: "\u6b54",
+	0x2F8F2: "\u3c4e",
+	0x2F8F3: "\u6b72",
+	0x2F8F4: "\u6b9f",
+	0x2F8F5: "\u6bba",
+	0x2F8F6: "\u6bbb",
+	0x2F8F7: "\U00023a8d",
+	0x2F8F8: "\U00021d0b",
+	0x2F8F9: "\U00023afa",
+	0x2F8FA: "\u6c4e",
+	0x2F8FB: "\U00023cbc",
+	0x2F8FC: "\u6cbf",
+	0x2F8FD: "\u6ccd",
+	0x2F8FE: "\u6c67",
+	0x2F8FF: "\u6d16",
+	0x2F900: "\u6d3e",
+	0x2F901: "\u6d77",
+	0x2F902: "\u6d41",
+	0x2F903: "\u6d69",
+	0x2F904: "\u6d78",
+	0x2F905: "\u6d85",
+	0x2F906: "\U00023d1e",
+	0x2F907: "\u6d34",
+	0x2F908: "\u6e2f",
+	0x2F909: "\u6e6e",
+	0x2F90A: "\u3d33",
+	0x2F90B: "\u6ecb",
+	0x2F90C: "\u6ec7",
+	0x2F90D: "\U00023ed1",
+	0x2F90E: "\u6df9",
+	0x2F90F: "\u6f6e",
+	0x2F910: "\U00023f5e",
+	0x2F911: "\U00023f8e",
+	0x2F912: "\u6fc6",
+	0x2F913: "\u7039",
+	0x2F914: "\u701e",
+	0x2F915: "\u701b",
+	0x2F916: "\u3d96",
+	0x2F917: "\u704a",
+	0x2F918: "\u707d",
+	0x2F919: "\u7077",
+	0x2F91A: "\u70ad",
+	0x2F91B: "\U00020525",
+	0x2F91C: "\u7145",
+	0x2F91D: "\U00024263",
+	0x2F91E: "\u719c",
+	0x2F91F: "\U000243ab",
+	0x2F920: "\u7228",
+	0x2F921: "\u7235",
+	0x2F922: "\u7250",
+	0x2F923: "\U00024608",
+	0x2F924: "\u7280",
+	0x2F925: "\u7295",
+	0x2F926: "\U00024735",
+	0x2F927: "\U00024814",
+	0x2F928: "\u737a",
+	0x2F929: "\u738b",
+	0x2F92A: "\u3eac",
+	0x2F92B: "\u73a5",
+	0x2F92C: "\u3eb8",
+	0x2F92D: "\u3eb8",
+	0x2F92E: "\u7447",
+	0x2F92F: "\u745c",
+	0x2F930: "\u7471",
+	0x2F931: "\u7485",
+	0x2F932: "\u74ca",
+	0x2F933: "\u3f1b",
+	0x2F934: "\u7524",
+	0x2F935: "\U00024c36",
+	0x2F936: "\u753e",
+	0x2F937: "\U00024c92",
+	0x2F938: "\u7570",
+	0x2F939: "\U0002219f",
+	0x2F93A: "\u7610",
+	0x2F93B: "\U00024fa1",
+	0x2F93C: "\U00024fb8",
+	0x2F93D: "\U00025044",
+	0x2F93E: "\u3ffc",
+	0x2F93F: "\u4008",
+	0x2F940: "\u76f4",
+	0x2F941: "\U000250f3",
+	0x2F942: "\U000250f2",
+	0x2F943: "\U00025119",
+	0x2F944: "\U00025133",
+	0x2F945: "\u771e",
+	0x2F946: "\u771f",
+	0x2F947: "\u771f",
+	0x2F948: "\u774a",
+	0x2F949: "\u4039",
+	0x2F94A: "\u778b",
+	0x2F94B: "\u4046",
+	0x2F94C: "\u4096",
+	0x2F94D: "\U0002541d",
+	0x2F94E: "\u784e",
+	0x2F94F: "\u788c",
+	0x2F950: "\u78cc",
+	0x2F951: "\u40e3",
+	0x2F952: "\U00025626",
+	0x2F953: "\u7956",
+	0x2F954: "\U0002569a",
+	0x2F955: "\U000256c5",
+	0x2F956: "\u798f",
+	0x2F957: "\u79eb",
+	0x2F958: "\u412f",
+	0x2F959: "\u7a40",
+	0x2F95A: "\u7a4a",
+	0x2F95B: "\u7a4f",
+	0x2F95C: "\U0002597c",
+	0x2F95D: "\U00025aa7",
+	0x2F95E: "\U00025aa7",
+	0x2F95F: "\u7aee",
+	0x2F960: "\u4202",
+	0x2F961: "\U00025bab",
+	0x2F962: "\u7bc6",
+	0x2F963: "\u7bc9",
+	0x2F964: "\u4227",
+	0x2F965: "\U00025c80",
+	0x2F966: "\u7cd2",
+	0x2F967: "\u42a0",
+	0x2F968: "\u7ce8",
+	0x2F969: "\u7ce3",
+	0x2F96A: "\u7d00",
+	0x2F96B: "\U00025f86",
+	0x2F96C: "\u7d63",
+	0x2F96D: "\u4301",
+	0x2F96E: "\u7dc7",
+	0x2F96F: "\u7e02",
+	0x2F970: "\u7e45",
+	0x2F971: "\u4334",
+	0x2F972: "\U00026228",
+	0x2F973: "\U00026247",
+	0x2F974: "\u4359",
+	0x2F975: "\U000262d9",
+	0x2F976: "\u7f7a",
+	0x2F977: "\U0002633e",
+	0x2F978: "\u7f95",
+	0x2F979: "\u7ffa",
+	0x2F97A: "\u8005",
+	0x2F97B: "\U000264da",
+	0x2F97C: "\U00026523",
+	0x2F97D: "\u8060",
+	0x2F97E: "\U000265a8",
+	0x2F97F: "\u8070",
+	0x2F980: "\U0002335f",
+	0x2F981: "\u43d5",
+	0x2F982: "\u80b2",
+	0x2F983: "\u8103",
+	0x2F984: "\u440b",
+	0x2F985: "\u813e",
+	0x2F986: "\u5ab5",
+	0x2F987: "\U000267a7",
+	0x2F988: "\U000267b5",
+	0x2F989: "\U00023393",
+	0x2F98A: "\U0002339c",
+	0x2F98B: "\u8201",
+	0x2F98C: "\u8204",
+	0x2F98D: "\u8f9e",
+	0x2F98E: "\u446b",
+	0x2F98F: "\u8291",
+	0x2F990: "\u828b",
+	0x2F991: "\u829d",
+	0x2F992: "\u52b3",
+	0x2F993: "\u82b1",
+	0x2F994: "\u82b3",
+	0x2F995: "\u82bd",
+	0x2F996: "\u82e6",
+	0x2F997: "\U00026b3c",
+	0x2F998: "\u82e5",
+	0x2F999: "\u831d",
+	0x2F99A: "\u8363",
+	0x2F99B: "\u83ad",
+	0x2F99C: "\u8323",
+	0x2F99D: "\u83bd",
+	0x2F99E: "\u83e7",
+	0x2F99F: "\u8457",
+	0x2F9A0: "\u8353",
+	0x2F9A1: "\u83ca",
+	0x2F9A2: "\u83cc",
+	0x2F9A3: "\u83dc",
+	0x2F9A4: "\U00026c36",
+	0x2F9A5: "\U00026d6b",
+	0x2F9A6: "\U00026cd5",
+	0x2F9A7: "\u452b",
+	0x2F9A8: "\u84f1",
+	0x2F9A9: "\u84f3",
+	0x2F9AA: "\u8516",
+	0x2F9AB: "\U000273ca",
+	0x2F9AC: "\u8564",
+	0x2F9AD: "\U00026f2c",
+	0x2F9AE: "\u455d",
+	0x2F9AF: "\u4561",
+	0x2F9B0: "\U00026fb1",
+	0x2F9B1: "\U000270d2",
+	0x2F9B2: "\u456b",
+	0x2F9B3: "\u8650",
+	0x2F9B4: "\u865c",
+	0x2F9B5: "\u8667",
+	0x2F9B6: "\u8669",
+	0x2F9B7: "\u86a9",
+	0x2F9B8: "\u8688",
+	0x2F9B9: "\u870e",
+	0x2F9BA: "\u86e2",
+	0x2F9BB: "\u8779",
+	0x2F9BC: "\u8728",
+	0x2F9BD: "\u876b",
+	0x2F9BE: "\u8786",
+	0x2F9BF: "\u45d7",
+	0x2F9C0: "\u87e1",
+	0x2F9C1: "\u8801",
+	0x2F9C2: "\u45f9",
+	0x2F9C3: "\u8860",
+	0x2F9C4: "\u8863",
+	0x2F9C5: "\U00027667",
+	0x2F9C6: "\u88d7",
+	0x2F9C7: "\u88de",
+	0x2F9C8: "\u4635",
+	0x2F9C9: "\u88fa",
+	0x2F9CA: "\u34bb",
+	0x2F9CB: "\U000278ae",
+	0x2F9CC: "\U00027966",
+	0x2F9CD: "\u46be",
+	0x2F9CE: "\u46c7",
+	0x2F9CF: "\u8aa0",
+	0x2F9D0: "\u8aed",
+	0x2F9D1: "\u8b8a",
+	0x2F9D2: "\u8c55",
+	0x2F9D3: "\U00027ca8",
+	0x2F9D4: "\u8cab",
+	0x2F9D5: "\u8cc1",
+	0x2F9D6: "\u8d1b",
+	0x2F9D7: "\u8d77",
+	0x2F9D8: "\U00027f2f",
+	0x2F9D9: "\U00020804",
+	0x2F9DA: "\u8dcb",
+	0x2F9DB: "\u8dbc",
+	0x2F9DC: "\u8df0",
+	0x2F9DD: "\U000208de",
+	0x2F9DE: "\u8ed4",
+	0x2F9DF: "\u8f38",
+	0x2F9E0: "\U000285d2",
+	0x2F9E1: "\U000285ed",
+	0x2F9E2: "\u9094",
+	0x2F9E3: "\u90f1",
+	0x2F9E4: "\u9111",
+	0x2F9E5: "\U0002872e",
+	0x2F9E6: "\u911b",
+	0x2F9E7: "\u9238",
+	0x2F9E8: "\u92d7",
+	0x2F9E9: "\u92d8",
+	0x2F9EA: "\u927c",
+	0x2F9EB: "\u93f9",
+	0x2F9EC: "\u9415",
+	0x2F9ED: "\U00028bfa",
+	0x2F9EE: "\u958b",
+	0x2F9EF: "\u4995",
+	0x2F9F0: "\u95b7",
+	0x2F9F1: "\U00028d77",
+	0x2F9F2: "\u49e6",
+	0x2F9F3: "\u96c3",
+	0x2F9F4: "\u5db2",
+	0x2F9F5: "\u9723",
+	0x2F9F6: "\U00029145",
+	0x2F9F7: "\U0002921a",
+	0x2F9F8: "\u4a6e",
+	0x2F9F9: "\u4a76",
+	0x2F9FA: "\u97e0",
+	0x2F9FB: "\U0002940a",
+	0x2F9FC: "\u4ab2",
+	0x2F9FD: "\U00029496",
+	0x2F9FE: "\u980b",
+	0x2F9FF: "\u980b",
+	0x2FA00: "\u9829",
+	0x2FA01: "\U000295b6",
+	0x2FA02: "\u98e2",
+	0x2FA03: "\u4b33",
+	0x2FA04: "\u9929",
+	0x2FA05: "\u99a7",
+	0x2FA06: "\u99c2",
+	0x2FA07: "\u99fe",
+	0x2FA08: "\u4bce",
+	0x2FA09: "\U00029b30",
+	0x2FA0A: "\u9b12",
+	0x2FA0B: "\u9c40",
+	0x2FA0C: "\u9cfd",
+	0x2FA0D: "\u4cce",
+	0x2FA0E: "\u4ced",
+	0x2FA0F: "\u9d67",
+	0x2FA10: "\U0002a0ce",
+	0x2FA11: "\u4cf8",
+	0x2FA12: "\U0002a105",
+	0x2FA13: "\U0002a20e",
+	0x2FA14: "\U0002a291",
+	0x2FA15: "\u9ebb",
+	0x2FA16: "\u4d56",
+	0x2FA17: "\u9ef9",
+	0x2FA18: "\u9efe",
+	0x2FA19: "\u9f05",
+	0x2FA1A: "\u9f0f",
+	0x2FA1B: "\u9f16",
+	0x2FA1C: "\u9f3b",
+	0x2FA1D: "\U0002a600",
+}
+
+// nfcCompositions maps starter pairs to their primary composite, except
+// Hangul syllables (see compose)
+var nfcCompositions = map[[2]rune]rune{
+	{0x0041, 0x0300}:   0x00C0,
+	{0x0041, 0x0301}:   0x00C1,
+	{0x0041, 0x0302}:   0x00C2,
+	{0x0041, 0x0303}:   0x00C3,
+	{0x0041, 0x0308}:   0x00C4,
+	{0x0041, 0x030A}:   0x00C5,
+	{0x0043, 0x0327}:   0x00C7,
+	{0x0045, 0x0300}:   0x00C8,
+	{0x0045, 0x0301}:   0x00C9,
+	{0x0045, 0x0302}:   0x00CA,
+	{0x0045, 0x0308}:   0x00CB,
+	{0x0049, 0x0300}:   0x00CC,
+	{0x0049, 0x0301}:   0x00CD,
+	{0x0049, 0x0302}:   0x00CE,
+	{0x0049, 0x0308}:   0x00CF,
+	{0x004E, 0x0303}:   0x00D1,
+	{0x004F, 0x0300}:   0x00D2,
+	{0x004F, 0x0301}:   0x00D3,
+	{0x004F, 0x0302}:   0x00D4,
+	{0x004F, 0x0303}:   0x00D5,
+	{0x004F, 0x0308}:   0x00D6,
+	{0x0055, 0x0300}:   0x00D9,
+	{0x0055, 0x0301}:   0x00DA,
+	{0x0055, 0x0302}:   0x00DB,
+	{0x0055, 0x0308}:   0x00DC,
+	{0x0059, 0x0301}:   0x00DD,
+	{0x0061, 0x0300}:   0x00E0,
+	{0x0061, 0x0301}:   0x00E1,
+	{0x0061, 0x0302}:   0x00E2,
+	{0x0061, 0x0303}:   0x00E3,
+	{0x0061, 0x0308}:   0x00E4,
+	{0x0061, 0x030A}:   0x00E5,
+	{0x0063, 0x0327}:   0x00E7,
+	{0x0065, 0x0300}:   0x00E8,
+	{0x0065, 0x0301}:   0x00E9,
+	{0x0065, 0x0302}:   0x00EA,
+	{0x0065, 0x0308}:   0x00EB,
+	{0x0069, 0x0300}:   0x00EC,
+	{0x0069, 0x0301}:   0x00ED,
+	{0x0069, 0x0302}:   0x00EE,
+	{0x0069, 0x0308}:   0x00EF,
+	{0x006E, 0x0303}:   0x00F1,
+	{0x006F, 0x0300}:   0x00F2,
+	{0x006F, 0x0301}:   0x00F3,
+	{0x006F, 0x0302}:   0x00F4,
+	{0x006F, 0x0303}:   0x00F5,
+	{0x006F, 0x0308}:   0x00F6,
+	{0x0075, 0x0300}:   0x00F9,
+	{0x0075, 0x0301}:   0x00FA,
+	{0x0075, 0x0302}:   0x00FB,
+	{0x0075, 0x0308}:   0x00FC,
+	{0x0079, 0x0301}:   0x00FD,
+	{0x0079, 0x0308}:   0x00FF,
+	{0x0041, 0x0304}:   0x0100,
+	{0x0061, 0x0304}:   0x0101,
+	{0x0041, 0x0306}:   0x0102,
+	{0x0061, 0x0306}:   0x0103,
+	{0x0041, 0x0328}:   0x0104,
+	{0x0061, 0x0328}:   0x0105,
+	{0x0043, 0x0301}:   0x0106,
+	{0x0063, 0x0301}:   0x0107,
+	{0x0043, 0x0302}:   0x0108,
+	{0x0063, 0x0302}:   0x0109,
+	{0x0043, 0x0307}:   0x010A,
+	{0x0063, 0x0307}:   0x010B,
+	{0x0043, 0x030C}:   0x010C,
+	{0x0063, 0x030C}:   0x010D,
+	{0x0044, 0x030C}:   0x010E,
+	{0x0064, 0x030C}:   0x010F,
+	{0x0045, 0x0304}:   0x0112,
+	{0x0065, 0x0304}:   0x0113,
+	{0x0045, 0x0306}:   0x0114,
+	{0x0065, 0x0306}:   0x0115,
+	{0x0045, 0x0307}:   0x0116,
+	{0x0065, 0x0307}:   0x0117,
+	{0x0045, 0x0328}:   0x0118,
+	{0x0065, 0x0328}:   0x0119,
+	{0x0045, 0x030C}:   0x011A,
+	{0x0065, 0x030C}:   0x011B,
+	{0x0047, 0x0302}:   0x011C,
+	{0x0067, 0x0302}:   0x011D,
+	{0x0047, 0x0306}:   0x011E,
+	{0x0067, 0x0306}:   0x011F,
+	{0x0047, 0x0307}:   0x0120,
+	{0x0067, 0x0307}:   0x0121,
+	{0x0047, 0x0327}:   0x0122,
+	{0x0067, 0x0327}:   0x0123,
+	{0x0048, 0x0302}:   0x0124,
+	{0x0068, 0x0302}:   0x0125,
+	{0x0049, 0x0303}:   0x0128,
+	{0x0069, 0x0303}:   0x0129,
+	{0x0049, 0x0304}:   0x012A,
+	{0x0069, 0x0304}:   0x012B,
+	{0x0049, 0x0306}:   0x012C,
+	{0x0069, 0x0306}:   0x012D,
+	{0x0049, 0x0328}:   0x012E,
+	{0x0069, 0x0328}:   0x012F,
+	{0x0049, 0x0307}:   0x0130,
+	{0x004A, 0x0302}:   0x0134,
+	{0x006A, 0x0302}:   0x0135,
+	{0x004B, 0x0327}:   0x0136,
+	{0x006B, 0x0327}:   0x0137,
+	{0x004C, 0x0301}:   0x0139,
+	{0x006C, 0x0301}:   0x013A,
+	{0x004C, 0x0327}:   0x013B,
+	{0x006C, 0x0327}:   0x013C,
+	{0x004C, 0x030C}:   0x013D,
+	{0x006C, 0x030C}:   0x013E,
+	{0x004E, 0x0301}:   0x0143,
+	{0x006E, 0x0301}:   0x0144,
+	{0x004E, 0x0327}:   0x0145,
+	{0x006E, 0x0327}:   0x0146,
+	{0x004E, 0x030C}:   0x0147,
+	{0x006E, 0x030C}:   0x0148,
+	{0x004F, 0x0304}:   0x014C,
+	{0x006F, 0x0304}:   0x014D,
+	{0x004F, 0x0306}:   0x014E,
+	{0x006F, 0x0306}:   0x014F,
+	{0x004F, 0x030B}:   0x0150,
+	{0x006F, 0x030B}:   0x0151,
+	{0x0052, 0x0301}:   0x0154,
+	{0x0072, 0x0301}:   0x0155,
+	{0x0052, 0x0327}:   0x0156,
+	{0x0072, 0x0327}:   0x0157,
+	{0x0052, 0x030C}:   0x0158,
+	{0x0072, 0x030C}:   0x0159,
+	{0x0053, 0x0301}:   0x015A,
+	{0x0073, 0x0301}:   0x015B,
+	{0x0053, 0x0302}:   0x015C,
+	{0x0073, 0x0302}:   0x015D,
+	{0x0053, 0x0327}:   0x015E,
+	{0x0073, 0x0327}:   0x015F,
+	{0x0053, 0x030C}:   0x0160,
+	{0x0073, 0x030C}:   0x0161,
+	{0x0054, 0x0327}:   0x0162,
+	{0x0074, 0x0327}:   0x0163,
+	{0x0054, 0x030C}:   0x0164,
+	{0x0074, 0x030C}:   0x0165,
+	{0x0055, 0x0303}:   0x0168,
+	{0x0075, 0x0303}:   0x0169,
+	{0x0055, 0x0304}:   0x016A,
+	{0x0075, 0x0304}:   0x016B,
+	{0x0055, 0x0306}:   0x016C,
+	{0x0075, 0x0306}:   0x016D,
+	{0x0055, 0x030A}:   0x016E,
+	{0x0075, 0x030A}:   0x016F,
+	{0x0055, 0x030B}:   0x0170,
+	{0x0075, 0x030B}:   0x0171,
+	{0x0055, 0x0328}:   0x0172,
+	{0x0075, 0x0328}:   0x0173,
+	{0x0057, 0x0302}:   0x0174,
+	{0x0077, 0x0302}:   0x0175,
+	{0x0059, 0x0302}:   0x0176,
+	{0x0079, 0x0302}:   0x0177,
+	{0x0059, 0x0308}:   0x0178,
+	{0x005A, 0x0301}:   0x0179,
+	{0x007A, 0x0301}:   0x017A,
+	{0x005A, 0x0307}:   0x017B,
+	{0x007A, 0x0307}:   0x017C,
+	{0x005A, 0x030C}:   0x017D,
+	{0x007A, 0x030C}:   0x017E,
+	{0x004F, 0x031B}:   0x01A0,
+	{0x006F, 0x031B}:   0x01A1,
+	{0x0055, 0x031B}:   0x01AF,
+	{0x0075, 0x031B}:   0x01B0,
+	{0x0041, 0x030C}:   0x01CD,
+	{0x0061, 0x030C}:   0x01CE,
+	{0x0049, 0x030C}:   0x01CF,
+	{0x0069, 0x030C}:   0x01D0,
+	{0x004F, 0x030C}:   0x01D1,
+	{0x006F, 0x030C}:   0x01D2,
+	{0x0055, 0x030C}:   0x01D3,
+	{0x0075, 0x030C}:   0x01D4,
+	{0x00DC, 0x0304}:   0x01D5,
+	{0x00FC, 0x0304}:   0x01D6,
+	{0x00DC, 0x0301}:   0x01D7,
+	{0x00FC, 0x0301}:   0x01D8,
+	{0x00DC, 0x030C}:   0x01D9,
+	{0x00FC, 0x030C}:   0x01DA,
+	{0x00DC, 0x0300}:   0x01DB,
+	{0x00FC, 0x0300}:   0x01DC,
+	{0x00C4, 0x0304}:   0x01DE,
+	{0x00E4, 0x0304}:   0x01DF,
+	{0x0226, 0x0304}:   0x01E0,
+	{0x0227, 0x0304}:   0x01E1,
+	{0x00C6, 0x0304}:   0x01E2,
+	{0x00E6, 0x0304}:   0x01E3,
+	{0x0047, 0x030C}:   0x01E6,
+	{0x0067, 0x030C}:   0x01E7,
+	{0x004B, 0x030C}:   0x01E8,
+	{0x006B, 0x030C}:   0x01E9,
+	{0x004F, 0x0328}:   0x01EA,
+	{0x006F, 0x0328}:   0x01EB,
+	{0x01EA, 0x0304}:   0x01EC,
+	{0x01EB, 0x0304}:   0x01ED,
+	{0x01B7, 0x030C}:   0x01EE,
+	{0x0292, 0x030C}:   0x01EF,
+	{0x006A, 0x030C}:   0x01F0,
+	{0x0047, 0x0301}:   0x01F4,
+	{0x0067, 0x0301}:   0x01F5,
+	{0x004E, 0x0300}:   0x01F8,
+	{0x006E, 0x0300}:   0x01F9,
+	{0x00C5, 0x0301}:   0x01FA,
+	{0x00E5, 0x0301}:   0x01FB,
+	{0x00C6, 0x0301}:   0x01FC,
+	{0x00E6, 0x0301}:   0x01FD,
+	{0x00D8, 0x0301}:   0x01FE,
+	{0x00F8, 0x0301}:   0x01FF,
+	{0x0041, 0x030F}:   0x0200,
+	{0x0061, 0x030F}:   0x0201,
+	{0x0041, 0x0311}:   0x0202,
+	{0x0061, 0x0311}:   0x0203,
+	{0x0045, 0x030F}:   0x0204,
+	{0x0065, 0x030F}:   0x0205,
+	{0x0045, 0x0311}:   0x0206,
+	{0x0065, 0x0311}:   0x0207,
+	{0x0049, 0x030F}:   0x0208,
+	{0x0069, 0x030F}:   0x0209,
+	{0x0049, 0x0311}:   0x020A,
+	{0x0069, 0x0311}:   0x020B,
+	{0x004F, 0x030F}:   0x020C,
+	{0x006F, 0x030F}:   0x020D,
+	{0x004F, 0x0311}:   0x020E,
+	{0x006F, 0x0311}:   0x020F,
+	{0x0052, 0x030F}:   0x0210,
+	{0x0072, 0x030F}:   0x0211,
+	{0x0052, 0x0311}:   0x0212,
+	{0x0072, 0x0311}:   0x0213,
+	{0x0055, 0x030F}:   0x0214,
+	{0x0075, 0x030F}:   0x0215,
+	{0x0055, 0x0311}:   0x0216,
+	{0x0075, 0x0311}:   0x0217,
+	{0x0053, 0x0326}:   0x0218,
+	{0x0073, 0x0326}:   0x0219,
+	{0x0054, 0x0326}:   0x021A,
+	{0x0074, 0x0326}:   0x021B,
+	{0x0048, 0x030C}:   0x021E,
+	{0x0068, 0x030C}:   0x021F,
+	{0x0041, 0x0307}:   0x0226,
+	{0x0061, 0x0307}:   0x0227,
+	{0x0045, 0x0327}:   0x0228,
+	{0x0065, 0x0327}:   0x0229,
+	{0x00D6, 0x0304}:   0x022A,
+	{0x00F6, 0x0304}:   0x022B,
+	{0x00D5, 0x0304}:   0x022C,
+	{0x00F5, 0x0304}:   0x022D,
+	{0x004F, 0x0307}:   0x022E,
+	{0x006F, 0x0307}:   0x022F,
+	{0x022E, 0x0304}:   0x0230,
+	{0x022F, 0x0304}:   0x0231,
+	{0x0059, 0x0304}:   0x0232,
+	{0x0079, 0x0304}:   0x0233,
+	{0x00A8, 0x0301}:   0x0385,
+	{0x0391, 0x0301}:   0x0386,
+	{0x0395, 0x0301}:   0x0388,
+	{0x0397, 0x0301}:   0x0389,
+	{0x0399, 0x0301}:   0x038A,
+	{0x039F, 0x0301}:   0x038C,
+	{0x03A5, 0x0301}:   0x038E,
+	{0x03A9, 0x0301}:   0x038F,
+	{0x03CA, 0x0301}:   0x0390,
+	{0x0399, 0x0308}:   0x03AA,
+	{0x03A5, 0x0308}:   0x03AB,
+	{0x03B1, 0x0301}:   0x03AC,
+	{0x03B5, 0x0301}:   0x03AD,
+	{0x03B7, 0x0301}:   0x03AE,
+	{0x03B9, 0x0301}:   0x03AF,
+	{0x03CB, 0x0301}:   0x03B0,
+	{0x03B9, 0x0308}:   0x03CA,
+	{0x03C5, 0x0308}:   0x03CB,
+	{0x03BF, 0x0301}:   0x03CC,
+	{0x03C5, 0x0301}:   0x03CD,
+	{0x03C9, 0x0301}:   0x03CE,
+	{0x03D2, 0x0301}:   0x03D3,
+	{0x03D2, 0x0308}:   0x03D4,
+	{0x0415, 0x0300}:   0x0400,
+	{0x0415, 0x0308}:   0x0401,
+	{0x0413, 0x0301}:   0x0403,
+	{0x0406, 0x0308}:   0x0407,
+	{0x041A, 0x0301}:   0x040C,
+	{0x0418, 0x0300}:   0x040D,
+	{0x0423, 0x0306}:   0x040E,
+	{0x0418, 0x0306}:   0x0419,
+	{0x0438, 0x0306}:   0x0439,
+	{0x0435, 0x0300}:   0x0450,
+	{0x0435, 0x0308}:   0x0451,
+	{0x0433, 0x0301}:   0x0453,
+	{0x0456, 0x0308}:   0x0457,
+	{0x043A, 0x0301}:   0x045C,
+	{0x0438, 0x0300}:   0x045D,
+	{0x0443, 0x0306}:   0x045E,
+	{0x0474, 0x030F}:   0x0476,
+	{0x0475, 0x030F}:   0x0477,
+	{0x0416, 0x0306}:   0x04C1,
+	{0x0436, 0x0306}:   0x04C2,
+	{0x0410, 0x0306}:   0x04D0,
+	{0x0430, 0x0306}:   0x04D1,
+	{0x0410, 0x0308}:   0x04D2,
+	{0x0430, 0x0308}:   0x04D3,
+	{0x0415, 0x0306}:   0x04D6,
+	{0x0435, 0x0306}:   0x04D7,
+	{0x04D8, 0x0308}:   0x04DA,
+	{0x04D9, 0x0308}:   0x04DB,
+	{0x0416, 0x0308}:   0x04DC,
+	{0x0436, 0x0308}:   0x04DD,
+	{0x0417, 0x0308}:   0x04DE,
+	{0x0437, 0x0308}:   0x04DF,
+	{0x0418, 0x0304}:   0x04E2,
+	{0x0438, 0x0304}:   0x04E3,
+	{0x0418, 0x0308}:   0x04E4,
+	{0x0438, 0x0308}:   0x04E5,
+	{0x041E, 0x0308}:   0x04E6,
+	{0x043E, 0x0308}:   0x04E7,
+	{0x04E8, 0x0308}:   0x04EA,
+	{0x04E9, 0x0308}:   0x04EB,
+	{0x042D, 0x0308}:   0x04EC,
+	{0x044D, 0x0308}:   0x04ED,
+	{0x0423, 0x0304}:   0x04EE,
+	{0x0443, 0x0304}:   0x04EF,
+	{0x0423, 0x0308}:   0x04F0,
+	{0x0443, 0x0308}:   0x04F1,
+	{0x0423, 0x030B}:   0x04F2,
+	{0x0443, 0x030B}:   0x04F3,
+	{0x0427, 0x0308}:   0x04F4,
+	{0x0447, 0x0308}:   0x04F5,
+	{0x042B, 0x0308}:   0x04F8,
+	{0x044B, 0x0308}:   0x04F9,
+	{0x0627, 0x0653}:   0x0622,
+	{0x0627, 0x0654}:   0x0623,
+	{0x0648, 0x0654}:   0x0624,
+	{0x0627, 0x0655}:   0x0625,
+	{0x064A, 0x0654}:   0x0626,
+	{0x06D5, 0x0654}:   0x06C0,
+	{0x06C1, 0x0654}:   0x06C2,
+	{0x06D2, 0x0654}:   0x06D3,
+	{0x0928, 0x093C}:   0x0929,
+	{0x0930, 0x093C}:   0x0931,
+	{0x0933, 0x093C}:   0x0934,
+	{0x09C7, 0x09BE}:   0x09CB,
+	{0x09C7, 0x09D7}:   0x09CC,
+	{0x0B47, 0x0B56}:   0x0B48,
+	{0x0B47, 0x0B3E}:   0x0B4B,
+	{0x0B47, 0x0B57}:   0x0B4C,
+	{0x0B92, 0x0BD7}:   0x0B94,
+	{0x0BC6, 0x0BBE}:   0x0BCA,
+	{0x0BC7, 0x0BBE}:   0x0BCB,
+	{0x0BC6, 0x0BD7}:   0x0BCC,
+	{0x0C46, 0x0C56}:   0x0C48,
+	{0x0CBF, 0x0CD5}:   0x0CC0,
+	{0x0CC6, 0x0CD5}:   0x0CC7,
+	{0x0CC6, 0x0CD6}:   0x0CC8,
+	{0x0CC6, 0x0CC2}:   0x0CCA,
+	{0x0CCA, 0x0CD5}:   0x0CCB,
+	{0x0D46, 0x0D3E}:   0x0D4A,
+	{0x0D47, 0x0D3E}:   0x0D4B,
+	{0x0D46, 0x0D57}:   0x0D4C,
+	{0x0DD9, 0x0DCA}:   0x0DDA,
+	{0x0DD9, 0x0DCF}:   0x0DDC,
+	{0x0DDC, 0x0DCA}:   0x0DDD,
+	{0x0DD9, 0x0DDF}:   0x0DDE,
+	{0x1025, 0x102E}:   0x1026,
+	{0x1B05, 0x1B35}:   0x1B06,
+	{0x1B07, 0x1B35}:   0x1B08,
+	{0x1B09, 0x1B35}:   0x1B0A,
+	{0x1B0B, 0x1B35}:   0x1B0C,
+	{0x1B0D, 0x1B35}:   0x1B0E,
+	{0x1B11, 0x1B35}:   0x1B12,
+	{0x1B3A, 0x1B35}:   0x1B3B,
+	{0x1B3C, 0x1B35}:   0x1B3D,
+	{0x1B3E, 0x1B35}:   0x1B40,
+	{0x1B3F, 0x1B35}:   0x1B41,
+	{0x1B42, 0x1B35}:   0x1B43,
+	{0x0041, 0x0325}:   0x1E00,
+	{0x0061, 0x0325}:   0x1E01,
+	{0x0042, 0x0307}:   0x1E02,
+	{0x0062, 0x0307}:   0x1E03,
+	{0x0042, 0x0323}:   0x1E04,
+	{0x0062, 0x0323}:   0x1E05,
+	{0x0042, 0x0331}:   0x1E06,
+	{0x0062, 0x0331}:   0x1E07,
+	{0x00C7, 0x0301}:   0x1E08,
+	{0x00E7, 0x0301}:   0x1E09,
+	{0x0044, 0x0307}:   0x1E0A,
+	{0x0064, 0x0307}:   0x1E0B,
+	{0x0044, 0x0323}:   0x1E0C,
+	{0x0064, 0x0323}:   0x1E0D,
+	{0x0044, 0x0331}:   0x1E0E,
+	{0x0064, 0x0331}:   0x1E0F,
+	{0x0044, 0x0327}:   0x1E10,
+	{0x0064, 0x0327}:   0x1E11,
+	{0x0044, 0x032D}:   0x1E12,
+	{0x0064, 0x032D}:   0x1E13,
+	{0x0112, 0x0300}:   0x1E14,
+	{0x0113, 0x0300}:   0x1E15,
+	{0x0112, 0x0301}:   0x1E16,
+	{0x0113, 0x0301}:   0x1E17,
+	{0x0045, 0x032D}:   0x1E18,
+	{0x0065, 0x032D}:   0x1E19,
+	{0x0045, 0x0330}:   0x1E1A,
+	{0x0065, 0x0330}:   0x1E1B,
+	{0x0228, 0x0306}:   0x1E1C,
+	{0x0229, 0x0306}:   0x1E1D,
+	{0x0046, 0x0307}:   0x1E1E,
+	{0x0066, 0x0307}:   0x1E1F,
+	{0x0047, 0x0304}:   0x1E20,
+	{0x0067, 0x0304}:   0x1E21,
+	{0x0048, 0x0307}:   0x1E22,
+	{0x0068, 0x0307}:   0x1E23,
+	{0x0048, 0x0323}:   0x1E24,
+	{0x0068, 0x0323}:   0x1E25,
+	{0x0048, 0x0308}:   0x1E26,
+	{0x0068, 0x0308}:   0x1E27,
+	{0x0048, 0x0327}:   0x1E28,
+	{0x0068, 0x0327}:   0x1E29,
+	{0x0048, 0x032E}:   0x1E2A,
+	{0x0068, 0x032E}:   0x1E2B,
+	{0x0049, 0x0330}:   0x1E2C,
+	{0x0069, 0x0330}:   0x1E2D,
+	{0x00CF, 0x0301}:   0x1E2E,
+	{0x00EF, 0x0301}:   0x1E2F,
+	{0x004B, 0x0301}:   0x1E30,
+	{0x006B, 0x0301}:   0x1E31,
+	{0x004B, 0x0323}:   0x1E32,
+	{0x006B, 0x0323}:   0x1E33,
+	{0x004B, 0x0331}:   0x1E34,
+	{0x006B, 0x0331}:   0x1E35,
+	{0x004C, 0x0323}:   0x1E36,
+	{0x006C, 0x0323}:   0x1E37,
+	{0x1E36, 0x0304}:   0x1E38,
+	{0x1E37, 0x0304}:   0x1E39,
+	{0x004C, 0x0331}:   0x1E3A,
+	{0x006C, 0x0331}:   0x1E3B,
+	{0x004C, 0x032D}:   0x1E3C,
+	{0x006C, 0x032D}:   0x1E3D,
+	{0x004D, 0x0301}:   0x1E3E,
+	{0x006D, 0x0301}:   0x1E3F,
+	{0x004D, 0x0307}:   0x1E40,
+	{0x006D, 0x0307}:   0x1E41,
+	{0x004D, 0x0323}:   0x1E42,
+	{0x006D, 0x0323}:   0x1E43,
+	{0x004E, 0x0307}:   0x1E44,
+	{0x006E, 0x0307}:   0x1E45,
+	{0x004E, 0x0323}:   0x1E46,
+	{0x006E, 0x0323}:   0x1E47,
+	{0x004E, 0x0331}:   0x1E48,
+	{0x006E, 0x0331}:   0x1E49,
+	{0x004E, 0x032D}:   0x1E4A,
+	{0x006E, 0x032D}:   0x1E4B,
+	{0x00D5, 0x0301}:   0x1E4C,
+	{0x00F5, 0x0301}:   0x1E4D,
+	{0x00D5, 0x0308}:   0x1E4E,
+	{0x00F5, 0x0308}:   0x1E4F,
+	{0x014C, 0x0300}:   0x1E50,
+	{0x014D, 0x0300}:   0x1E51,
+	{0x014C, 0x0301}:   0x1E52,
+	{0x014D, 0x0301}:   0x1E53,
+	{0x0050, 0x0301}:   0x1E54,
+	{0x0070, 0x0301}:   0x1E55,
+	{0x0050, 0x0307}:   0x1E56,
+	{0x0070, 0x0307}:   0x1E57,
+	{0x0052, 0x0307}:   0x1E58,
+	{0x0072, 0x0307}:   0x1E59,
+	{0x0052, 0x0323}:   0x1E5A,
+	{0x0072, 0x0323}:   0x1E5B,
+	{0x1E5A, 0x0304}:   0x1E5C,
+	{0x1E5B, 0x0304}:   0x1E5D,
+	{0x0052, 0x0331}:   0x1E5E,
+	{0x0072, 0x0331}:   0x1E5F,
+	{0x0053, 0x0307}:   0x1E60,
+	{0x0073, 0x0307}:   0x1E61,
+	{0x0053, 0x0323}:   0x1E62,
+	{0x0073, 0x0323}:   0x1E63,
+	{0x015A, 0x0307}:   0x1E64,
+	{0x015B, 0x0307}:   0x1E65,
+	{0x0160, 0x0307}:   0x1E66,
+	{0x0161, 0x0307}:   0x1E67,
+	{0x1E62, 0x0307}:   0x1E68,
+	{0x1E63, 0x0307}:   0x1E69,
+	{0x0054, 0x0307}:   0x1E6A,
+	{0x0074, 0x0307}:   0x1E6B,
+	{0x0054, 0x0323}:   0x1E6C,
+	{0x0074, 0x0323}:   0x1E6D,
+	{0x0054, 0x0331}:   0x1E6E,
+	{0x0074, 0x0331}:   0x1E6F,
+	{0x0054, 0x032D}:   0x1E70,
+	{0x0074, 0x032D}:   0x1E71,
+	{0x0055, 0x0324}:   0x1E72,
+	{0x0075, 0x0324}:   0x1E73,
+	{0x0055, 0x0330}:   0x1E74,
+	{0x0075, 0x0330}:   0x1E75,
+	{0x0055, 0x032D}:   0x1E76,
+	{0x0075, 0x032D}:   0x1E77,
+	{0x0168, 0x0301}:   0x1E78,
+	{0x0169, 0x0301}:   0x1E79,
+	{0x016A, 0x0308}:   0x1E7A,
+	{0x016B, 0x0308}:   0x1E7B,
+	{0x0056, 0x0303}:   0x1E7C,
+	{0x0076, 0x0303}:   0x1E7D,
+	{0x0056, 0x0323}:   0x1E7E,
+	{0x0076, 0x0323}:   0x1E7F,
+	{0x0057, 0x0300}:   0x1E80,
+	{0x0077, 0x0300}:   0x1E81,
+	{0x0057, 0x0301}:   0x1E82,
+	{0x0077, 0x0301}:   0x1E83,
+	{0x0057, 0x0308}:   0x1E84,
+	{0x0077, 0x0308}:   0x1E85,
+	{0x0057, 0x0307}:   0x1E86,
+	{0x0077, 0x0307}:   0x1E87,
+	{0x0057, 0x0323}:   0x1E88,
+	{0x0077, 0x0323}:   0x1E89,
+	{0x0058, 0x0307}:   0x1E8A,
+	{0x0078, 0x0307}:   0x1E8B,
+	{0x0058, 0x0308}:   0x1E8C,
+	{0x0078, 0x0308}:   0x1E8D,
+	{0x0059, 0x0307}:   0x1E8E,
+	{0x0079, 0x0307}:   0x1E8F,
+	{0x005A, 0x0302}:   0x1E90,
+	{0x007A, 0x0302}:   0x1E91,
+	{0x005A, 0x0323}:   0x1E92,
+	{0x007A, 0x0323}:   0x1E93,
+	{0x005A, 0x0331}:   0x1E94,
+	{0x007A, 0x0331}:   0x1E95,
+	{0x0068, 0x0331}:   0x1E96,
+	{0x0074, 0x0308}:   0x1E97,
+	{0x0077, 0x030A}:   0x1E98,
+	{0x0079, 0x030A}:   0x1E99,
+	{0x017F, 0x0307}:   0x1E9B,
+	{0x0041, 0x0323}:   0x1EA0,
+	{0x0061, 0x0323}:   0x1EA1,
+	{0x0041, 0x0309}:   0x1EA2,
+	{0x0061, 0x0309}:   0x1EA3,
+	{0x00C2, 0x0301}:   0x1EA4,
+	{0x00E2, 0x0301}:   0x1EA5,
+	{0x00C2, 0x0300}:   0x1EA6,
+	{0x00E2, 0x0300}:   0x1EA7,
+	{0x00C2, 0x0309}:   0x1EA8,
+	{0x00E2, 0x0309}:   0x1EA9,
+	{0x00C2, 0x0303}:   0x1EAA,
+	{0x00E2, 0x0303}:   0x1EAB,
+	{0x1EA0, 0x0302}:   0x1EAC,
+	{0x1EA1, 0x0302}:   0x1EAD,
+	{0x0102, 0x0301}:   0x1EAE,
+	{0x0103, 0x0301}:   0x1EAF,
+	{0x0102, 0x0300}:   0x1EB0,
+	{0x0103, 0x0300}:   0x1EB1,
+	{0x0102, 0x0309}:   0x1EB2,
+	{0x0103, 0x0309}:   0x1EB3,
+	{0x0102, 0x0303}:   0x1EB4,
+	{0x0103, 0x0303}:   0x1EB5,
+	{0x1EA0, 0x0306}:   0x1EB6,
+	{0x1EA1, 0x0306}:   0x1EB7,
+	{0x0045, 0x0323}:   0x1EB8,
+	{0x0065, 0x0323}:   0x1EB9,
+	{0x0045, 0x0309}:   0x1EBA,
+	{0x0065, 0x0309}:   0x1EBB,
+	{0x0045, 0x0303}:   0x1EBC,
+	{0x0065, 0x0303}:   0x1EBD,
+	{0x00CA, 0x0301}:   0x1EBE,
+	{0x00EA, 0x0301}:   0x1EBF,
+	{0x00CA, 0x0300}:   0x1EC0,
+	{0x00EA, 0x0300}:   0x1EC1,
+	{0x00CA, 0x0309}:   0x1EC2,
+	{0x00EA, 0x0309}:   0x1EC3,
+	{0x00CA, 0x0303}:   0x1EC4,
+	{0x00EA, 0x0303}:   0x1EC5,
+	{0x1EB8, 0x0302}:   0x1EC6,
+	{0x1EB9, 0x0302}:   0x1EC7,
+	{0x0049, 0x0309}:   0x1EC8,
+	{0x0069, 0x0309}:   0x1EC9,
+	{0x0049, 0x0323}:   0x1ECA,
+	{0x0069, 0x0323}:   0x1ECB,
+	{0x004F, 0x0323}:   0x1ECC,
+	{0x006F, 0x0323}:   0x1ECD,
+	{0x004F, 0x0309}:   0x1ECE,
+	{0x006F, 0x0309}:   0x1ECF,
+	{0x00D4, 0x0301}:   0x1ED0,
+	{0x00F4, 0x0301}:   0x1ED1,
+	{0x00D4, 0x0300}:   0x1ED2,
+	{0x00F4, 0x0300}:   0x1ED3,
+	{0x00D4, 0x0309}:   0x1ED4,
+	{0x00F4, 0x0309}:   0x1ED5,
+	{0x00D4, 0x0303}:   0x1ED6,
+	{0x00F4, 0x0303}:   0x1ED7,
+	{0x1ECC, 0x0302}:   0x1ED8,
+	{0x1ECD, 0x0302}:   0x1ED9,
+	{0x01A0, 0x0301}:   0x1EDA,
+	{0x01A1, 0x0301}:   0x1EDB,
+	{0x01A0, 0x0300}:   0x1EDC,
+	{0x01A1, 0x0300}:   0x1EDD,
+	{0x01A0, 0x0309}:   0x1EDE,
+	{0x01A1, 0x0309}:   0x1EDF,
+	{0x01A0, 0x0303}:   0x1EE0,
+	{0x01A1, 0x0303}:   0x1EE1,
+	{0x01A0, 0x0323}:   0x1EE2,
+	{0x01A1, 0x0323}:   0x1EE3,
+	{0x0055, 0x0323}:   0x1EE4,
+	{0x0075, 0x0323}:   0x1EE5,
+	{0x0055, 0x0309}:   0x1EE6,
+	{0x0075, 0x0309}:   0x1EE7,
+	{0x01AF, 0x0301}:   0x1EE8,
+	{0x01B0, 0x0301}:   0x1EE9,
+	{0x01AF, 0x0300}:   0x1EEA,
+	{0x01B0, 0x0300}:   0x1EEB,
+	{0x01AF, 0x0309}:   0x1EEC,
+	{0x01B0, 0x0309}:   0x1EED,
+	{0x01AF, 0x0303}:   0x1EEE,
+	{0x01B0, 0x0303}:   0x1EEF,
+	{0x01AF, 0x0323}:   0x1EF0,
+	{0x01B0, 0x0323}:   0x1EF1,
+	{0x0059, 0x0300}:   0x1EF2,
+	{0x0079, 0x0300}:   0x1EF3,
+	{0x0059, 0x0323}:   0x1EF4,
+	{0x0079, 0x0323}:   0x1EF5,
+	{0x0059, 0x0309}:   0x1EF6,
+	{0x0079, 0x0309}:   0x1EF7,
+	{0x0059, 0x0303}:   0x1EF8,
+	{0x0079, 0x0303}:   0x1EF9,
+	{0x03B1, 0x0313}:   0x1F00,
+	{0x03B1, 0x0314}:   0x1F01,
+	{0x1F00, 0x0300}:   0x1F02,
+	{0x1F01, 0x0300}:   0x1F03,
+	{0x1F00, 0x0301}:   0x1F04,
+	{0x1F01, 0x0301}:   0x1F05,
+	{0x1F00, 0x0342}:   0x1F06,
+	{0x1F01, 0x0342}:   0x1F07,
+	{0x0391, 0x0313}:   0x1F08,
+	{0x0391, 0x0314}:   0x1F09,
+	{0x1F08, 0x0300}:   0x1F0A,
+	{0x1F09, 0x0300}:   0x1F0B,
+	{0x1F08, 0x0301}:   0x1F0C,
+	{0x1F09, 0x0301}:   0x1F0D,
+	{0x1F08, 0x0342}:   0x1F0E,
+	{0x1F09, 0x0342}:   0x1F0F,
+	{0x03B5, 0x0313}:   0x1F10,
+	{0x03B5, 0x0314}:   0x1F11,
+	{0x1F10, 0x0300}:   0x1F12,
+	{0x1F11, 0x0300}:   0x1F13,
+	{0x1F10, 0x0301}:   0x1F14,
+	{0x1F11, 0x0301}:   0x1F15,
+	{0x0395, 0x0313}:   0x1F18,
+	{0x0395, 0x0314}:   0x1F19,
+	{0x1F18, 0x0300}:   0x1F1A,
+	{0x1F19, 0x0300}:   0x1F1B,
+	{0x1F18, 0x0301}:   0x1F1C,
+	{0x1F19, 0x0301}:   0x1F1D,
+	{0x03B7, 0x0313}:   0x1F20,
+	{0x03B7, 0x0314}:   0x1F21,
+	{0x1F20, 0x0300}:   0x1F22,
+	{0x1F21, 0x0300}:   0x1F23,
+	{0x1F20, 0x0301}:   0x1F24,
+	{0x1F21, 0x0301}:   0x1F25,
+	{0x1F20, 0x0342}:   0x1F26,
+	{0x1F21, 0x0342}:   0x1F27,
+	{0x0397, 0x0313}:   0x1F28,
+	{0x0397, 0x0314}:   0x1F29,
+	{0x1F28, 0x0300}:   0x1F2A,
+	{0x1F29, 0x0300}:   0x1F2B,
+	{0x1F28, 0x0301}:   0x1F2C,
+	{0x1F29, 0x0301}:   0x1F2D,
+	{0x1F28, 0x0342}:   0x1F2E,
+	{0x1F29, 0x0342}:   0x1F2F,
+	{0x03B9, 0x0313}:   0x1F30,
+	{0x03B9, 0x0314}:   0x1F31,
+	{0x1F30, 0x0300}:   0x1F32,
+	{0x1F31, 0x0300}:   0x1F33,
+	{0x1F30, 0x0301}:   0x1F34,
+	{0x1F31, 0x0301}:   0x1F35,
+	{0x1F30, 0x0342}:   0x1F36,
+	{0x1F31, 0x0342}:   0x1F37,
+	{0x0399, 0x0313}:   0x1F38,
+	{0x0399, 0x0314}:   0x1F39,
+	{0x1F38, 0x0300}:   0x1F3A,
+	{0x1F39, 0x0300}:   0x1F3B,
+	{0x1F38, 0x0301}:   0x1F3C,
+	{0x1F39, 0x0301}:   0x1F3D,
+	{0x1F38, 0x0342}:   0x1F3E,
+	{0x1F39, 0x0342}:   0x1F3F,
+	{0x03BF, 0x0313}:   0x1F40,
+	{0x03BF, 0x0314}:   0x1F41,
+	{0x1F40, 0x0300}:   0x1F42,
+	{0x1F41, 0x0300}:   0x1F43,
+	{0x1F40, 0x0301}:   0x1F44,
+	{0x1F41, 0x0301}:   0x1F45,
+	{0x039F, 0x0313}:   0x1F48,
+	{0x039F, 0x0314}:   0x1F49,
+	{0x1F48, 0x0300}:   0x1F4A,
+	{0x1F49, 0x0300}:   0x1F4B,
+	{0x1F48, 0x0301}:   0x1F4C,
+	{0x1F49, 0x0301}:   0x1F4D,
+	{0x03C5, 0x0313}:   0x1F50,
+	{0x03C5, 0x0314}:   0x1F51,
+	{0x1F50, 0x0300}:   0x1F52,
+	{0x1F51, 0x0300}:   0x1F53,
+	{0x1F50, 0x0301}:   0x1F54,
+	{0x1F51, 0x0301}:   0x1F55,
+	{0x1F50, 0x0342}:   0x1F56,
+	{0x1F51, 0x0342}:   0x1F57,
+	{0x03A5, 0x0314}:   0x1F59,
+	{0x1F59, 0x0300}:   0x1F5B,
+	{0x1F59, 0x0301}:   0x1F5D,
+	{0x1F59, 0x0342}:   0x1F5F,
+	{0x03C9, 0x0313}:   0x1F60,
+	{0x03C9, 0x0314}:   0x1F61,
+	{0x1F60, 0x0300}:   0x1F62,
+	{0x1F61, 0x0300}:   0x1F63,
+	{0x1F60, 0x0301}:   0x1F64,
+	{0x1F61, 0x0301}:   0x1F65,
+	{0x1F60, 0x0342}:   0x1F66,
+	{0x1F61, 0x0342}:   0x1F67,
+	{0x03A9, 0x0313}:   0x1F68,
+	{0x03A9, 0x0314}:   0x1F69,
+	{0x1F68, 0x0300}:   0x1F6A,
+	{0x1F69, 0x0300}:   0x1F6B,
+	{0x1F68, 0x0301}:   0x1F6C,
+	{0x1F69, 0x0301}:   0x1F6D,
+	{0x1F68, 0x0342}:   0x1F6E,
+	{0x1F69, 0x0342}:   0x1F6F,
+	{0x03B1, 0x0300}:   0x1F70,
+	{0x03B5, 0x0300}:   0x1F72,
+	{0x03B7, 0x0300}:   0x1F74,
+	{0x03B9, 0x0300}:   0x1F76,
+	{0x03BF, 0x0300}:   0x1F78,
+	{0x03C5, 0x0300}:   0x1F7A,
+	{0x03C9, 0x0300}:   0x1F7C,
+	{0x1F00, 0x0345}:   0x1F80,
+	{0x1F01, 0x0345}:   0x1F81,
+	{0x1F02, 0x0345}:   0x1F82,
+	{0x1F03, 0x0345}:   0x1F83,
+	{0x1F04, 0x0345}:   0x1F84,
+	{0x1F05, 0x0345}:   0x1F85,
+	{0x1F06, 0x0345}:   0x1F86,
+	{0x1F07, 0x0345}:   0x1F87,
+	{0x1F08, 0x0345}:   0x1F88,
+	{0x1F09, 0x0345}:   0x1F89,
+	{0x1F0A, 0x0345}:   0x1F8A,
+	{0x1F0B, 0x0345}:   0x1F8B,
+	{0x1F0C, 0x0345}:   0x1F8C,
+	{0x1F0D, 0x0345}:   0x1F8D,
+	{0x1F0E, 0x0345}:   0x1F8E,
+	{0x1F0F, 0x0345}:   0x1F8F,
+	{0x1F20, 0x0345}:   0x1F90,
+	{0x1F21, 0x0345}:   0x1F91,
+	{0x1F22, 0x0345}:   0x1F92,
+	{0x1F23, 0x0345}:   0x1F93,
+	{0x1F24, 0x0345}:   0x1F94,
+	{0x1F25, 0x0345}:   0x1F95,
+	{0x1F26, 0x0345}:   0x1F96,
+	{0x1F27, 0x0345}:   0x1F97,
+	{0x1F28, 0x0345}:   0x1F98,
+	{0x1F29, 0x0345}:   0x1F99,
+	{0x1F2A, 0x0345}:   0x1F9A,
+	{0x1F2B, 0x0345}:   0x1F9B,
+	{0x1F2C, 0x0345}:   0x1F9C,
+	{0x1F2D, 0x0345}:   0x1F9D,
+	{0x1F2E, 0x0345}:   0x1F9E,
+	{0x1F2F, 0x0345}:   0x1F9F,
+	{0x1F60, 0x0345}:   0x1FA0,
+	{0x1F61, 0x0345}:   0x1FA1,
+	{0x1F62, 0x0345}:   0x1FA2,
+	{0x1F63, 0x0345}:   0x1FA3,
+	{0x1F64, 0x0345}:   0x1FA4,
+	{0x1F65, 0x0345}:   0x1FA5,
+	{0x1F66, 0x0345}:   0x1FA6,
+	{0x1F67, 0x0345}:   0x1FA7,
+	{0x1F68, 0x0345}:   0x1FA8,
+	{0x1F69, 0x0345}:   0x1FA9,
+	{0x1F6A, 0x0345}:   0x1FAA,
+	{0x1F6B, 0x0345}:   0x1FAB,
+	{0x1F6C, 0x0345}:   0x1FAC,
+	{0x1F6D, 0x0345}:   0x1FAD,
+	{0x1F6E, 0x0345}:   0x1FAE,
+	{0x1F6F, 0x0345}:   0x1FAF,
+	{0x03B1, 0x0306}:   0x1FB0,
+	{0x03B1, 0x0304}:   0x1FB1,
+	{0x1F70, 0x0345}:   0x1FB2,
+	{0x03B1, 0x0345}:   0x1FB3,
+	{0x03AC, 0x0345}:   0x1FB4,
+	{0x03B1, 0x0342}:   0x1FB6,
+	{0x1FB6, 0x0345}:   0x1FB7,
+	{0x0391, 0x0306}:   0x1FB8,
+	{0x0391, 0x0304}:   0x1FB9,
+	{0x0391, 0x0300}:   0x1FBA,
+	{0x0391, 0x0345}:   0x1FBC,
+	{0x00A8, 0x0342}:   0x1FC1,
+	{0x1F74, 0x0345}:   0x1FC2,
+	{0x03B7, 0x0345}:   0x1FC3,
+	{0x03AE, 0x0345}:   0x1FC4,
+	{0x03B7, 0x0342}:   0x1FC6,
+	{0x1FC6, 0x0345}:   0x1FC7,
+	{0x0395, 0x0300}:   0x1FC8,
+	{0x0397, 0x0300}:   0x1FCA,
+	{0x0397, 0x0345}:   0x1FCC,
+	{0x1FBF, 0x0300}:   0x1FCD,
+	{0x1FBF, 0x0301}:   0x1FCE,
+	{0x1FBF, 0x0342}:   0x1FCF,
+	{0x03B9, 0x0306}:   0x1FD0,
+	{0x03B9, 0x0304}:   0x1FD1,
+	{0x03CA, 0x0300}:   0x1FD2,
+	{0x03B9, 0x0342}:   0x1FD6,
+	{0x03CA, 0x0342}:   0x1FD7,
+	{0x0399, 0x0306}:   0x1FD8,
+	{0x0399, 0x0304}:   0x1FD9,
+	{0x0399, 0x0300}:   0x1FDA,
+	{0x1FFE, 0x0300}:   0x1FDD,
+	{0x1FFE, 0x0301}:   0x1FDE,
+	{0x1FFE, 0x0342}:   0x1FDF,
+	{0x03C5, 0x0306}:   0x1FE0,
+	{0x03C5, 0x0304}:   0x1FE1,
+	{0x03CB, 0x0300}:   0x1FE2,
+	{0x03C1, 0x0313}:   0x1FE4,
+	{0x03C1, 0x0314}:   0x1FE5,
+	{0x03C5, 0x0342}:   0x1FE6,
+	{0x03CB, 0x0342}:   0x1FE7,
+	{0x03A5, 0x0306}:   0x1FE8,
+	{0x03A5, 0x0304}:   0x1FE9,
+	{0x03A5, 0x0300}:   0x1FEA,
+	{0x03A1, 0x0314}:   0x1FEC,
+	{0x00A8, 0x0300}:   0x1FED,
+	{0x1F7C, 0x0345}:   0x1FF2,
+	{0x03C9, 0x0345}:   0x1FF3,
+	{0x03CE, 0x0345}:   0x1FF4,
+	{0x03C9, 0x0342}:   0x1FF6,
+	{0x1FF6, 0x0345}:   0x1FF7,
+	{0x039F, 0x0300}:   0x1FF8,
+	{0x03A9, 0x0300}:   0x1FFA,
+	{0x03A9, 0x0345}:   0x1FFC,
+	{0x2190, 0x0338}:   0x219A,
+	{0x2192, 0x0338}:   0x219B,
+	{0x2194, 0x0338}:   0x21AE,
+	{0x21D0, 0x0338}:   0x21CD,
+	{0x21D4, 0x0338}:   0x21CE,
+	{0x21D2, 0x0338}:   0x21CF,
+	{0x2203, 0x0338}:   0x2204,
+	{0x2208, 0x0338}:   0x2209,
+	{0x220B, 0x0338}:   0x220C,
+	{0x2223, 0x0338}:   0x2224,
+	{0x2225, 0x0338}:   0x2226,
+	{0x223C, 0x0338}:   0x2241,
+	{0x2243, 0x0338}:   0x2244,
+	{0x2245, 0x0338}:   0x2247,
+	{0x2248, 0x0338}:   0x2249,
+	{0x003D, 0x0338}:   0x2260,
+	{0x2261, 0x0338}:   0x2262,
+	{0x224D, 0x0338}:   0x226D,
+	{0x003C, 0x0338}:   0x226E,
+	{0x003E, 0x0338}:   0x226F,
+	{0x2264, 0x0338}:   0x2270,
+	{0x2265, 0x0338}:   0x2271,
+	{0x2272, 0x0338}:   0x2274,
+	{0x2273, 0x0338}:   0x2275,
+	{0x2276, 0x0338}:   0x2278,
+	{0x2277, 0x0338}:   0x2279,
+	{0x227A, 0x0338}:   0x2280,
+	{0x227B, 0x0338}:   0x2281,
+	{0x2282, 0x0338}:   0x2284,
+	{0x2283, 0x0338}:   0x2285,
+	{0x2286, 0x0338}:   0x2288,
+	{0x2287, 0x0338}:   0x2289,
+	{0x22A2, 0x0338}:   0x22AC,
+	{0x22A8, 0x0338}:   0x22AD,
+	{0x22A9, 0x0338}:   0x22AE,
+	{0x22AB, 0x0338}:   0x22AF,
+	{0x227C, 0x0338}:   0x22E0,
+	{0x227D, 0x0338}:   0x22E1,
+	{0x2291, 0x0338}:   0x22E2,
+	{0x2292, 0x0338}:   0x22E3,
+	{0x22B2, 0x0338}:   0x22EA,
+	{0x22B3, 0x0338}:   0x22EB,
+	{0x22B4, 0x0338}:   0x22EC,
+	{0x22B5, 0x0338}:   0x22ED,
+	{0x304B, 0x3099}:   0x304C,
+	{0x304D, 0x3099}:   0x304E,
+	{0x304F, 0x3099}:   0x3050,
+	{0x3051, 0x3099}:   0x3052,
+	{0x3053, 0x3099}:   0x3054,
+	{0x3055, 0x3099}:   0x3056,
+	{0x3057, 0x3099}:   0x3058,
+	{0x3059, 0x3099}:   0x305A,
+	{0x305B, 0x3099}:   0x305C,
+	{0x305D, 0x3099}:   0x305E,
+	{0x305F, 0x3099}:   0x3060,
+	{0x3061, 0x3099}:   0x3062,
+	{0x3064, 0x3099}:   0x3065,
+	{0x3066, 0x3099}:   0x3067,
+	{0x3068, 0x3099}:   0x3069,
+	{0x306F, 0x3099}:   0x3070,
+	{0x306F, 0x309A}:   0x3071,
+	{0x3072, 0x3099}:   0x3073,
+	{0x3072, 0x309A}:   0x3074,
+	{0x3075, 0x3099}:   0x3076,
+	{0x3075, 0x309A}:   0x3077,
+	{0x3078, 0x3099}:   0x3079,
+	{0x3078, 0x309A}:   0x307A,
+	{0x307B, 0x3099}:   0x307C,
+	{0x307B, 0x309A}:   0x307D,
+	{0x3046, 0x3099}:   0x3094,
+	{0x309D, 0x3099}:   0x309E,
+	{0x30AB, 0x3099}:   0x30AC,
+	{0x30AD, 0x3099}:   0x30AE,
+	{0x30AF, 0x3099}:   0x30B0,
+	{0x30B1, 0x3099}:   0x30B2,
+	{0x30B3, 0x3099}:   0x30B4,
+	{0x30B5, 0x3099}:   0x30B6,
+	{0x30B7, 0x3099}:   0x30B8,
+	{0x30B9, 0x3099}:   0x30BA,
+	{0x30BB, 0x3099}:   0x30BC,
+	{0x30BD, 0x3099}:   0x30BE,
+	{0x30BF, 0x3099}:   0x30C0,
+	{0x30C1, 0x3099}:   0x30C2,
+	{0x30C4, 0x3099}:   0x30C5,
+	{0x30C6, 0x3099}:   0x30C7,
+	{0x30C8, 0x3099}:   0x30C9,
+	{0x30CF, 0x3099}:   0x30D0,
+	{0x30CF, 0x309A}:   0x30D1,
+	{0x30D2, 0x3099}:   0x30D3,
+	{0x30D2, 0x309A}:   0x30D4,
+	{0x30D5, 0x3099}:   0x30D6,
+	{0x30D5, 0x309A}:   0x30D7,
+	{0x30D8, 0x3099}:   0x30D9,
+	{0x30D8, 0x309A}:   0x30DA,
+	{0x30DB, 0x3099}:   0x30DC,
+	{0x30DB, 0x309A}:   0x30DD,
+	{0x30A6, 0x3099}:   0x30F4,
+	{0x30EF, 0x3099}:   0x30F7,
+	{0x30F0, 0x3099}:   0x30F8,
+	{0x30F1, 0x3099}:   0x30F9,
+	{0x30F2, 0x3099}:   0x30FA,
+	{0x30FD, 0x3099}:   0x30FE,
+	{0x105D2, 0x0307}:  0x105C9,
+	{0x105DA, 0x0307}:  0x105E4,
+	{0x11099, 0x110BA}: 0x1109A,
+	{0x1109B, 0x110BA}: 0x1109C,
+	{0x110A5, 0x110BA}: 0x110AB,
+	{0x11131, 0x11127}: 0x1112E,
+	{0x11132, 0x11127}: 0x1112F,
+	{0x11347, 0x1133E}: 0x1134B,
+	{0x11347, 0x11357}: 0x1134C,
+	{0x11382, 0x113C9}: 0x11383,
+	{0x11384, 0x113BB}: 0x11385,
+	{0x1138B, 0x113C2}: 0x1138E,
+	{0x11390, 0x113C9}: 0x11391,
+	{0x113C2, 0x113C2}: 0x113C5,
+	{0x113C2, 0x113B8}: 0x113C7,
+	{0x113C2, 0x113C9}: 0x113C8,
+	{0x114B9, 0x114BA}: 0x114BB,
+	{0x114B9, 0x114B0}: 0x114BC,
+	{0x114B9, 0x114BD}: 0x114BE,
+	{0x115B8, 0x115AF}: 0x115BA,
+	{0x115B9, 0x115AF}: 0x115BB,
+	{0x11935, 0x11930}: 0x11938,
+	{0x1611E, 0x1611E}: 0x16121,
+	{0x1611E, 0x16129}: 0x16122,
+	{0x1611E, 0x1611F}: 0x16123,
+	{0x16129, 0x1611F}: 0x16124,
+	{0x1611E, 0x16120}: 0x16125,
+	{0x16121, 0x1611F}: 0x16126,
+	{0x16122, 0x1611F}: 0x16127,
+	{0x16121, 0x16120}: 0x16128,
+	{0x16D67, 0x16D67}: 0x16D68,
+	{0x16D63, 0x16D67}: 0x16D69,
+	{0x16D69, 0x16D67}: 0x16D6A,
+}
@@ -0,0 +1,192 @@
+package rawurlparser
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPunycode(t *testing.T) {
+	testCases := []struct {
+		unicode string
+		ascii   string
+	}{
+		{"bücher", "bcher-kva"},
+		{"münchen", "mnchen-3ya"},
+		{"他们为什么不说中文", "ihqwcrb4cv8a8dqg056pqjye"},
+		{"ليهمابتكلموشعربي؟", "egbpdaj6bu4bxfgehfvwxn"},
+		{"3年B組金八先生", "3B-ww4c5e180e575a65lsy2b"},
+		{"аpple", "pple-43d"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.ascii, func(t *testing.T) {
+			encoded, err := PunycodeEncode(tc.unicode)
+			if err != nil {
+				t.Fatalf("PunycodeEncode(%q) returned error: %v", tc.unicode, err)
+			}
+			if encoded != tc.ascii {
+				t.Errorf("PunycodeEncode(%q) = %q, want %q", tc.unicode, encoded, tc.ascii)
+			}
+			decoded, err := PunycodeDecode(tc.ascii)
+			if err != nil {
+				t.Fatalf("PunycodeDecode(%q) returned error: %v", tc.ascii, err)
+			}
+			if decoded != tc.unicode {
+				t.Errorf("PunycodeDecode(%q) = %q, want %q", tc.ascii, decoded, tc.unicode)
+			}
+		})
+	}
+
+	if _, err := PunycodeDecode("bcher-kv!"); !errors.Is(err, ErrInvalidPunycode) {
+		t.Errorf("PunycodeDecode accepted an invalid digit, err = %v", err)
+	}
+}
+
+func TestToASCII(t *testing.T) {
+	testCases := []struct {
+		input    string
+		want     string
+		wantStep IDNAStepKind
+		wantErr  bool
+	}{
+		{input: "example.com", want: "example.com"},
+		{input: "EXAMPLE.com", want: "example.com", wantStep: IDNAMapped},
+		{input: "bücher.de", want: "xn--bcher-kva.de", wantStep: IDNAPunycodeEncoded},
+		{input: "BÜCHER.de", want: "xn--bcher-kva.de", wantStep: IDNAMapped},
+		{input: "bücher.de", want: "xn--bcher-kva.de", wantStep: IDNANormalized},
+		{input: "ｅｘａｍｐｌｅ。com", want: "example.com", wantStep: IDNAMapped},
+		{input: "exa­mple.com", want: "example.com", wantStep: IDNAIgnored},
+		{input: "exa​mple.com", want: "example.com", wantStep: IDNAIgnored},
+		{input: "ⓔⓧⓐⓜⓟⓛⓔ.com", want: "example.com", wantStep: IDNAMapped},
+		{input: "\U0001d41e\U0001d431ample.com", want: "example.com", wantStep: IDNAMapped},
+		{input: "faß.de", want: "xn--fa-hia.de", wantStep: IDNADeviation},
+		{input: "аpple.com", want: "xn--pple-43d.com", wantStep: IDNAPunycodeEncoded},
+		{input: "[::1]", want: "[::1]"},
+		{input: "exa mple.com", wantErr: true},
+		{input: "́example.com", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			res, err := ToASCII(tc.input)
+			if tc.wantErr {
+				if !errors.Is(err, ErrInvalidIDNA) {
+					t.Errorf("ToASCII(%q) error = %v, want ErrInvalidIDNA", tc.input, err)
+				}
+				if res == nil || len(res.Errors) == 0 {
+					t.Errorf("ToASCII(%q) did not report its errors", tc.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ToASCII(%q) returned error: %v", tc.input, err)
+			}
+			if res.Output != tc.want {
+				t.Errorf("ToASCII(%q) = %q, want %q", tc.input, res.Output, tc.want)
+			}
+			if tc.wantStep != "" && !hasIDNAStep(res, tc.wantStep) {
+				t.Errorf("ToASCII(%q) steps %+v do not include %q", tc.input, res.Steps, tc.wantStep)
+			}
+		})
+	}
+}
+
+func TestToASCIIOptions(t *testing.T) {
+	res, err := ToASCIIWithOptions("faß.de", &IDNAOptions{Transitional: true})
+	if err != nil || res.Output != "fass.de" {
+		t.Errorf("transitional ToASCII = %q, %v, want fass.de", res.Output, err)
+	}
+
+	res, err = ToASCIIWithOptions("STRAẞE.de", &IDNAOptions{Transitional: true})
+	if err != nil || res.Output != "strasse.de" {
+		t.Errorf("transitional ToASCII = %q, %v, want strasse.de", res.Output, err)
+	}
+	res, err = ToASCIIWithOptions("STRAẞE.de", nil)
+	if err != nil || res.Output != "xn--strae-oqa.de" {
+		t.Errorf("nontransitional ToASCII = %q, %v, want xn--strae-oqa.de", res.Output, err)
+	}
+
+	if _, err := ToASCIIWithOptions("\u2474.com", &IDNAOptions{UseSTD3Rules: true}); err == nil {
+		t.Error("STD3 rules accepted a mapping to parentheses")
+	}
+	if _, err := ToASCIIWithOptions("exa_mple.com", &IDNAOptions{UseSTD3Rules: true}); err == nil {
+		t.Error("STD3 rules accepted an underscore")
+	}
+	if _, err := ToASCIIWithOptions("ab--c.com", &IDNAOptions{CheckHyphens: true}); err == nil {
+		t.Error("CheckHyphens accepted hyphens in positions 3-4")
+	}
+	if _, err := ToASCIIWithOptions("a..com", &IDNAOptions{VerifyDNSLength: true}); err == nil {
+		t.Error("VerifyDNSLength accepted an empty label")
+	}
+}
+
+func TestToUnicode(t *testing.T) {
+	u, err := RawURLParse("https://xn--bcher-kva.XN--pple-43d.com/")
+	if err != nil {
+		t.Fatalf("Failed to parse URL: %v", err)
+	}
+	res, err := u.ToUnicode()
+	if err != nil {
+		t.Fatalf("ToUnicode returned error: %v", err)
+	}
+	if res.Output != "bücher.аpple.com" {
+		t.Errorf("ToUnicode = %q, want %q", res.Output, "bücher.аpple.com")
+	}
+	if !hasIDNAStep(res, IDNAPunycodeDecoded) {
+		t.Errorf("ToUnicode steps %+v do not include punycode decoding", res.Steps)
+	}
+
+	// A Punycode label must not smuggle in upper case or unnormalized text
+	if _, err := ToUnicode("xn--Bcher-kva.de"); err != nil {
+		t.Errorf("mixed case ACE prefix rejected: %v", err)
+	}
+	if _, err := ToUnicode("xn--bcher-kva-.de"); err == nil {
+		t.Error("ToUnicode accepted a broken Punycode label")
+	}
+	if res, err := ToUnicode("xn--ls8h.la"); err != nil || res.Output != "\U0001f4a9.la" {
+		t.Errorf("ToUnicode(xn--ls8h.la) = %q, %v", res.Output, err)
+	}
+	// "bücher" spelled with a combining diaeresis, u + U+0308
+	if _, err := ToUnicode("xn--bucher-xyd.de"); err == nil {
+		t.Error("ToUnicode accepted a label that is not NFC")
+	}
+}
+
+func TestNFC(t *testing.T) {
+	testCases := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"composed", "e\u0301", "\u00e9"},
+		{"canonical order", "e\u0302\u0323", "\u1ec7"},
+		{"two marks", "a\u0323\u0302", "\u1ead"},
+		{"blocked", "a\u0301\u0301", "\u00e1\u0301"},
+		{"singleton", "\u2126", "\u03a9"},
+		{"exclusion", "\u0958", "\u0915\u093c"},
+		{"non-starter decomposition", "\u0344", "\u0308\u0301"},
+		{"hangul LV", "\u1100\u1161", "\uac00"},
+		{"hangul LVT", "\u1100\u1161\u11a8", "\uac01"},
+		{"hangul LV + T", "\uac00\u11a8", "\uac01"},
+		{"hangul then starter pair", "\uac00\U0001611e\U00016129", "\uac00\U00016122"},
+		{"cjk compatibility", "\uf900", "\u8c48"},
+		{"stable", "b\u00fccher", "b\u00fccher"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := string(nfc([]rune(tc.in))); got != tc.want {
+				t.Errorf("nfc(%+q) = %+q, want %+q", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+func hasIDNAStep(res *IDNAResult, kind IDNAStepKind) bool {
+	for _, s := range res.Steps {
+		if s.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,176 @@
+package rawurlparser
+
+import (
+	"errors"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidIPv4 = errors.New("invalid IPv4 address")
+
+// IPv4Variant is an alternate spelling of an IPv4 address
+type IPv4Variant struct {
+	Host     string // The host as it should appear in a URL
+	Notation string // Name of the encoding, e.g. "octal" or "ipv4-mapped-ipv6"
+}
+
+// ParseIPv4 parses an IPv4 host the way browsers and inet_aton(3) do.
+// Besides dotted-decimal it accepts 1 to 4 parts, each written in decimal,
+// octal (leading 0) or hex (0x prefix), where the last part fills the
+// remaining bytes: "0x7f.1", "017700000001", "2130706433" and "127.1" all
+// return 127.0.0.1. A single trailing dot is allowed.
+func ParseIPv4(host string) (netip.Addr, error) {
+	parts := strings.Split(host, ".")
+	if len(parts) > 1 && parts[len(parts)-1] == "" {
+		parts = parts[:len(parts)-1]
+	}
+	if len(parts) == 0 || len(parts) > 4 {
+		return netip.Addr{}, ErrInvalidIPv4
+	}
+
+	numbers := make([]uint64, len(parts))
+	for i, part := range parts {
+		n, ok := parseIPv4Number(part)
+		if !ok {
+			return netip.Addr{}, ErrInvalidIPv4
+		}
+		numbers[i] = n
+	}
+
+	// Every part but the last is a single byte, the last one fills the rest
+	last := numbers[len(numbers)-1]
+	if last >= 1<<(8*(5-len(numbers))) {
+		return netip.Addr{}, ErrInvalidIPv4
+	}
+	ipv4 := last
+	for i, n := range numbers[:len(numbers)-1] {
+		if n > 255 {
+			return netip.Addr{}, ErrInvalidIPv4
+		}
+		ipv4 += n << (8 * (3 - i))
+	}
+
+	return netip.AddrFrom4([4]byte{byte(ipv4 >> 24), byte(ipv4 >> 16), byte(ipv4 >> 8), byte(ipv4)}), nil
+}
+
+// IsCanonicalIPv4 reports whether host is a plain dotted-decimal IPv4 address
+func IsCanonicalIPv4(host string) bool {
+	addr, err := netip.ParseAddr(host)
+	return err == nil && addr.Is4() && addr.String() == host
+}
+
+// parseIPv4Number parses a single part of an IPv4 address
+func parseIPv4Number(s string) (uint64, bool) {
+	if s == "" {
+		return 0, false
+	}
+	base := 10
+	switch {
+	case len(s) >= 2 && (s[:2] == "0x" || s[:2] == "0X"):
+		s = s[2:]
+		base = 16
+		if s == "" {
+			return 0, true
+		}
+	case len(s) >= 2 && s[0] == '0':
+		s = s[1:]
+		base = 8
+	}
+	// Reject signs and other things ParseUint would tolerate
+	for i := 0; i < len(s); i++ {
+		if !isHexDigit(s[i]) {
+			return 0, false
+		}
+	}
+	n, err := strconv.ParseUint(s, base, 32)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// IPv4Variants returns every alternate encoding of an IPv4 address that
+// browsers or libc resolve to the same address: integer, hex and octal forms,
+// per-octet and mixed encodings, shortened forms and IPv4-mapped IPv6.
+// The canonical dotted-decimal form is not included. Deprecated
+// IPv4-compatible addresses ("[::a.b.c.d]") are left out: whether they reach
+// the IPv4 host depends on the network stack.
+func IPv4Variants(addr netip.Addr) []IPv4Variant {
+	addr = addr.Unmap()
+	if !addr.Is4() {
+		return nil
+	}
+
+	b := addr.As4()
+	dword := uint64(b[0])<<24 | uint64(b[1])<<16 | uint64(b[2])<<8 | uint64(b[3])
+	low16 := uint64(b[2])<<8 | uint64(b[3])
+	low24 := uint64(b[1])<<16 | low16
+
+	hex := func(n uint64) string { return "0x" + strconv.FormatUint(n, 16) }
+	oct := func(n uint64) string { return "0" + strconv.FormatUint(n, 8) }
+	dec := func(n uint64) string { return strconv.FormatUint(n, 10) }
+	join := func(f func(uint64) string, parts ...uint64) string {
+		s := make([]string, len(parts))
+		for i, p := range parts {
+			s[i] = f(p)
+		}
+		return strings.Join(s, ".")
+	}
+	o := []uint64{uint64(b[0]), uint64(b[1]), uint64(b[2]), uint64(b[3])}
+
+	candidates := []IPv4Variant{
+		{dec(dword), "decimal"},
+		{hex(dword), "hex"},
+		{"0X" + strings.ToUpper(strconv.FormatUint(dword, 16)), "hex-uppercase"},
+		{"0x" + strings.Repeat("0", 8-len(strconv.FormatUint(dword, 16))) + strconv.FormatUint(dword, 16), "hex-padded"},
+		{oct(dword), "octal"},
+		{"0000" + strconv.FormatUint(dword, 8), "octal-padded"},
+		{join(hex, o...), "dotted-hex"},
+		{join(oct, o...), "dotted-octal"},
+		{join(func(n uint64) string { return "0000" + strconv.FormatUint(n, 8) }, o...), "dotted-octal-padded"},
+		{join(dec, o[0], o[1], low16), "shortened"},
+		{join(dec, o[0], low24), "shortened"},
+		{join(hex, o[0], low24), "shortened-hex"},
+		{join(oct, o[0], low24), "shortened-octal"},
+		{hex(o[0]) + "." + join(dec, o[1], o[2], o[3]), "mixed"},
+		{oct(o[0]) + "." + join(dec, o[1], o[2], o[3]), "mixed"},
+		{hex(o[0]) + "." + oct(o[1]) + "." + dec(low16), "mixed"},
+		{oct(o[0]) + "." + hex(low24), "mixed"},
+		{addr.String() + ".", "trailing-dot"},
+		{"[::ffff:" + addr.String() + "]", "ipv4-mapped-ipv6"},
+		{"[::ffff:" + strconv.FormatUint(dword>>16, 16) + ":" + strconv.FormatUint(low16, 16) + "]", "ipv4-mapped-ipv6-hex"},
+		{"[0:0:0:0:0:ffff:" + addr.String() + "]", "ipv4-mapped-ipv6-expanded"},
+	}
+
+	seen := map[string]struct{}{addr.String(): {}}
+	var variants []IPv4Variant
+	for _, v := range candidates {
+		if _, ok := seen[v.Host]; ok {
+			continue
+		}
+		seen[v.Host] = struct{}{}
+		variants = append(variants, v)
+	}
+	return variants
+}
+
+// GenerateIPv4URLs returns copies of u with the host replaced by every
+// alternate encoding of its IPv4 address. Userinfo, port, path, query and
+// fragment are kept as they are. The hostname may itself use any notation
+// accepted by ParseIPv4.
+func GenerateIPv4URLs(u *RawURL) ([]*RawURL, error) {
+	addr, err := ParseIPv4(u.Hostname)
+	if err != nil {
+		return nil, err
+	}
+
+	var urls []*RawURL
+	for _, v := range IPv4Variants(addr) {
+		if v.Host == u.Hostname {
+			continue
+		}
+		urls = append(urls, withHostname(u, v.Host))
+	}
+	return urls, nil
+}
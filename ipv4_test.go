@@ -0,0 +1,106 @@
+package rawurlparser
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestParseIPv4(t *testing.T) {
+	testCases := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{input: "127.0.0.1", want: "127.0.0.1"},
+		{input: "0x7f.1", want: "127.0.0.1"},
+		{input: "017700000001", want: "127.0.0.1"},
+		{input: "2130706433", want: "127.0.0.1"},
+		{input: "127.1", want: "127.0.0.1"},
+		{input: "127.0.1", want: "127.0.0.1"},
+		{input: "0x7F.0.0.0x1", want: "127.0.0.1"},
+		{input: "0177.0.0.01", want: "127.0.0.1"},
+		{input: "127.0.0.1.", want: "127.0.0.1"},
+		{input: "0xa9fea9fe", want: "169.254.169.254"},
+		{input: "0x", want: "0.0.0.0"},
+		{input: "256.0.0.1", wantErr: true},
+		{input: "1.2.3.4.5", wantErr: true},
+		{input: "1.2.3.foo", wantErr: true},
+		{input: "08.0.0.1", wantErr: true},
+		{input: "4294967296", wantErr: true},
+		{input: "1..2", wantErr: true},
+		{input: "example.com", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			addr, err := ParseIPv4(tc.input)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("ParseIPv4(%q) = %v, want error", tc.input, addr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseIPv4(%q) returned error: %v", tc.input, err)
+			}
+			if addr.String() != tc.want {
+				t.Errorf("ParseIPv4(%q) = %v, want %s", tc.input, addr, tc.want)
+			}
+		})
+	}
+}
+
+func TestIPv4Variants(t *testing.T) {
+	addr := netip.MustParseAddr("127.0.0.1")
+	variants := IPv4Variants(addr)
+	if len(variants) == 0 {
+		t.Fatal("IPv4Variants returned no variants")
+	}
+
+	seen := make(map[string]bool)
+	for _, v := range variants {
+		if seen[v.Host] {
+			t.Errorf("duplicate variant %q", v.Host)
+		}
+		seen[v.Host] = true
+
+		// Every non-IPv6 variant must round-trip through ParseIPv4
+		if v.Host[0] == '[' {
+			continue
+		}
+		got, err := ParseIPv4(v.Host)
+		if err != nil || got != addr {
+			t.Errorf("variant %q (%s) parses to %v, %v", v.Host, v.Notation, got, err)
+		}
+	}
+
+	for _, want := range []string{"2130706433", "0x7f000001", "017700000001", "127.1", "0x7f.0x0.0x0.0x1", "[::ffff:127.0.0.1]"} {
+		if !seen[want] {
+			t.Errorf("missing variant %q", want)
+		}
+	}
+}
+
+func TestGenerateIPv4URLs(t *testing.T) {
+	u, err := RawURLParse("http://user@127.0.0.1:8080/admin?x=1")
+	if err != nil {
+		t.Fatalf("Failed to parse URL: %v", err)
+	}
+
+	urls, err := GenerateIPv4URLs(u)
+	if err != nil {
+		t.Fatalf("GenerateIPv4URLs returned error: %v", err)
+	}
+	for _, g := range urls {
+		if g.Port != "8080" || g.Path != "/admin" || g.Query != "x=1" || GetUserInfo(g) != "user@" {
+			t.Errorf("components not preserved in %s", g)
+		}
+		if c := g.Classify(); !c.IsIP() || !c.Loopback {
+			t.Errorf("%s is not classified as loopback: %+v", g, c)
+		}
+	}
+
+	if _, err := GenerateIPv4URLs(&RawURL{Hostname: "example.com"}); err == nil {
+		t.Error("GenerateIPv4URLs accepted a DNS name")
+	}
+}
@@ -0,0 +1,261 @@
+package rawurlparser
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidIPv6 = errors.New("invalid IPv6 literal")
+
+// IPv6Literal is a parsed IP-literal host (RFC 3986, RFC 4291 and RFC 6874)
+type IPv6Literal struct {
+	Raw           string     // The literal exactly as provided, including brackets
+	Addr          netip.Addr // The IPv6 address (without zone), invalid for IPvFuture
+	Zone          string     // Percent-decoded zone identifier, e.g. "eth0"
+	RawZone       string     // Zone as written after the address, e.g. "%25eth0" or "%eth0"
+	FutureVersion string     // IPvFuture version, e.g. "1" for [v1.fe80::a+en1]
+	Future        string     // IPvFuture address part after the version dot
+}
+
+// IsFuture reports whether the literal uses the IPvFuture form
+func (l *IPv6Literal) IsFuture() bool {
+	return l.FutureVersion != ""
+}
+
+// IPv6Variant is an alternate spelling of an IPv6 address
+type IPv6Variant struct {
+	Host     string // The bracketed host as it should appear in a URL
+	Notation string // Name of the spelling, e.g. "expanded" or "embedded-ipv4"
+}
+
+// IPv6 parses the URL's Hostname as an IP-literal
+func (u *RawURL) IPv6() (*IPv6Literal, error) {
+	return ParseIPv6Literal(u.Hostname)
+}
+
+// ParseIPv6Literal parses a bracketed IP-literal such as "[2001:db8::1]",
+// "[fe80::1%25eth0]" or "[v1.fe80::a+en1]". Zones written with a bare '%'
+// (as accepted by browsers and getaddrinfo) are understood as well, RawZone
+// keeps the original spelling. The input is never modified.
+func ParseIPv6Literal(host string) (*IPv6Literal, error) {
+	if len(host) < 2 || host[0] != '[' || host[len(host)-1] != ']' {
+		return nil, fmt.Errorf("%w: %q is not enclosed in brackets", ErrInvalidIPv6, host)
+	}
+	lit := &IPv6Literal{Raw: host}
+	inner := host[1 : len(host)-1]
+
+	// IPvFuture = "v" 1*HEXDIG "." 1*( unreserved / sub-delims / ":" )
+	if inner != "" && (inner[0] == 'v' || inner[0] == 'V') {
+		dot := strings.IndexByte(inner, '.')
+		if dot < 2 || dot == len(inner)-1 {
+			return nil, fmt.Errorf("%w: malformed IPvFuture %q", ErrInvalidIPv6, host)
+		}
+		for i := 1; i < dot; i++ {
+			if !isHexDigit(inner[i]) {
+				return nil, fmt.Errorf("%w: malformed IPvFuture version in %q", ErrInvalidIPv6, host)
+			}
+		}
+		for i := dot + 1; i < len(inner); i++ {
+			if c := inner[i]; !isUnreserved(c) && !isSubDelim(c) && c != ':' {
+				return nil, fmt.Errorf("%w: invalid character %q in IPvFuture", ErrInvalidIPv6, c)
+			}
+		}
+		lit.FutureVersion = inner[1:dot]
+		lit.Future = inner[dot+1:]
+		return lit, nil
+	}
+
+	// IPv6addrz = IPv6address "%25" ZoneID
+	address := inner
+	if i := strings.IndexByte(inner, '%'); i != -1 {
+		address = inner[:i]
+		lit.RawZone = inner[i:]
+		decoded, err := decodeZone(strings.TrimPrefix(inner[i+1:], "25"))
+		if err != nil {
+			return nil, err
+		}
+		lit.Zone = decoded
+	}
+
+	addr, err := netip.ParseAddr(address)
+	if err != nil || !addr.Is6() {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidIPv6, host)
+	}
+	lit.Addr = addr
+	return lit, nil
+}
+
+// decodeZone validates and percent-decodes a ZoneID (1*( unreserved / pct-encoded ))
+func decodeZone(zone string) (string, error) {
+	if zone == "" {
+		return "", fmt.Errorf("%w: empty zone identifier", ErrInvalidIPv6)
+	}
+	var buf strings.Builder
+	for i := 0; i < len(zone); i++ {
+		c := zone[i]
+		switch {
+		case c == '%':
+			if i+2 >= len(zone) || !isHexDigit(zone[i+1]) || !isHexDigit(zone[i+2]) {
+				return "", fmt.Errorf("%w: bad percent-encoding in zone %q", ErrInvalidIPv6, zone)
+			}
+			b, _ := strconv.ParseUint(zone[i+1:i+3], 16, 8)
+			buf.WriteByte(byte(b))
+			i += 2
+		case isUnreserved(c):
+			buf.WriteByte(c)
+		default:
+			return "", fmt.Errorf("%w: invalid character %q in zone", ErrInvalidIPv6, c)
+		}
+	}
+	return buf.String(), nil
+}
+
+// isUnreserved reports whether c is an RFC 3986 unreserved character
+func isUnreserved(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9') ||
+		c == '-' || c == '.' || c == '_' || c == '~'
+}
+
+// isSubDelim reports whether c is an RFC 3986 sub-delim
+func isSubDelim(c byte) bool {
+	return strings.IndexByte("!$&'()*+,;=", c) != -1
+}
+
+// IPv6Variants returns equivalent spellings of an IPv6 address: compressed,
+// fully expanded, without compression, upper and mixed case, alternative
+// "::" placement and the embedded IPv4 form of the low 32 bits.
+// A zone on addr is appended to every variant as "%25zone".
+func IPv6Variants(addr netip.Addr) []IPv6Variant {
+	if !addr.Is6() {
+		return nil
+	}
+
+	zone := ""
+	if addr.Zone() != "" {
+		zone = "%25" + addr.Zone()
+	}
+	raw := addr.As16()
+	var groups [8]uint16
+	for i := range groups {
+		groups[i] = uint16(raw[2*i])<<8 | uint16(raw[2*i+1])
+	}
+
+	short := func(g uint16) string { return strconv.FormatUint(uint64(g), 16) }
+	padded := func(g uint16) string { return fmt.Sprintf("%04x", g) }
+	canonical := addr.WithZone("").String()
+
+	candidates := []IPv6Variant{
+		{canonical, "compressed"},
+		{formatIPv6(groups, -1, 0, padded, false), "expanded"},
+		{formatIPv6(groups, -1, 0, short, false), "uncompressed"},
+		{strings.ToUpper(canonical), "upper-case"},
+		{alternateCase(canonical), "mixed-case"},
+		{formatIPv6(groups, -1, 0, short, true), "embedded-ipv4"},
+	}
+
+	// Compress every run of zero groups, not only the longest one
+	for start := 0; start < 8; start++ {
+		if groups[start] != 0 || (start > 0 && groups[start-1] == 0) {
+			continue
+		}
+		end := start
+		for end < 8 && groups[end] == 0 {
+			end++
+		}
+		candidates = append(candidates,
+			IPv6Variant{formatIPv6(groups, start, end-start, short, false), "alternate-compression"},
+			IPv6Variant{formatIPv6(groups, start, end-start, short, true), "embedded-ipv4"},
+			IPv6Variant{formatIPv6(groups, start, end-start, padded, false), "leading-zeros"},
+		)
+	}
+
+	seen := map[string]struct{}{}
+	var variants []IPv6Variant
+	for _, v := range candidates {
+		host := "[" + v.Host + zone + "]"
+		if _, ok := seen[host]; ok {
+			continue
+		}
+		seen[host] = struct{}{}
+		variants = append(variants, IPv6Variant{Host: host, Notation: v.Notation})
+	}
+	return variants
+}
+
+// GenerateIPv6URLs returns copies of u with the host replaced by every
+// equivalent spelling of its IPv6 address. The original spelling is skipped.
+func GenerateIPv6URLs(u *RawURL) ([]*RawURL, error) {
+	lit, err := ParseIPv6Literal(u.Hostname)
+	if err != nil {
+		return nil, err
+	}
+	if lit.IsFuture() {
+		return nil, fmt.Errorf("%w: IPvFuture has no equivalent spellings", ErrInvalidIPv6)
+	}
+
+	addr := lit.Addr
+	if lit.Zone != "" {
+		addr = addr.WithZone(lit.Zone)
+	}
+
+	var urls []*RawURL
+	for _, v := range IPv6Variants(addr) {
+		if v.Host == u.Hostname {
+			continue
+		}
+		urls = append(urls, withHostname(u, v.Host))
+	}
+	return urls, nil
+}
+
+// formatIPv6 writes the eight groups, replacing n groups from start with "::"
+// (n == 0 disables compression). With ipv4Tail the last two groups are written
+// in dotted-decimal form.
+func formatIPv6(groups [8]uint16, start, n int, group func(uint16) string, ipv4Tail bool) string {
+	last := 8
+	if ipv4Tail {
+		last = 6
+		if start+n > 6 {
+			n = 6 - start
+		}
+	}
+
+	var buf strings.Builder
+	for i := 0; i < last; i++ {
+		if n > 0 && i == start {
+			buf.WriteString("::")
+			i += n - 1
+			continue
+		}
+		if i > 0 && !(n > 0 && i == start+n) {
+			buf.WriteByte(':')
+		}
+		buf.WriteString(group(groups[i]))
+	}
+
+	if ipv4Tail {
+		if last > 0 && !(n > 0 && start+n == last) {
+			buf.WriteByte(':')
+		}
+		fmt.Fprintf(&buf, "%d.%d.%d.%d", groups[6]>>8, groups[6]&0xff, groups[7]>>8, groups[7]&0xff)
+	}
+	return buf.String()
+}
+
+// alternateCase upper-cases every other hex letter
+func alternateCase(s string) string {
+	b := []byte(s)
+	upper := true
+	for i, c := range b {
+		if 'a' <= c && c <= 'f' {
+			if upper {
+				b[i] = c - 'a' + 'A'
+			}
+			upper = !upper
+		}
+	}
+	return string(b)
+}
@@ -0,0 +1,172 @@
+package rawurlparser
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+)
+
+func TestParseIPv6Literal(t *testing.T) {
+	testCases := []struct {
+		input      string
+		wantAddr   string
+		wantZone   string
+		wantFuture string
+		wantErr    bool
+	}{
+		{input: "[2001:db8::1]", wantAddr: "2001:db8::1"},
+		{input: "[::ffff:127.0.0.1]", wantAddr: "::ffff:127.0.0.1"},
+		{input: "[fe80::1%25eth0]", wantAddr: "fe80::1", wantZone: "eth0"},
+		{input: "[fe80::1%eth0]", wantAddr: "fe80::1", wantZone: "eth0"},
+		{input: "[fe80::1%25en%2D1]", wantAddr: "fe80::1", wantZone: "en-1"},
+		{input: "[v1.fe80::a+en1]", wantFuture: "fe80::a+en1"},
+		{input: "[2001:db8::1", wantErr: true},
+		{input: "[not-an-ip]", wantErr: true},
+		{input: "[127.0.0.1]", wantErr: true},
+		{input: "[1:2:3:4:5:6:7:8:9]", wantErr: true},
+		{input: "[fe80::1%25]", wantErr: true},
+		{input: "[fe80::1%25eth/0]", wantErr: true},
+		{input: "[v.fe80]", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			lit, err := ParseIPv6Literal(tc.input)
+			if tc.wantErr {
+				if !errors.Is(err, ErrInvalidIPv6) {
+					t.Errorf("ParseIPv6Literal(%q) error = %v, want ErrInvalidIPv6", tc.input, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseIPv6Literal(%q) returned error: %v", tc.input, err)
+			}
+			if tc.wantFuture != "" {
+				if lit.Future != tc.wantFuture || lit.FutureVersion != "1" {
+					t.Errorf("Future = %q version %q, want %q version 1", lit.Future, lit.FutureVersion, tc.wantFuture)
+				}
+				return
+			}
+			if lit.Addr.String() != tc.wantAddr {
+				t.Errorf("Addr = %v, want %s", lit.Addr, tc.wantAddr)
+			}
+			if lit.Zone != tc.wantZone {
+				t.Errorf("Zone = %q, want %q", lit.Zone, tc.wantZone)
+			}
+			if lit.Raw != tc.input {
+				t.Errorf("Raw = %q, want %q", lit.Raw, tc.input)
+			}
+		})
+	}
+}
+
+func TestValidateIPv6Option(t *testing.T) {
+	opts := DefaultOptions()
+	opts.ValidateIPv6 = true
+
+	u, err := RawURLParseWithOptions("https://[fe80::1%25eth0]:8443/x", opts)
+	if err != nil {
+		t.Fatalf("valid literal rejected: %v", err)
+	}
+	if u.Host != "[fe80::1%25eth0]:8443" {
+		t.Errorf("Host = %q, Host must not be rewritten", u.Host)
+	}
+
+	if _, err := RawURLParseWithOptions("https://[zz::1]/x", opts); !errors.Is(err, ErrInvalidIPv6) {
+		t.Errorf("invalid literal error = %v, want ErrInvalidIPv6", err)
+	}
+	if _, err := RawURLParse("https://[zz::1]/x"); err != nil {
+		t.Errorf("invalid literal rejected without ValidateIPv6: %v", err)
+	}
+}
+
+func TestRawURLParseSchemelessIPv6(t *testing.T) {
+	// A leading IP-literal is a host, not a scheme. Before the '[' check
+	// "[::1]:8080/x" parsed as scheme "[" with opaque ":1]:8080/x".
+	tests := []struct {
+		input    string
+		scheme   string
+		opaque   string
+		host     string
+		hostname string
+		port     string
+		path     string
+	}{
+		{"[::1]:8080/x", "https", "", "[::1]:8080", "[::1]", "8080", "/x"},
+		{"[::1]/x", "https", "", "[::1]", "[::1]", "", "/x"},
+		{"[fe80::1%25eth0]:80/a", "https", "", "[fe80::1%25eth0]:80", "[fe80::1%25eth0]", "80", "/a"},
+		// Other scheme-less inputs are unchanged
+		{"mailto:a@b", "mailto", "a@b", "", "", "", ""},
+		{"x:[::1]:80", "x", "[::1]:80", "", "", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			u, err := RawURLParse(tt.input)
+			if err != nil {
+				t.Fatalf("RawURLParse() error = %v", err)
+			}
+			if u.Scheme != tt.scheme || u.Opaque != tt.opaque || u.Host != tt.host || u.Hostname != tt.hostname || u.Port != tt.port || u.Path != tt.path {
+				t.Errorf("RawURLParse() = %+v", u)
+			}
+		})
+	}
+}
+
+func TestIPv6Variants(t *testing.T) {
+	addr := netip.MustParseAddr("2001:db8::1")
+	variants := IPv6Variants(addr)
+
+	seen := make(map[string]bool)
+	for _, v := range variants {
+		seen[v.Host] = true
+		lit, err := ParseIPv6Literal(v.Host)
+		if err != nil {
+			t.Errorf("variant %q (%s) does not parse: %v", v.Host, v.Notation, err)
+			continue
+		}
+		if lit.Addr != addr {
+			t.Errorf("variant %q (%s) parses to %v, want %v", v.Host, v.Notation, lit.Addr, addr)
+		}
+	}
+
+	for _, want := range []string{
+		"[2001:db8::1]",
+		"[2001:0db8:0000:0000:0000:0000:0000:0001]",
+		"[2001:db8:0:0:0:0:0:1]",
+		"[2001:DB8::1]",
+		"[2001:db8::0.0.0.1]",
+	} {
+		if !seen[want] {
+			t.Errorf("missing variant %q", want)
+		}
+	}
+
+	zoned := IPv6Variants(netip.MustParseAddr("fe80::1%eth0"))
+	for _, v := range zoned {
+		if lit, err := ParseIPv6Literal(v.Host); err != nil || lit.Zone != "eth0" {
+			t.Errorf("zoned variant %q lost its zone: %v", v.Host, err)
+		}
+	}
+}
+
+func TestGenerateIPv6URLs(t *testing.T) {
+	u, err := RawURLParse("http://[::1]:8080/admin")
+	if err != nil {
+		t.Fatalf("Failed to parse URL: %v", err)
+	}
+	urls, err := GenerateIPv6URLs(u)
+	if err != nil {
+		t.Fatalf("GenerateIPv6URLs returned error: %v", err)
+	}
+	if len(urls) == 0 {
+		t.Fatal("GenerateIPv6URLs returned no URLs")
+	}
+	for _, g := range urls {
+		if g.Hostname == u.Hostname {
+			t.Errorf("original spelling %q was not skipped", g.Hostname)
+		}
+		if g.Port != "8080" || g.Path != "/admin" || !g.Classify().Loopback {
+			t.Errorf("unexpected generated URL %s", g)
+		}
+	}
+}
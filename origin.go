@@ -0,0 +1,150 @@
+package rawurlparser
+
+import (
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// Origin is a web origin as defined by RFC 6454 and the WHATWG URL standard.
+// Tuple origins carry a scheme, host and port, every other URL has an opaque
+// origin that serializes as "null" and is never same-origin with anything.
+type Origin struct {
+	Scheme string // Lower case scheme
+	Host   string // Serialized host: lower case ASCII, canonical IPv4, bracketed compressed IPv6
+	Port   string // Port without leading zeros, empty when it is the scheme's default
+	Opaque bool   // True for opaque origins (data:, file:, javascript:, unknown schemes, bad hosts)
+}
+
+// Origin returns the origin of the URL.
+// Only special schemes other than file have tuple origins, blob: URLs take
+// the origin of the URL they wrap. Userinfo, path, query and fragment never
+// affect the origin, and a port equal to the scheme's default is dropped.
+func (u *RawURL) Origin() Origin {
+	scheme := strings.ToLower(u.Scheme)
+
+	// "blob:https://a.com/uuid" parses with scheme "blob:https", other blob:
+	// URLs keep what they wrap in Opaque or Path
+	if strings.HasPrefix(scheme, "blob:") || scheme == "blob" {
+		var inner RawURL
+		if scheme == "blob" {
+			wrapped := u.Opaque
+			if wrapped == "" {
+				wrapped = u.Path
+			}
+			parsed, err := RawURLParseStrict(wrapped)
+			if err != nil {
+				return Origin{Opaque: true}
+			}
+			inner = *parsed
+		} else {
+			inner = *u
+			inner.Scheme = u.Scheme[len("blob:"):]
+		}
+		if s := strings.ToLower(inner.Scheme); s == "http" || s == "https" {
+			return inner.Origin()
+		}
+		return Origin{Opaque: true}
+	}
+
+	info, ok := LookupScheme(scheme)
+	if !ok || !info.Special || scheme == "file" || u.Opaque != "" {
+		return Origin{Opaque: true}
+	}
+
+	host, ok := originHost(u.Hostname)
+	if !ok {
+		return Origin{Opaque: true}
+	}
+
+	port := ""
+	if u.Port != "" && !u.HasDefaultPort() {
+		n, err := strconv.ParseUint(u.Port, 10, 16)
+		if err != nil {
+			return Origin{Opaque: true}
+		}
+		port = strconv.FormatUint(n, 10)
+	}
+
+	return Origin{Scheme: scheme, Host: host, Port: port}
+}
+
+// String serializes the origin as used in the Origin header:
+// scheme://host[:port], or "null" for opaque origins
+func (o Origin) String() string {
+	if o.Opaque {
+		return "null"
+	}
+	if o.Port == "" {
+		return o.Scheme + "://" + o.Host
+	}
+	return o.Scheme + "://" + o.Host + ":" + o.Port
+}
+
+// SameOrigin reports whether a and b have the same tuple origin.
+// Opaque origins are never same-origin, not even with themselves.
+func SameOrigin(a, b *RawURL) bool {
+	oa, ob := a.Origin(), b.Origin()
+	if oa.Opaque || ob.Opaque {
+		return false
+	}
+	return oa == ob
+}
+
+// SameSite reports whether a and b are schemeful same-site: same scheme and
+// same registrable domain. Hosts without a registrable domain (IP literals,
+// public suffixes) must match exactly. Ports are ignored.
+func SameSite(a, b *RawURL) bool {
+	oa, ob := a.Origin(), b.Origin()
+	if oa.Opaque || ob.Opaque || oa.Scheme != ob.Scheme {
+		return false
+	}
+	return originSite(oa.Host) == originSite(ob.Host)
+}
+
+// originSite returns the registrable domain of an origin host, or the host
+// itself when it has none. A trailing dot is kept, "example.com." is a
+// different site than "example.com".
+func originSite(host string) string {
+	name, ok := pslHost(host)
+	if !ok {
+		return host
+	}
+	if domain := DefaultPublicSuffixList().RegistrableDomain(name); domain != "" {
+		if strings.HasSuffix(host, ".") {
+			return domain + "."
+		}
+		return domain
+	}
+	return host
+}
+
+// originHost serializes a hostname the way the WHATWG host parser would for
+// special schemes
+func originHost(hostname string) (string, bool) {
+	host, err := parseWHATWGHost(hostname)
+	return host, err == nil
+}
+
+// serializeIPv6 writes addr in the WHATWG form: lower case hex groups with the
+// first longest run of two or more zero groups compressed. Unlike
+// netip.Addr.String, IPv4-mapped addresses are written in hex too.
+func serializeIPv6(addr netip.Addr) string {
+	raw := addr.As16()
+	var groups [8]uint16
+	for i := range groups {
+		groups[i] = uint16(raw[2*i])<<8 | uint16(raw[2*i+1])
+	}
+
+	start, n := -1, 0
+	for i := 0; i < 8; i++ {
+		j := i
+		for j < 8 && groups[j] == 0 {
+			j++
+		}
+		if j-i > n && j-i > 1 {
+			start, n = i, j-i
+		}
+	}
+	return formatIPv6(groups, start, n, func(g uint16) string { return strconv.FormatUint(uint64(g), 16) }, false)
+}
@@ -0,0 +1,213 @@
+package rawurlparser
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+var ErrInvalidPublicSuffixList = errors.New("invalid public suffix list")
+
+// embeddedPublicSuffixList is a copy of the upstream list, refreshed with
+// "go generate"
+//
+//go:generate curl -sSfLo public_suffix_list.dat https://publicsuffix.org/list/public_suffix_list.dat
+//go:embed public_suffix_list.dat
+var embeddedPublicSuffixList []byte
+
+// PublicSuffixList is a parsed Public Suffix List
+type PublicSuffixList struct {
+	rules map[string]bool // rule -> true for the ICANN section, false for PRIVATE
+}
+
+var (
+	pslMu      sync.RWMutex
+	pslDefault *PublicSuffixList
+)
+
+// ParsePublicSuffixList parses a list in the publicsuffix.org format.
+// Unicode rules are stored in their Punycode form.
+func ParsePublicSuffixList(r io.Reader) (*PublicSuffixList, error) {
+	l := &PublicSuffixList{rules: make(map[string]bool)}
+	icann := false
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.Contains(text, "===BEGIN ICANN DOMAINS==="):
+			icann = true
+			continue
+		case strings.Contains(text, "===END ICANN DOMAINS==="):
+			icann = false
+			continue
+		case text == "" || strings.HasPrefix(text, "//"):
+			continue
+		}
+
+		// Rules end at the first whitespace
+		if i := strings.IndexAny(text, " \t"); i != -1 {
+			text = text[:i]
+		}
+		prefix := ""
+		switch {
+		case strings.HasPrefix(text, "!"):
+			prefix, text = "!", text[1:]
+		case strings.HasPrefix(text, "*."):
+			prefix, text = "*.", text[2:]
+		}
+		res, err := ToASCII(text)
+		if err != nil || res.Output == "" {
+			return nil, fmt.Errorf("%w: line %d: %q", ErrInvalidPublicSuffixList, line, scanner.Text())
+		}
+		l.rules[prefix+res.Output] = icann
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(l.rules) == 0 {
+		return nil, fmt.Errorf("%w: no rules found", ErrInvalidPublicSuffixList)
+	}
+	return l, nil
+}
+
+// DefaultPublicSuffixList returns the list used by the RawURL methods,
+// the embedded snapshot unless another list was loaded
+func DefaultPublicSuffixList() *PublicSuffixList {
+	pslMu.RLock()
+	l := pslDefault
+	pslMu.RUnlock()
+	if l != nil {
+		return l
+	}
+
+	pslMu.Lock()
+	defer pslMu.Unlock()
+	if pslDefault == nil {
+		l, err := ParsePublicSuffixList(bytes.NewReader(embeddedPublicSuffixList))
+		if err != nil {
+			panic("rawurlparser: embedded public suffix list: " + err.Error())
+		}
+		pslDefault = l
+	}
+	return pslDefault
+}
+
+// SetPublicSuffixList replaces the list used by the RawURL methods
+func SetPublicSuffixList(l *PublicSuffixList) {
+	pslMu.Lock()
+	pslDefault = l
+	pslMu.Unlock()
+}
+
+// LoadPublicSuffixList reads a local copy of the list (e.g. a fresh download
+// of public_suffix_list.dat) and makes it the default
+func LoadPublicSuffixList(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	l, err := ParsePublicSuffixList(f)
+	if err != nil {
+		return err
+	}
+	SetPublicSuffixList(l)
+	return nil
+}
+
+// PublicSuffix returns the public suffix of domain and whether it comes from
+// the ICANN section of the list. Unlisted TLDs fall back to the "*" rule.
+// Domain must be a lower case ASCII name without trailing dot.
+func (l *PublicSuffixList) PublicSuffix(domain string) (suffix string, icann bool) {
+	labels := strings.Split(domain, ".")
+	for i := range labels {
+		candidate := strings.Join(labels[i:], ".")
+		if v, ok := l.rules["!"+candidate]; ok {
+			return strings.Join(labels[i+1:], "."), v
+		}
+		if v, ok := l.rules[candidate]; ok {
+			return candidate, v
+		}
+		if i+1 < len(labels) {
+			if v, ok := l.rules["*."+strings.Join(labels[i+1:], ".")]; ok {
+				return candidate, v
+			}
+		}
+	}
+	return labels[len(labels)-1], false
+}
+
+// RegistrableDomain returns the public suffix plus one label ("eTLD+1"),
+// or empty string when domain is itself a public suffix
+func (l *PublicSuffixList) RegistrableDomain(domain string) string {
+	suffix, _ := l.PublicSuffix(domain)
+	if len(suffix) >= len(domain) {
+		return ""
+	}
+	rest := domain[:len(domain)-len(suffix)-1]
+	return rest[strings.LastIndexByte(rest, '.')+1:] + "." + suffix
+}
+
+// EffectiveTLD returns the public suffix of the URL's Hostname, e.g. "co.uk".
+// Results are lower case, internationalized names are returned in Punycode.
+// IP literals and invalid names have no public suffix.
+func (u *RawURL) EffectiveTLD() string {
+	host, ok := pslHost(u.Hostname)
+	if !ok {
+		return ""
+	}
+	suffix, _ := DefaultPublicSuffixList().PublicSuffix(host)
+	return suffix
+}
+
+// RegistrableDomain returns the eTLD+1 of the URL's Hostname, e.g.
+// "example.co.uk" for "www.example.co.uk"
+func (u *RawURL) RegistrableDomain() string {
+	host, ok := pslHost(u.Hostname)
+	if !ok {
+		return ""
+	}
+	return DefaultPublicSuffixList().RegistrableDomain(host)
+}
+
+// Subdomain returns the part of the Hostname left of the registrable domain,
+// e.g. "a.b" for "a.b.example.com"
+func (u *RawURL) Subdomain() string {
+	host, ok := pslHost(u.Hostname)
+	if !ok {
+		return ""
+	}
+	domain := DefaultPublicSuffixList().RegistrableDomain(host)
+	if domain == "" || len(domain) >= len(host) {
+		return ""
+	}
+	return host[:len(host)-len(domain)-1]
+}
+
+// pslHost prepares a hostname for list lookups: lower case ASCII without
+// trailing dot. IP literals and names with empty labels are rejected.
+func pslHost(hostname string) (string, bool) {
+	if ClassifyHost(hostname).Kind != HostDNS {
+		return "", false
+	}
+	host := strings.ToLower(strings.TrimSuffix(hostname, "."))
+	if !isASCII(host) {
+		res, err := ToASCII(host)
+		if err != nil {
+			return "", false
+		}
+		host = res.Output
+	}
+	if host == "" || strings.HasPrefix(host, ".") || strings.Contains(host, "..") {
+		return "", false
+	}
+	return host, true
+}
@@ -0,0 +1,103 @@
+package rawurlparser
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPublicSuffix(t *testing.T) {
+	testCases := []struct {
+		input         string
+		wantTLD       string
+		wantDomain    string
+		wantSubdomain string
+	}{
+		{"https://www.example.com/", "com", "example.com", "www"},
+		{"https://a.b.example.co.uk/", "co.uk", "example.co.uk", "a.b"},
+		{"https://Example.COM./", "com", "example.com", ""},
+		{"https://co.uk/", "co.uk", "", ""},
+		{"https://user.github.io/", "github.io", "user.github.io", ""},
+		{"https://x.y.kawasaki.jp/", "y.kawasaki.jp", "x.y.kawasaki.jp", ""},
+		{"https://www.city.kawasaki.jp/", "kawasaki.jp", "city.kawasaki.jp", "www"},
+		{"https://foo.bar.unknowntld/", "unknowntld", "bar.unknowntld", "foo"},
+		{"https://www.bücher.de/", "de", "xn--bcher-kva.de", "www"},
+		{"https://shop.example.公司.cn/", "xn--55qx5d.cn", "example.xn--55qx5d.cn", "shop"},
+		{"https://shop.example.co.id/", "co.id", "example.co.id", "shop"},
+		{"https://ec2-1-2-3-4.eu-west-1.compute.amazonaws.com/", "eu-west-1.compute.amazonaws.com", "ec2-1-2-3-4.eu-west-1.compute.amazonaws.com", ""},
+		{"https://bucket.s3.eu-west-1.amazonaws.com/", "s3.eu-west-1.amazonaws.com", "bucket.s3.eu-west-1.amazonaws.com", ""},
+		{"https://www.ck/", "ck", "www.ck", ""},
+		{"http://127.0.0.1/", "", "", ""},
+		{"http://[::1]/", "", "", ""},
+		{"http://a..example.com/", "", "", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			u, err := RawURLParse(tc.input)
+			if err != nil {
+				t.Fatalf("Failed to parse URL %q: %v", tc.input, err)
+			}
+			if got := u.EffectiveTLD(); got != tc.wantTLD {
+				t.Errorf("EffectiveTLD() = %q, want %q", got, tc.wantTLD)
+			}
+			if got := u.RegistrableDomain(); got != tc.wantDomain {
+				t.Errorf("RegistrableDomain() = %q, want %q", got, tc.wantDomain)
+			}
+			if got := u.Subdomain(); got != tc.wantSubdomain {
+				t.Errorf("Subdomain() = %q, want %q", got, tc.wantSubdomain)
+			}
+		})
+	}
+}
+
+func TestEmbeddedPublicSuffixListSections(t *testing.T) {
+	l := DefaultPublicSuffixList()
+	if len(l.rules) < 9000 {
+		t.Errorf("embedded list has %d rules, want the full list", len(l.rules))
+	}
+	testCases := []struct {
+		domain     string
+		wantSuffix string
+		wantICANN  bool
+	}{
+		{"example.co.id", "co.id", true},
+		{"example.xn--55qx5d.cn", "xn--55qx5d.cn", true},
+		{"host.eu-west-1.compute.amazonaws.com", "eu-west-1.compute.amazonaws.com", false},
+		{"bucket.s3.us-east-2.amazonaws.com", "s3.us-east-2.amazonaws.com", false},
+		{"user.github.io", "github.io", false},
+	}
+
+	for _, tc := range testCases {
+		suffix, icann := l.PublicSuffix(tc.domain)
+		if suffix != tc.wantSuffix || icann != tc.wantICANN {
+			t.Errorf("PublicSuffix(%q) = %q, %v, want %q, %v", tc.domain, suffix, icann, tc.wantSuffix, tc.wantICANN)
+		}
+	}
+}
+
+func TestLoadPublicSuffixList(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "list.dat")
+	list := "// ===BEGIN PRIVATE DOMAINS===\nexample.com\n// ===END PRIVATE DOMAINS===\ncom\n"
+	if err := os.WriteFile(path, []byte(list), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	previous := DefaultPublicSuffixList()
+	defer SetPublicSuffixList(previous)
+
+	if err := LoadPublicSuffixList(path); err != nil {
+		t.Fatalf("LoadPublicSuffixList returned error: %v", err)
+	}
+	u, _ := RawURLParse("https://a.b.example.com/")
+	if got := u.RegistrableDomain(); got != "b.example.com" {
+		t.Errorf("RegistrableDomain() = %q, want %q", got, "b.example.com")
+	}
+	if suffix, icann := DefaultPublicSuffixList().PublicSuffix("b.example.com"); suffix != "example.com" || icann {
+		t.Errorf("PublicSuffix() = %q, %v, want example.com from the private section", suffix, icann)
+	}
+
+	if err := LoadPublicSuffixList(filepath.Join(t.TempDir(), "missing.dat")); err == nil {
+		t.Error("LoadPublicSuffixList accepted a missing file")
+	}
+}
@@ -0,0 +1,189 @@
+package rawurlparser
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"unicode/utf8"
+)
+
+var ErrInvalidPunycode = errors.New("invalid punycode")
+
+// Bootstring parameters for Punycode (RFC 3492, section 5)
+const (
+	punyBase        = 36
+	punyTMin        = 1
+	punyTMax        = 26
+	punySkew        = 38
+	punyDamp        = 700
+	punyInitialBias = 72
+	punyInitialN    = 128
+)
+
+// PunycodeEncode encodes a Unicode label as Punycode, without the "xn--" prefix.
+// "bücher" becomes "bcher-kva".
+func PunycodeEncode(label string) (string, error) {
+	runes := []rune(label)
+
+	var out strings.Builder
+	for _, r := range runes {
+		if r < utf8.RuneSelf {
+			out.WriteRune(r)
+		}
+	}
+	basic := out.Len()
+	handled := basic
+	if basic > 0 {
+		out.WriteByte('-')
+	}
+
+	n := int32(punyInitialN)
+	bias := int32(punyInitialBias)
+	delta := int32(0)
+	for handled < len(runes) {
+		// Smallest code point not handled yet
+		m := int32(math.MaxInt32)
+		for _, r := range runes {
+			if r >= n && r < m {
+				m = r
+			}
+		}
+		if m-n > (math.MaxInt32-delta)/int32(handled+1) {
+			return "", ErrInvalidPunycode
+		}
+		delta += (m - n) * int32(handled+1)
+		n = m
+
+		for _, r := range runes {
+			if r < n {
+				delta++
+				if delta < 0 {
+					return "", ErrInvalidPunycode
+				}
+			}
+			if r != n {
+				continue
+			}
+			q := delta
+			for k := int32(punyBase); ; k += punyBase {
+				t := punyThreshold(k, bias)
+				if q < t {
+					break
+				}
+				out.WriteByte(punyDigit(t + (q-t)%(punyBase-t)))
+				q = (q - t) / (punyBase - t)
+			}
+			out.WriteByte(punyDigit(q))
+			bias = punyAdapt(delta, int32(handled+1), handled == basic)
+			delta = 0
+			handled++
+		}
+		delta++
+		n++
+	}
+	return out.String(), nil
+}
+
+// PunycodeDecode decodes a Punycode label, without the "xn--" prefix.
+// "bcher-kva" becomes "bücher".
+func PunycodeDecode(encoded string) (string, error) {
+	var output []rune
+	pos := 0
+	if i := strings.LastIndexByte(encoded, '-'); i != -1 {
+		for j := 0; j < i; j++ {
+			if encoded[j] >= utf8.RuneSelf {
+				return "", ErrInvalidPunycode
+			}
+			output = append(output, rune(encoded[j]))
+		}
+		pos = i + 1
+	}
+
+	n := int32(punyInitialN)
+	bias := int32(punyInitialBias)
+	i := int32(0)
+	for pos < len(encoded) {
+		oldi := i
+		w := int32(1)
+		for k := int32(punyBase); ; k += punyBase {
+			if pos >= len(encoded) {
+				return "", ErrInvalidPunycode
+			}
+			digit, ok := punyDecodeDigit(encoded[pos])
+			pos++
+			if !ok || digit > (math.MaxInt32-i)/w {
+				return "", ErrInvalidPunycode
+			}
+			i += digit * w
+			t := punyThreshold(k, bias)
+			if digit < t {
+				break
+			}
+			if w > math.MaxInt32/(punyBase-t) {
+				return "", ErrInvalidPunycode
+			}
+			w *= punyBase - t
+		}
+
+		x := int32(len(output) + 1)
+		bias = punyAdapt(i-oldi, x, oldi == 0)
+		if i/x > math.MaxInt32-n {
+			return "", ErrInvalidPunycode
+		}
+		n += i / x
+		i %= x
+		if n > utf8.MaxRune || (n >= 0xD800 && n <= 0xDFFF) {
+			return "", ErrInvalidPunycode
+		}
+
+		output = append(output, 0)
+		copy(output[i+1:], output[i:])
+		output[i] = n
+		i++
+	}
+	return string(output), nil
+}
+
+func punyThreshold(k, bias int32) int32 {
+	switch {
+	case k <= bias:
+		return punyTMin
+	case k >= bias+punyTMax:
+		return punyTMax
+	}
+	return k - bias
+}
+
+func punyAdapt(delta, numPoints int32, first bool) int32 {
+	if first {
+		delta /= punyDamp
+	} else {
+		delta /= 2
+	}
+	delta += delta / numPoints
+	k := int32(0)
+	for delta > ((punyBase-punyTMin)*punyTMax)/2 {
+		delta /= punyBase - punyTMin
+		k += punyBase
+	}
+	return k + (punyBase-punyTMin+1)*delta/(delta+punySkew)
+}
+
+func punyDigit(d int32) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+	return byte('0' + d - 26)
+}
+
+func punyDecodeDigit(c byte) (int32, bool) {
+	switch {
+	case '0' <= c && c <= '9':
+		return int32(c-'0') + 26, true
+	case 'a' <= c && c <= 'z':
+		return int32(c - 'a'), true
+	case 'A' <= c && c <= 'Z':
+		return int32(c - 'A'), true
+	}
+	return 0, false
+}
@@ -0,0 +1,188 @@
+package rawurlparser
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// RawRequest is an HTTP/1.x request as it was captured
+type RawRequest struct {
+	RequestLine string        // Request line without the line ending, byte for byte
+	Method      string        // Request method, byte for byte
+	Target      string        // Request-target, byte for byte
+	Proto       string        // Protocol version as sent, e.g. "HTTP/1.1"
+	Header      []HeaderField // Headers in order, names and values as sent
+	Host        string        // Value of the first Host header
+	Body        []byte        // Body as sent; chunked bodies keep their chunk framing
+	URL         *RawURL       // Target and Host, see FromRequestTarget; nil when URLError is set
+	URLError    error         // Why Target and Host do not form a URL
+}
+
+// Get returns the first value of the named header, case-insensitively
+func (r *RawRequest) Get(name string) string {
+	for _, h := range r.Header {
+		if strings.EqualFold(h.Name, name) {
+			return h.Value
+		}
+	}
+	return ""
+}
+
+// ParseRequestLine splits "METHOD target HTTP/x.y" into its parts. Spaces
+// and tabs both separate the parts, and the target ends at the last of them,
+// so targets containing spaces are kept. The protocol is returned as sent,
+// without checking that it is an HTTP version.
+func ParseRequestLine(line string) (method, target, proto string, err error) {
+	line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+	first, last := strings.IndexAny(line, " \t"), strings.LastIndexAny(line, " \t")
+	if first <= 0 || first == last || last == len(line)-1 {
+		return "", "", "", fmt.Errorf("%w: malformed request line %q", ErrInvalidRequest, line)
+	}
+	return line[:first], line[first+1 : last], line[last+1:], nil
+}
+
+// ParseRequest reads a single request from r and builds its URL with the
+// "http" scheme. See ParseRequestWithScheme.
+func ParseRequest(r io.Reader) (*RawRequest, error) {
+	return ParseRequestWithScheme(r, "http")
+}
+
+// ParseRequestWithScheme reads a single request from r: the request line,
+// headers up to the empty line and a body framed by Content-Length or
+// chunked Transfer-Encoding. Nothing is normalized. All four request-target
+// forms are accepted, absolute-form targets bring their own scheme. When the
+// target and Host do not form a URL the request is still returned, with
+// URLError set.
+// Pass a *bufio.Reader to read several requests from one stream; io.EOF is
+// returned when the stream ends before a request starts.
+func ParseRequestWithScheme(r io.Reader, scheme string) (*RawRequest, error) {
+	br, ok := r.(*bufio.Reader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+
+	line, err := readRequestLine(br)
+	if err != nil {
+		return nil, err
+	}
+	req := &RawRequest{RequestLine: line}
+	if req.Method, req.Target, req.Proto, err = ParseRequestLine(line); err != nil {
+		return nil, err
+	}
+
+	hostSeen, chunked, length := false, false, -1
+	for {
+		line, err := readRequestLine(br)
+		if err != nil {
+			return nil, noEOF(err)
+		}
+		if line == "" {
+			break
+		}
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("%w: malformed header line %q", ErrInvalidRequest, line)
+		}
+		value = strings.TrimLeft(value, " \t")
+		req.Header = append(req.Header, HeaderField{Name: name, Value: value})
+
+		switch strings.ToLower(name) {
+		case "host":
+			if !hostSeen {
+				req.Host, hostSeen = value, true
+			}
+		case "content-length":
+			n, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("%w: invalid Content-Length %q", ErrInvalidRequest, value)
+			}
+			length = n
+		case "transfer-encoding":
+			chunked = strings.EqualFold(strings.TrimSpace(value), "chunked")
+		}
+	}
+
+	switch {
+	case chunked:
+		if req.Body, err = readChunkedRaw(br); err != nil {
+			return nil, err
+		}
+	case length > 0:
+		// Read instead of allocating, Content-Length is untrusted
+		if req.Body, err = io.ReadAll(io.LimitReader(br, int64(length))); err != nil {
+			return nil, noEOF(err)
+		}
+		if len(req.Body) < length {
+			return nil, io.ErrUnexpectedEOF
+		}
+	}
+
+	req.URL, req.URLError = FromRequestTarget(scheme, req.Host, req.Target)
+	return req, nil
+}
+
+// readRequestLine reads a line and strips the CRLF (or bare LF)
+func readRequestLine(br *bufio.Reader) (string, error) {
+	line, err := br.ReadString('\n')
+	if err != nil {
+		if err == io.EOF && line != "" {
+			return "", io.ErrUnexpectedEOF
+		}
+		return "", err
+	}
+	line = strings.TrimSuffix(line, "\n")
+	return strings.TrimSuffix(line, "\r"), nil
+}
+
+// readChunkedRaw reads a chunked body including sizes, extensions and
+// trailers, exactly as sent
+func readChunkedRaw(br *bufio.Reader) ([]byte, error) {
+	var body bytes.Buffer
+	for {
+		line, err := br.ReadString('\n')
+		body.WriteString(line)
+		if err != nil {
+			return nil, noEOF(err)
+		}
+		sizeField, _, _ := strings.Cut(strings.TrimRight(line, "\r\n"), ";")
+		size, err := strconv.ParseUint(strings.TrimSpace(sizeField), 16, 63)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid chunk size %q", ErrInvalidRequest, line)
+		}
+		if size == 0 {
+			break
+		}
+		if _, err := io.CopyN(&body, br, int64(size)); err != nil {
+			return nil, noEOF(err)
+		}
+		line, err = br.ReadString('\n')
+		body.WriteString(line)
+		if err != nil {
+			return nil, noEOF(err)
+		}
+	}
+
+	// Trailers up to the empty line
+	for {
+		line, err := br.ReadString('\n')
+		body.WriteString(line)
+		if err != nil {
+			return nil, noEOF(err)
+		}
+		if line == "\r\n" || line == "\n" {
+			return body.Bytes(), nil
+		}
+	}
+}
+
+// noEOF turns io.EOF inside a request into io.ErrUnexpectedEOF
+func noEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,203 @@
+package rawurlparser
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseRequestLine(t *testing.T) {
+	tests := []struct {
+		line                  string
+		method, target, proto string
+		wantErr               bool
+	}{
+		{"GET /a/..;/b?x HTTP/1.1", "GET", "/a/..;/b?x", "HTTP/1.1", false},
+		{"GET /a/..;/b?x HTTP/1.1\r\n", "GET", "/a/..;/b?x", "HTTP/1.1", false},
+		{"GET /with space/x HTTP/1.0", "GET", "/with space/x", "HTTP/1.0", false},
+		{"CONNECT example.com:443 HTTP/1.1", "CONNECT", "example.com:443", "HTTP/1.1", false},
+		{"OPTIONS * HTTP/1.1", "OPTIONS", "*", "HTTP/1.1", false},
+		{"get http://x.com/%2e%2e HTTP/1.1", "get", "http://x.com/%2e%2e", "HTTP/1.1", false},
+		{"GET /", "", "", "", true},
+		{"GET / FTP/1.0", "GET", "/", "FTP/1.0", false},
+		{"GET\t/tab\tHTTP/1.1", "GET", "/tab", "HTTP/1.1", false},
+		{"GET /a\tb HTTP/1.1", "GET", "/a\tb", "HTTP/1.1", false},
+		{" / HTTP/1.1", "", "", "", true},
+		{"GET / ", "", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			method, target, proto, err := ParseRequestLine(tt.line)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRequestLine() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if method != tt.method || target != tt.target || proto != tt.proto {
+				t.Errorf("ParseRequestLine() = %q, %q, %q", method, target, proto)
+			}
+		})
+	}
+}
+
+func TestParseRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		scheme   string
+		wantURL  string
+		wantHost string
+		wantURI  string
+		wantBody string
+	}{
+		{
+			name:     "origin-form",
+			raw:      "GET /a/..;/b?x HTTP/1.1\r\nHost: Example.com:8080\r\nX-A:  1\r\nx-a: 2\r\n\r\n",
+			scheme:   "https",
+			wantURL:  "https://Example.com:8080/a/..;/b?x",
+			wantHost: "Example.com:8080",
+			wantURI:  "/a/..;/b?x",
+		},
+		{
+			name:     "absolute-form proxy request",
+			raw:      "GET http://target.com/%2e%2e/admin HTTP/1.1\r\nHost: proxy\r\n\r\n",
+			scheme:   "https",
+			wantURL:  "http://target.com/%2e%2e/admin",
+			wantHost: "target.com",
+			wantURI:  "/%2e%2e/admin",
+		},
+		{
+			name:     "authority-form",
+			raw:      "CONNECT internal:8443 HTTP/1.1\r\nHost: internal:8443\r\n\r\n",
+			scheme:   "http",
+			wantURL:  "http://internal:8443",
+			wantHost: "internal:8443",
+			wantURI:  "",
+		},
+		{
+			name:     "asterisk-form with bare LF",
+			raw:      "OPTIONS * HTTP/1.1\nHost: h\n\n",
+			scheme:   "http",
+			wantURL:  "http://h*",
+			wantHost: "h",
+			wantURI:  "*",
+		},
+		{
+			name:     "content-length body",
+			raw:      "POST /submit HTTP/1.1\r\nHost: h\r\nContent-Length: 3\r\n\r\na=1GET /next HTTP/1.1\r\n",
+			scheme:   "http",
+			wantURL:  "http://h/submit",
+			wantHost: "h",
+			wantURI:  "/submit",
+			wantBody: "a=1",
+		},
+		{
+			name:     "chunked body kept raw",
+			raw:      "POST / HTTP/1.1\r\nHost: h\r\nTransfer-Encoding: chunked\r\n\r\n3;ext=1\r\nabc\r\n0\r\nX-Trailer: t\r\n\r\n",
+			scheme:   "http",
+			wantURL:  "http://h/",
+			wantHost: "h",
+			wantURI:  "/",
+			wantBody: "3;ext=1\r\nabc\r\n0\r\nX-Trailer: t\r\n\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := ParseRequestWithScheme(strings.NewReader(tt.raw), tt.scheme)
+			if err != nil {
+				t.Fatalf("ParseRequest() error = %v", err)
+			}
+			if got := req.URL.Original; got != tt.wantURL {
+				t.Errorf("URL = %q, want %q", got, tt.wantURL)
+			}
+			if req.URL.Host != tt.wantHost {
+				t.Errorf("URL.Host = %q, want %q", req.URL.Host, tt.wantHost)
+			}
+			if got := req.URL.GetRawRequestURI(); got != tt.wantURI {
+				t.Errorf("GetRawRequestURI() = %q, want %q", got, tt.wantURI)
+			}
+			if string(req.Body) != tt.wantBody {
+				t.Errorf("Body = %q, want %q", req.Body, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestParseRequestHeadersAndStream(t *testing.T) {
+	raw := "GET /one HTTP/1.1\r\nHost: a\r\nHost: b\r\nX-Case:  v \r\n\r\nGET /two HTTP/1.1\r\nHost: c\r\n\r\n"
+	br := bufio.NewReader(strings.NewReader(raw))
+
+	first, err := ParseRequest(br)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []HeaderField{{"Host", "a"}, {"Host", "b"}, {"X-Case", "v "}}
+	if !reflect.DeepEqual(first.Header, want) {
+		t.Errorf("Header = %q, want %q", first.Header, want)
+	}
+	if first.Host != "a" || first.Get("x-case") != "v " || first.Get("missing") != "" {
+		t.Errorf("Host = %q, Get = %q", first.Host, first.Get("x-case"))
+	}
+
+	second, err := ParseRequest(br)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.Target != "/two" || second.Host != "c" {
+		t.Errorf("second request = %+v", second)
+	}
+	if _, err := ParseRequest(br); err != io.EOF {
+		t.Errorf("end of stream error = %v, want io.EOF", err)
+	}
+}
+
+func TestParseRequestURLError(t *testing.T) {
+	tests := []struct {
+		name, raw string
+	}{
+		{"bad host", "GET /x HTTP/1.1\r\nHost: [::1\r\nX-A: 1\r\n\r\n"},
+		{"bad absolute-form", "GET http://[::1/x HTTP/1.1\r\nHost: h\r\nX-A: 1\r\n\r\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := ParseRequest(strings.NewReader(tt.raw))
+			if err != nil {
+				t.Fatalf("ParseRequest() error = %v", err)
+			}
+			if req.URL != nil || req.URLError == nil {
+				t.Errorf("URL, URLError = %v, %v, want nil and an error", req.URL, req.URLError)
+			}
+			if req.Method != "GET" || req.Get("X-A") != "1" || req.RequestLine != strings.SplitN(tt.raw, "\r\n", 2)[0] {
+				t.Errorf("request = %+v", req)
+			}
+		})
+	}
+}
+
+func TestParseRequestErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want error
+	}{
+		{"truncated headers", "GET / HTTP/1.1\r\nHost: h\r\n", io.ErrUnexpectedEOF},
+		{"truncated body", "POST / HTTP/1.1\r\nContent-Length: 10\r\n\r\nabc", io.ErrUnexpectedEOF},
+		{"bad header", "GET / HTTP/1.1\r\nno colon\r\n\r\n", ErrInvalidRequest},
+		{"huge length", "POST / HTTP/1.1\r\nContent-Length: 9223372036854775807\r\n\r\nabc", io.ErrUnexpectedEOF},
+		{"large length", "POST / HTTP/1.1\r\nContent-Length: 68719476736\r\n\r\nabc", io.ErrUnexpectedEOF},
+		{"bad length", "GET / HTTP/1.1\r\nContent-Length: -1\r\n\r\n", ErrInvalidRequest},
+		{"bad chunk", "POST / HTTP/1.1\r\nTransfer-Encoding: chunked\r\n\r\nzz\r\n", ErrInvalidRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseRequest(strings.NewReader(tt.raw)); !errors.Is(err, tt.want) {
+				t.Errorf("ParseRequest() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
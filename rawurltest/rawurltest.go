@@ -0,0 +1,204 @@
+// File: rawurltest/rawurltest.go
+/*
+Package rawurltest provides a local HTTP/1.x server that records requests
+byte for byte, to check that what a client sent is exactly what rawurlparser
+produced.
+
+The server reads request lines and headers itself instead of going through
+net/http, so nothing is cleaned, unescaped or rejected. Every request is
+answered with its own record as JSON. The records of all requests are
+available from Requests and from the JSON endpoint at RequestsPath.
+*/
+package rawurltest
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/slicingmelon/go-rawurlparser"
+)
+
+// RequestsPath is the request-target that returns all recorded requests
+// as a JSON array. Requests to it are not recorded.
+const RequestsPath = "/_rawurltest/requests"
+
+// Request is a recorded request
+type Request struct {
+	Seq         int                        `json:"seq"`          // Position in arrival order, starting at 1
+	RemoteAddr  string                     `json:"remote_addr"`  // Client address
+	RequestLine string                     `json:"request_line"` // The request line without CRLF, byte for byte
+	Method      string                     `json:"method"`
+	Target      string                     `json:"target"` // The request-target, byte for byte
+	Proto       string                     `json:"proto"`
+	Host        string                     `json:"host"`   // The first Host header value, byte for byte
+	Header      []rawurlparser.HeaderField `json:"header"` // All headers in order
+	Body        []byte                     `json:"body,omitempty"`
+	URL         *rawurlparser.RawURL       `json:"url,omitempty"` // Target and Host parsed by rawurlparser, nil on error
+	URLError    string                     `json:"url_error,omitempty"`
+}
+
+// Server is a recording server listening on a loopback address
+type Server struct {
+	URL      string // Base URL of the form http://127.0.0.1:port
+	Addr     string // Listening address, host:port
+	Listener net.Listener
+
+	mu       sync.Mutex
+	requests []Request
+	conns    map[net.Conn]struct{}
+	closed   bool
+	wg       sync.WaitGroup
+}
+
+// NewServer starts and returns a new Server. The caller should call Close
+// when finished, to shut it down.
+func NewServer() *Server {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		if l, err = net.Listen("tcp6", "[::1]:0"); err != nil {
+			panic(fmt.Sprintf("rawurltest: failed to listen on a port: %v", err))
+		}
+	}
+	s := &Server{
+		Addr:     l.Addr().String(),
+		URL:      "http://" + l.Addr().String(),
+		Listener: l,
+		conns:    make(map[net.Conn]struct{}),
+	}
+	s.wg.Add(1)
+	go s.serve()
+	return s
+}
+
+// Requests returns a copy of the recorded requests in arrival order
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// Last returns the most recent request
+func (s *Server) Last() (Request, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.requests) == 0 {
+		return Request{}, false
+	}
+	return s.requests[len(s.requests)-1], true
+}
+
+// Reset forgets all recorded requests
+func (s *Server) Reset() {
+	s.mu.Lock()
+	s.requests = nil
+	s.mu.Unlock()
+}
+
+// Close shuts down the server and closes all connections
+func (s *Server) Close() {
+	s.mu.Lock()
+	s.closed = true
+	s.Listener.Close()
+	for c := range s.conns {
+		c.Close()
+	}
+	s.mu.Unlock()
+	s.wg.Wait()
+}
+
+// serve accepts connections until the listener is closed
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		c, err := s.Listener.Accept()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			c.Close()
+			return
+		}
+		s.conns[c] = struct{}{}
+		s.wg.Add(1)
+		s.mu.Unlock()
+		go s.handle(c)
+	}
+}
+
+// handle serves the requests of a single connection
+func (s *Server) handle(c net.Conn) {
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, c)
+		s.mu.Unlock()
+		c.Close()
+		s.wg.Done()
+	}()
+
+	br := bufio.NewReader(c)
+	for {
+		req, keepAlive, err := readRequest(br)
+		if err != nil {
+			if err != io.EOF {
+				writeResponse(c, "400 Bad Request", []byte(`{"error":`+strconv.Quote(err.Error())+`}`), false)
+			}
+			return
+		}
+		req.RemoteAddr = c.RemoteAddr().String()
+
+		var body []byte
+		if req.Target == RequestsPath {
+			body, _ = json.Marshal(s.Requests())
+		} else {
+			s.mu.Lock()
+			req.Seq = len(s.requests) + 1
+			s.requests = append(s.requests, *req)
+			s.mu.Unlock()
+			body, _ = json.Marshal(req)
+		}
+		if err := writeResponse(c, "200 OK", body, keepAlive); err != nil || !keepAlive {
+			return
+		}
+	}
+}
+
+// readRequest reads the next request of a connection
+func readRequest(br *bufio.Reader) (*Request, bool, error) {
+	raw, err := rawurlparser.ParseRequest(br)
+	if err != nil {
+		return nil, false, err
+	}
+	req := &Request{
+		RequestLine: raw.RequestLine,
+		Method:      raw.Method,
+		Target:      raw.Target,
+		Proto:       raw.Proto,
+		Host:        raw.Host,
+		Header:      raw.Header,
+		Body:        raw.Body,
+		URL:         raw.URL,
+	}
+	if raw.URLError != nil {
+		req.URLError = raw.URLError.Error()
+	}
+	keepAlive := raw.Proto == "HTTP/1.1" && !strings.EqualFold(strings.TrimSpace(raw.Get("Connection")), "close")
+	return req, keepAlive, nil
+}
+
+// writeResponse writes a JSON response
+func writeResponse(w io.Writer, status string, body []byte, keepAlive bool) error {
+	connection := "keep-alive"
+	if !keepAlive {
+		connection = "close"
+	}
+	_, err := fmt.Fprintf(w, "HTTP/1.1 %s\r\nContent-Type: application/json\r\nContent-Length: %d\r\nConnection: %s\r\n\r\n%s", status, len(body), connection, body)
+	return err
+}
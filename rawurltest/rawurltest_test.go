@@ -0,0 +1,220 @@
+package rawurltest
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/slicingmelon/go-rawurlparser"
+)
+
+func TestServerRecordsRawRequests(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	targets := []string{
+		"/a/../b/./c",
+		"/%zz/invalid-escape",
+		"/path;/..;/admin?x=<script>",
+		"//evil.com/x",
+		`/\..\windows\win.ini`,
+		"/unicode/。。/é",
+		"/with space/x",
+	}
+
+	for _, target := range targets {
+		u, err := rawurlparser.RawURLParse(srv.URL + target)
+		if err != nil {
+			t.Fatal(err)
+		}
+		conn, err := net.Dial("tcp", srv.Addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = rawurlparser.WriteRequest(conn, u, &rawurlparser.RequestOptions{Host: "Example.COM:8080", Header: []rawurlparser.HeaderField{{Name: "connection", Value: "close"}}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := http.ReadResponse(bufio.NewReader(conn), nil)
+		if err != nil {
+			t.Fatalf("%q: ReadResponse() error = %v", target, err)
+		}
+		var echoed Request
+		if err := json.NewDecoder(resp.Body).Decode(&echoed); err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		conn.Close()
+
+		if echoed.Target != target || echoed.RequestLine != "GET "+target+" HTTP/1.1" {
+			t.Errorf("echoed %q, want %q", echoed.RequestLine, target)
+		}
+	}
+
+	got := srv.Requests()
+	if len(got) != len(targets) {
+		t.Fatalf("recorded %d requests, want %d", len(got), len(targets))
+	}
+	for i, req := range got {
+		if req.Seq != i+1 || req.Target != targets[i] || req.Host != "Example.COM:8080" {
+			t.Errorf("request %d = %+v", i, req)
+		}
+		if req.URL == nil || req.URL.GetRawRequestURI() != targets[i] || req.URL.Host != "Example.COM:8080" {
+			t.Errorf("request %d URL = %v (%s)", i, req.URL, req.URLError)
+		}
+	}
+}
+
+func TestServerWithTransport(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	u, err := rawurlparser.RawURLParse(srv.URL + "/%2e%2e/admin?q=%00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := rawurlparser.NewRequest(context.Background(), "POST", u, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("X-Test", "1")
+	resp, err := rawurlparser.NewClient().Do(req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	last, ok := srv.Last()
+	if !ok {
+		t.Fatal("no request recorded")
+	}
+	if last.RequestLine != "POST /%2e%2e/admin?q=%00 HTTP/1.1" {
+		t.Errorf("RequestLine = %q", last.RequestLine)
+	}
+	found := false
+	for _, h := range last.Header {
+		if h.Name == "X-Test" && h.Value == "1" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("header X-Test not recorded: %v", last.Header)
+	}
+
+	srv.Reset()
+	if len(srv.Requests()) != 0 {
+		t.Errorf("Reset() kept requests")
+	}
+}
+
+func TestServerJSONEndpointAndKeepAlive(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	conn, err := net.Dial("tcp", srv.Addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	br := bufio.NewReader(conn)
+
+	// Two requests on one connection, then the JSON endpoint
+	for _, target := range []string{"/first", "/second?x=1", RequestsPath} {
+		if _, err := io.WriteString(conn, "GET "+target+" HTTP/1.1\r\nHost: h\r\n\r\n"); err != nil {
+			t.Fatal(err)
+		}
+		resp, err := http.ReadResponse(br, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if target != RequestsPath {
+			continue
+		}
+		var list []Request
+		if err := json.Unmarshal(body, &list); err != nil {
+			t.Fatalf("decode %s: %v", body, err)
+		}
+		if len(list) != 2 || list[0].Target != "/first" || list[1].Target != "/second?x=1" {
+			t.Errorf("JSON endpoint returned %s", body)
+		}
+		if list[1].URL == nil || list[1].URL.Query != "x=1" {
+			t.Errorf("parsed URL = %+v", list[1].URL)
+		}
+	}
+}
+
+func TestServerBadRequest(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	conn, err := net.Dial("tcp", srv.Addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	io.WriteString(conn, "NOSPACES\r\n\r\n")
+	resp, err := http.ReadResponse(bufio.NewReader(conn), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("status = %d", resp.StatusCode)
+	}
+	if len(srv.Requests()) != 0 {
+		t.Errorf("malformed request was recorded")
+	}
+}
+
+func TestServerRecordsHostileRequests(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	tests := []struct {
+		raw         string
+		requestLine string
+		wantURL     bool
+	}{
+		{"GET /x HTTP/1.1\r\nHost: [::1\r\n\r\n", "GET /x HTTP/1.1", false},
+		{"GET http://[::1/x HTTP/1.1\r\nHost: h\r\n\r\n", "GET http://[::1/x HTTP/1.1", false},
+		{"GET\t/tabs\tHTTP/1.1\r\nHost: h\r\n\r\n", "GET\t/tabs\tHTTP/1.1", true},
+		{"GET /proto FOO/9\r\nHost: h\r\n\r\n", "GET /proto FOO/9", true},
+	}
+
+	for i, tt := range tests {
+		conn, err := net.Dial("tcp", srv.Addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		io.WriteString(conn, tt.raw)
+		resp, err := http.ReadResponse(bufio.NewReader(conn), nil)
+		conn.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("%q: status = %d", tt.raw, resp.StatusCode)
+		}
+
+		reqs := srv.Requests()
+		if len(reqs) != i+1 {
+			t.Fatalf("%q: recorded %d requests, want %d", tt.raw, len(reqs), i+1)
+		}
+		req := reqs[i]
+		if req.RequestLine != tt.requestLine {
+			t.Errorf("RequestLine = %q, want %q", req.RequestLine, tt.requestLine)
+		}
+		if (req.URL != nil) != tt.wantURL || (req.URLError == "") != tt.wantURL {
+			t.Errorf("%q: URL, URLError = %v, %q", tt.raw, req.URL, req.URLError)
+		}
+	}
+}
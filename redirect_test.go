@@ -0,0 +1,131 @@
+package rawurlparser
+
+import "testing"
+
+func TestGenerateRedirectPayloads(t *testing.T) {
+	allowed, err := RawURLParse("https://allowed.com/login")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	payloads, err := GenerateRedirectPayloads(allowed, "")
+	if err != nil {
+		t.Fatalf("GenerateRedirectPayloads() error = %v", err)
+	}
+
+	byValue := map[string]RedirectPayload{}
+	techniques := map[string]bool{}
+	for _, p := range payloads {
+		if _, dup := byValue[p.Value]; dup {
+			t.Errorf("duplicate payload %q", p.Value)
+		}
+		if _, err := RawURLParse(p.Value); err != nil {
+			t.Errorf("payload %q does not parse: %v", p.Value, err)
+		}
+		byValue[p.Value] = p
+		techniques[p.Technique] = true
+	}
+
+	for _, technique := range []string{RedirectUserinfo, RedirectBackslash, RedirectSchemeRelative, RedirectEncodedSlash, RedirectWhitespace, RedirectSchemeConfuse, RedirectFragmentQuery, RedirectDomainConfuse} {
+		if !techniques[technique] {
+			t.Errorf("no payload for technique %q", technique)
+		}
+	}
+
+	tests := []struct {
+		value     string
+		location  string
+		rawURL    string
+		stdlib    string
+		whatwg    string
+		divergent bool
+	}{
+		{"https://allowed.com@evil.com", "https://allowed.com@evil.com", "evil.com", "evil.com", "evil.com", false},
+		{`https://evil.com\@allowed.com`, `https://evil.com\@allowed.com`, "allowed.com", "", "evil.com", true},
+		{`/\evil.com`, `/\evil.com`, "allowed.com", "allowed.com", "evil.com", true},
+		{"/%09/evil.com", "/\t/evil.com", "allowed.com", "", "evil.com", true},
+		{"%2f%2fevil.com", "//evil.com", "evil.com", "evil.com", "evil.com", false},
+		{"https://evil.com#@allowed.com", "https://evil.com#@allowed.com", "allowed.com", "evil.com", "evil.com", true},
+		{"https:evil.com", "https:evil.com", "", "", "allowed.com", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			p, ok := byValue[tt.value]
+			if !ok {
+				t.Fatalf("payload %q not generated", tt.value)
+			}
+			if p.Location != tt.location || p.RawURLHost != tt.rawURL || p.StdlibHost != tt.stdlib || p.WHATWGHost != tt.whatwg {
+				t.Errorf("got %+v", p)
+			}
+			if p.Divergent() != tt.divergent {
+				t.Errorf("Divergent() = %v, want %v", p.Divergent(), tt.divergent)
+			}
+		})
+	}
+}
+
+func TestGenerateRedirectPayloadsIP(t *testing.T) {
+	allowed, err := RawURLParse("https://allowed.com/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	payloads, err := GenerateRedirectPayloads(allowed, "1.2.3.4")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n := 0
+	for _, p := range payloads {
+		if p.Technique != RedirectIPNotation {
+			continue
+		}
+		n++
+		if p.WHATWGHost != "1.2.3.4" && p.WHATWGHost != "[::ffff:102:304]" {
+			t.Errorf("%q: WHATWGHost = %q", p.Value, p.WHATWGHost)
+		}
+	}
+	if n == 0 {
+		t.Errorf("no %s payloads for an IPv4 attacker", RedirectIPNotation)
+	}
+
+	if _, err := GenerateRedirectPayloads(allowed, "ev il.com"); err == nil {
+		t.Errorf("GenerateRedirectPayloads() invalid attacker should fail")
+	}
+}
+
+func TestRawURLRefHost(t *testing.T) {
+	base, err := RawURLParse("https://allowed.com/login")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for ref, want := range map[string]string{
+		"/next":                "allowed.com",
+		"next?x=1":             "allowed.com",
+		"#top":                 "allowed.com",
+		`\\evil.com`:           "allowed.com",
+		"//evil.com/x":         "evil.com",
+		"http://evil.com:8080": "evil.com",
+		"https:evil.com":       "",
+	} {
+		if got := rawURLRefHost(ref, base, "https"); got != want {
+			t.Errorf("rawURLRefHost(%q) = %q, want %q", ref, got, want)
+		}
+	}
+}
+
+func TestRedirectPayloadDivergent(t *testing.T) {
+	tests := []struct {
+		p    RedirectPayload
+		want bool
+	}{
+		{RedirectPayload{RawURLHost: "Evil.COM", StdlibHost: "Evil.COM", WHATWGHost: "evil.com"}, false},
+		{RedirectPayload{RawURLHost: "evil.com", StdlibHost: "EVIL.com", WHATWGHost: "evil.com"}, false},
+		{RedirectPayload{RawURLHost: "allowed.com", StdlibHost: "evil.com", WHATWGHost: "evil.com"}, true},
+		{RedirectPayload{RawURLHost: "", StdlibHost: "", WHATWGHost: "evil.com"}, true},
+	}
+	for _, tt := range tests {
+		if got := tt.p.Divergent(); got != tt.want {
+			t.Errorf("%+v Divergent() = %v, want %v", tt.p, got, tt.want)
+		}
+	}
+}
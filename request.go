@@ -0,0 +1,155 @@
+package rawurlparser
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidRequest = errors.New("invalid request")
+
+// RequestForm is the form of the request-target (RFC 9112, section 3.2)
+type RequestForm int
+
+const (
+	OriginForm    RequestForm = iota // GET /path?query HTTP/1.1
+	AbsoluteForm                     // GET http://host/path?query HTTP/1.1 (proxies)
+	AuthorityForm                    // CONNECT host:port HTTP/1.1
+	AsteriskForm                     // OPTIONS * HTTP/1.1
+)
+
+func (f RequestForm) String() string {
+	switch f {
+	case OriginForm:
+		return "origin-form"
+	case AbsoluteForm:
+		return "absolute-form"
+	case AuthorityForm:
+		return "authority-form"
+	case AsteriskForm:
+		return "asterisk-form"
+	}
+	return "unknown"
+}
+
+// HeaderField is a single header line, written exactly as given
+type HeaderField struct {
+	Name  string
+	Value string
+}
+
+// RequestOptions controls how a request is written
+type RequestOptions struct {
+	Method   string        // Request method, "GET" when empty
+	Form     RequestForm   // Form of the request-target
+	Proto    string        // Protocol version, "HTTP/1.1" when empty
+	Host     string        // Host header value, the URL's Host when empty
+	OmitHost bool          // Do not write a Host header at all
+	Header   []HeaderField // Additional headers in order, names are not canonicalized
+	Body     []byte        // Request body; Content-Length is added unless set in Header
+}
+
+// DefaultRequestOptions returns options for a plain origin-form GET request
+func DefaultRequestOptions() *RequestOptions {
+	return &RequestOptions{
+		Method: "GET",
+		Form:   OriginForm,
+		Proto:  "HTTP/1.1",
+	}
+}
+
+// RequestTarget returns the request-target of u in the given form.
+// Origin-form is GetRawRequestURI() exactly as it is, authority-form adds the
+// scheme's default port when the URL has none.
+func (u *RawURL) RequestTarget(form RequestForm) string {
+	switch form {
+	case AbsoluteForm:
+		return GetScheme(u) + GetAuthority(u) + u.GetRawRequestURI()
+	case AuthorityForm:
+		if u.Port == "" && u.EffectivePort() != "" {
+			return u.Hostname + ":" + u.EffectivePort()
+		}
+		return u.Host
+	case AsteriskForm:
+		return "*"
+	}
+	return u.GetRawRequestURI()
+}
+
+// RequestLine returns "METHOD target PROTO" without the trailing CRLF
+func RequestLine(u *RawURL, opts *RequestOptions) string {
+	opts = requestOptions(opts)
+	return opts.Method + " " + u.RequestTarget(opts.Form) + " " + opts.Proto
+}
+
+// WriteRequest writes an HTTP/1.x request for u to w: the request line, the
+// Host header, opts.Header in order, Content-Length for a body and the body.
+// Nothing is normalized or encoded, the request-target goes onto the wire
+// byte for byte.
+func WriteRequest(w io.Writer, u *RawURL, opts *RequestOptions) error {
+	if u == nil {
+		return fmt.Errorf("%w: nil URL", ErrInvalidRequest)
+	}
+	opts = requestOptions(opts)
+	if strings.ContainsAny(opts.Method, " \r\n") {
+		return fmt.Errorf("%w: method %q", ErrInvalidRequest, opts.Method)
+	}
+	if u.RequestTarget(opts.Form) == "" {
+		return fmt.Errorf("%w: empty request target", ErrInvalidRequest)
+	}
+
+	bw := bufio.NewWriter(w)
+	bw.WriteString(RequestLine(u, opts))
+	bw.WriteString("\r\n")
+
+	if !opts.OmitHost {
+		host := opts.Host
+		if host == "" {
+			host = u.Host
+		}
+		bw.WriteString("Host: " + host + "\r\n")
+	}
+
+	hasLength := false
+	for _, h := range opts.Header {
+		if strings.EqualFold(h.Name, "Content-Length") || strings.EqualFold(h.Name, "Transfer-Encoding") {
+			hasLength = true
+		}
+		bw.WriteString(h.Name + ": " + h.Value + "\r\n")
+	}
+	if opts.Body != nil && !hasLength {
+		bw.WriteString("Content-Length: " + strconv.Itoa(len(opts.Body)) + "\r\n")
+	}
+	bw.WriteString("\r\n")
+	bw.Write(opts.Body)
+
+	return bw.Flush()
+}
+
+// BuildRequest returns the bytes WriteRequest would write
+func BuildRequest(u *RawURL, opts *RequestOptions) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := WriteRequest(&buf, u, opts); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// requestOptions fills in defaults without modifying the caller's options
+func requestOptions(opts *RequestOptions) *RequestOptions {
+	if opts == nil {
+		return DefaultRequestOptions()
+	}
+	o := *opts
+	if o.Method == "" {
+		o.Method = "GET"
+	}
+	if o.Proto == "" {
+		o.Proto = "HTTP/1.1"
+	}
+	return &o
+}
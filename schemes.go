@@ -0,0 +1,145 @@
+package rawurlparser
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var ErrInvalidScheme = errors.New("invalid scheme")
+
+// SchemeInfo describes how URLs of a scheme behave
+type SchemeInfo struct {
+	Name              string // Lower case scheme name, e.g. "https"
+	DefaultPort       string // Port used when none is given, empty if the scheme has none
+	Hierarchical      bool   // True for scheme://authority/path URLs, false for opaque ones (mailto:)
+	AllowsCredentials bool   // Whether user:pass@ may appear in the authority
+	Special           bool   // WHATWG special scheme (http, https, ws, wss, ftp, file)
+}
+
+var (
+	schemesMu sync.RWMutex
+	schemes   = map[string]SchemeInfo{}
+)
+
+func init() {
+	for _, info := range []SchemeInfo{
+		// WHATWG special schemes
+		{Name: "http", DefaultPort: "80", Hierarchical: true, AllowsCredentials: true, Special: true},
+		{Name: "https", DefaultPort: "443", Hierarchical: true, AllowsCredentials: true, Special: true},
+		{Name: "ws", DefaultPort: "80", Hierarchical: true, AllowsCredentials: true, Special: true},
+		{Name: "wss", DefaultPort: "443", Hierarchical: true, AllowsCredentials: true, Special: true},
+		{Name: "ftp", DefaultPort: "21", Hierarchical: true, AllowsCredentials: true, Special: true},
+		{Name: "file", Hierarchical: true, Special: true},
+
+		// Other hierarchical schemes seen in SSRF and protocol smuggling work
+		{Name: "dict", DefaultPort: "2628", Hierarchical: true, AllowsCredentials: true},
+		{Name: "git", DefaultPort: "9418", Hierarchical: true},
+		{Name: "gopher", DefaultPort: "70", Hierarchical: true},
+		{Name: "imap", DefaultPort: "143", Hierarchical: true, AllowsCredentials: true},
+		{Name: "irc", DefaultPort: "6667", Hierarchical: true},
+		{Name: "ldap", DefaultPort: "389", Hierarchical: true, AllowsCredentials: true},
+		{Name: "ldaps", DefaultPort: "636", Hierarchical: true, AllowsCredentials: true},
+		{Name: "mongodb", DefaultPort: "27017", Hierarchical: true, AllowsCredentials: true},
+		{Name: "mysql", DefaultPort: "3306", Hierarchical: true, AllowsCredentials: true},
+		{Name: "pop3", DefaultPort: "110", Hierarchical: true, AllowsCredentials: true},
+		{Name: "postgres", DefaultPort: "5432", Hierarchical: true, AllowsCredentials: true},
+		{Name: "postgresql", DefaultPort: "5432", Hierarchical: true, AllowsCredentials: true},
+		{Name: "redis", DefaultPort: "6379", Hierarchical: true, AllowsCredentials: true},
+		{Name: "rtsp", DefaultPort: "554", Hierarchical: true, AllowsCredentials: true},
+		{Name: "sftp", DefaultPort: "22", Hierarchical: true, AllowsCredentials: true},
+		{Name: "smb", DefaultPort: "445", Hierarchical: true, AllowsCredentials: true},
+		{Name: "smtp", DefaultPort: "25", Hierarchical: true, AllowsCredentials: true},
+		{Name: "ssh", DefaultPort: "22", Hierarchical: true, AllowsCredentials: true},
+		{Name: "telnet", DefaultPort: "23", Hierarchical: true, AllowsCredentials: true},
+		{Name: "tftp", DefaultPort: "69", Hierarchical: true},
+
+		// Opaque schemes
+		{Name: "about"},
+		{Name: "blob"},
+		{Name: "data"},
+		{Name: "javascript"},
+		{Name: "mailto"},
+		{Name: "tel"},
+		{Name: "urn"},
+	} {
+		schemes[info.Name] = info
+	}
+}
+
+// RegisterScheme adds or replaces a scheme in the registry
+func RegisterScheme(info SchemeInfo) error {
+	name := strings.ToLower(info.Name)
+	if !validScheme(name) {
+		return ErrInvalidScheme
+	}
+	if info.DefaultPort != "" && !validOptionalPort(":"+info.DefaultPort) {
+		return ErrInvalidScheme
+	}
+	info.Name = name
+
+	schemesMu.Lock()
+	schemes[name] = info
+	schemesMu.Unlock()
+	return nil
+}
+
+// LookupScheme returns the registry entry of a scheme, case-insensitively
+func LookupScheme(name string) (SchemeInfo, bool) {
+	schemesMu.RLock()
+	info, ok := schemes[strings.ToLower(name)]
+	schemesMu.RUnlock()
+	return info, ok
+}
+
+// SchemeInfo returns the registry entry of the URL's scheme
+func (u *RawURL) SchemeInfo() (SchemeInfo, bool) {
+	return LookupScheme(u.Scheme)
+}
+
+// EffectivePort returns the port a connection will use: the explicit port
+// exactly as written, or the scheme's default port. Returns empty string when
+// neither is known.
+func (u *RawURL) EffectivePort() string {
+	if u.Port != "" {
+		return u.Port
+	}
+	info, _ := u.SchemeInfo()
+	return info.DefaultPort
+}
+
+// HasDefaultPort reports whether the URL has an explicit port equal to the
+// scheme's default port, so https://a:443 and https://a:0443 are true while
+// https://a (no explicit port) is false
+func (u *RawURL) HasDefaultPort() bool {
+	if u.Port == "" {
+		return false
+	}
+	info, ok := u.SchemeInfo()
+	if !ok || info.DefaultPort == "" {
+		return false
+	}
+	port, err := strconv.ParseUint(u.Port, 10, 16)
+	if err != nil {
+		return false
+	}
+	return strconv.FormatUint(port, 10) == info.DefaultPort
+}
+
+// validScheme reports whether s matches ALPHA *( ALPHA / DIGIT / "+" / "-" / "." )
+func validScheme(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z':
+		case i > 0 && (('0' <= c && c <= '9') || c == '+' || c == '-' || c == '.'):
+		default:
+			return false
+		}
+	}
+	return true
+}
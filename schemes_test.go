@@ -0,0 +1,103 @@
+package rawurlparser
+
+import "testing"
+
+func TestLookupScheme(t *testing.T) {
+	tests := []struct {
+		name   string
+		want   SchemeInfo
+		wantOk bool
+	}{
+		{"https", SchemeInfo{Name: "https", DefaultPort: "443", Hierarchical: true, AllowsCredentials: true, Special: true}, true},
+		{"HTTP", SchemeInfo{Name: "http", DefaultPort: "80", Hierarchical: true, AllowsCredentials: true, Special: true}, true},
+		{"file", SchemeInfo{Name: "file", Hierarchical: true, Special: true}, true},
+		{"gopher", SchemeInfo{Name: "gopher", DefaultPort: "70", Hierarchical: true}, true},
+		{"mailto", SchemeInfo{Name: "mailto"}, true},
+		{"unknown", SchemeInfo{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := LookupScheme(tt.name)
+			if ok != tt.wantOk || got != tt.want {
+				t.Errorf("LookupScheme(%q) = %+v, %v, want %+v, %v", tt.name, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestRegisterScheme(t *testing.T) {
+	t.Cleanup(func() {
+		schemesMu.Lock()
+		delete(schemes, "x-test+scheme")
+		schemesMu.Unlock()
+	})
+	if err := RegisterScheme(SchemeInfo{Name: "X-Test+Scheme", DefaultPort: "8443", Hierarchical: true}); err != nil {
+		t.Fatalf("RegisterScheme() error = %v", err)
+	}
+	info, ok := LookupScheme("x-test+scheme")
+	if !ok || info.Name != "x-test+scheme" || info.DefaultPort != "8443" {
+		t.Errorf("LookupScheme() = %+v, %v", info, ok)
+	}
+
+	u, err := RawURLParse("x-test+scheme://example.com/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := u.EffectivePort(); got != "8443" {
+		t.Errorf("EffectivePort() = %q, want 8443", got)
+	}
+
+	invalid := []SchemeInfo{
+		{Name: ""},
+		{Name: "1http"},
+		{Name: "ht tp"},
+		{Name: "good", DefaultPort: "80a"},
+	}
+	for _, info := range invalid {
+		if err := RegisterScheme(info); err == nil {
+			t.Errorf("RegisterScheme(%+v) expected error", info)
+		}
+		if _, ok := LookupScheme(info.Name); ok && info.Name == "good" {
+			t.Errorf("invalid scheme %q was registered", info.Name)
+		}
+	}
+}
+
+func TestEffectivePort(t *testing.T) {
+	tests := []struct {
+		url             string
+		wantPort        string
+		wantDefaultPort bool
+	}{
+		{"https://a.com/", "443", false},
+		{"https://a.com:443/", "443", true},
+		{"https://a.com:0443/", "0443", true},
+		{"HTTPS://a.com:443/", "443", true},
+		{"https://a.com:8443/", "8443", false},
+		{"http://a.com/", "80", false},
+		{"http://a.com:80/", "80", true},
+		{"ws://a.com:443/", "443", false},
+		{"ftp://a.com/", "21", false},
+		{"file:///etc/passwd", "", false},
+		{"gopher://127.0.0.1:70/_", "70", true},
+		{"unknown://a.com/", "", false},
+		{"unknown://a.com:1234/", "1234", false},
+		{"https://[::1]:443/", "443", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			u, err := RawURLParse(tt.url)
+			if err != nil {
+				t.Fatalf("RawURLParse() error = %v", err)
+			}
+			if got := u.EffectivePort(); got != tt.wantPort {
+				t.Errorf("EffectivePort() = %q, want %q", got, tt.wantPort)
+			}
+			if got := u.HasDefaultPort(); got != tt.wantDefaultPort {
+				t.Errorf("HasDefaultPort() = %v, want %v", got, tt.wantDefaultPort)
+			}
+		})
+	}
+}
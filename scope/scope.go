@@ -0,0 +1,424 @@
+// File: scope/scope.go
+/*
+Package scope decides whether raw URLs belong to a bug-bounty or pentest scope.
+
+Rules are matched against the components produced by rawurlparser, not a
+re-normalised net/url view: paths are compared exactly as sent, hosts only
+ignore ASCII case and a single trailing dot.
+*/
+package scope
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/slicingmelon/go-rawurlparser"
+)
+
+var ErrInvalidRule = errors.New("invalid scope rule")
+
+// Rule is a single include or exclude entry. Empty fields match anything.
+type Rule struct {
+	Host    string   `json:"host,omitempty"`    // Exact host, "*.example.com", IP address or CIDR range
+	Schemes []string `json:"schemes,omitempty"` // Allowed schemes, e.g. ["https"]
+	Ports   []string `json:"ports,omitempty"`   // Allowed ports or ranges, e.g. ["443", "8000-8100"]
+	Paths   []string `json:"paths,omitempty"`   // Path prefixes, or globs when they contain * ? or [
+	Source  string   `json:"-"`                 // The rule as written in the scope file
+	Line    int      `json:"-"`                 // Line number in text files, 0 for JSON
+
+	prefix   netip.Prefix // Set for IP and CIDR hosts
+	wildcard bool         // Set for "*.example.com" hosts
+	ports    [][2]int     // Compiled port ranges
+}
+
+// Scope is a compiled set of include and exclude rules
+type Scope struct {
+	Include []*Rule `json:"include"`
+	Exclude []*Rule `json:"exclude,omitempty"`
+}
+
+// Decision is the result of matching a URL against a scope
+type Decision struct {
+	InScope  bool  // Matched an include rule and no exclude rule
+	Excluded bool  // Matched an exclude rule
+	Rule     *Rule // The deciding rule, nil when nothing matched
+}
+
+// New compiles the given rules into a scope
+func New(include, exclude []*Rule) (*Scope, error) {
+	s := &Scope{Include: include, Exclude: exclude}
+	if err := s.compile(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// LoadFile reads a scope file, JSON if it starts with '{' and text otherwise
+func LoadFile(path string) (*Scope, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		return LoadJSON(bytes.NewReader(data))
+	}
+	return LoadText(bytes.NewReader(data))
+}
+
+// LoadJSON reads a scope in the form
+//
+//	{"include": [{"host": "*.example.com", "schemes": ["https"], "ports": ["443"], "paths": ["/api/"]}],
+//	 "exclude": [{"host": "admin.example.com"}]}
+func LoadJSON(r io.Reader) (*Scope, error) {
+	s := &Scope{}
+	if err := json.NewDecoder(r).Decode(s); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRule, err)
+	}
+	if err := s.compile(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// LoadText reads a scope with one rule per line. Lines starting with '#'
+// are comments, '!' or '-' marks an exclude rule. A rule is a host, wildcard
+// or CIDR range, optionally written as a URL:
+//
+//	*.example.com
+//	10.0.0.0/8
+//	https://app.example.com:443,8443/api/*
+//	!admin.example.com
+func LoadText(r io.Reader) (*Scope, error) {
+	s := &Scope{}
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		exclude := false
+		if text[0] == '!' || text[0] == '-' {
+			exclude = true
+			text = strings.TrimSpace(text[1:])
+		}
+
+		rule, err := ParseRule(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		rule.Line = line
+		if exclude {
+			s.Exclude = append(s.Exclude, rule)
+		} else {
+			s.Include = append(s.Include, rule)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// ParseRule parses a single text rule (see LoadText) without the exclude marker
+func ParseRule(text string) (*Rule, error) {
+	rule := &Rule{Source: text}
+	target := text
+
+	if i := strings.Index(target, "://"); i != -1 {
+		if scheme := target[:i]; scheme != "*" {
+			rule.Schemes = strings.Split(scheme, ",")
+		}
+		target = target[i+3:]
+	}
+
+	// CIDR ranges contain a '/', so cut them off before splitting off the path
+	cidr, target := cutCIDR(target)
+	if i := strings.IndexByte(target, '/'); i != -1 {
+		rule.Paths = []string{target[i:]}
+		target = target[:i]
+	}
+	ports := ""
+	if cidr != "" {
+		rule.Host = cidr
+		ports = strings.TrimPrefix(target, ":")
+	} else {
+		rule.Host, ports = splitRuleHostPorts(target)
+	}
+	if ports != "" {
+		rule.Ports = strings.Split(ports, ",")
+	}
+
+	if err := rule.compile(); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// Decide matches u against the scope. Exclude rules win over include rules.
+func (s *Scope) Decide(u *rawurlparser.RawURL) Decision {
+	for _, rule := range s.Exclude {
+		if rule.Match(u) {
+			return Decision{Excluded: true, Rule: rule}
+		}
+	}
+	for _, rule := range s.Include {
+		if rule.Match(u) {
+			return Decision{InScope: true, Rule: rule}
+		}
+	}
+	return Decision{}
+}
+
+// InScope reports whether u matches an include rule and no exclude rule
+func (s *Scope) InScope(u *rawurlparser.RawURL) bool {
+	return s.Decide(u).InScope
+}
+
+// Match reports whether u satisfies every constraint of the rule
+func (r *Rule) Match(u *rawurlparser.RawURL) bool {
+	return r.matchHost(u) && r.matchScheme(u) && r.matchPort(u) && r.matchPath(u)
+}
+
+// String returns the rule as written, or a text rule describing it
+func (r *Rule) String() string {
+	if r.Source != "" {
+		return r.Source
+	}
+	var buf strings.Builder
+	if len(r.Schemes) > 0 {
+		buf.WriteString(strings.Join(r.Schemes, ","))
+		buf.WriteString("://")
+	}
+	buf.WriteString(r.Host)
+	if len(r.Ports) > 0 {
+		buf.WriteByte(':')
+		buf.WriteString(strings.Join(r.Ports, ","))
+	}
+	buf.WriteString(strings.Join(r.Paths, " "))
+	return buf.String()
+}
+
+func (s *Scope) compile() error {
+	for _, rules := range [][]*Rule{s.Include, s.Exclude} {
+		for _, rule := range rules {
+			if rule == nil {
+				return fmt.Errorf("%w: empty rule", ErrInvalidRule)
+			}
+			if err := rule.compile(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *Rule) compile() error {
+	host := strings.ToLower(strings.TrimSuffix(r.Host, "."))
+	r.wildcard = strings.HasPrefix(host, "*.")
+	r.prefix = netip.Prefix{}
+
+	unbracketed := strings.NewReplacer("[", "", "]", "").Replace(host)
+	if prefix, err := netip.ParsePrefix(unbracketed); err == nil {
+		if a := prefix.Addr(); a.Is4In6() && prefix.Bits() >= 96 {
+			prefix = netip.PrefixFrom(a.Unmap(), prefix.Bits()-96)
+		}
+		r.prefix = prefix.Masked()
+	} else if addr, err := netip.ParseAddr(unbracketed); err == nil {
+		addr = addr.Unmap().WithZone("")
+		r.prefix = netip.PrefixFrom(addr, addr.BitLen())
+	} else if host != "*" && strings.ContainsAny(strings.TrimPrefix(host, "*."), "*/ ") {
+		return fmt.Errorf("%w: bad host %q", ErrInvalidRule, r.Host)
+	}
+
+	r.ports = nil
+	for _, p := range r.Ports {
+		lo, hi, ok := parsePortRange(p)
+		if !ok {
+			return fmt.Errorf("%w: bad port %q", ErrInvalidRule, p)
+		}
+		r.ports = append(r.ports, [2]int{lo, hi})
+	}
+	return nil
+}
+
+func (r *Rule) matchHost(u *rawurlparser.RawURL) bool {
+	if r.Host == "" || r.Host == "*" {
+		return true
+	}
+	if r.prefix.IsValid() {
+		// IPv4-mapped IPv6 hosts reach the IPv4 address, match them as such
+		c := u.Classify()
+		return c.IsIP() && r.prefix.Contains(c.Addr.Unmap().WithZone(""))
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname, "."))
+	want := strings.ToLower(strings.TrimSuffix(r.Host, "."))
+	if r.wildcard {
+		return strings.HasSuffix(host, want[1:])
+	}
+	return host == want
+}
+
+func (r *Rule) matchScheme(u *rawurlparser.RawURL) bool {
+	if len(r.Schemes) == 0 {
+		return true
+	}
+	for _, s := range r.Schemes {
+		if strings.EqualFold(s, u.Scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Rule) matchPort(u *rawurlparser.RawURL) bool {
+	if len(r.ports) == 0 {
+		return true
+	}
+	n, err := strconv.Atoi(u.EffectivePort())
+	if err != nil {
+		return false
+	}
+	for _, p := range r.ports {
+		if n >= p[0] && n <= p[1] {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Rule) matchPath(u *rawurlparser.RawURL) bool {
+	if len(r.Paths) == 0 {
+		return true
+	}
+	for _, p := range r.Paths {
+		if strings.ContainsAny(p, "*?[") {
+			if globMatch(p, u.Path) {
+				return true
+			}
+		} else if strings.HasPrefix(u.Path, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// cutCIDR splits a leading CIDR range such as "10.0.0.0/8" or
+// "[2001:db8::]/32" from the rest of a rule
+func cutCIDR(s string) (cidr, rest string) {
+	i := strings.IndexByte(s, '/')
+	if i == -1 {
+		return "", s
+	}
+	if _, err := netip.ParseAddr(strings.Trim(s[:i], "[]")); err != nil {
+		return "", s
+	}
+	j := i + 1
+	for j < len(s) && s[j] >= '0' && s[j] <= '9' {
+		j++
+	}
+	if j == i+1 {
+		return "", s
+	}
+	return s[:j], s[j:]
+}
+
+// splitRuleHostPorts splits "host:80,443" into host and port list,
+// leaving IPv6 literals intact
+func splitRuleHostPorts(s string) (host, ports string) {
+	i := strings.LastIndexByte(s, ':')
+	if i == -1 || strings.LastIndexByte(s, ']') > i || (strings.Count(s, ":") > 1 && !strings.HasPrefix(s, "[")) {
+		return s, ""
+	}
+	return s[:i], s[i+1:]
+}
+
+// parsePortRange parses "443" or "8000-8100"
+func parsePortRange(s string) (lo, hi int, ok bool) {
+	from, to, isRange := strings.Cut(strings.TrimSpace(s), "-")
+	lo, err := strconv.Atoi(from)
+	if err != nil || lo < 0 || lo > 65535 {
+		return 0, 0, false
+	}
+	hi = lo
+	if isRange {
+		hi, err = strconv.Atoi(to)
+		if err != nil || hi < lo || hi > 65535 {
+			return 0, 0, false
+		}
+	}
+	return lo, hi, true
+}
+
+// globMatch matches s against a glob where '*' matches any run of
+// characters (including '/'), '?' a single byte and [...] a byte class
+func globMatch(pattern, s string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for len(pattern) > 0 && pattern[0] == '*' {
+				pattern = pattern[1:]
+			}
+			if pattern == "" {
+				return true
+			}
+			for i := 0; i <= len(s); i++ {
+				if globMatch(pattern, s[i:]) {
+					return true
+				}
+			}
+			return false
+		case '?':
+			if s == "" {
+				return false
+			}
+		case '[':
+			end := strings.IndexByte(pattern, ']')
+			if end == -1 || s == "" {
+				return false
+			}
+			class := pattern[1:end]
+			negate := strings.HasPrefix(class, "!") || strings.HasPrefix(class, "^")
+			if negate {
+				class = class[1:]
+			}
+			if inByteClass(class, s[0]) == negate {
+				return false
+			}
+			pattern = pattern[end:]
+		default:
+			if s == "" || s[0] != pattern[0] {
+				return false
+			}
+		}
+		pattern = pattern[1:]
+		s = s[1:]
+	}
+	return s == ""
+}
+
+// inByteClass reports whether c is listed in a class such as "a-z0-9_"
+func inByteClass(class string, c byte) bool {
+	for i := 0; i < len(class); i++ {
+		if i+2 < len(class) && class[i+1] == '-' {
+			if class[i] <= c && c <= class[i+2] {
+				return true
+			}
+			i += 2
+			continue
+		}
+		if class[i] == c {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package scope
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/slicingmelon/go-rawurlparser"
+)
+
+const textScope = `
+# Main program
+*.example.com
+https://app.example.org:443,8443/api/*
+10.0.0.0/8:80,8000-8100
+[2001:db8::1]
+[fd00::]/8:443
+
+# Out of scope
+!admin.example.com
+-*.example.com/logout
+`
+
+func TestLoadText(t *testing.T) {
+	s, err := LoadText(strings.NewReader(textScope))
+	if err != nil {
+		t.Fatalf("LoadText returned error: %v", err)
+	}
+
+	testCases := []struct {
+		input    string
+		inScope  bool
+		excluded bool
+		rule     string
+	}{
+		{"https://www.example.com/", true, false, "*.example.com"},
+		{"https://WWW.Example.COM./x", true, false, "*.example.com"},
+		{"https://example.com/", false, false, ""},
+		{"https://admin.example.com/", false, true, "admin.example.com"},
+		{"https://admin.example.com./", false, true, "admin.example.com"},
+		{"https://www.example.com/logout", false, true, "*.example.com/logout"},
+		{"https://www.example.com/api/..;/logout", true, false, "*.example.com"},
+		{"https://app.example.org/api/v1/users", true, false, "https://app.example.org:443,8443/api/*"},
+		{"https://app.example.org:8443/api/x", true, false, "https://app.example.org:443,8443/api/*"},
+		{"http://app.example.org/api/x", false, false, ""},
+		{"https://app.example.org/%61pi/x", false, false, ""},
+		{"http://10.1.2.3/", true, false, "10.0.0.0/8:80,8000-8100"},
+		{"http://10.1.2.3:8080/", true, false, "10.0.0.0/8:80,8000-8100"},
+		{"http://10.1.2.3:9000/", false, false, ""},
+		{"http://0x0a.1/", true, false, "10.0.0.0/8:80,8000-8100"},
+		{"http://[2001:db8::1]:8080/", true, false, "[2001:db8::1]"},
+		{"https://[fd00:ec2::254]/", true, false, "[fd00::]/8:443"},
+		{"http://[fd00:ec2::254]/", false, false, ""},
+		{"http://192.168.0.1/", false, false, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			u, err := rawurlparser.RawURLParse(tc.input)
+			if err != nil {
+				t.Fatalf("Failed to parse URL %q: %v", tc.input, err)
+			}
+			d := s.Decide(u)
+			if d.InScope != tc.inScope || d.Excluded != tc.excluded {
+				t.Errorf("Decide() = %+v, want InScope %v, Excluded %v", d, tc.inScope, tc.excluded)
+			}
+			got := ""
+			if d.Rule != nil {
+				got = d.Rule.String()
+			}
+			if got != tc.rule {
+				t.Errorf("matched rule %q, want %q", got, tc.rule)
+			}
+		})
+	}
+}
+
+func TestExcludeMappedAndZonedLiterals(t *testing.T) {
+	s, err := LoadText(strings.NewReader("*\n!169.254.169.254\n!10.0.0.0/8\n![fe80::]/10\n![::ffff:192.168.0.0]/112\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, input := range []string{
+		"http://169.254.169.254/",
+		"http://[::ffff:169.254.169.254]/",
+		"http://[::ffff:a9fe:a9fe]/",
+		"http://[::ffff:10.0.0.1]/",
+		"http://[::ffff:a00:1]/",
+		"http://[0:0:0:0:0:ffff:10.1.2.3]:8080/",
+		"http://[fe80::1%25eth0]/",
+		"http://192.168.1.1/",
+		"http://[::ffff:192.168.1.1]/",
+	} {
+		u, err := rawurlparser.RawURLParse(input)
+		if err != nil {
+			t.Fatalf("Failed to parse URL %q: %v", input, err)
+		}
+		if d := s.Decide(u); d.InScope || !d.Excluded {
+			t.Errorf("Decide(%q) = %+v, want excluded", input, d)
+		}
+	}
+}
+
+func TestLoadJSON(t *testing.T) {
+	data := `{
+		"include": [{"host": "*.example.com", "schemes": ["https"], "paths": ["/api/"]}],
+		"exclude": [{"host": "internal.example.com"}]
+	}`
+	path := filepath.Join(t.TempDir(), "scope.json")
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := LoadFile(path)
+	if err != nil {
+		t.Fatalf("LoadFile returned error: %v", err)
+	}
+
+	for input, want := range map[string]bool{
+		"https://a.example.com/api/v1":        true,
+		"http://a.example.com/api/v1":         false,
+		"https://a.example.com/web":           false,
+		"https://internal.example.com/api/v1": false,
+	} {
+		u, _ := rawurlparser.RawURLParse(input)
+		if got := s.InScope(u); got != want {
+			t.Errorf("InScope(%q) = %v, want %v", input, got, want)
+		}
+	}
+}
+
+func TestParseRuleErrors(t *testing.T) {
+	for _, rule := range []string{"example.com:99999", "example.com:abc", "exa*mple.com", "10.0.0.0/8:80-70"} {
+		if _, err := ParseRule(rule); err == nil {
+			t.Errorf("ParseRule(%q) succeeded, want error", rule)
+		}
+	}
+}
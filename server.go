@@ -0,0 +1,90 @@
+package rawurlparser
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// FromRequest rebuilds the URL a client sent from r.RequestURI, r.Host and
+// the TLS state. r.URL is not used, net/http has already cleaned it.
+// Only server requests have a RequestURI.
+func FromRequest(r *http.Request) (*RawURL, error) {
+	if r.RequestURI == "" {
+		return nil, fmt.Errorf("%w: no RequestURI, not a server request", ErrInvalidRequest)
+	}
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return FromRequestTarget(scheme, r.Host, r.RequestURI)
+}
+
+// FromRequestTarget builds a RawURL from a request-target as it appeared in
+// the request line, in any of the four forms:
+//   - origin-form "/path?query" (or anything else) is kept byte for byte as
+//     RawRequestURI and combined with scheme and host
+//   - absolute-form "http://host/path" is parsed as it is, host is ignored
+//   - authority-form "host:port" (CONNECT) becomes Host with an empty path
+//   - asterisk-form "*" becomes the path "*"
+func FromRequestTarget(scheme, host, target string) (*RawURL, error) {
+	if target == "" {
+		return nil, fmt.Errorf("%w: empty request target", ErrInvalidRequest)
+	}
+
+	// absolute-form
+	if !strings.HasPrefix(target, "/") {
+		if i := strings.Index(target, "://"); i > 0 && validScheme(target[:i]) {
+			return RawURLParseStrict(target)
+		}
+	}
+
+	// authority-form
+	if target != "*" && !strings.HasPrefix(target, "/") && !strings.ContainsAny(target, "/?#") && strings.Contains(target, ":") {
+		u, err := RawURLParseStrict(scheme + "://" + target)
+		if err != nil {
+			return nil, err
+		}
+		u.Path, u.RawRequestURI = "", ""
+		u.Original = u.String()
+		return u, nil
+	}
+
+	return originFormURL(scheme, host, target)
+}
+
+// originFormURL combines scheme and host with target, kept byte for byte as
+// RawRequestURI and split the way RawURLParse does
+func originFormURL(scheme, host, target string) (*RawURL, error) {
+	u, err := RawURLParseStrict(scheme + "://" + host + "/")
+	if err != nil {
+		return nil, err
+	}
+
+	rest := target
+	u.Query, u.Fragment = "", ""
+	if i := strings.IndexByte(rest, '#'); i != -1 {
+		u.Fragment = rest[i+1:]
+		rest = rest[:i]
+	}
+	if i := strings.IndexByte(rest, '?'); i != -1 {
+		u.Query = rest[i+1:]
+		rest = rest[:i]
+	}
+	u.Path = rest
+	u.RawRequestURI = target
+	u.Original = scheme + "://" + host + target
+	return u, nil
+}
+
+// Middleware stores the RawURL of every request in its context, see
+// RawURLFromContext. Requests whose target cannot be rebuilt are passed on
+// unchanged.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if u, err := FromRequest(r); err == nil {
+			r = r.WithContext(ContextWithRawURL(r.Context(), u))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
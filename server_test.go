@@ -0,0 +1,146 @@
+package rawurlparser
+
+import (
+	"bufio"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFromRequestTarget(t *testing.T) {
+	tests := []struct {
+		scheme, host, target string
+		wantHost             string
+		wantPath             string
+		wantQuery            string
+		wantFragment         string
+		wantURI              string
+	}{
+		{"http", "example.com", "/a/../b;/%2e%2e?x=1&y#frag", "example.com", "/a/../b;/%2e%2e", "x=1&y", "frag", "/a/../b;/%2e%2e?x=1&y#frag"},
+		{"https", "example.com:8443", "//evil.com/x", "example.com:8443", "//evil.com/x", "", "", "//evil.com/x"},
+		{"http", "example.com", "@evil.com", "example.com", "@evil.com", "", "", "@evil.com"},
+		{"http", "example.com", `\..\admin`, "example.com", `\..\admin`, "", "", `\..\admin`},
+		{"http", "proxy.local", "http://target.com:8080/p?q", "target.com:8080", "/p", "q", "", "/p?q"},
+		{"http", "proxy.local", "target.com:443", "target.com:443", "", "", "", ""},
+		{"http", "example.com", "*", "example.com", "*", "", "", "*"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			u, err := FromRequestTarget(tt.scheme, tt.host, tt.target)
+			if err != nil {
+				t.Fatalf("FromRequestTarget() error = %v", err)
+			}
+			if u.Host != tt.wantHost || u.Path != tt.wantPath || u.Query != tt.wantQuery || u.Fragment != tt.wantFragment {
+				t.Errorf("got Host %q Path %q Query %q Fragment %q", u.Host, u.Path, u.Query, u.Fragment)
+			}
+			if got := u.GetRawRequestURI(); got != tt.wantURI {
+				t.Errorf("GetRawRequestURI() = %q, want %q", got, tt.wantURI)
+			}
+		})
+	}
+
+	if _, err := FromRequestTarget("http", "example.com", ""); err == nil {
+		t.Errorf("empty target should fail")
+	}
+}
+
+func TestFromRequestClientRequest(t *testing.T) {
+	r := httptest.NewRequest("GET", "/x", nil)
+	r.RequestURI = ""
+	if _, err := FromRequest(r); err == nil {
+		t.Errorf("FromRequest() without RequestURI should fail")
+	}
+
+	r = httptest.NewRequest("GET", "https://example.com/a/..%2f?x", nil)
+	u, err := FromRequest(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Scheme != "https" || u.Host != "example.com" || u.GetRawRequestURI() != "/a/..%2f?x" {
+		t.Errorf("FromRequest() = %s", u)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	srv := httptest.NewUnstartedServer(Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		u, ok := RawURLFromContext(r.Context())
+		if !ok {
+			http.Error(w, "no RawURL", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("X-Raw-Host", u.Host)
+		w.Header().Set("X-Raw-Target", u.GetRawRequestURI())
+	})))
+	srv.Config.DisableGeneralOptionsHandler = true
+	srv.Start()
+	defer srv.Close()
+	addr := srv.Listener.Addr().String()
+
+	tests := []struct {
+		method string
+		url    string
+		form   RequestForm
+		host   string
+		target string
+	}{
+		{"GET", "http://" + addr + "/a/../b/./c;x=1/%2e%2e?q=<>", OriginForm, addr, "/a/../b/./c;x=1/%2e%2e?q=<>"},
+		{"GET", "http://" + addr + "//double/slash", OriginForm, addr, "//double/slash"},
+		{"GET", "http://target.example:8080/abs/../path", AbsoluteForm, "target.example:8080", "/abs/../path"},
+		{"OPTIONS", "http://" + addr + "/", AsteriskForm, addr, "*"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.form.String()+" "+tt.url, func(t *testing.T) {
+			u, err := RawURLParse(tt.url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			conn, err := net.Dial("tcp", addr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer conn.Close()
+
+			opts := &RequestOptions{Method: tt.method, Form: tt.form, Header: []HeaderField{{"Connection", "close"}}}
+			if err := WriteRequest(conn, u, opts); err != nil {
+				t.Fatal(err)
+			}
+			resp, err := http.ReadResponse(bufio.NewReader(conn), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("status = %d", resp.StatusCode)
+			}
+			if got := resp.Header.Get("X-Raw-Host"); got != tt.host {
+				t.Errorf("host = %q, want %q", got, tt.host)
+			}
+			if got := resp.Header.Get("X-Raw-Target"); got != tt.target {
+				t.Errorf("target = %q, want %q", got, tt.target)
+			}
+		})
+	}
+}
+
+func TestMiddlewareConnect(t *testing.T) {
+	handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		u, ok := RawURLFromContext(r.Context())
+		if !ok || u.Host != "internal.example:443" || u.Path != "" {
+			t.Errorf("RawURL = %v, %v", u, ok)
+		}
+	}))
+
+	r := httptest.NewRequest("CONNECT", "http://internal.example:443", nil)
+	r.RequestURI = "internal.example:443"
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, r)
+
+	if !strings.HasPrefix(rec.Result().Status, "200") {
+		t.Errorf("status = %s", rec.Result().Status)
+	}
+}
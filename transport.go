@@ -0,0 +1,197 @@
+package rawurlparser
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// rawURLContextKey is the context key for the RawURL of a request
+type rawURLContextKey struct{}
+
+// ContextWithRawURL returns a copy of ctx carrying u
+func ContextWithRawURL(ctx context.Context, u *RawURL) context.Context {
+	return context.WithValue(ctx, rawURLContextKey{}, u)
+}
+
+// RawURLFromContext returns the RawURL stored by ContextWithRawURL
+func RawURLFromContext(ctx context.Context) (*RawURL, bool) {
+	u, ok := ctx.Value(rawURLContextKey{}).(*RawURL)
+	return u, ok && u != nil
+}
+
+// Transport is an http.RoundTripper that writes requests itself, so the
+// request-target of a RawURL goes onto the wire byte for byte. net/http's
+// path cleaning and re-escaping are skipped entirely; responses are read
+// with http.ReadResponse. Every request uses a fresh connection.
+//
+// The RawURL is taken from the request context (see NewRequest and
+// ContextWithRawURL). Requests without one, and redirects followed by
+// http.Client, are sent to req.URL.
+type Transport struct {
+	// DialContext dials plain TCP connections, a net.Dialer when nil
+	DialContext func(ctx context.Context, network, addr string) (net.Conn, error)
+
+	// TLSClientConfig is used for https and wss, ServerName defaults to the hostname
+	TLSClientConfig *tls.Config
+}
+
+// NewClient returns an http.Client using a Transport
+func NewClient() *http.Client {
+	return &http.Client{Transport: &Transport{}}
+}
+
+// NewRequest returns an http.Request for u that Transport sends with u's
+// raw request-target. req.URL is set from ToStdURL, so other transports
+// send the same path, but only Transport guarantees the exact bytes.
+func NewRequest(ctx context.Context, method string, u *RawURL, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ContextWithRawURL(ctx, u), method, "", body)
+	if err != nil {
+		return nil, err
+	}
+	req.URL = u.ToStdURL()
+	req.Host = u.Host
+	return req, nil
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	u, ok := RawURLFromContext(req.Context())
+	if !ok || req.Response != nil {
+		var err error
+		if u, err = FromStdURL(req.URL); err != nil {
+			closeBody(req)
+			return nil, err
+		}
+	}
+
+	opts := &RequestOptions{Method: req.Method, Host: req.Host}
+	if opts.Host == "" {
+		opts.Host = u.Host
+	}
+	keys := make([]string, 0, len(req.Header))
+	for k := range req.Header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		// opts.Host is the Host header, a copy here would send it twice
+		if strings.EqualFold(k, "Host") {
+			continue
+		}
+		for _, v := range req.Header[k] {
+			opts.Header = append(opts.Header, HeaderField{Name: k, Value: v})
+		}
+	}
+	if req.Header.Get("Connection") == "" {
+		opts.Header = append(opts.Header, HeaderField{Name: "Connection", Value: "close"})
+	}
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		opts.Body = body
+	}
+
+	conn, err := t.dial(req.Context(), u)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unblock reads and writes when the request is cancelled
+	stop := context.AfterFunc(req.Context(), func() { conn.Close() })
+
+	if err := WriteRequest(conn, u, opts); err != nil {
+		stop()
+		conn.Close()
+		return nil, err
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(conn), req)
+	if err != nil {
+		stop()
+		conn.Close()
+		return nil, err
+	}
+	resp.Body = &connBody{ReadCloser: resp.Body, conn: conn, stop: stop}
+	return resp, nil
+}
+
+// dial opens a connection to the URL's host and effective port
+func (t *Transport) dial(ctx context.Context, u *RawURL) (net.Conn, error) {
+	port := u.EffectivePort()
+	if port == "" {
+		return nil, fmt.Errorf("%w: no port for scheme %q", ErrInvalidRequest, u.Scheme)
+	}
+	host, addr := u.Hostname, ""
+	if strings.HasPrefix(host, "[") {
+		lit, err := ParseIPv6Literal(host)
+		if err != nil {
+			return nil, err
+		}
+		if lit.IsFuture() {
+			return nil, fmt.Errorf("%w: cannot dial IPvFuture %q", ErrInvalidRequest, host)
+		}
+		// The zone is percent-encoded in the URL, the dialer wants it decoded
+		host = lit.Addr.String()
+		addr = net.JoinHostPort(lit.Addr.WithZone(lit.Zone).String(), port)
+	} else {
+		addr = net.JoinHostPort(host, port)
+	}
+
+	dial := t.DialContext
+	if dial == nil {
+		dial = (&net.Dialer{}).DialContext
+	}
+	conn, err := dial(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(u.Scheme) {
+	case "https", "wss":
+		cfg := &tls.Config{}
+		if t.TLSClientConfig != nil {
+			cfg = t.TLSClientConfig.Clone()
+		}
+		if cfg.ServerName == "" {
+			cfg.ServerName = host
+		}
+		cfg.NextProtos = []string{"http/1.1"}
+		tlsConn := tls.Client(conn, cfg)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		return tlsConn, nil
+	}
+	return conn, nil
+}
+
+// connBody closes the connection together with the response body
+type connBody struct {
+	io.ReadCloser
+	conn net.Conn
+	stop func() bool
+}
+
+func (b *connBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.stop()
+	b.conn.Close()
+	return err
+}
+
+// closeBody closes the request body, as RoundTrip must on every error
+func closeBody(req *http.Request) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+}
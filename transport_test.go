@@ -0,0 +1,227 @@
+package rawurlparser
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// recordingServer records the raw RequestURI and Host of every request
+func recordingServer(t *testing.T, tlsServer bool) (*httptest.Server, func() []string) {
+	var mu sync.Mutex
+	var seen []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		seen = append(seen, r.Method+" "+r.RequestURI+" "+r.Host+" "+string(body))
+		mu.Unlock()
+		if r.RequestURI == "/redirect" {
+			http.Redirect(w, r, "/final?x=1", http.StatusFound)
+			return
+		}
+		w.Header().Set("X-Seen", r.RequestURI)
+		io.WriteString(w, "ok")
+	})
+
+	var srv *httptest.Server
+	if tlsServer {
+		srv = httptest.NewTLSServer(handler)
+	} else {
+		srv = httptest.NewServer(handler)
+	}
+	t.Cleanup(srv.Close)
+	return srv, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), seen...)
+	}
+}
+
+func TestTransportRawPath(t *testing.T) {
+	srv, seen := recordingServer(t, false)
+
+	paths := []string{
+		"/a/../b/./c",
+		"/%2e%2e/admin",
+		"//double//slash",
+		"/path;jsessionid=1/..;/x",
+		"/unicode/é",
+		"/?q=<script>&x=%00",
+	}
+
+	client := NewClient()
+	for _, path := range paths {
+		t.Run(path, func(t *testing.T) {
+			u, err := RawURLParse(srv.URL + path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req, err := NewRequest(context.Background(), "GET", u, nil)
+			if err != nil {
+				t.Fatalf("NewRequest() error = %v", err)
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("Do() error = %v", err)
+			}
+			defer resp.Body.Close()
+			body, _ := io.ReadAll(resp.Body)
+
+			if resp.StatusCode != http.StatusOK || string(body) != "ok" {
+				t.Fatalf("response = %d %q", resp.StatusCode, body)
+			}
+			if got := resp.Header.Get("X-Seen"); got != path {
+				t.Errorf("server saw %q, want %q", got, path)
+			}
+		})
+	}
+
+	if n := len(seen()); n != len(paths) {
+		t.Errorf("server saw %d requests, want %d", n, len(paths))
+	}
+}
+
+func TestTransportHostAndBody(t *testing.T) {
+	srv, seen := recordingServer(t, false)
+
+	u, err := RawURLParse(srv.URL + "/submit/..;/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := NewRequest(context.Background(), "POST", u, strings.NewReader("a=1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Host = "internal.example"
+
+	resp, err := NewClient().Do(req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	resp.Body.Close()
+
+	want := "POST /submit/..;/ internal.example a=1"
+	if got := seen(); len(got) != 1 || got[0] != want {
+		t.Errorf("server saw %q, want %q", got, want)
+	}
+}
+
+func TestTransportHostHeader(t *testing.T) {
+	srv, seen := recordingServer(t, false)
+
+	u, err := RawURLParse(srv.URL + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := NewRequest(context.Background(), "GET", u, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Host = "internal.example"
+	req.Header.Set("Host", "other.example")
+
+	resp, err := NewClient().Do(req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	resp.Body.Close()
+
+	// net/http answers 400 to a request with two Host headers
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	want := "GET / internal.example "
+	if got := seen(); len(got) != 1 || got[0] != want {
+		t.Errorf("server saw %q, want %q", got, want)
+	}
+}
+
+func TestTransportDialAddr(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"http://example.com/", "example.com:80"},
+		{"https://example.com:8443/", "example.com:8443"},
+		{"http://[::1]:8080/", "[::1]:8080"},
+		{"http://[fe80::1%25eth0]/", "[fe80::1%eth0]:80"},
+		{"http://[fe80::1%25en%2D1]:81/", "[fe80::1%en-1]:81"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			u, err := RawURLParse(tt.url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got string
+			tr := &Transport{DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				got = addr
+				return nil, errors.New("not dialing")
+			}}
+			tr.dial(context.Background(), u)
+			if got != tt.want {
+				t.Errorf("dial addr = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransportTLSAndRedirect(t *testing.T) {
+	srv, seen := recordingServer(t, true)
+
+	client := &http.Client{Transport: &Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}}
+	u, err := RawURLParse(srv.URL + "/redirect")
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := NewRequest(context.Background(), "GET", u, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	resp.Body.Close()
+
+	got := seen()
+	if len(got) != 2 || !strings.HasPrefix(got[0], "GET /redirect ") || !strings.HasPrefix(got[1], "GET /final?x=1 ") {
+		t.Errorf("server saw %q", got)
+	}
+}
+
+func TestTransportWithoutRawURL(t *testing.T) {
+	srv, seen := recordingServer(t, false)
+
+	req, err := http.NewRequest("GET", srv.URL+"/plain?x=1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := (&Transport{}).RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+	resp.Body.Close()
+
+	if got := seen(); len(got) != 1 || !strings.HasPrefix(got[0], "GET /plain?x=1 ") {
+		t.Errorf("server saw %q", got)
+	}
+}
+
+func TestRawURLContext(t *testing.T) {
+	if _, ok := RawURLFromContext(context.Background()); ok {
+		t.Errorf("RawURLFromContext() found a URL in an empty context")
+	}
+	u, _ := RawURLParse("https://example.com/x")
+	got, ok := RawURLFromContext(ContextWithRawURL(context.Background(), u))
+	if !ok || got != u {
+		t.Errorf("RawURLFromContext() = %v, %v", got, ok)
+	}
+}
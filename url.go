@@ -42,10 +42,27 @@ type Userinfo struct {
 	passwordSet bool
 }
 
+// Username returns the username exactly as written
+func (u *Userinfo) Username() string {
+	if u == nil {
+		return ""
+	}
+	return u.username
+}
+
+// Password returns the password exactly as written and whether one was set
+func (u *Userinfo) Password() (string, bool) {
+	if u == nil {
+		return "", false
+	}
+	return u.password, u.passwordSet
+}
+
 // ParseOptions contains configuration options for URL parsing
 type ParseOptions struct {
 	FallbackScheme     string // Default scheme if none provided
 	AllowMissingScheme bool   // If true, uses FallbackScheme when scheme is missing
+	ValidateIPv6       bool   // If true, rejects malformed IP-literals (Host is never rewritten)
 }
 
 // DefaultOptions returns the default parsing options
@@ -77,7 +94,7 @@ func RawURLParseWithOptions(rawURL string, opts *ParseOptions) (*RawURL, error)
 		// Check for scheme without //
 		if colonIndex := strings.Index(rawURL, ":"); colonIndex != -1 {
 			beforeColon := rawURL[:colonIndex]
-			if !strings.Contains(beforeColon, "/") && !strings.Contains(beforeColon, ".") {
+			if !strings.Contains(beforeColon, "/") && !strings.Contains(beforeColon, ".") && !strings.HasPrefix(beforeColon, "[") {
 				result.Scheme = beforeColon
 				result.Opaque = rawURL[colonIndex+1:]
 				return result, nil
@@ -124,6 +141,11 @@ func RawURLParseWithOptions(rawURL string, opts *ParseOptions) (*RawURL, error)
 
 		// Get the IPv6 address part
 		result.Host = authority[:closeBracket+1]
+		if opts != nil && opts.ValidateIPv6 {
+			if _, err := ParseIPv6Literal(result.Host); err != nil {
+				return nil, err
+			}
+		}
 
 		// Check for port after the IPv6 address
 		if len(authority) > closeBracket+1 {
@@ -0,0 +1,177 @@
+package rawurlparser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// WHATWGHost returns the host a browser would navigate to for ref, resolved
+// against base the way the WHATWG URL parser does: tabs and newlines are
+// removed, backslashes count as slashes, any number of slashes may follow a
+// special scheme and userinfo ends at the last '@'. The host is returned
+// serialized (lower case ASCII, canonical IPv4, bracketed IPv6). Relative
+// references keep base's host, base may be nil for absolute references.
+// Non-special schemes such as javascript: have no host and return "".
+func WHATWGHost(ref string, base *RawURL) (string, error) {
+	s := strings.TrimFunc(ref, func(r rune) bool { return r <= ' ' })
+	s = strings.NewReplacer("\t", "", "\n", "", "\r", "").Replace(s)
+
+	baseScheme := ""
+	if base != nil {
+		baseScheme = strings.ToLower(base.Scheme)
+	}
+
+	if scheme, rest, ok := cutWHATWGScheme(s); ok {
+		info, known := LookupScheme(scheme)
+		switch {
+		case !known || !info.Special || scheme == "file":
+			return "", nil
+		case scheme != baseScheme:
+			return whatwgAuthorityHost(strings.TrimLeft(rest, `/\`))
+		case strings.HasPrefix(rest, "//"):
+			return whatwgAuthorityHost(strings.TrimLeft(rest, `/\`))
+		}
+		s = rest
+	}
+
+	if base == nil {
+		return "", fmt.Errorf("%w: relative reference %q without base", ErrInvalidURL, ref)
+	}
+	// Relative reference: "//", "/\", "\\" and "\/" all start an authority
+	if len(s) >= 2 && isWHATWGSlash(s[0]) && isWHATWGSlash(s[1]) {
+		return whatwgAuthorityHost(strings.TrimLeft(s, `/\`))
+	}
+	return parseWHATWGHost(base.Hostname)
+}
+
+// cutWHATWGScheme splits a leading "scheme:" from s
+func cutWHATWGScheme(s string) (scheme, rest string, ok bool) {
+	i := strings.IndexByte(s, ':')
+	if i < 1 || !validScheme(s[:i]) {
+		return "", s, false
+	}
+	return strings.ToLower(s[:i]), s[i+1:], true
+}
+
+// whatwgAuthorityHost extracts and parses the host of an authority that
+// starts at the beginning of s
+func whatwgAuthorityHost(s string) (string, error) {
+	if i := strings.IndexAny(s, `/\?#`); i != -1 {
+		s = s[:i]
+	}
+	if i := strings.LastIndexByte(s, '@'); i != -1 {
+		s = s[i+1:]
+	}
+
+	host, port := s, ""
+	if strings.HasPrefix(s, "[") {
+		if i := strings.IndexByte(s, ']'); i != -1 {
+			host, port = s[:i+1], strings.TrimPrefix(s[i+1:], ":")
+		}
+	} else if i := strings.IndexByte(s, ':'); i != -1 {
+		host, port = s[:i], s[i+1:]
+	}
+	n := 0
+	for i := 0; i < len(port); i++ {
+		if port[i] < '0' || port[i] > '9' {
+			return "", fmt.Errorf("%w: invalid port %q", ErrInvalidURL, port)
+		}
+		if n = n*10 + int(port[i]-'0'); n > 65535 {
+			return "", fmt.Errorf("%w: port %q out of range", ErrInvalidURL, port)
+		}
+	}
+	return parseWHATWGHost(host)
+}
+
+// parseWHATWGHost runs the WHATWG host parser for special schemes on input:
+// IPv6 literals without zones, percent-decoding, IDNA, forbidden code points
+// and IPv4 numbers in any notation ParseIPv4 accepts
+func parseWHATWGHost(input string) (string, error) {
+	if input == "" {
+		return "", fmt.Errorf("%w: empty host", ErrInvalidURL)
+	}
+	if strings.HasPrefix(input, "[") {
+		lit, err := ParseIPv6Literal(input)
+		if err != nil || lit.IsFuture() || lit.Zone != "" {
+			return "", fmt.Errorf("%w: invalid IPv6 host %q", ErrInvalidURL, input)
+		}
+		return "[" + serializeIPv6(lit.Addr) + "]", nil
+	}
+
+	res, err := ToASCII(percentDecode(input))
+	if err != nil || res.Output == "" {
+		return "", fmt.Errorf("%w: invalid host %q", ErrInvalidURL, input)
+	}
+	domain := res.Output
+	for i := 0; i < len(domain); i++ {
+		if c := domain[i]; c <= ' ' || c == 0x7f || strings.IndexByte(`#%/:<>?@[\]^|`, c) != -1 {
+			return "", fmt.Errorf("%w: forbidden character %q in host %q", ErrInvalidURL, c, input)
+		}
+	}
+
+	if endsInNumber(domain) {
+		addr, err := ParseIPv4(domain)
+		if err != nil {
+			return "", fmt.Errorf("%w: invalid IPv4 host %q", ErrInvalidURL, input)
+		}
+		return addr.String(), nil
+	}
+	return domain, nil
+}
+
+// endsInNumber reports whether the last label of domain (ignoring a trailing
+// dot) is decimal or 0x-prefixed hex, which makes it an IPv4 host for WHATWG
+func endsInNumber(domain string) bool {
+	domain = strings.TrimSuffix(domain, ".")
+	last := domain[strings.LastIndexByte(domain, '.')+1:]
+	if last == "" {
+		return false
+	}
+	if isDigits(last) {
+		return true
+	}
+	if len(last) >= 2 && (last[:2] == "0x" || last[:2] == "0X") {
+		for i := 2; i < len(last); i++ {
+			if !isHexDigit(last[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// percentDecode decodes valid %XX escapes and leaves invalid ones as they are
+func percentDecode(s string) string {
+	if !strings.Contains(s, "%") {
+		return s
+	}
+	var buf strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '%' && i+2 < len(s) && isHexDigit(s[i+1]) && isHexDigit(s[i+2]) {
+			buf.WriteByte(unhex(s[i+1])<<4 | unhex(s[i+2]))
+			i += 2
+			continue
+		}
+		buf.WriteByte(s[i])
+	}
+	return buf.String()
+}
+
+// unhex returns the value of a hex digit
+func unhex(c byte) byte {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0'
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10
+	case 'A' <= c && c <= 'F':
+		return c - 'A' + 10
+	}
+	return 0
+}
+
+// isWHATWGSlash reports whether c separates path segments in special URLs
+func isWHATWGSlash(c byte) bool {
+	return c == '/' || c == '\\'
+}
@@ -0,0 +1,64 @@
+package rawurlparser
+
+import "testing"
+
+func TestWHATWGHost(t *testing.T) {
+	base, err := RawURLParse("https://Allowed.com/login")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		ref     string
+		want    string
+		wantErr bool
+	}{
+		{"https://evil.com/", "evil.com", false},
+		{"//evil.com", "evil.com", false},
+		{`/\evil.com`, "evil.com", false},
+		{`\\evil.com`, "evil.com", false},
+		{"///evil.com", "evil.com", false},
+		{"/\t/evil.com", "evil.com", false},
+		{" \n//evil.com", "evil.com", false},
+		{`https:\\evil.com`, "evil.com", false},
+		{"http:evil.com", "evil.com", false},
+		{"https:evil.com", "allowed.com", false},
+		{"https:/evil.com", "allowed.com", false},
+		{"/path", "allowed.com", false},
+		{"path?x=//evil.com", "allowed.com", false},
+		{"https://a@b@evil.com", "evil.com", false},
+		{`https://evil.com\@allowed.com`, "evil.com", false},
+		{"https://evil.com#@allowed.com", "evil.com", false},
+		{"https://EVIL.com:443/", "evil.com", false},
+		{"https://0x7f.1/", "127.0.0.1", false},
+		{"https://2130706433/", "127.0.0.1", false},
+		{"https://[0:0::1]/", "[::1]", false},
+		{"https://b%C3%BCcher.example/", "xn--bcher-kva.example", false},
+		{"javascript:alert(1)", "", false},
+		{"https://evil.com:80a/", "", true},
+		{"https://evil.com:65535/", "evil.com", false},
+		{"https://evil.com:000080/", "evil.com", false},
+		{"https://evil.com:65536/", "", true},
+		{"https://evil.com:99999999999999999999/", "", true},
+		{"https://1.2.3.4.5/", "", true},
+		{"https://ev<il.com/", "", true},
+		{"https://[fe80::1%25eth0]/", "", true},
+		{"https:///", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			got, err := WHATWGHost(tt.ref, base)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("WHATWGHost() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("WHATWGHost() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := WHATWGHost("/path", nil); err == nil {
+		t.Errorf("WHATWGHost() relative reference without base should fail")
+	}
+}